@@ -84,3 +84,36 @@ func TestABI(t *testing.T) {
 
 	}
 }
+
+func TestParseArgs(t *testing.T) {
+	data := []byte(`[{"type":"function","name":"f","inputs":[
+		{"name":"a","type":"address"},
+		{"name":"b","type":"uint256"},
+		{"name":"c","type":"uint8"},
+		{"name":"d","type":"bool"},
+		{"name":"e","type":"bytes32"},
+		{"name":"f","type":"string"}],
+		"outputs":[{"name":"","type":"uint256"}]}]`)
+	abi, err := abi.New(data)
+	assert.Nil(t, err)
+	method, _ := abi.MethodByName("f")
+
+	addr := thor.BytesToAddress([]byte("addr"))
+	key := thor.BytesToBytes32([]byte("key"))
+	args, err := method.ParseArgs([]string{addr.String(), "0x10", "255", "true", key.String(), "str"})
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{common.Address(addr), big.NewInt(16), uint8(255), true, [32]byte(key), "str"}, args)
+
+	_, err = method.EncodeInput(args...)
+	assert.Nil(t, err)
+
+	_, err = method.ParseArgs([]string{addr.String(), "1", "256", "true", key.String(), "str"})
+	assert.NotNil(t, err, "uint8 overflow")
+	_, err = method.ParseArgs([]string{addr.String()})
+	assert.NotNil(t, err, "count mismatch")
+
+	output, _ := method.EncodeOutput(big.NewInt(1))
+	values, err := method.DecodeOutputValues(output)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{big.NewInt(1)}, values)
+}
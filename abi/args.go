@@ -0,0 +1,97 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package abi
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+// EncodeArgs encode args to data without method id, e.g. for constructor.
+func (m *Method) EncodeArgs(args ...interface{}) ([]byte, error) {
+	return m.method.Inputs.Pack(args...)
+}
+
+// ParseArgs converts args in text form, into values of method input types.
+// Numbers are decimal or 0x prefixed hex, bytes are 0x prefixed hex.
+// Array types are not supported.
+func (m *Method) ParseArgs(args []string) ([]interface{}, error) {
+	inputs := m.method.Inputs
+	if len(args) != len(inputs) {
+		return nil, fmt.Errorf("argument count mismatch: want %v, got %v", len(inputs), len(args))
+	}
+	values := make([]interface{}, 0, len(args))
+	for i, arg := range args {
+		v, err := parseArg(inputs[i].Type, arg)
+		if err != nil {
+			return nil, fmt.Errorf("argument #%v (%v): %v", i, inputs[i].Type, err)
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// DecodeOutputValues decodes output data into a list of values.
+func (m *Method) DecodeOutputValues(output []byte) ([]interface{}, error) {
+	return m.method.Outputs.UnpackValues(output)
+}
+
+func parseArg(typ ethabi.Type, arg string) (interface{}, error) {
+	switch typ.T {
+	case ethabi.IntTy, ethabi.UintTy:
+		n, ok := math.ParseBig256(arg)
+		if !ok {
+			return nil, errors.New("invalid number")
+		}
+		if typ.Type == reflect.TypeOf(&big.Int{}) {
+			return n, nil
+		}
+		v := reflect.New(typ.Type).Elem()
+		if typ.T == ethabi.IntTy {
+			if !n.IsInt64() || v.OverflowInt(n.Int64()) {
+				return nil, errors.New("number overflow")
+			}
+			v.SetInt(n.Int64())
+		} else {
+			if n.Sign() < 0 || !n.IsUint64() || v.OverflowUint(n.Uint64()) {
+				return nil, errors.New("number overflow")
+			}
+			v.SetUint(n.Uint64())
+		}
+		return v.Interface(), nil
+	case ethabi.BoolTy:
+		return strconv.ParseBool(arg)
+	case ethabi.StringTy:
+		return arg, nil
+	case ethabi.AddressTy:
+		if !common.IsHexAddress(arg) {
+			return nil, errors.New("invalid address")
+		}
+		return common.HexToAddress(arg), nil
+	case ethabi.BytesTy:
+		return hexutil.Decode(arg)
+	case ethabi.FixedBytesTy:
+		data, err := hexutil.Decode(arg)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) != typ.Size {
+			return nil, fmt.Errorf("length should be %v", typ.Size)
+		}
+		v := reflect.New(typ.Type).Elem()
+		reflect.Copy(v, reflect.ValueOf(data))
+		return v.Interface(), nil
+	}
+	return nil, errors.New("unsupported type")
+}
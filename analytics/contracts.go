@@ -0,0 +1,205 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package analytics
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/runtime"
+	"github.com/vechain/thor/thor"
+)
+
+// ContractStats aggregated usage of a contract.
+type ContractStats struct {
+	Address  thor.Address
+	Calls    uint64
+	Failures uint64
+	GasUsed  uint64
+}
+
+func (s *ContractStats) add(other *ContractStats) {
+	s.Calls += other.Calls
+	s.Failures += other.Failures
+	s.GasUsed += other.GasUsed
+}
+
+func (s *ContractStats) sub(other *ContractStats) {
+	s.Calls -= other.Calls
+	s.Failures -= other.Failures
+	s.GasUsed -= other.GasUsed
+}
+
+type blockStats struct {
+	id        thor.Bytes32
+	number    uint32
+	contracts map[thor.Address]*ContractStats
+}
+
+// Contracts collects per-contract usage over a rolling window of recent trunk blocks.
+// It implements runtime.Hook.
+// Clauses with data, or creating contracts, are regarded as contract calls.
+type Contracts struct {
+	window int
+	lock   sync.Mutex
+	blocks []*blockStats // trunk blocks in the window, in order of number
+	total  map[thor.Address]*ContractStats
+	// recent blocks not on trunk, to be counted once they become trunk
+	branches map[thor.Bytes32]*blockStats
+	// of the block being committed
+	pending map[thor.Address]*ContractStats
+}
+
+var _ runtime.Hook = (*Contracts)(nil)
+
+// NewContracts create contracts analytics with the window of given count of blocks.
+func NewContracts(window int) *Contracts {
+	return &Contracts{
+		window:   window,
+		total:    make(map[thor.Address]*ContractStats),
+		branches: make(map[thor.Bytes32]*blockStats),
+		pending:  make(map[thor.Address]*ContractStats),
+	}
+}
+
+func (c *Contracts) stats(m map[thor.Address]*ContractStats, addr thor.Address) *ContractStats {
+	s, ok := m[addr]
+	if !ok {
+		s = &ContractStats{Address: addr}
+		m[addr] = s
+	}
+	return s
+}
+
+// OnTxExecuted implements runtime.Hook.
+func (c *Contracts) OnTxExecuted(executed *runtime.ExecutedTx) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	clauses := executed.Tx.Clauses()
+	for i, output := range executed.Outputs {
+		clause := clauses[i]
+		var addr thor.Address
+		switch {
+		case clause.To() == nil:
+			if output.ContractAddress == nil {
+				continue
+			}
+			addr = *output.ContractAddress
+		case len(clause.Data()) > 0:
+			addr = *clause.To()
+		default:
+			continue
+		}
+
+		s := c.stats(c.pending, addr)
+		s.Calls++
+		s.GasUsed += executed.GasUsed[i]
+		if output.VMErr != nil {
+			s.Failures++
+		}
+	}
+}
+
+func (c *Contracts) add(bs *blockStats) {
+	c.blocks = append(c.blocks, bs)
+	for addr, s := range bs.contracts {
+		c.stats(c.total, addr).add(s)
+	}
+}
+
+func (c *Contracts) remove(i int) *blockStats {
+	bs := c.blocks[i]
+	for addr, s := range bs.contracts {
+		t := c.stats(c.total, addr)
+		if t.sub(s); t.Calls == 0 {
+			delete(c.total, addr)
+		}
+	}
+	c.blocks = append(c.blocks[:i], c.blocks[i+1:]...)
+	return bs
+}
+
+// OnBlockCommitted implements runtime.Hook.
+// Blocks of branches are not counted, until they become trunk.
+func (c *Contracts) OnBlockCommitted(blk *block.Block, fork *chain.Fork) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	header := blk.Header()
+	bs := &blockStats{header.ID(), header.Number(), c.pending}
+	c.pending = make(map[thor.Address]*ContractStats)
+	if len(fork.Trunk) == 0 {
+		c.branches[bs.id] = bs
+		return
+	}
+
+	// blocks no longer on trunk
+	for _, h := range fork.Branch {
+		for i := len(c.blocks) - 1; i >= 0; i-- {
+			if c.blocks[i].id == h.ID() {
+				removed := c.remove(i)
+				c.branches[removed.id] = removed
+				break
+			}
+		}
+	}
+	// blocks becoming trunk, ending with the committed one
+	c.branches[bs.id] = bs
+	for _, h := range fork.Trunk {
+		if s, ok := c.branches[h.ID()]; ok {
+			delete(c.branches, h.ID())
+			c.add(s)
+		}
+	}
+
+	for len(c.blocks) > c.window {
+		c.remove(0)
+	}
+	for id, s := range c.branches {
+		if s.number+uint32(c.window) <= header.Number() {
+			delete(c.branches, id)
+		}
+	}
+}
+
+// Summary usage of contracts in the window.
+type Summary struct {
+	Blocks    int // count of blocks collected
+	FromBlock uint32
+	ToBlock   uint32
+	Contracts []*ContractStats
+}
+
+// Summary returns stats of at most limit contracts, in descending order of gas used.
+func (c *Contracts) Summary(limit int) *Summary {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	stats := make([]*ContractStats, 0, len(c.total))
+	for _, s := range c.total {
+		cpy := *s
+		stats = append(stats, &cpy)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].GasUsed != stats[j].GasUsed {
+			return stats[i].GasUsed > stats[j].GasUsed
+		}
+		return stats[i].Calls > stats[j].Calls
+	})
+	if len(stats) > limit {
+		stats = stats[:limit]
+	}
+
+	summary := &Summary{Blocks: len(c.blocks), Contracts: stats}
+	if len(c.blocks) > 0 {
+		summary.FromBlock = c.blocks[0].number
+		summary.ToBlock = c.blocks[len(c.blocks)-1].number
+	}
+	return summary
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package analytics_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/analytics"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/runtime"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestContracts(t *testing.T) {
+	var (
+		a    = thor.BytesToAddress([]byte("a"))
+		b    = thor.BytesToAddress([]byte("b"))
+		eoa  = thor.BytesToAddress([]byte("eoa"))
+		data = []byte{1, 2, 3, 4}
+	)
+
+	trx := new(tx.Builder).
+		Gas(1000000).
+		Clause(tx.NewClause(&a).WithData(data)).
+		Clause(tx.NewClause(&eoa)).
+		Clause(tx.NewClause(&b).WithData(data)).
+		Build()
+	executed := &runtime.ExecutedTx{
+		Tx:      trx,
+		Receipt: &tx.Receipt{Reverted: true},
+		Outputs: []*runtime.Output{{}, {}, {VMErr: errors.New("reverted")}},
+		GasUsed: []uint64{30000, 0, 5000},
+	}
+
+	c := analytics.NewContracts(2)
+	summary := c.Summary(10)
+	assert.Equal(t, 0, summary.Blocks)
+	assert.Equal(t, 0, len(summary.Contracts))
+
+	var headers []*block.Header
+	for i := uint32(1); i <= 3; i++ {
+		c.OnTxExecuted(executed)
+		b := new(block.Builder).ParentID(thor.Bytes32{0, 0, 0, byte(i - 1)}).Build()
+		headers = append(headers, b.Header())
+		c.OnBlockCommitted(b, &chain.Fork{Trunk: []*block.Header{b.Header()}})
+	}
+
+	summary = c.Summary(10)
+	assert.Equal(t, 2, summary.Blocks)
+	assert.Equal(t, uint32(2), summary.FromBlock)
+	assert.Equal(t, uint32(3), summary.ToBlock)
+	assert.Equal(t, []*analytics.ContractStats{
+		{Address: a, Calls: 2, GasUsed: 60000},
+		{Address: b, Calls: 2, Failures: 2, GasUsed: 10000},
+	}, summary.Contracts)
+
+	assert.Equal(t, 1, len(c.Summary(1).Contracts))
+
+	// a branch block is not counted
+	c.OnTxExecuted(executed)
+	branch := new(block.Builder).ParentID(headers[1].ID()).TotalScore(1).Build()
+	c.OnBlockCommitted(branch, &chain.Fork{Branch: []*block.Header{branch.Header()}})
+	assert.Equal(t, summary, c.Summary(10))
+
+	// until it becomes trunk, and the replaced block is uncounted
+	c.OnBlockCommitted(new(block.Builder).ParentID(branch.Header().ID()).Build(), &chain.Fork{
+		Trunk:  []*block.Header{branch.Header(), new(block.Builder).ParentID(branch.Header().ID()).Build().Header()},
+		Branch: []*block.Header{headers[2]},
+	})
+	summary = c.Summary(10)
+	assert.Equal(t, 2, summary.Blocks)
+	assert.Equal(t, uint32(3), summary.FromBlock)
+	assert.Equal(t, uint32(4), summary.ToBlock)
+	assert.Equal(t, []*analytics.ContractStats{
+		{Address: a, Calls: 1, GasUsed: 30000},
+		{Address: b, Calls: 1, Failures: 1, GasUsed: 5000},
+	}, summary.Contracts)
+}
@@ -0,0 +1,348 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package analytics
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/inconshreveable/log15"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/cache"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/kv"
+	"github.com/vechain/thor/poa"
+	"github.com/vechain/thor/runtime"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+var log = log15.New("pkg", "analytics")
+
+// maxPropagationDelay blocks received later than it are regarded as synced rather than propagated,
+// and not sampled for the delay.
+const maxPropagationDelay = time.Minute
+
+// ProposerStats block production of an authority.
+type ProposerStats struct {
+	Address  thor.Address // node master
+	Proposed uint64
+	Missed   uint64 // count of slots missed
+	// sum and count of delays between block timestamp and the block received from peers
+	Delay   time.Duration
+	Delayed uint64
+}
+
+// AvgDelay returns the average propagation delay, zero if not sampled.
+func (s *ProposerStats) AvgDelay() time.Duration {
+	if s.Delayed == 0 {
+		return 0
+	}
+	return s.Delay / time.Duration(s.Delayed)
+}
+
+var proposedBlockPrefix = []byte("proposedblock") // (prefix, block id) -> proposed block, of trunk blocks in the window
+
+func proposedBlockKey(id thor.Bytes32) []byte {
+	return append(append([]byte{}, proposedBlockPrefix...), id.Bytes()...)
+}
+
+// maxReceivedBlocks limits count of received blocks kept for their delays until committed.
+const maxReceivedBlocks = 1024
+
+type proposedBlock struct {
+	ID      thor.Bytes32
+	Number  uint32
+	Signer  thor.Address
+	Missed  []thor.Address
+	Delay   uint64 // in nanoseconds
+	Delayed bool
+}
+
+// Proposers collects per-authority block production over a rolling window of recent trunk blocks.
+// It implements runtime.Hook.
+// Slots between a block and its parent are regarded as missed by proposers scheduled for them.
+// Propagation delays are sampled when blocks are received from peers, so blocks packed locally or
+// synced are not sampled.
+// Trunk blocks collected are persisted, so that the window survives restarts.
+type Proposers struct {
+	chain        *chain.Chain
+	stateCreator *state.Creator
+	kv           kv.GetPutter
+	window       int
+	lock         sync.Mutex
+	blocks       []*proposedBlock // trunk blocks in the window, in order of number
+	total        map[thor.Address]*ProposerStats
+	// recent blocks not on trunk, to be counted once they become trunk
+	branches map[thor.Bytes32]*proposedBlock
+	// delays of blocks received, but not committed yet
+	received *cache.PrioCache
+}
+
+var _ runtime.Hook = (*Proposers)(nil)
+
+// NewProposers create proposers analytics with the window of given count of blocks.
+// Blocks in the window are loaded from kv, or collected again if not persisted.
+func NewProposers(chain *chain.Chain, stateCreator *state.Creator, kv kv.GetPutter, window int) *Proposers {
+	p := &Proposers{
+		chain:        chain,
+		stateCreator: stateCreator,
+		kv:           kv,
+		window:       window,
+		total:        make(map[thor.Address]*ProposerStats),
+		branches:     make(map[thor.Bytes32]*proposedBlock),
+		received:     cache.NewPrioCache(maxReceivedBlocks),
+	}
+	if err := p.load(); err != nil {
+		log.Warn("failed to load proposers analytics", "err", err)
+	}
+	return p
+}
+
+// load loads trunk blocks in the window.
+func (p *Proposers) load() error {
+	best := p.chain.BestBlock().Header()
+	seeker := p.chain.NewSeeker(best.ID())
+	var blocks []*proposedBlock
+	for num := best.Number(); num > 0 && len(blocks) < p.window; num-- {
+		id := seeker.GetID(num)
+		if err := seeker.Err(); err != nil {
+			return err
+		}
+		pb, err := p.loadProposedBlock(id)
+		if err != nil {
+			return err
+		}
+		blocks = append(blocks, pb)
+	}
+	for i := len(blocks) - 1; i >= 0; i-- {
+		p.add(blocks[i])
+	}
+	return nil
+}
+
+func (p *Proposers) loadProposedBlock(id thor.Bytes32) (*proposedBlock, error) {
+	data, err := p.kv.Get(proposedBlockKey(id))
+	if err != nil {
+		if !p.kv.IsNotFound(err) {
+			return nil, err
+		}
+		header, err := p.chain.GetBlockHeader(id)
+		if err != nil {
+			return nil, err
+		}
+		return p.newProposedBlock(header, 0, false)
+	}
+	var pb proposedBlock
+	if err := rlp.DecodeBytes(data, &pb); err != nil {
+		return nil, err
+	}
+	return &pb, nil
+}
+
+func (p *Proposers) stats(addr thor.Address) *ProposerStats {
+	s, ok := p.total[addr]
+	if !ok {
+		s = &ProposerStats{Address: addr}
+		p.total[addr] = s
+	}
+	return s
+}
+
+// OnBlockReceived samples the propagation delay of the block received from peers.
+func (p *Proposers) OnBlockReceived(blk *block.Block, receivedAt time.Time) {
+	delay := receivedAt.Sub(time.Unix(int64(blk.Header().Timestamp()), 0))
+	if delay >= maxPropagationDelay {
+		return
+	}
+	if delay < 0 {
+		delay = 0
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	// only the first receipt counts
+	if !p.received.Contains(blk.Header().ID()) {
+		p.received.Set(blk.Header().ID(), delay, float64(receivedAt.UnixNano()))
+	}
+}
+
+// OnTxExecuted implements runtime.Hook.
+func (p *Proposers) OnTxExecuted(*runtime.ExecutedTx) {}
+
+// OnBlockCommitted implements runtime.Hook.
+// Blocks of branches are not counted, until they become trunk.
+func (p *Proposers) OnBlockCommitted(blk *block.Block, fork *chain.Fork) {
+	header := blk.Header()
+
+	var (
+		delay   time.Duration
+		delayed bool
+	)
+	p.lock.Lock()
+	if entry := p.received.Remove(header.ID()); entry != nil {
+		delay, delayed = entry.Value.(time.Duration), true
+	}
+	p.lock.Unlock()
+
+	pb, err := p.newProposedBlock(header, delay, delayed)
+	if err != nil {
+		log.Debug("failed to collect block proposer", "id", header.ID(), "err", err)
+		return
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.branches[pb.ID] = pb
+	if len(fork.Trunk) == 0 {
+		return
+	}
+
+	batch := p.kv.NewBatch()
+	// blocks no longer on trunk
+	for _, h := range fork.Branch {
+		for i := len(p.blocks) - 1; i >= 0; i-- {
+			if p.blocks[i].ID == h.ID() {
+				removed := p.remove(i)
+				p.branches[removed.ID] = removed
+				batch.Delete(proposedBlockKey(removed.ID))
+				break
+			}
+		}
+	}
+	// blocks becoming trunk, ending with the committed one
+	for _, h := range fork.Trunk {
+		if b, ok := p.branches[h.ID()]; ok {
+			delete(p.branches, h.ID())
+			p.add(b)
+			if data, err := rlp.EncodeToBytes(b); err == nil {
+				batch.Put(proposedBlockKey(b.ID), data)
+			}
+		}
+	}
+
+	for len(p.blocks) > p.window {
+		batch.Delete(proposedBlockKey(p.remove(0).ID))
+	}
+	for id, b := range p.branches {
+		if b.Number+uint32(p.window) <= header.Number() {
+			delete(p.branches, id)
+		}
+	}
+	if err := batch.Write(); err != nil {
+		log.Warn("failed to save proposers analytics", "err", err)
+	}
+}
+
+func (p *Proposers) newProposedBlock(header *block.Header, delay time.Duration, delayed bool) (*proposedBlock, error) {
+	signer, err := header.Signer()
+	if err != nil {
+		return nil, err
+	}
+	pb := &proposedBlock{
+		ID:      header.ID(),
+		Number:  header.Number(),
+		Signer:  signer,
+		Delay:   uint64(delay),
+		Delayed: delayed,
+	}
+
+	parent, err := p.chain.GetBlockHeader(header.ParentID())
+	if err != nil {
+		return nil, err
+	}
+	if header.Timestamp() <= parent.Timestamp()+thor.BlockInterval {
+		return pb, nil
+	}
+
+	// the same way as consensus finds proposers to be deactivated
+	st, err := p.stateCreator.NewState(parent.StateRoot())
+	if err != nil {
+		return nil, err
+	}
+	poa.ActivateRotations(st, header.Number())
+	sched, err := poa.NewScheduler(signer, poa.ToProposers(poa.LoadCandidates(st)), parent.Number(), parent.Timestamp())
+	if err != nil {
+		return nil, err
+	}
+	t := header.Timestamp() - thor.BlockInterval
+	for i := uint64(0); i < thor.MaxBlockProposers && t > parent.Timestamp(); i++ {
+		pb.Missed = append(pb.Missed, sched.WhoseTurn(t).Address)
+		t -= thor.BlockInterval
+	}
+	return pb, nil
+}
+
+func (p *Proposers) add(pb *proposedBlock) {
+	p.blocks = append(p.blocks, pb)
+	s := p.stats(pb.Signer)
+	s.Proposed++
+	if pb.Delayed {
+		s.Delay += time.Duration(pb.Delay)
+		s.Delayed++
+	}
+	for _, addr := range pb.Missed {
+		p.stats(addr).Missed++
+	}
+}
+
+func (p *Proposers) remove(i int) *proposedBlock {
+	pb := p.blocks[i]
+	s := p.stats(pb.Signer)
+	s.Proposed--
+	if pb.Delayed {
+		s.Delay -= time.Duration(pb.Delay)
+		s.Delayed--
+	}
+	for _, addr := range pb.Missed {
+		p.stats(addr).Missed--
+	}
+	for _, addr := range append([]thor.Address{pb.Signer}, pb.Missed...) {
+		if s := p.total[addr]; s.Proposed == 0 && s.Missed == 0 {
+			delete(p.total, addr)
+		}
+	}
+	p.blocks = append(p.blocks[:i], p.blocks[i+1:]...)
+	return pb
+}
+
+// ProposersSummary block production of authorities in the window.
+type ProposersSummary struct {
+	Blocks    int // count of blocks collected
+	FromBlock uint32
+	ToBlock   uint32
+	Proposers []*ProposerStats
+}
+
+// Summary returns stats of authorities, in descending order of missed slots.
+func (p *Proposers) Summary() *ProposersSummary {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	stats := make([]*ProposerStats, 0, len(p.total))
+	for _, s := range p.total {
+		cpy := *s
+		stats = append(stats, &cpy)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Missed != stats[j].Missed {
+			return stats[i].Missed > stats[j].Missed
+		}
+		if stats[i].Proposed != stats[j].Proposed {
+			return stats[i].Proposed < stats[j].Proposed
+		}
+		return string(stats[i].Address[:]) < string(stats[j].Address[:])
+	})
+
+	summary := &ProposersSummary{Blocks: len(p.blocks), Proposers: stats}
+	if len(p.blocks) > 0 {
+		summary.FromBlock = p.blocks[0].Number
+		summary.ToBlock = p.blocks[len(p.blocks)-1].Number
+	}
+	return summary
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package analytics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/analytics"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+func TestProposers(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	stateCreator := state.NewCreator(kv)
+	b0, _, _ := genesis.NewDevnet().Build(stateCreator)
+	ch, err := chain.New(kv, b0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the only authority of devnet
+	a0 := genesis.DevAccounts()[0]
+	newBlock := func(parent *block.Header, skipped uint64, score uint64) (*block.Block, *chain.Fork) {
+		b := new(block.Builder).
+			ParentID(parent.ID()).
+			Timestamp(parent.Timestamp() + (skipped+1)*thor.BlockInterval).
+			TotalScore(parent.TotalScore() + score).
+			StateRoot(parent.StateRoot()).
+			Build()
+		sig, _ := crypto.Sign(b.Header().SigningHash().Bytes(), a0.PrivateKey)
+		b = b.WithSignature(sig)
+		fork, err := ch.AddBlock(b, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b, fork
+	}
+	receivedAfter := func(b *block.Block, delay time.Duration) time.Time {
+		return time.Unix(int64(b.Header().Timestamp()), 0).Add(delay)
+	}
+
+	p := analytics.NewProposers(ch, stateCreator, kv, 2)
+	summary := p.Summary()
+	assert.Equal(t, 0, summary.Blocks)
+	assert.Equal(t, 0, len(summary.Proposers))
+
+	parent := b0.Header()
+	var blocks []*block.Block
+	for i := uint64(0); i < 3; i++ {
+		b, fork := newBlock(parent, i, 2)
+		if i == 2 {
+			p.OnBlockReceived(b, receivedAfter(b, time.Second))
+			// only the first receipt counts
+			p.OnBlockReceived(b, receivedAfter(b, 3*time.Second))
+		}
+		p.OnBlockCommitted(b, fork)
+		parent = b.Header()
+		blocks = append(blocks, b)
+	}
+
+	summary = p.Summary()
+	assert.Equal(t, 2, summary.Blocks)
+	assert.Equal(t, uint32(2), summary.FromBlock)
+	assert.Equal(t, uint32(3), summary.ToBlock)
+	assert.Equal(t, []*analytics.ProposerStats{
+		{Address: a0.Address, Proposed: 2, Missed: 3, Delay: time.Second, Delayed: 1},
+	}, summary.Proposers)
+	assert.Equal(t, time.Second, summary.Proposers[0].AvgDelay())
+
+	// blocks of side chains not counted
+	side, fork := newBlock(blocks[1].Header(), 0, 1)
+	p.OnBlockCommitted(side, fork)
+	assert.Equal(t, summary, p.Summary())
+
+	// until they become trunk
+	b, fork := newBlock(side.Header(), 0, 2)
+	p.OnBlockReceived(b, receivedAfter(b, 3*time.Second))
+	p.OnBlockCommitted(b, fork)
+	summary = p.Summary()
+	assert.Equal(t, 2, summary.Blocks)
+	assert.Equal(t, uint32(3), summary.FromBlock)
+	assert.Equal(t, uint32(4), summary.ToBlock)
+	assert.Equal(t, []*analytics.ProposerStats{
+		{Address: a0.Address, Proposed: 2, Delay: 3 * time.Second, Delayed: 1},
+	}, summary.Proposers)
+
+	// blocks received too late regarded as synced
+	b, fork = newBlock(b.Header(), 0, 2)
+	p.OnBlockReceived(b, receivedAfter(b, time.Hour))
+	p.OnBlockCommitted(b, fork)
+	assert.Equal(t, []*analytics.ProposerStats{
+		{Address: a0.Address, Proposed: 2, Delay: 3 * time.Second, Delayed: 1},
+	}, p.Summary().Proposers)
+
+	// the window persisted
+	assert.Equal(t, p.Summary(), analytics.NewProposers(ch, stateCreator, kv, 2).Summary())
+	// and collected again without delays if not persisted
+	kv2, _ := lvldb.NewMem()
+	summary = analytics.NewProposers(ch, stateCreator, kv2, 4).Summary()
+	assert.Equal(t, 4, summary.Blocks)
+	assert.Equal(t, []*analytics.ProposerStats{
+		{Address: a0.Address, Proposed: 4, Missed: 1},
+	}, summary.Proposers)
+}
@@ -14,30 +14,77 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/gorilla/mux"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/usage"
 	"github.com/vechain/thor/api/utils"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/finality"
 	"github.com/vechain/thor/runtime"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/trie"
 	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/vm"
+	"github.com/vechain/thor/vm/disasm"
 	"github.com/vechain/thor/xenv"
 )
 
+const (
+	defaultStorageRangeLimit = 100
+	maxStorageRangeLimit     = 1000
+
+	maxCallLogMemory = 16 * 1024 * 1024 // limits memory held by logs emitted in a call
+)
+
 type Accounts struct {
 	chain        *chain.Chain
 	stateCreator *state.Creator
+	finality     *finality.Finality
 	callGasLimit uint64
+	snapshots    *lru.Cache
 }
 
-func New(chain *chain.Chain, stateCreator *state.Creator, callGasLimit uint64) *Accounts {
+func New(chain *chain.Chain, stateCreator *state.Creator, finality *finality.Finality, callGasLimit uint64) *Accounts {
+	snapshots, _ := lru.New(16)
 	return &Accounts{
 		chain,
 		stateCreator,
+		finality,
 		callGasLimit,
+		snapshots,
+	}
+}
+
+// newCallState creates state for contract calls, upon a cached snapshot,
+// so that concurrent calls on the same block share the read cache.
+func (a *Accounts) newCallState(root thor.Bytes32) (*state.State, error) {
+	if cached, ok := a.snapshots.Get(root); ok {
+		snapshot := cached.(*state.Snapshot)
+		if snapshot.Err() == nil {
+			return snapshot.NewState(), nil
+		}
+		a.snapshots.Remove(root)
+	}
+	snapshot, err := a.stateCreator.NewSnapshot(root)
+	if err != nil {
+		return nil, err
 	}
+	a.snapshots.Add(root, snapshot)
+	return snapshot.NewState(), nil
+}
+
+// stateError converts the error of missing trie nodes into a client error, since the state
+// of the revision is not available locally, e.g. pruned.
+func stateError(err error, header *block.Header) error {
+	if trie.IsMissingNode(err) {
+		return utils.BadRequest(errors.WithMessage(err,
+			fmt.Sprintf("revision: state of block %v unavailable, may be pruned", header.ID())))
+	}
+	return err
 }
 
 func (a *Accounts) getCode(addr thor.Address, stateRoot thor.Bytes32) ([]byte, error) {
@@ -58,15 +105,27 @@ func (a *Accounts) handleGetCode(w http.ResponseWriter, req *http.Request) error
 	if err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "address"))
 	}
-	h, err := a.handleRevision(req.URL.Query().Get("revision"))
+	h, err := utils.ParseRevision(a.chain, a.finality, req.URL.Query().Get("revision"))
 	if err != nil {
 		return err
 	}
 	code, err := a.getCode(addr, h.StateRoot())
 	if err != nil {
-		return err
+		return stateError(err, h)
+	}
+	result := &Code{Code: hexutil.Encode(code)}
+	if req.URL.Query().Get("disassemble") == "true" {
+		instrs := disasm.Disassemble(code)
+		result.Instructions = make([]string, 0, len(instrs))
+		for _, instr := range instrs {
+			result.Instructions = append(result.Instructions, instr.String())
+		}
+		result.Selectors = []string{}
+		for _, sel := range disasm.Selectors(instrs) {
+			result.Selectors = append(result.Selectors, hexutil.Encode(sel[:]))
+		}
 	}
-	return utils.WriteJSON(w, map[string]string{"code": hexutil.Encode(code)})
+	return utils.WriteJSON(w, result)
 }
 
 func (a *Accounts) getAccount(addr thor.Address, header *block.Header) (*Account, error) {
@@ -104,13 +163,13 @@ func (a *Accounts) handleGetAccount(w http.ResponseWriter, req *http.Request) er
 	if err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "address"))
 	}
-	h, err := a.handleRevision(req.URL.Query().Get("revision"))
+	h, err := utils.ParseRevision(a.chain, a.finality, req.URL.Query().Get("revision"))
 	if err != nil {
 		return err
 	}
 	acc, err := a.getAccount(addr, h)
 	if err != nil {
-		return err
+		return stateError(err, h)
 	}
 	return utils.WriteJSON(w, acc)
 }
@@ -124,23 +183,81 @@ func (a *Accounts) handleGetStorage(w http.ResponseWriter, req *http.Request) er
 	if err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "key"))
 	}
-	h, err := a.handleRevision(req.URL.Query().Get("revision"))
+	h, err := utils.ParseRevision(a.chain, a.finality, req.URL.Query().Get("revision"))
 	if err != nil {
 		return err
 	}
 	storage, err := a.getStorage(addr, key, h.StateRoot())
 	if err != nil {
-		return err
+		return stateError(err, h)
 	}
 	return utils.WriteJSON(w, map[string]string{"value": storage.String()})
 }
 
+// getStorageRange iterates storage entries of the account in order of hashed key, starting at cursor.
+func (a *Accounts) getStorageRange(addr thor.Address, cursor thor.Bytes32, limit int, stateRoot thor.Bytes32) (*StorageRange, error) {
+	state, err := a.stateCreator.NewState(stateRoot)
+	if err != nil {
+		return nil, err
+	}
+	result := &StorageRange{Storage: []StorageEntry{}}
+	if err := state.ForEachStorageFrom(addr, cursor, func(hashedKey, key thor.Bytes32, _ rlp.RawValue) bool {
+		if len(result.Storage) == limit {
+			result.NextCursor = &hashedKey
+			return false
+		}
+		// decoded the same way as a single storage value
+		result.Storage = append(result.Storage, StorageEntry{key, state.GetStorage(addr, key)})
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	if err := state.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (a *Accounts) handleGetStorageRange(w http.ResponseWriter, req *http.Request) error {
+	addr, err := thor.ParseAddress(mux.Vars(req)["address"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "address"))
+	}
+	query := req.URL.Query()
+	var cursor thor.Bytes32
+	if str := query.Get("cursor"); str != "" {
+		if cursor, err = thor.ParseBytes32(str); err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "cursor"))
+		}
+	}
+	limit := defaultStorageRangeLimit
+	if str := query.Get("limit"); str != "" {
+		n, err := strconv.ParseUint(str, 0, 32)
+		if err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "limit"))
+		}
+		if n == 0 || n > maxStorageRangeLimit {
+			return utils.BadRequest(errors.Errorf("limit: should be within [1, %v]", maxStorageRangeLimit))
+		}
+		limit = int(n)
+	}
+	h, err := utils.ParseRevision(a.chain, a.finality, query.Get("revision"))
+	if err != nil {
+		return err
+	}
+	result, err := a.getStorageRange(addr, cursor, limit, h.StateRoot())
+	if err != nil {
+		return stateError(err, h)
+	}
+	return utils.WriteJSON(w, result)
+}
+
 func (a *Accounts) handleCallContract(w http.ResponseWriter, req *http.Request) error {
 	callData := &CallData{}
 	if err := utils.ParseJSON(req.Body, &callData); err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "body"))
 	}
-	h, err := a.handleRevision(req.URL.Query().Get("revision"))
+	h, err := utils.ParseRevision(a.chain, a.finality, req.URL.Query().Get("revision"))
 	if err != nil {
 		return err
 	}
@@ -176,7 +293,7 @@ func (a *Accounts) handleCallBatchCode(w http.ResponseWriter, req *http.Request)
 	if err := utils.ParseJSON(req.Body, &batchCallData); err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "body"))
 	}
-	h, err := a.handleRevision(req.URL.Query().Get("revision"))
+	h, err := utils.ParseRevision(a.chain, a.finality, req.URL.Query().Get("revision"))
 	if err != nil {
 		return err
 	}
@@ -192,9 +309,9 @@ func (a *Accounts) batchCall(ctx context.Context, batchCallData *BatchCallData,
 	if err != nil {
 		return nil, err
 	}
-	state, err := a.stateCreator.NewState(header.StateRoot())
+	state, err := a.newCallState(header.StateRoot())
 	if err != nil {
-		return nil, err
+		return nil, stateError(err, header)
 	}
 	signer, _ := header.Signer()
 	rt := runtime.New(a.chain.NewSeeker(header.ParentID()), state,
@@ -205,34 +322,31 @@ func (a *Accounts) batchCall(ctx context.Context, batchCallData *BatchCallData,
 			Time:        header.Timestamp(),
 			GasLimit:    header.GasLimit(),
 			TotalScore:  header.TotalScore()})
+	rt.SetVMConfig(vm.Config{MaxLogMemory: maxCallLogMemory})
 	results = make(BatchCallResults, 0)
-	vmout := make(chan *runtime.Output, 1)
+	// clauses are executed as in a single tx
+	accessList := rt.NewAccessList(*caller, clauses)
 	for i, clause := range clauses {
-		exec, interrupt := rt.PrepareClause(clause, uint32(i), gas, &xenv.TransactionContext{
+		out, err := rt.ExecuteClauseContext(ctx, clause, uint32(i), gas, &xenv.TransactionContext{
 			Origin:     *caller,
 			GasPrice:   gasPrice,
-			ProvedWork: &big.Int{}})
-		go func() {
-			out, _ := exec()
-			vmout <- out
-		}()
-		select {
-		case <-ctx.Done():
-			interrupt()
-			return nil, ctx.Err()
-		case out := <-vmout:
-			if err := rt.Seeker().Err(); err != nil {
-				return nil, err
-			}
-			if err := state.Err(); err != nil {
-				return nil, err
-			}
-			results = append(results, convertCallResultWithInputGas(out, gas))
-			if out.VMErr != nil {
-				return results, nil
-			}
-			gas = out.LeftOverGas
+			ProvedWork: &big.Int{},
+			AccessList: accessList})
+		if err != nil {
+			return nil, err
 		}
+		if err := rt.Seeker().Err(); err != nil {
+			return nil, err
+		}
+		if err := state.Err(); err != nil {
+			return nil, stateError(err, header)
+		}
+		usage.AddGas(ctx, gas-out.LeftOverGas)
+		results = append(results, convertCallResultWithInputGas(out, gas))
+		if out.VMErr != nil {
+			return results, nil
+		}
+		gas = out.LeftOverGas
 	}
 	return results, nil
 }
@@ -249,6 +363,9 @@ func (a *Accounts) handleBatchCallData(batchCallData *BatchCallData) (gas uint64
 		gasPrice = new(big.Int)
 	} else {
 		gasPrice = (*big.Int)(batchCallData.GasPrice)
+		if !thor.IsUint256(gasPrice) {
+			return 0, nil, nil, nil, utils.BadRequest(errors.New("gasPrice: must be non-negative and within 256 bits"))
+		}
 	}
 	if batchCallData.Caller == nil {
 		caller = &thor.Address{}
@@ -262,6 +379,10 @@ func (a *Accounts) handleBatchCallData(batchCallData *BatchCallData) (gas uint64
 			value = new(big.Int)
 		} else {
 			value = (*big.Int)(c.Value)
+			if !thor.IsUint256(value) {
+				err = utils.BadRequest(fmt.Errorf("value[%d]: must be non-negative and within 256 bits", i))
+				return
+			}
 		}
 		var data []byte
 		if c.Data != "" {
@@ -276,47 +397,13 @@ func (a *Accounts) handleBatchCallData(batchCallData *BatchCallData) (gas uint64
 	return
 }
 
-func (a *Accounts) handleRevision(revision string) (*block.Header, error) {
-	if revision == "" || revision == "best" {
-		return a.chain.BestBlock().Header(), nil
-	}
-	if len(revision) == 66 || len(revision) == 64 {
-		blockID, err := thor.ParseBytes32(revision)
-		if err != nil {
-			return nil, utils.BadRequest(errors.WithMessage(err, "revision"))
-		}
-		h, err := a.chain.GetBlockHeader(blockID)
-		if err != nil {
-			if a.chain.IsNotFound(err) {
-				return nil, utils.BadRequest(errors.WithMessage(err, "revision"))
-			}
-			return nil, err
-		}
-		return h, nil
-	}
-	n, err := strconv.ParseUint(revision, 0, 0)
-	if err != nil {
-		return nil, utils.BadRequest(errors.WithMessage(err, "revision"))
-	}
-	if n > math.MaxUint32 {
-		return nil, utils.BadRequest(errors.WithMessage(errors.New("block number out of max uint32"), "revision"))
-	}
-	h, err := a.chain.GetTrunkBlockHeader(uint32(n))
-	if err != nil {
-		if a.chain.IsNotFound(err) {
-			return nil, utils.BadRequest(errors.WithMessage(err, "revision"))
-		}
-		return nil, err
-	}
-	return h, nil
-}
-
 func (a *Accounts) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
 
 	sub.Path("/*").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(a.handleCallBatchCode))
 	sub.Path("/{address}").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(a.handleGetAccount))
 	sub.Path("/{address}/code").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(a.handleGetCode))
+	sub.Path("/{address}/storage").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(a.handleGetStorageRange))
 	sub.Path("/{address}/storage/{key}").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(a.handleGetStorage))
 	sub.Path("").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(a.handleCallContract))
 	sub.Path("/{address}").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(a.handleCallContract))
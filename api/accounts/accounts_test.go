@@ -24,6 +24,7 @@ import (
 	ABI "github.com/vechain/thor/abi"
 	"github.com/vechain/thor/api/accounts"
 	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/finality"
 	"github.com/vechain/thor/genesis"
 	"github.com/vechain/thor/lvldb"
 	"github.com/vechain/thor/packer"
@@ -105,6 +106,7 @@ func TestAccount(t *testing.T) {
 	getAccount(t)
 	getCode(t)
 	getStorage(t)
+	getStorageRange(t)
 	deployContractWithCall(t)
 	callContract(t)
 	batchCall(t)
@@ -147,6 +149,14 @@ func getCode(t *testing.T) {
 	}
 	assert.Equal(t, runtimeBytecode, c, "code should be equal")
 	assert.Equal(t, http.StatusOK, statusCode, "OK")
+	res, statusCode = httpGet(t, ts.URL+"/accounts/"+contractAddr.String()+"/code?disassemble=true")
+	var disassembled accounts.Code
+	if err := json.Unmarshal(res, &disassembled); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "00000: PUSH1 0x80", disassembled.Instructions[0])
+	// selectors of set and add
+	assert.Equal(t, []string{"0x24b8ba5f", "0xbb4e3f4d"}, disassembled.Selectors)
 }
 
 func getStorage(t *testing.T) {
@@ -173,6 +183,23 @@ func getStorage(t *testing.T) {
 	assert.Equal(t, http.StatusOK, statusCode, "OK")
 }
 
+func getStorageRange(t *testing.T) {
+	_, statusCode := httpGet(t, ts.URL+"/accounts/"+contractAddr.String()+"/storage?cursor="+invalidBytes32)
+	assert.Equal(t, http.StatusBadRequest, statusCode, "bad cursor")
+
+	_, statusCode = httpGet(t, ts.URL+"/accounts/"+contractAddr.String()+"/storage?limit=0")
+	assert.Equal(t, http.StatusBadRequest, statusCode, "bad limit")
+
+	res, statusCode := httpGet(t, ts.URL+"/accounts/"+contractAddr.String()+"/storage?limit=1")
+	assert.Equal(t, http.StatusOK, statusCode, "OK")
+	var storage accounts.StorageRange
+	if err := json.Unmarshal(res, &storage); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []accounts.StorageEntry{{storageKey, thor.BytesToBytes32([]byte{storageValue})}}, storage.Storage)
+	assert.Nil(t, storage.NextCursor)
+}
+
 func initAccountServer(t *testing.T) {
 	db, _ := lvldb.NewMem()
 	stateC := state.NewCreator(db)
@@ -201,7 +228,7 @@ func initAccountServer(t *testing.T) {
 	packTx(chain, stateC, transactionCall, t)
 
 	router := mux.NewRouter()
-	accounts.New(chain, stateC, math.MaxUint64).Mount(router, "/accounts")
+	accounts.New(chain, stateC, finality.New(chain, stateC), math.MaxUint64).Mount(router, "/accounts")
 	ts = httptest.NewServer(router)
 }
 
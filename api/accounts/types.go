@@ -20,6 +20,26 @@ type Account struct {
 	HasCode bool                 `json:"hasCode"`
 }
 
+// Code code of account, along with disassembly if queried.
+type Code struct {
+	Code         string   `json:"code"`
+	Instructions []string `json:"instructions,omitempty"`
+	Selectors    []string `json:"selectors,omitempty"` // detected selectors of functions
+}
+
+// StorageEntry storage key and value, where value is presented as in single storage query.
+type StorageEntry struct {
+	Key   thor.Bytes32 `json:"key"`
+	Value thor.Bytes32 `json:"value"`
+}
+
+// StorageRange a page of storage entries.
+// NextCursor is used to query the next page, nil if no more entries.
+type StorageRange struct {
+	Storage    []StorageEntry `json:"storage"`
+	NextCursor *thor.Bytes32  `json:"nextCursor"`
+}
+
 //CallData represents contract-call body
 type CallData struct {
 	Value    *math.HexOrDecimal256 `json:"value"`
@@ -0,0 +1,103 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package activities
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/thor"
+)
+
+const (
+	defaultLimit = 10
+	maxLimit     = 256
+)
+
+type Activities struct {
+	db *logdb.LogDB
+}
+
+func New(db *logdb.LogDB) *Activities {
+	return &Activities{
+		db,
+	}
+}
+
+func parseUint(str string, def uint64) (uint64, error) {
+	if str == "" {
+		return def, nil
+	}
+	return strconv.ParseUint(str, 0, 64)
+}
+
+func (a *Activities) handleGetActivities(w http.ResponseWriter, req *http.Request) error {
+	addr, err := thor.ParseAddress(mux.Vars(req)["address"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "address"))
+	}
+	query := req.URL.Query()
+	offset, err := parseUint(query.Get("offset"), 0)
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "offset"))
+	}
+	limit, err := parseUint(query.Get("limit"), defaultLimit)
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "limit"))
+	}
+	if limit == 0 || limit > maxLimit {
+		return utils.BadRequest(errors.Errorf("limit: should be within [1, %v]", maxLimit))
+	}
+	filter := &logdb.ActivityFilter{
+		Address: addr,
+		Options: &logdb.Options{Offset: offset, Limit: limit},
+		Order:   logdb.ASC,
+	}
+	if query.Get("order") == string(logdb.DESC) {
+		filter.Order = logdb.DESC
+	}
+	if query.Get("from") != "" || query.Get("to") != "" {
+		from, err := parseUint(query.Get("from"), 0)
+		if err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "from"))
+		}
+		to, err := parseUint(query.Get("to"), math.MaxUint32)
+		if err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "to"))
+		}
+		if from > to {
+			return utils.BadRequest(errors.New("range: from greater than to"))
+		}
+		filter.Range = &logdb.Range{Unit: logdb.Block, From: from, To: to}
+	}
+
+	activities, err := a.db.FilterActivities(req.Context(), filter)
+	if err != nil {
+		if err == logdb.ErrActivityIndexDisabled {
+			return utils.HTTPError(err, http.StatusNotImplemented)
+		}
+		if logdb.IsPruned(err) {
+			return utils.BadRequest(err)
+		}
+		return err
+	}
+	results := make([]*Activity, len(activities))
+	for i, activity := range activities {
+		results[i] = convertActivity(activity)
+	}
+	return utils.WriteJSON(w, results)
+}
+
+func (a *Activities) Mount(root *mux.Router, pathPrefix string) {
+	sub := root.PathPrefix(pathPrefix).Subrouter()
+
+	sub.Path("/{address}").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(a.handleGetActivities))
+}
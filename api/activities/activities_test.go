@@ -0,0 +1,111 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package activities_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/api/activities"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/tx"
+)
+
+var ts *httptest.Server
+
+func TestActivities(t *testing.T) {
+	db := initActivityServer(t)
+	defer ts.Close()
+
+	acc := genesis.DevAccounts()[0]
+
+	// disabled
+	code, _ := httpGet(t, ts.URL+"/activities/"+acc.Address.String())
+	assert.Equal(t, http.StatusNotImplemented, code)
+
+	db.SetActivityIndex(true)
+	// blocks 2 to 11
+	header := new(block.Builder).Build().Header()
+	for i := 0; i < 10; i++ {
+		header = new(block.Builder).ParentID(header.ID()).Build().Header()
+		trx := new(tx.Builder).Nonce(uint64(i)).Build()
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), acc.PrivateKey)
+		trx = trx.WithSignature(sig)
+		assert.Nil(t, db.Prepare(header).TrackActivities(0, trx, acc.Address, &tx.Receipt{}).Commit())
+	}
+
+	getActivities := func(query string) []*activities.Activity {
+		code, body := httpGet(t, ts.URL+"/activities/"+acc.Address.String()+query)
+		assert.Equal(t, http.StatusOK, code, string(body))
+		var results []*activities.Activity
+		assert.Nil(t, json.Unmarshal(body, &results))
+		return results
+	}
+
+	results := getActivities("")
+	assert.Equal(t, 10, len(results))
+	assert.Equal(t, uint32(2), results[0].BlockNumber)
+
+	results = getActivities("?offset=2&limit=3&order=desc")
+	assert.Equal(t, 3, len(results))
+	assert.Equal(t, uint32(9), results[0].BlockNumber)
+	assert.Equal(t, uint32(7), results[2].BlockNumber)
+
+	results = getActivities("?from=3&to=5")
+	assert.Equal(t, 3, len(results))
+	assert.Equal(t, uint32(3), results[0].BlockNumber)
+
+	results = getActivities("?from=10")
+	assert.Equal(t, 2, len(results))
+
+	for _, query := range []string{"?limit=0", "?limit=257", "?offset=x", "?from=5&to=3", "?to=x"} {
+		code, _ := httpGet(t, ts.URL+"/activities/"+acc.Address.String()+query)
+		assert.Equal(t, http.StatusBadRequest, code, query)
+	}
+	code, _ = httpGet(t, ts.URL+"/activities/0x01")
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// pruned
+	db.SetRetention(5)
+	assert.Nil(t, db.Prune(context.Background(), 11))
+	code, _ = httpGet(t, ts.URL+"/activities/"+acc.Address.String()+"?from=1")
+	assert.Equal(t, http.StatusBadRequest, code)
+	results = getActivities("?from=7")
+	assert.Equal(t, 5, len(results))
+}
+
+func initActivityServer(t *testing.T) *logdb.LogDB {
+	db, err := logdb.NewMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+	router := mux.NewRouter()
+	activities.New(db).Mount(router, "/activities")
+	ts = httptest.NewServer(router)
+	return db
+}
+
+func httpGet(t *testing.T, url string) (int, []byte) {
+	res, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.StatusCode, body
+}
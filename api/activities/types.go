@@ -0,0 +1,30 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package activities
+
+import (
+	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/thor"
+)
+
+// Activity a tx touching the address.
+type Activity struct {
+	BlockID        thor.Bytes32 `json:"blockID"`
+	BlockNumber    uint32       `json:"blockNumber"`
+	BlockTimestamp uint64       `json:"blockTimestamp"`
+	TxIndex        uint32       `json:"txIndex"`
+	TxID           thor.Bytes32 `json:"txID"`
+}
+
+func convertActivity(activity *logdb.Activity) *Activity {
+	return &Activity{
+		BlockID:        activity.BlockID,
+		BlockNumber:    activity.BlockNumber,
+		BlockTimestamp: activity.BlockTime,
+		TxIndex:        activity.TxIndex,
+		TxID:           activity.TxID,
+	}
+}
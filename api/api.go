@@ -8,29 +8,83 @@ package api
 import (
 	"net/http"
 	"strings"
+	"time"
 
 	assetfs "github.com/elazarl/go-bindata-assetfs"
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/analytics"
 	"github.com/vechain/thor/api/accounts"
+	"github.com/vechain/thor/api/activities"
+	"github.com/vechain/thor/api/attestations"
 	"github.com/vechain/thor/api/blocks"
+	"github.com/vechain/thor/api/contracts"
 	"github.com/vechain/thor/api/debug"
 	"github.com/vechain/thor/api/doc"
 	"github.com/vechain/thor/api/events"
 	"github.com/vechain/thor/api/eventslegacy"
+	"github.com/vechain/thor/api/fees"
+	"github.com/vechain/thor/api/health"
 	"github.com/vechain/thor/api/node"
 	"github.com/vechain/thor/api/subscriptions"
 	"github.com/vechain/thor/api/transactions"
 	"github.com/vechain/thor/api/transfers"
 	"github.com/vechain/thor/api/transferslegacy"
+	"github.com/vechain/thor/attest"
 	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/evidence"
+	"github.com/vechain/thor/finality"
+	"github.com/vechain/thor/kv"
 	"github.com/vechain/thor/logdb"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/txpool"
+	"github.com/vechain/thor/verification"
 )
 
-//New return api router
-func New(chain *chain.Chain, stateCreator *state.Creator, txPool *txpool.TxPool, logDB *logdb.LogDB, nw node.Network, allowedOrigins string, backtraceLimit uint32, callGasLimit uint64) (http.HandlerFunc, func()) {
+// Modules names of all API modules.
+var Modules = []string{
+	"accounts",
+	"logs",
+	"blocks",
+	"transactions",
+	"fees",
+	"contracts",
+	"debug",
+	"node",
+	"health",
+	"attestations",
+	"subscriptions",
+}
+
+// module mounts its endpoints on the router.
+type module struct {
+	name  string
+	mount func(router *mux.Router)
+}
+
+// New return api router.
+// Only modules in enabledModules are mounted, and an error returned for unknown names.
+func New(
+	chain *chain.Chain,
+	db kv.Getter,
+	stateCreator *state.Creator,
+	txPool *txpool.TxPool,
+	logDB *logdb.LogDB,
+	nw node.Network,
+	evidencePool *evidence.Pool,
+	verified *verification.Store,
+	attester *attest.Attester,
+	contractsAnalytics *analytics.Contracts,
+	proposersAnalytics *analytics.Proposers,
+	assembler node.BlockAssembler,
+	allowedOrigins string,
+	backtraceLimit uint32,
+	callGasLimit uint64,
+	syncTolerance time.Duration,
+	subscriptionOptions subscriptions.Options,
+	enabledModules []string,
+) (http.HandlerFunc, func(), error) {
 	origins := strings.Split(strings.TrimSpace(allowedOrigins), ",")
 	for i, o := range origins {
 		origins[i] = strings.ToLower(strings.TrimSpace(o))
@@ -52,35 +106,91 @@ func New(chain *chain.Chain, stateCreator *state.Creator, txPool *txpool.TxPool,
 			http.Redirect(w, req, "doc/swagger-ui/", http.StatusTemporaryRedirect)
 		})
 
-	accounts.New(chain, stateCreator, callGasLimit).
-		Mount(router, "/accounts")
-	eventslegacy.New(logDB).
-		Mount(router, "/events")
-	transferslegacy.New(logDB).
-		Mount(router, "/transfers")
-	eventslegacy.New(logDB).
-		Mount(router, "/logs/events")
-	events.New(logDB).
-		Mount(router, "/logs/event")
-	transferslegacy.New(logDB).
-		Mount(router, "/logs/transfers")
-	transfers.New(logDB).
-		Mount(router, "/logs/transfer")
-	blocks.New(chain).
-		Mount(router, "/blocks")
-	transactions.New(chain, txPool).
-		Mount(router, "/transactions")
-	debug.New(chain, stateCreator).
-		Mount(router, "/debug")
-	node.New(nw).
-		Mount(router, "/node")
-	subs := subscriptions.New(chain, origins, backtraceLimit)
-	subs.Mount(router, "/subscriptions")
+	finality := finality.New(chain, stateCreator)
+	closer := func() {}
+
+	modules := []module{
+		{"accounts", func(router *mux.Router) {
+			accounts.New(chain, stateCreator, finality, callGasLimit).
+				Mount(router, "/accounts")
+		}},
+		{"logs", func(router *mux.Router) {
+			eventslegacy.New(chain, logDB).
+				Mount(router, "/events")
+			transferslegacy.New(chain, logDB).
+				Mount(router, "/transfers")
+			eventslegacy.New(chain, logDB).
+				Mount(router, "/logs/events")
+			events.New(chain, logDB).
+				Mount(router, "/logs/event")
+			transferslegacy.New(chain, logDB).
+				Mount(router, "/logs/transfers")
+			transfers.New(chain, logDB).
+				Mount(router, "/logs/transfer")
+			activities.New(logDB).
+				Mount(router, "/logs/activities")
+		}},
+		{"blocks", func(router *mux.Router) {
+			blocks.New(chain, finality).
+				Mount(router, "/blocks")
+		}},
+		{"transactions", func(router *mux.Router) {
+			transactions.New(chain, stateCreator, txPool, finality, callGasLimit).
+				Mount(router, "/transactions")
+		}},
+		{"fees", func(router *mux.Router) {
+			fees.New(chain, stateCreator, txPool).
+				Mount(router, "/fees")
+		}},
+		{"contracts", func(router *mux.Router) {
+			contracts.New(chain, stateCreator, verified).
+				Mount(router, "/contracts")
+		}},
+		{"debug", func(router *mux.Router) {
+			debug.New(chain, stateCreator).
+				Mount(router, "/debug")
+		}},
+		{"node", func(router *mux.Router) {
+			node.New(nw, chain, stateCreator, finality, evidencePool, txPool, contractsAnalytics, proposersAnalytics, assembler).
+				Mount(router, "/node")
+		}},
+		{"health", func(router *mux.Router) {
+			health.New(chain, db, syncTolerance).
+				Mount(router, "")
+		}},
+		{"attestations", func(router *mux.Router) {
+			if attester != nil {
+				attestations.New(attester).
+					Mount(router, "/attestations")
+			}
+		}},
+		{"subscriptions", func(router *mux.Router) {
+			subs := subscriptions.New(chain, finality, origins, backtraceLimit, subscriptionOptions)
+			subs.Mount(router, "/subscriptions")
+			closer = subs.Close // subscriptions handles hijacked conns, which need to be closed
+		}},
+	}
+
+	known := make(map[string]bool)
+	for _, m := range modules {
+		known[m.name] = true
+	}
+	enabled := make(map[string]bool)
+	for _, name := range enabledModules {
+		if !known[name] {
+			return nil, nil, errors.Errorf("unknown module '%v'", name)
+		}
+		enabled[name] = true
+	}
+	for _, m := range modules {
+		if enabled[m.name] {
+			m.mount(router)
+		}
+	}
 
 	handler := handlers.CompressHandler(router)
 	handler = handlers.CORS(
 		handlers.AllowedOrigins(origins),
-		handlers.AllowedHeaders([]string{"content-type"}))(handler)
-	return handler.ServeHTTP,
-		subs.Close // subscriptions handles hijacked conns, which need to be closed
+		handlers.AllowedHeaders([]string{"content-type", "authorization", "x-api-key", "x-idempotency-key"}))(handler)
+	return handler.ServeHTTP, closer, nil
 }
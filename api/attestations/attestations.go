@@ -0,0 +1,108 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package attestations
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/attest"
+	"github.com/vechain/thor/thor"
+)
+
+const maxAttestations = 256
+
+type Attestations struct {
+	attester *attest.Attester
+}
+
+func New(attester *attest.Attester) *Attestations {
+	return &Attestations{attester}
+}
+
+func (a *Attestations) handleGetAttestations(w http.ResponseWriter, req *http.Request) error {
+	from, err := parseNumber(req.URL.Query().Get("from"), 0)
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "from"))
+	}
+	to, err := parseNumber(req.URL.Query().Get("to"), math.MaxUint32)
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "to"))
+	}
+	if from > to {
+		return utils.BadRequest(errors.New("from: greater than to"))
+	}
+	ats, err := a.attester.Range(from, to, maxAttestations)
+	if err != nil {
+		return err
+	}
+	result := make([]*Attestation, 0, len(ats))
+	for _, at := range ats {
+		result = append(result, convertAttestation(at))
+	}
+	return utils.WriteJSON(w, result)
+}
+
+func (a *Attestations) handleGetAttestation(w http.ResponseWriter, req *http.Request) error {
+	hash, err := thor.ParseBytes32(mux.Vars(req)["hash"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "hash"))
+	}
+	at, err := a.attester.Get(hash)
+	if err != nil {
+		return err
+	}
+	if at == nil {
+		return utils.WriteJSON(w, nil)
+	}
+	return utils.WriteJSON(w, convertAttestation(at))
+}
+
+func (a *Attestations) handlePostSignature(w http.ResponseWriter, req *http.Request) error {
+	hash, err := thor.ParseBytes32(mux.Vars(req)["hash"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "hash"))
+	}
+	var body SignatureBody
+	if err := utils.ParseJSON(req.Body, &body); err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "body"))
+	}
+	sig, err := hexutil.Decode(body.Signature)
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "signature"))
+	}
+	if err := a.attester.AddSignature(hash, sig); err != nil {
+		if attest.IsRejected(err) {
+			return utils.Forbidden(err)
+		}
+		return err
+	}
+	return utils.WriteJSON(w, nil)
+}
+
+func parseNumber(s string, def uint32) (uint32, error) {
+	if s == "" {
+		return def, nil
+	}
+	n, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(n), nil
+}
+
+func (a *Attestations) Mount(root *mux.Router, pathPrefix string) {
+	sub := root.PathPrefix(pathPrefix).Subrouter()
+
+	sub.Path("").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(a.handleGetAttestations))
+	sub.Path("/{hash}").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(a.handleGetAttestation))
+	sub.Path("/{hash}/signatures").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(a.handlePostSignature))
+}
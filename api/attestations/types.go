@@ -0,0 +1,62 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package attestations
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/vechain/thor/attest"
+	"github.com/vechain/thor/thor"
+)
+
+// Event the attested contract event.
+type Event struct {
+	BlockID     thor.Bytes32   `json:"blockID"`
+	BlockNumber uint32         `json:"blockNumber"`
+	TxID        thor.Bytes32   `json:"txID"`
+	Index       uint32         `json:"index"`
+	Address     thor.Address   `json:"address"`
+	Topics      []thor.Bytes32 `json:"topics"`
+	Data        string         `json:"data"`
+}
+
+// Signature of an authority node.
+type Signature struct {
+	Signer    thor.Address `json:"signer"`
+	Signature string       `json:"signature"`
+}
+
+// Attestation the event and signatures over its hash.
+type Attestation struct {
+	Hash       thor.Bytes32 `json:"hash"`
+	Event      Event        `json:"event"`
+	Signatures []Signature  `json:"signatures"`
+}
+
+// SignatureBody body of posting signature.
+type SignatureBody struct {
+	Signature string `json:"signature"`
+}
+
+func convertAttestation(at *attest.Attestation) *Attestation {
+	ev := &at.Event
+	sigs := make([]Signature, 0, len(at.Signatures))
+	for _, s := range at.Signatures {
+		sigs = append(sigs, Signature{s.Signer, hexutil.Encode(s.Signature)})
+	}
+	return &Attestation{
+		Hash: ev.Hash(),
+		Event: Event{
+			BlockID:     ev.BlockID,
+			BlockNumber: ev.BlockNumber,
+			TxID:        ev.TxID,
+			Index:       ev.Index,
+			Address:     ev.Address,
+			Topics:      ev.Topics,
+			Data:        hexutil.Encode(ev.Data),
+		},
+		Signatures: sigs,
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package batch
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/utils"
+)
+
+// Path path to serve batch requests.
+const Path = "/batch"
+
+const (
+	maxRequests = 64 // max count of requests in a batch
+	workers     = 8  // count of requests executed concurrently
+)
+
+// Request a request in the batch.
+type Request struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"` // path with query
+	Body   json.RawMessage `json:"body"`
+}
+
+// Response response of a request in the batch.
+// Body is embedded as is if in JSON, otherwise as a string.
+type Response struct {
+	Status int             `json:"status"`
+	Body   json.RawMessage `json:"body"`
+}
+
+// Handler returns the middleware to serve batch requests at Path.
+// Requests in a batch are independent, executed concurrently by h, with headers of the batch request,
+// e.g. for authentication. Responses are returned in order of requests.
+func Handler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != Path {
+			h.ServeHTTP(w, r)
+			return
+		}
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		utils.WrapHandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+			var reqs []*Request
+			if err := utils.ParseJSON(r.Body, &reqs); err != nil {
+				return utils.BadRequest(errors.WithMessage(err, "body"))
+			}
+			if len(reqs) > maxRequests {
+				return utils.BadRequest(errors.Errorf("body: too many requests, should be no more than %v", maxRequests))
+			}
+			return utils.WriteJSON(w, execute(h, r, reqs))
+		})(w, r)
+	})
+}
+
+func execute(h http.Handler, outer *http.Request, reqs []*Request) []*Response {
+	resps := make([]*Response, len(reqs))
+	jobs := make(chan int, len(reqs))
+	for i := range reqs {
+		jobs <- i
+	}
+	close(jobs)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers && i < len(reqs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				resps[j] = serve(h, outer, reqs[j])
+			}
+		}()
+	}
+	wg.Wait()
+	return resps
+}
+
+func serve(h http.Handler, outer *http.Request, req *Request) *Response {
+	if req == nil || req.Path == "" || req.Path[0] != '/' {
+		return newErrorResponse(http.StatusBadRequest, "path: should start with '/'")
+	}
+	// nested batch and websocket are not allowed
+	if strings.HasPrefix(req.Path, Path) || strings.HasPrefix(req.Path, "/subscriptions") {
+		return newErrorResponse(http.StatusBadRequest, "path: not allowed in batch")
+	}
+	method := strings.ToUpper(req.Method)
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	inner, err := http.NewRequest(method, req.Path, bytes.NewReader(req.Body))
+	if err != nil {
+		return newErrorResponse(http.StatusBadRequest, err.Error())
+	}
+	inner = inner.WithContext(outer.Context())
+	inner.RemoteAddr = outer.RemoteAddr
+	inner.Host = outer.Host
+	for key, values := range outer.Header {
+		inner.Header[key] = values
+	}
+	// response compression is up to the batch response
+	inner.Header.Del("Accept-Encoding")
+	inner.Header.Set("Content-Type", "application/json")
+
+	rec := newRecorder()
+	h.ServeHTTP(rec, inner)
+
+	body := rec.body.Bytes()
+	if !strings.HasPrefix(rec.header.Get("Content-Type"), "application/json") {
+		body, _ = json.Marshal(strings.TrimRight(string(body), "\n"))
+	} else if len(body) == 0 {
+		body = []byte("null")
+	}
+	return &Response{rec.status, body}
+}
+
+func newErrorResponse(status int, msg string) *Response {
+	body, _ := json.Marshal(msg)
+	return &Response{status, body}
+}
+
+// recorder records response of a request in the batch.
+type recorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newRecorder() *recorder {
+	return &recorder{header: make(http.Header)}
+}
+
+func (r *recorder) Header() http.Header {
+	return r.header
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.body.Write(b)
+}
+
+func (r *recorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
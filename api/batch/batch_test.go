@@ -0,0 +1,68 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package batch_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/api/batch"
+)
+
+func TestBatch(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/echo", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.Method + " " + r.Header.Get("x-token")))
+	})
+	ts := httptest.NewServer(batch.Handler(mux))
+	defer ts.Close()
+
+	post := func(reqs interface{}) (int, []*batch.Response) {
+		data, _ := json.Marshal(reqs)
+		req, _ := http.NewRequest("POST", ts.URL+batch.Path, bytes.NewReader(data))
+		req.Header.Set("x-token", "t")
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		var resps []*batch.Response
+		json.NewDecoder(res.Body).Decode(&resps)
+		return res.StatusCode, resps
+	}
+
+	status, resps := post([]*batch.Request{
+		{Method: "POST", Path: "/echo", Body: json.RawMessage(`{"a":1}`)},
+		{Path: "/token"},
+		{Path: "/none"},
+		{Path: "/batch"},
+	})
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, 4, len(resps))
+	assert.Equal(t, http.StatusOK, resps[0].Status)
+	assert.Equal(t, `{"a":1}`, string(resps[0].Body))
+	assert.Equal(t, `"GET t"`, string(resps[1].Body))
+	assert.Equal(t, http.StatusNotFound, resps[2].Status)
+	assert.Equal(t, http.StatusBadRequest, resps[3].Status)
+
+	status, _ = post(make([]*batch.Request, 65))
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	res, err := http.Get(ts.URL + "/token")
+	assert.Nil(t, err)
+	body, _ := ioutil.ReadAll(res.Body)
+	assert.Equal(t, "GET ", string(body))
+}
@@ -12,19 +12,26 @@ import (
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/transactions"
 	"github.com/vechain/thor/api/utils"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/finality"
 	"github.com/vechain/thor/thor"
 )
 
+// finalizedRevision the revision of the latest finalized block.
+type finalizedRevision struct{}
+
 type Blocks struct {
-	chain *chain.Chain
+	chain    *chain.Chain
+	finality *finality.Finality
 }
 
-func New(chain *chain.Chain) *Blocks {
+func New(chain *chain.Chain, finality *finality.Finality) *Blocks {
 	return &Blocks{
 		chain,
+		finality,
 	}
 }
 
@@ -48,13 +55,61 @@ func (b *Blocks) handleGetBlock(w http.ResponseWriter, req *http.Request) error
 	if err != nil {
 		return err
 	}
-	return utils.WriteJSON(w, blk)
+	return b.writeJSON(w, req, revision, block.Header(), blk)
+}
+
+func (b *Blocks) handleGetBlockReceipts(w http.ResponseWriter, req *http.Request) error {
+	revision, err := b.parseRevision(mux.Vars(req)["revision"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "revision"))
+	}
+	block, err := b.getBlock(revision)
+	if err != nil {
+		if b.chain.IsNotFound(err) {
+			return utils.WriteJSON(w, nil)
+		}
+		return err
+	}
+	header := block.Header()
+	receipts, err := b.chain.GetBlockReceipts(header.ID())
+	if err != nil {
+		return err
+	}
+	txs := block.Transactions()
+	result := make([]*transactions.Receipt, 0, len(receipts))
+	for i, r := range receipts {
+		receipt, err := transactions.ConvertReceipt(r, header, txs[i])
+		if err != nil {
+			return err
+		}
+		result = append(result, receipt)
+	}
+	return b.writeJSON(w, req, revision, header, result)
+}
+
+// writeJSON responses obj of the block as immutable, if the block is finalized or forked before the finalized one,
+// and requested by number or id, rather than tag 'best' or 'finalized'.
+func (b *Blocks) writeJSON(w http.ResponseWriter, req *http.Request, revision interface{}, header *block.Header, obj interface{}) error {
+	switch revision.(type) {
+	case thor.Bytes32, uint32:
+		finalized, err := b.finality.Finalized()
+		if err != nil {
+			return err
+		}
+		if header.Number() <= finalized.Number() {
+			return utils.WriteImmutableJSON(w, req, obj)
+		}
+	}
+	return utils.WriteJSON(w, obj)
 }
 
 func (b *Blocks) parseRevision(revision string) (interface{}, error) {
 	if revision == "" || revision == "best" {
 		return nil, nil
 	}
+	if revision == "finalized" {
+		return finalizedRevision{}, nil
+	}
 	if len(revision) == 66 || len(revision) == 64 {
 		blockID, err := thor.ParseBytes32(revision)
 		if err != nil {
@@ -78,6 +133,12 @@ func (b *Blocks) getBlock(revision interface{}) (*block.Block, error) {
 		return b.chain.GetBlock(revision.(thor.Bytes32))
 	case uint32:
 		return b.chain.GetTrunkBlock(revision.(uint32))
+	case finalizedRevision:
+		header, err := b.finality.Finalized()
+		if err != nil {
+			return nil, err
+		}
+		return b.chain.GetBlock(header.ID())
 	default:
 		return b.chain.BestBlock(), nil
 	}
@@ -95,5 +156,6 @@ func (b *Blocks) isTrunk(blkID thor.Bytes32, blkNum uint32) (bool, error) {
 func (b *Blocks) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
 	sub.Path("/{revision}").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(b.handleGetBlock))
+	sub.Path("/{revision}/receipts").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(b.handleGetBlockReceipts))
 
 }
@@ -6,6 +6,7 @@
 package blocks_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"io/ioutil"
 	"math/big"
@@ -18,8 +19,10 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"github.com/vechain/thor/api/blocks"
+	"github.com/vechain/thor/api/transactions"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/finality"
 	"github.com/vechain/thor/genesis"
 	"github.com/vechain/thor/lvldb"
 	"github.com/vechain/thor/packer"
@@ -71,6 +74,70 @@ func TestBlock(t *testing.T) {
 	checkBlock(t, blk, rb)
 	assert.Equal(t, http.StatusOK, statusCode)
 
+	// single authority node of devnet signed block 1, so genesis is finalized
+	res, statusCode = httpGet(t, ts.URL+"/blocks/finalized")
+	if err := json.Unmarshal(res, &rb); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, uint32(0), rb.Number)
+}
+
+func TestBlockReceipts(t *testing.T) {
+	initBlockServer(t)
+	defer ts.Close()
+
+	res, statusCode := httpGet(t, ts.URL+"/blocks/"+invalidBytes32+"/receipts")
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+
+	res, statusCode = httpGet(t, ts.URL+"/blocks/1/receipts")
+	assert.Equal(t, http.StatusOK, statusCode)
+	var receipts []*transactions.Receipt
+	if err := json.Unmarshal(res, &receipts); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, len(blk.Transactions()), len(receipts))
+	for i, r := range receipts {
+		assert.Equal(t, blk.Transactions()[i].ID(), r.Meta.TxID)
+		assert.Equal(t, blk.Header().ID(), r.Meta.BlockID)
+	}
+
+	res, statusCode = httpGet(t, ts.URL+"/blocks/100/receipts")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, "null", string(bytes.TrimSpace(res)))
+}
+
+func TestBlockETag(t *testing.T) {
+	initBlockServer(t)
+	defer ts.Close()
+
+	get := func(path string, etag string) *http.Response {
+		req, _ := http.NewRequest("GET", ts.URL+path, nil)
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		return res
+	}
+
+	// genesis is finalized
+	res := get("/blocks/0", "")
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	etag := res.Header.Get("ETag")
+	assert.NotEmpty(t, etag)
+	assert.NotEmpty(t, res.Header.Get("Cache-Control"))
+
+	res = get("/blocks/0", etag)
+	assert.Equal(t, http.StatusNotModified, res.StatusCode)
+	assert.NotEqual(t, etag, get("/blocks/0/receipts", "").Header.Get("ETag"))
+
+	// not finalized or by tag
+	assert.Empty(t, get("/blocks/1", "").Header.Get("ETag"))
+	assert.Empty(t, get("/blocks/finalized", "").Header.Get("ETag"))
 }
 
 func initBlockServer(t *testing.T) {
@@ -120,7 +187,7 @@ func initBlockServer(t *testing.T) {
 		t.Fatal(err)
 	}
 	router := mux.NewRouter()
-	blocks.New(chain).Mount(router, "/blocks")
+	blocks.New(chain, finality.New(chain, stateC)).Mount(router, "/blocks")
 	ts = httptest.NewServer(router)
 	blk = block
 }
@@ -0,0 +1,119 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package contracts
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/verification"
+)
+
+// maxVerifyBodySize limits size of the verify request, which carries the source and ABI.
+const maxVerifyBodySize = 256 * 1024
+
+type Contracts struct {
+	chain        *chain.Chain
+	stateCreator *state.Creator
+	store        *verification.Store
+}
+
+func New(chain *chain.Chain, stateCreator *state.Creator, store *verification.Store) *Contracts {
+	return &Contracts{
+		chain,
+		stateCreator,
+		store,
+	}
+}
+
+func (c *Contracts) getCode(addr thor.Address) (code []byte, codeHash thor.Bytes32, err error) {
+	st, err := c.stateCreator.NewState(c.chain.BestBlock().Header().StateRoot())
+	if err != nil {
+		return nil, thor.Bytes32{}, err
+	}
+	code = st.GetCode(addr)
+	codeHash = st.GetCodeHash(addr)
+	if err := st.Err(); err != nil {
+		return nil, thor.Bytes32{}, err
+	}
+	return code, codeHash, nil
+}
+
+func (c *Contracts) handleVerify(w http.ResponseWriter, req *http.Request) error {
+	addr, err := thor.ParseAddress(mux.Vars(req)["address"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "address"))
+	}
+	var body VerifyRequest
+	if err := utils.ParseJSON(http.MaxBytesReader(w, req.Body, maxVerifyBodySize), &body); err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "body"))
+	}
+	bytecode, err := hexutil.Decode(body.Bytecode)
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "bytecode"))
+	}
+	code, _, err := c.getCode(addr)
+	if err != nil {
+		return err
+	}
+
+	contract := &verification.Contract{
+		ABI:      string(body.ABI),
+		Source:   body.Source,
+		Compiler: body.Compiler,
+		Settings: string(body.Settings),
+	}
+	if err := c.store.Verify(addr, code, bytecode, contract); err != nil {
+		if verification.IsRejected(err) {
+			return utils.WriteJSON(w, &VerifyResult{Verified: false, Error: err.Error()})
+		}
+		return err
+	}
+	return utils.WriteJSON(w, &VerifyResult{Verified: true})
+}
+
+func (c *Contracts) handleGetContract(w http.ResponseWriter, req *http.Request) error {
+	addr, err := thor.ParseAddress(mux.Vars(req)["address"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "address"))
+	}
+	contract, err := c.store.Get(addr)
+	if err != nil {
+		return err
+	}
+	if contract == nil {
+		return utils.WriteJSON(w, nil)
+	}
+	_, codeHash, err := c.getCode(addr)
+	if err != nil {
+		return err
+	}
+	verified := &Verified{
+		ABI:      json.RawMessage(contract.ABI),
+		Source:   contract.Source,
+		Compiler: contract.Compiler,
+		CodeHash: contract.CodeHash,
+		Outdated: contract.CodeHash != codeHash,
+	}
+	if contract.Settings != "" {
+		verified.Settings = json.RawMessage(contract.Settings)
+	}
+	return utils.WriteJSON(w, verified)
+}
+
+func (c *Contracts) Mount(root *mux.Router, pathPrefix string) {
+	sub := root.PathPrefix(pathPrefix).Subrouter()
+
+	sub.Path("/{address}").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(c.handleGetContract))
+	sub.Path("/{address}/verify").Methods(http.MethodPost).HandlerFunc(utils.WrapHandlerFunc(c.handleVerify))
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package contracts_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/api/contracts"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/builtin/gen"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/verification"
+)
+
+var ts *httptest.Server
+
+func TestContracts(t *testing.T) {
+	initContractsServer(t)
+	defer ts.Close()
+
+	addr := builtin.Params.Address.String()
+	abiJSON := gen.MustAsset("compiled/Params.abi")
+
+	res, status := httpPost(t, ts.URL+"/contracts/"+addr+"/verify", &contracts.VerifyRequest{
+		Bytecode: "0x00",
+		ABI:      abiJSON,
+	})
+	assert.Equal(t, http.StatusOK, status)
+	var result contracts.VerifyResult
+	if err := json.Unmarshal(res, &result); err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, result.Verified)
+	assert.Equal(t, "bytecode mismatch", result.Error)
+
+	res, status = httpPost(t, ts.URL+"/contracts/"+addr+"/verify", &contracts.VerifyRequest{
+		Bytecode: hexutil.Encode(builtin.Params.RuntimeBytecodes()),
+		ABI:      abiJSON,
+		Source:   "contract Params { }",
+		Compiler: "0.4.24",
+	})
+	assert.Equal(t, http.StatusOK, status)
+	if err := json.Unmarshal(res, &result); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, result.Verified)
+
+	res, status = httpPost(t, ts.URL+"/contracts/"+addr+"/verify", &contracts.VerifyRequest{
+		Bytecode: hexutil.Encode(builtin.Params.RuntimeBytecodes()),
+		ABI:      abiJSON,
+		Source:   "contract Params { function get() {} }",
+		Compiler: "0.4.25",
+	})
+	assert.Equal(t, http.StatusOK, status)
+	if err := json.Unmarshal(res, &result); err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, result.Verified)
+	assert.Equal(t, "already verified", result.Error)
+
+	_, status = httpPost(t, ts.URL+"/contracts/"+addr+"/verify", &contracts.VerifyRequest{
+		Bytecode: hexutil.Encode(builtin.Params.RuntimeBytecodes()),
+		ABI:      abiJSON,
+		Source:   strings.Repeat(" ", 256*1024),
+	})
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	resp, err := http.Get(ts.URL + "/contracts/" + addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var verified contracts.Verified
+	if err := json.NewDecoder(resp.Body).Decode(&verified); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "0.4.24", verified.Compiler)
+	assert.False(t, verified.Outdated)
+}
+
+func initContractsServer(t *testing.T) {
+	db, _ := lvldb.NewMem()
+	stateC := state.NewCreator(db)
+	b0, _, err := genesis.NewDevnet().Build(stateC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, _ := chain.New(db, b0)
+
+	router := mux.NewRouter()
+	contracts.New(c, stateC, verification.New(db)).Mount(router, "/contracts")
+	ts = httptest.NewServer(router)
+}
+
+func httpPost(t *testing.T, url string, body interface{}) ([]byte, int) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := http.Post(url, "application/x-www-form-urlencoded", bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	r, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r, res.StatusCode
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package contracts
+
+import (
+	"encoding/json"
+
+	"github.com/vechain/thor/thor"
+)
+
+// VerifyRequest the compiled contract to be verified.
+// Bytecode is the runtime bytecode, e.g. 'evm.deployedBytecode.object' in solc's standard JSON output.
+type VerifyRequest struct {
+	Bytecode string          `json:"bytecode"`
+	ABI      json.RawMessage `json:"abi"`
+	Source   string          `json:"source"`
+	Compiler string          `json:"compiler"`
+	Settings json.RawMessage `json:"settings"`
+}
+
+type VerifyResult struct {
+	Verified bool   `json:"verified"`
+	Error    string `json:"error,omitempty"`
+}
+
+// Verified the verified contract.
+// Outdated is true if the code has changed since verified.
+type Verified struct {
+	ABI      json.RawMessage `json:"abi"`
+	Source   string          `json:"source"`
+	Compiler string          `json:"compiler"`
+	Settings json.RawMessage `json:"settings,omitempty"`
+	CodeHash thor.Bytes32    `json:"codeHash"`
+	Outdated bool            `json:"outdated"`
+}
@@ -11,11 +11,14 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/usage"
 	"github.com/vechain/thor/api/utils"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/consensus"
@@ -27,6 +30,51 @@ import (
 	"github.com/vechain/thor/vm"
 )
 
+const (
+	jsTracerTimeout = 5 * time.Second
+	// maxStuckJSTracers limits JavaScript tracers left running after the hard timeout.
+	// Duktape can't be interrupted from outside, so the goroutine running it is abandoned,
+	// and JavaScript tracers are refused until stuck ones are back under the limit.
+	maxStuckJSTracers = 2
+)
+
+var (
+	// jsTracerHardTimeout is the deadline of waiting for JavaScript, which is not interrupted by
+	// the soft timeout in a tight loop, e.g. 'while(true){}' in user supplied code.
+	jsTracerHardTimeout = jsTracerTimeout + time.Second
+	stuckJSTracers      int32
+)
+
+// runJS runs f, which calls into JavaScript, and gives up waiting for it after jsTracerHardTimeout.
+func runJS(f func() (interface{}, error)) (interface{}, error) {
+	if atomic.LoadInt32(&stuckJSTracers) >= maxStuckJSTracers {
+		return nil, utils.HTTPError(errors.New("too many stuck tracers"), http.StatusServiceUnavailable)
+	}
+	type result struct {
+		v   interface{}
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		v, err := f()
+		done <- result{v, err}
+	}()
+
+	timer := time.NewTimer(jsTracerHardTimeout)
+	defer timer.Stop()
+	select {
+	case r := <-done:
+		return r.v, r.err
+	case <-timer.C:
+		atomic.AddInt32(&stuckJSTracers, 1)
+		go func() {
+			<-done
+			atomic.AddInt32(&stuckJSTracers, -1)
+		}()
+		return nil, utils.HTTPError(errors.New("tracer: execution timeout"), http.StatusServiceUnavailable)
+	}
+}
+
 var devNetGenesisID = thor.MustParseBytes32("0x00000000973ceb7f343a58b08f0693d6701a5fd354ff73d7058af3fba222aea4")
 
 type Debug struct {
@@ -65,7 +113,7 @@ func (d *Debug) handleTxEnv(ctx context.Context, blockID thor.Bytes32, txIndex u
 		if uint64(i) > txIndex {
 			break
 		}
-		txExec, err := rt.PrepareTransaction(tx)
+		txExec, err := rt.PrepareTransactionContext(ctx, tx)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -74,9 +122,12 @@ func (d *Debug) handleTxEnv(ctx context.Context, blockID thor.Bytes32, txIndex u
 			if txIndex == uint64(i) && clauseIndex == clauseCounter {
 				return rt, txExec, nil
 			}
-			if _, _, err := txExec.NextClause(); err != nil {
+			// replaying costs as much as executing, so metered as well
+			gasUsed, _, err := txExec.NextClause()
+			if err != nil {
 				return nil, nil, err
 			}
+			usage.AddGas(ctx, gasUsed)
 			clauseCounter++
 		}
 		if _, err := txExec.Finalize(); err != nil {
@@ -91,30 +142,83 @@ func (d *Debug) handleTxEnv(ctx context.Context, blockID thor.Bytes32, txIndex u
 	return nil, nil, utils.Forbidden(errors.New("early reverted"))
 }
 
+// newTracer creates the tracer by name, or from the user supplied JavaScript code.
+// Native tracers take precedence over the built-in JavaScript ones of the same name.
+func (d *Debug) newTracer(name string, code string) (vm.Tracer, error) {
+	if code != "" {
+		if name != "" {
+			return nil, utils.BadRequest(errors.New("name and code: only one can be set"))
+		}
+		// the code is evaluated on creation
+		tr, err := runJS(func() (interface{}, error) {
+			tr, err := tracers.New(code)
+			if err != nil {
+				return nil, utils.BadRequest(errors.WithMessage(err, "code"))
+			}
+			return tr, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return tr.(vm.Tracer), nil
+	}
+	if name == "" {
+		return vm.NewStructLogger(nil), nil
+	}
+	if !strings.HasSuffix(name, "Tracer") {
+		name += "Tracer"
+	}
+	if ctor, ok := tracers.NativeByName(name); ok {
+		return ctor(), nil
+	}
+	code, ok := tracers.CodeByName(name)
+	if !ok {
+		return nil, utils.BadRequest(errors.New("name: unsupported tracer"))
+	}
+	return tracers.New(code)
+}
+
+// traceClause executes the next clause of the tx under the tracer, and returns the trace.
+func traceClause(ctx context.Context, rt *runtime.Runtime, txExec *runtime.TransactionExecutor, tracer vm.Tracer) (interface{}, error) {
+	rt.SetVMConfig(vm.Config{Debug: true, Tracer: tracer})
+	run := func() (interface{}, error) {
+		gasUsed, output, err := txExec.NextClause()
+		if err != nil {
+			return nil, err
+		}
+		usage.AddGas(ctx, gasUsed)
+		switch tr := tracer.(type) {
+		case *vm.StructLogger:
+			return &ExecutionResult{
+				Gas:         gasUsed,
+				Failed:      output.VMErr != nil,
+				ReturnValue: hexutil.Encode(output.Data),
+				StructLogs:  formatLogs(tr.StructLogs()),
+			}, nil
+		case tracers.ResultTracer:
+			return tr.GetResult()
+		default:
+			return nil, fmt.Errorf("bad tracer type %T", tracer)
+		}
+	}
+	if jst, ok := tracer.(*tracers.Tracer); ok {
+		// JavaScript tracers may be supplied by users, and are much slower
+		deadline := time.AfterFunc(jsTracerTimeout, func() {
+			jst.Stop(errors.New("execution timeout"))
+		})
+		defer deadline.Stop()
+		return runJS(run)
+	}
+	return run()
+}
+
 //trace an existed transaction
 func (d *Debug) traceTransaction(ctx context.Context, tracer vm.Tracer, blockID thor.Bytes32, txIndex uint64, clauseIndex uint64) (interface{}, error) {
 	rt, txExec, err := d.handleTxEnv(ctx, blockID, txIndex, clauseIndex)
 	if err != nil {
 		return nil, err
 	}
-	rt.SetVMConfig(vm.Config{Debug: true, Tracer: tracer})
-	gasUsed, output, err := txExec.NextClause()
-	if err != nil {
-		return nil, err
-	}
-	switch tr := tracer.(type) {
-	case *vm.StructLogger:
-		return &ExecutionResult{
-			Gas:         gasUsed,
-			Failed:      output.VMErr != nil,
-			ReturnValue: hexutil.Encode(output.Data),
-			StructLogs:  formatLogs(tr.StructLogs()),
-		}, nil
-	case *tracers.Tracer:
-		return tr.GetResult()
-	default:
-		return nil, fmt.Errorf("bad tracer type %T", tracer)
-	}
+	return traceClause(ctx, rt, txExec, tracer)
 }
 
 func (d *Debug) handleTraceTransaction(w http.ResponseWriter, req *http.Request) error {
@@ -125,40 +229,88 @@ func (d *Debug) handleTraceTransaction(w http.ResponseWriter, req *http.Request)
 	if opt == nil {
 		return utils.BadRequest(errors.New("body: empty body"))
 	}
-	var tracer vm.Tracer
-	if opt.Name == "" {
-		tracer = vm.NewStructLogger(nil)
-	} else {
-		name := opt.Name
-		if !strings.HasSuffix(name, "Tracer") {
-			name += "Tracer"
+	tracer, err := d.newTracer(opt.Name, opt.Code)
+	if err != nil {
+		return err
+	}
+	blockID, txIndex, clauseIndex, err := d.parseTarget(opt.Target)
+	if err != nil {
+		return err
+	}
+	res, err := d.traceTransaction(req.Context(), tracer, blockID, txIndex, clauseIndex)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, res)
+}
+
+// traceTransactionByID replays the block of the trunk tx up to it, and traces its clauses one by one.
+// Clauses after a reverted one are not executed, so not traced.
+func (d *Debug) traceTransactionByID(ctx context.Context, name string, code string, txID thor.Bytes32) ([]interface{}, error) {
+	txMeta, err := d.chain.GetTransactionMeta(txID, d.chain.BestBlock().Header().ID())
+	if err != nil {
+		if d.chain.IsNotFound(err) {
+			return nil, utils.Forbidden(errors.New("transaction not found"))
 		}
-		code, ok := tracers.CodeByName(name)
-		if !ok {
-			return utils.BadRequest(errors.New("name: unsupported tracer"))
+		return nil, err
+	}
+	rt, txExec, err := d.handleTxEnv(ctx, txMeta.BlockID, txMeta.Index, 0)
+	if err != nil {
+		return nil, err
+	}
+	results := []interface{}{}
+	for txExec.HasNextClause() {
+		// tracers accumulate states, so one for each clause
+		tracer, err := d.newTracer(name, code)
+		if err != nil {
+			return nil, err
 		}
-		tr, err := tracers.New(code)
+		res, err := traceClause(ctx, rt, txExec, tracer)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		tracer = tr
+		results = append(results, res)
 	}
-	blockID, txIndex, clauseIndex, err := d.parseTarget(opt.Target)
+	return results, nil
+}
+
+func (d *Debug) handleTraceTransactionByID(w http.ResponseWriter, req *http.Request) error {
+	txID, err := thor.ParseBytes32(mux.Vars(req)["id"])
 	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "id"))
+	}
+	var opt *TxTracerOption
+	if err := utils.ParseJSON(req.Body, &opt); err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "body"))
+	}
+	if opt == nil {
+		return utils.BadRequest(errors.New("body: empty body"))
+	}
+	// validate the name before replaying
+	if _, err := d.newTracer(opt.Name, opt.Code); err != nil {
 		return err
 	}
-	res, err := d.traceTransaction(req.Context(), tracer, blockID, txIndex, clauseIndex)
+	res, err := d.traceTransactionByID(req.Context(), opt.Name, opt.Code, txID)
 	if err != nil {
 		return err
 	}
 	return utils.WriteJSON(w, res)
 }
 
-func (d *Debug) debugStorage(ctx context.Context, contractAddress thor.Address, blockID thor.Bytes32, txIndex uint64, clauseIndex uint64, keyStart []byte, maxResult int) (*StorageRangeResult, error) {
-	rt, _, err := d.handleTxEnv(ctx, blockID, txIndex, clauseIndex)
+// debugStorage returns storage range of the contract, in state right before the target clause,
+// or after it if after is true. If the clause reverted, changes of the whole tx are reverted.
+func (d *Debug) debugStorage(ctx context.Context, contractAddress thor.Address, blockID thor.Bytes32, txIndex uint64, clauseIndex uint64, after bool, keyStart []byte, maxResult int) (*StorageRangeResult, error) {
+	rt, txExec, err := d.handleTxEnv(ctx, blockID, txIndex, clauseIndex)
 	if err != nil {
 		return nil, err
 	}
+	if after {
+		gasUsed, _, err := txExec.NextClause()
+		if err != nil {
+			return nil, err
+		}
+		usage.AddGas(ctx, gasUsed)
+	}
 	storageTrie, err := rt.State().BuildStorageTrie(contractAddress)
 	if err != nil {
 		return nil, err
@@ -209,7 +361,7 @@ func (d *Debug) handleDebugStorage(w http.ResponseWriter, req *http.Request) err
 		}
 		keyStart = k
 	}
-	res, err := d.debugStorage(req.Context(), opt.Address, blockID, txIndex, clauseIndex, keyStart, opt.MaxResult)
+	res, err := d.debugStorage(req.Context(), opt.Address, blockID, txIndex, clauseIndex, opt.After, keyStart, opt.MaxResult)
 	if err != nil {
 		return err
 	}
@@ -256,6 +408,7 @@ func (d *Debug) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
 
 	sub.Path("/tracers").Methods(http.MethodPost).HandlerFunc(utils.WrapHandlerFunc(d.handleTraceTransaction))
+	sub.Path("/tracers/transaction/{id}").Methods(http.MethodPost).HandlerFunc(utils.WrapHandlerFunc(d.handleTraceTransactionByID))
 	sub.Path("/storage-range").Methods(http.MethodPost).HandlerFunc(utils.WrapHandlerFunc(d.handleDebugStorage))
 
 }
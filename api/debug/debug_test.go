@@ -0,0 +1,92 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package debug
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/tracers"
+	"github.com/vechain/thor/vm"
+)
+
+// statusOf returns the http status responded for the error.
+func statusOf(err error) int {
+	rec := httptest.NewRecorder()
+	utils.WrapHandlerFunc(func(http.ResponseWriter, *http.Request) error {
+		return err
+	})(rec, httptest.NewRequest(http.MethodPost, "/", nil))
+	return rec.Code
+}
+
+func TestNewTracer(t *testing.T) {
+	d := New(nil, nil)
+
+	tr, err := d.newTracer("", "")
+	assert.Nil(t, err)
+	assert.IsType(t, &vm.StructLogger{}, tr)
+
+	// native ones take precedence
+	for _, name := range []string{"call", "callTracer", "prestate", "4byte"} {
+		tr, err = d.newTracer(name, "")
+		assert.Nil(t, err, name)
+		_, isJS := tr.(*tracers.Tracer)
+		assert.False(t, isJS, name)
+	}
+
+	_, err = d.newTracer("unknown", "")
+	assert.Equal(t, http.StatusBadRequest, statusOf(err))
+
+	// user supplied code
+	_, err = d.newTracer("call", "{step: function() {}, fault: function() {}, result: function() {}}")
+	assert.Equal(t, http.StatusBadRequest, statusOf(err), "name and code both set")
+	_, err = d.newTracer("", "{")
+	assert.Equal(t, http.StatusBadRequest, statusOf(err), "bad code")
+	_, err = d.newTracer("", "{}")
+	assert.Equal(t, http.StatusBadRequest, statusOf(err), "no step function")
+}
+
+func TestRunJS(t *testing.T) {
+	defer func(timeout time.Duration) { jsTracerHardTimeout = timeout }(jsTracerHardTimeout)
+	jsTracerHardTimeout = 10 * time.Millisecond
+
+	v, err := runJS(func() (interface{}, error) { return 1, nil })
+	assert.Nil(t, err)
+	assert.Equal(t, 1, v)
+
+	_, err = runJS(func() (interface{}, error) { return nil, errors.New("failed") })
+	assert.Equal(t, "failed", err.Error())
+
+	// tight loops
+	release := make(chan struct{})
+	for i := 0; i < maxStuckJSTracers; i++ {
+		_, err = runJS(func() (interface{}, error) {
+			<-release
+			return nil, nil
+		})
+		assert.Equal(t, http.StatusServiceUnavailable, statusOf(err))
+	}
+	called := false
+	_, err = runJS(func() (interface{}, error) {
+		called = true
+		return nil, nil
+	})
+	assert.Equal(t, http.StatusServiceUnavailable, statusOf(err))
+	assert.False(t, called, "refused when too many stuck")
+
+	close(release)
+	for atomic.LoadInt32(&stuckJSTracers) > 0 {
+		time.Sleep(time.Millisecond)
+	}
+	_, err = runJS(func() (interface{}, error) { return nil, nil })
+	assert.Nil(t, err)
+}
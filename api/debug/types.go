@@ -11,9 +11,16 @@ import (
 
 type TracerOption struct {
 	Name   string `json:"name"`
+	Code   string `json:"code"` // JavaScript code of custom tracer, exclusive with name
 	Target string `json:"target"`
 }
 
+// TxTracerOption option to trace all clauses of a tx.
+type TxTracerOption struct {
+	Name string `json:"name"`
+	Code string `json:"code"`
+}
+
 type ExecutionResult struct {
 	Gas         uint64         `json:"gas"`
 	Failed      bool           `json:"failed"`
@@ -75,6 +82,7 @@ type StorageRangeOption struct {
 	KeyStart  string
 	MaxResult int
 	Target    string
+	After     bool // state after the target clause executed
 }
 
 type StorageRangeResult struct {
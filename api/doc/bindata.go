@@ -236,7 +236,7 @@ func swaggerUiSwaggerUiJs() (*asset, error) {
 	return a, nil
 }
 
-var _thorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6b\x73\xdb\x38\x96\xe8\x77\xff\x0a\x54\xe6\xd6\x75\x7a\x2a\x91\x01\x10\x24\x40\x7f\xeb\xee\x64\xb7\x5d\xd3\x33\xc9\x4d\xbc\x33\x1f\xb6\xb6\xae\xf0\x38\x90\x38\x91\x48\x0d\x49\xf9\x71\x7b\xf6\xbf\xdf\x02\x41\x51\x24\x45\xc9\x94\x23\x67\xed\x9e\xa8\xab\xba\x1c\x12\x8f\x83\xf3\xc6\xc1\xc1\x61\xb6\x82\x54\xae\x92\x4b\x14\x4c\xf0\x84\x9c\x25\xa9\xcd\x2e\xcf\x10\x2a\x93\x72\x01\x97\xe8\x7a\x9e\xe5\x50\x94\x67\x08\x19\x28\x74\x9e\xac\xca\x24\x4b\x2f\xd1\x3f\xcf\x10\x42\xe8\xd3\xfb\xcf\xd7\x76\xbd\x40\x3f\x7e\xbc\x42\x65\x86\xa4\xd6\x50\x14\xe8\xaf\xf0\xf3\x5c\x26\x69\xd5\x15\xfd\x05\xca\xdb\x2c\xff\x72\x56\xb5\xff\xcf\x8f\x79\xf6\x77\xd0\x25\xfa\x25\x5b\xc2\x7f\xbd\x9e\x97\xe5\xaa\xb8\xbc\xb8\x98\x25\xe5\x7c\xad\x26\x3a\x5b\x5e\xdc\x80\x76\x7d\x2f\xca\x79\x96\xff\x70\x86\xd0\x22\xd1\x90\x16\x70\x59\x75\x4f\xe5\x12\x2e\xd1\xaf\xff\xfe\xf1\x57\x07\x6b\xf5\x68\x9d\x2f\x2e\xd1\xf9\x66\xa0\xdb\xdb\xdb\xc9\x2c\x5d\x4f\xb2\x7c\x76\x51\xf7\x2c\x2e\x16\xb3\xd5\xe2\xad\x5b\x1b\xa4\x93\x79\xb9\x5c\x9c\x9f\x21\x74\x03\x79\x51\xad\x83\x4c\xc8\x04\x9f\x9d\x15\x90\xbb\x47\x6e\x9a\xb7\xf5\x98\x17\xe7\xd5\x04\x9d\x55\x2f\x32\x2d\x17\xc8\xc1\x86\xd2\xcc\xc0\xd9\x59\x29\x67\x75\x27\x0f\xdb\x8f\x5a\x67\xeb\xb4\x2c\x76\xbb\xfe\xe8\x71\xe3\xb1\xe4\xda\xa0\x4c\x39\x54\x14\xad\xde\xd7\xb9\x4c\x0b\xa9\x5d\x87\x83\x23\x94\xdd\x76\x9b\xee\x3f\x2d\x32\xfd\xe5\x60\x47\xb5\x69\xb1\xe9\xf2\x6b\x36\x3b\xd8\x01\x6e\x20\x2d\xd1\xff\xf6\x33\x5a\xc8\xd1\xc2\x77\xd8\xf4\xff\x8b\xc3\xc2\x81\xfe\x0e\x4b\xa8\x28\x65\xb9\x2e\x90\x63\xac\x56\xd7\xcf\x6b\xd5\x74\x19\x80\xa1\x7e\xad\x00\x25\x69\x09\x8e\x05\xc1\xa0\x62\xbd\x83\xb3\x77\xa0\xd6\xb3\xdd\xee\xd5\x63\xb4\x2e\x93\x45\x52\x26\xe0\xc7\x3f\x5b\xc9\x72\x5e\x91\xeb\xa2\xa6\x41\x71\xf1\x9b\x34\x26\x87\xa2\xf8\x6f\xcf\x61\x2b\x99\xcb\x25\x94\x35\x2b\xb8\xdf\x5b\xf4\xbf\x72\xb0\x97\xe8\xfc\x0f\x17\x3a\x5b\xae\xb2\x14\x5c\xb7\x6d\xbb\x8b\x1f\xfd\x00\x57\xe9\x47\x59\xce\xcf\xc7\xf6\xfa\x04\x37\x89\xe3\xc0\xab\xf4\xff\xac\x21\xbf\xf7\xfd\x66\x50\x6e\xa6\xdd\x30\xd6\x66\xb8\x0e\x63\x21\x54\xac\x97\x4b\x99\xdf\x5f\xa2\x4f\x50\xe6\x09\xdc\x40\xc3\x55\x06\x4a\x99\x2c\xea\x66\x03\x22\xeb\x7e\x49\xaa\x17\x6b\x03\x05\x9a\x2a\xb9\x90\xa9\x86\xe9\x1b\x34\x85\x14\xf2\xd9\xfd\x14\xc9\xd4\xa0\xe9\x5c\x16\x3f\x67\xc6\x3d\x57\xf7\xcd\xd0\xd3\x1a\x57\xd3\x09\xfa\x31\x6d\x9e\xde\x26\xe5\x7c\xdb\x01\x29\x40\x7f\x2c\xf3\x35\xfc\x11\x25\x05\x92\x48\x67\x69\x99\x4b\x5d\x4e\xce\x9a\xd9\x7f\x49\x8a\x32\xcb\x13\x27\x49\x5d\xa0\x91\x96\xa9\xeb\xff\x8f\x35\xe4\x09\x18\x37\x75\xb1\x02\x9d\xd8\xfb\x24\x9d\xa1\x69\x5e\xa3\x6c\x5a\x35\xb8\x47\x45\x99\x27\xe9\x6c\x52\x8f\x9b\x43\xb1\xca\x9c\xbc\x6f\xb1\x76\x4e\x31\x3e\xdf\xfe\xb3\x87\x8e\x0f\x7f\x6a\xbd\x71\x60\x42\x5a\xb6\x1b\x23\x24\x57\xab\x45\xa2\xa5\x6b\x7e\xf1\xf7\x22\x4b\xbb\x6f\x11\x2a\xf4\x1c\x96\xb2\xff\x14\x0d\x92\xde\xb7\x2d\x2e\x6a\x3a\x9e\x7b\x74\xac\xb2\xe2\x68\x8a\xbf\xbf\x03\xbd\x2e\xb7\x04\xd7\x1b\x11\xdc\x4b\xee\x32\x43\x45\xb2\x5c\x2f\x64\x09\x0d\x3d\xd0\x12\xca\x79\x66\x90\x96\x8b\xc5\x9b\x8a\x86\xd9\xba\x44\x05\xa4\xc6\xe1\xba\xa5\x60\x1a\xb5\x81\x2a\xc5\x3c\x69\x46\x6d\xfe\xb8\x2a\xcf\x0b\xb4\x2e\xc0\x19\x02\xa7\x32\x8a\x32\x59\xba\xa9\x66\xd2\x3d\x96\x33\xa8\x58\x0a\x2a\xb0\xdd\x80\x39\x14\xeb\x45\x89\x32\xeb\xd8\x63\x21\xd7\x05\x6c\x69\xf8\x8f\x35\x14\xe5\x4f\x99\xb9\xdf\x62\xa2\xb3\x28\x99\xcf\xd6\x4b\x87\x50\x3f\x66\x7a\x93\xe4\x59\xea\x1e\x34\xcd\xdd\x18\x49\x0e\xe6\x12\x39\x2e\x3c\x3b\x40\xe0\xc3\xe4\x1d\x26\xee\x21\xd2\xfe\x2c\x17\x8b\x77\xb2\x94\xe7\x2f\x8b\x23\x1d\xd8\x9f\x2a\x92\x9c\x77\x34\xe3\x1f\x2f\x77\x58\x74\x57\x3b\x3e\x56\xd3\x3d\x82\xdd\x91\x92\xa5\x9e\x3b\xb6\x71\x1c\x5f\x8c\x67\xf9\x2d\xe7\x55\x2c\xd7\xe2\xed\xdf\x07\xdf\xfd\xe4\xf0\xf2\x42\x99\xaf\x81\x7d\xc3\x81\x6d\x16\x7c\x5e\x0c\xa8\xee\x4b\x38\x92\xf3\x1a\x65\x6b\x60\xb5\xc8\xee\x1d\xbf\x7c\x0b\x55\x3b\x34\xed\x7e\xa5\xdb\x1a\xfe\x0f\x7f\xf8\x03\xba\xbe\xfa\xf8\xb9\x4d\xc3\xb7\x68\x6a\x64\x29\xa7\x28\x49\x37\x72\x82\x54\x66\xee\x9d\x79\x2f\xe7\x2d\xb4\xd4\x63\xd7\x73\xef\x1d\xc1\xb3\x65\x67\x88\x7c\x9d\x96\xc9\xb2\x3d\x94\x2c\x8a\x64\x96\x82\x69\xbb\xcb\xb7\xf3\x44\xcf\xab\xf6\xcd\xfa\x1c\xbe\xa0\x5e\x25\x98\xdf\x85\x30\xbf\x50\x39\xde\x27\xc2\x5b\xff\xfa\xc2\x51\xf6\xf7\xe2\x64\x3f\xec\x73\x25\x16\xc9\xf4\x7e\x82\x7e\x81\x1c\x6a\xa6\x35\xe0\x18\x7e\x87\xd9\x5f\x98\x03\xeb\xbc\xfc\xbd\x34\x76\x8e\xbd\x9c\xc1\xc5\x6f\x5f\xe0\xfe\x5b\xef\xa8\x3e\xfb\xb9\xff\x04\xf7\xcf\x85\x4b\x6a\x6c\xa0\x1b\xb9\x58\x3f\xc0\x2e\x36\xcb\xd1\x2c\xb9\x81\x14\x7d\x81\xfb\x17\xc6\x11\x35\xe2\x3d\x53\xb4\x43\x13\x17\xbf\x25\xe6\xf1\x5c\x70\x7d\x77\xf5\xee\x58\x4a\xca\xdb\x9e\x91\x7f\xb0\xcb\x2f\x20\xcd\x58\xc2\xef\x84\x67\x86\x88\xdf\x42\xc0\x61\x92\xab\x7b\x74\xf5\xee\x85\x91\xfa\xfa\xee\x43\xfe\x49\xde\x5e\xdf\xfd\x2d\x29\xe7\x7f\x06\x67\xa6\x06\x89\x7e\x91\x83\x86\x64\x55\x7e\x4b\xe2\x3f\x25\x25\x51\xbd\x9e\xdf\x1f\x45\x3f\xf9\x85\xed\xd2\x71\xd7\xf9\x3e\x06\x89\x3f\x67\xcb\x65\x52\x8e\x17\x06\xe7\x1a\xca\x5b\x94\xe5\xa8\x28\xf3\xb5\x2e\xd7\x39\x18\xa7\x14\x97\xb2\x9c\xa0\x2b\x8b\x52\xe7\x55\xcf\x52\xe9\x5e\xb8\xc6\x3b\xad\xde\x34\x43\x4d\x5d\xc3\x24\x9d\xfd\x22\x8b\xf9\xb4\xb2\xb8\x50\xae\x73\xe7\x4c\xf6\xfd\xcf\x83\xdb\xbf\xff\x39\x17\xf0\x93\xbc\xfd\x90\x7f\xae\xfc\xdf\x0f\xf9\x7f\xa4\xde\x13\xbe\xbe\x7b\x61\x1e\xe1\xd5\x3b\xbf\x88\x9a\x12\x9e\xc1\x7c\xfc\xf9\xe2\xb7\x4d\x28\xef\xf1\xd6\x61\x6b\xb4\xb7\x4a\xe2\x80\xc0\xb7\x42\xe3\x43\xa2\x5e\xc1\x35\x42\xb8\x1d\x83\xa6\xeb\xa5\x82\xfc\x8d\xfb\xf3\x5c\x41\x51\x9e\x57\xc6\xdb\xed\xf7\xdc\xce\xc8\x0d\xf4\x0c\x55\x80\x5c\x2c\x3e\xd8\xdd\xc7\xfb\x10\xdd\x6c\xcf\xdd\x72\xce\x07\xbb\x95\xf7\x2b\xb8\xac\xcf\x30\x06\x1a\x20\xb4\xca\xb3\x15\xe4\x65\xd2\x5e\x7e\xf7\x97\x14\xd7\xf9\x3a\xfd\xb2\xef\x35\xaa\xe7\x50\x59\xb6\x00\x99\xee\x6d\xd5\x41\xe1\xed\x1c\xca\x39\xe4\x7e\x6b\x5a\xed\xa5\x93\x02\xb9\xad\xf5\xdc\xc9\x71\x5a\x9d\x43\x5d\x2c\xb2\x59\x71\x51\x1d\x6d\x3c\xac\xe5\x9a\x13\x92\x16\xdf\xfc\x5b\xb2\x28\x21\xaf\x0f\x47\x16\xdb\x06\x7b\x58\xe7\x7d\xd3\x0e\xc9\x1c\x1c\x62\xcc\x5a\xfb\xf8\xf6\xf4\xc3\xc7\xff\xfb\xeb\x87\x7f\xaf\xf6\xc6\xef\xff\xfa\xe7\x67\xaa\x91\xaa\x05\xf8\x45\x3f\x43\x2d\xe4\xb9\x44\xe6\xb9\xbc\xdf\x79\x97\x94\xb0\x1c\xe4\xbf\xbd\x02\xf1\x90\x48\x54\xb8\x18\x12\x89\x51\x42\x31\x46\x2c\x10\x5a\x42\x39\xb0\xce\xed\xef\x10\x7c\xbf\x66\xb3\xad\x63\x56\x31\xfa\xe6\xec\xee\xab\x78\xbd\x7f\x00\x88\xf6\xb3\xfb\x75\xbb\x69\xc5\xf1\x39\xe8\x2c\x37\x60\x9c\x20\xfe\xf5\xfd\x75\x33\x58\xf7\xfc\xe6\x59\xb1\xfc\x66\x11\xdf\xb9\xbe\x83\x8e\x17\xc0\xf8\xfb\xfa\xf6\x34\xff\x80\x83\x6b\x60\x95\x83\x96\x65\x8f\xdf\x9e\x85\x45\x78\x54\xe4\xdb\x43\xf5\x21\x37\x90\xf7\xf6\xc5\xa3\x3b\x37\xb1\x98\x4e\xf7\x87\x63\xac\x1e\x13\xd6\xa3\x45\xe7\x49\x09\x79\x22\x9f\x81\x5c\xb7\x4c\xd9\xaf\x30\x93\xfa\xfe\x5f\x5e\xb4\x5f\xae\x41\x7b\x12\x11\x7e\x72\x43\x77\x62\x49\x7e\x58\x14\xdb\x2b\x7a\x86\x12\xd9\xb5\xb4\xdf\x85\xf2\xa5\xd9\xdb\x81\xee\x95\xa8\x7e\x43\x2b\xfb\xdd\x38\x7e\x37\x8e\xe8\xbb\x71\xfc\xe6\x76\xf1\xbb\x29\xfb\x6e\xca\x7e\x57\xa6\xcc\x49\x51\x9a\x19\xb8\x48\x7d\xd6\xfa\xc5\x0a\x1a\xe6\x3e\x10\x5d\xfe\xcb\x36\x1d\x60\x37\xb6\xac\xb3\x34\x05\x5d\x82\x41\xd5\x60\xcf\x8f\x1d\xf6\x92\xfc\x10\xca\x3e\x02\xe4\x9f\x4b\x59\x16\x1e\x69\x45\x3b\x99\xdb\x87\xf9\x1f\xc4\xda\x6e\x02\x78\x0b\x7d\xaf\xff\x06\xaa\xc8\xf4\x17\x28\x7f\x68\xa5\x82\xa7\x70\xbb\xcd\x61\x47\x8f\xd5\x40\x1f\xb3\x22\x29\x77\x13\xc2\x9e\x1f\x65\x4e\x1f\xb5\x3f\xd4\xed\x83\x2a\xb2\x05\x94\xd0\xee\xb9\x4b\xdb\x56\xd8\xfc\xf4\xb4\xf5\x3e\xe3\xe1\x5d\x97\xcf\x03\x2b\x64\x99\x14\xf6\xbe\xd1\xfe\x28\x49\x7d\x5a\xf8\x4e\x5a\xdb\x29\x59\x64\x7b\xed\x40\x1a\x93\xb7\xf0\x94\xa4\x97\x7e\xfa\xb3\xc3\x54\xf5\xca\xce\x67\xae\xef\xe3\xa7\x3a\x9b\x06\x65\xb6\x76\x14\x61\x99\x94\x25\xe4\x3b\x30\x94\xf8\x89\x20\x28\xb3\x55\xa2\x71\x03\xc0\xee\xc4\xe4\x29\x27\x26\x07\x26\xa6\x4f\x39\x31\x3d\x30\x71\xf0\x94\x13\x07\x07\x26\x66\x4f\x39\x31\xeb\x4f\xfc\xf2\x95\xdf\x5e\x8f\xfd\x78\xe5\xb7\xed\x79\x82\xc3\xce\xc3\xfe\xc9\xa3\x36\xda\x07\xf5\x74\xf7\xd4\xe7\xf4\xaa\xba\xd9\x6c\x9c\x44\x5b\x3f\x8d\x92\x2e\xef\x3e\xe4\xc9\x2c\x49\x9f\x48\x84\xaa\xcc\x8c\xbc\xad\xaf\xcb\xbb\x7a\xc1\x4e\x12\x64\x92\xfa\xfc\xe6\x0d\xaa\x76\xe0\x2b\x20\x35\xf0\x0d\xcc\x48\x99\x7d\x81\xb4\x3f\xdb\x06\x88\x1c\x74\xb2\x4a\xda\xba\xe7\x89\xe1\xe8\x4f\xf8\x12\x74\xce\xd7\x6e\x72\x1e\xab\x7a\x9e\xe3\x06\xa9\xe7\xeb\x83\x7c\x12\x77\xb0\x75\x11\xe2\xbc\x40\x6e\x96\x51\x9a\xa6\x16\xbc\xcd\xe8\x8e\xeb\xb6\x9b\x86\x37\x55\xc2\xbf\x5a\x64\xd9\xb2\x8e\x1e\x38\x01\x95\x25\xaa\x96\x5c\x24\x6e\x93\x56\x5d\x67\x94\xd6\xfa\x2d\x5b\xcd\xbc\xdb\x2c\xed\x53\x2a\xaa\xdf\x03\xe3\xff\x04\x72\x6f\x7c\x6c\x1c\xd3\x3b\x96\x32\xa0\xd6\x33\x67\xb2\xf4\x60\xf4\xaa\xcf\x4e\xdb\x3b\xbf\xed\x94\xc3\x1c\x64\x75\x4f\xcc\x0f\x33\xc0\x2c\xcd\x08\x36\xcb\x9b\x6b\x30\x0d\x2d\x9e\x5b\xfa\x81\x86\xfc\x43\x05\x77\x7d\xa2\x7d\xf6\xfc\xb8\xa6\xa3\x99\xb6\x74\xac\xf3\xde\xdf\xe6\x32\x9d\xc1\x23\xa9\xd9\x84\x4f\x36\x49\xf4\xd5\x60\x87\x35\x80\xb3\x30\x73\xe8\x5e\x4c\xf6\x49\xf5\xb5\x18\x3f\x4f\x5a\xd7\xf9\xf3\x9f\xdc\x02\x6b\x8a\x3f\x3f\x52\x8f\x5d\xc0\xf9\xd9\xd9\xb6\x85\x1b\xa6\x6e\xe4\x47\xac\xaf\x4e\x6c\x86\x1f\x32\x5b\xf5\x8d\xf4\x36\x04\x63\xbc\x8c\xba\x9b\x73\x2c\xd7\x69\x52\xa2\xbf\xbd\xbf\x7a\x83\x56\x39\x14\x90\x36\x5a\x7d\x0e\x77\xbb\xa3\xc0\x9d\x5c\xae\x16\x70\x89\xce\xf1\x1d\xe3\xd6\x12\x1b\xe3\x80\x72\x29\xb1\x15\x2d\x93\xec\x6f\xc7\x1f\x0b\x95\xef\x55\x01\x95\xa4\x8f\x04\x4a\xdb\x88\x32\x12\x0a\x13\xc6\x24\x88\xc5\x16\xa4\xfa\xca\xfd\x2e\x4c\xbb\x09\x93\x7b\x53\x24\x37\xb2\x32\x97\x45\xfb\x52\x53\x07\x06\x2b\x17\x05\x78\xed\xd3\x9e\x6f\x88\x78\x7a\x10\x9e\x83\xcb\x8b\xb0\xfb\x8f\xe1\x90\x46\x18\x63\x81\xad\xc1\x58\x92\x28\x8c\x28\x97\x5c\x72\x1a\xe0\x50\x50\xac\x69\x60\x02\x09\xd4\x68\x11\x49\x43\x02\x1c\x46\x44\x52\x41\x63\x23\xb8\xe6\x5a\x09\x16\x84\x41\x14\xb2\x98\x2a\x43\x42\x26\x40\x71\xe0\x56\x63\x1b\x44\x01\x55\x10\x63\x4c\xe3\xfa\xce\x7d\xcd\xad\x87\x96\x51\x5d\xd8\x39\x72\x1d\xf8\xeb\x7e\xa4\x86\xee\xfa\xee\xcf\x2d\x3f\x6d\xf7\xf0\xa3\xbe\x8f\xe0\x9c\xb9\x4d\x41\x8d\x7d\xab\xa8\x7c\x9e\xab\x77\x47\x4b\x92\xcf\x9a\x35\x90\x96\x89\x4d\x20\x47\xaf\xd5\x7d\x09\x45\x40\x7f\xd8\xbf\x72\x66\x23\xad\x85\x50\x8a\x45\x34\x92\x31\x8d\x31\xe7\x44\x80\xa0\x96\x86\xa1\x12\x56\x86\x84\xb0\x30\x90\x5c\x80\xe0\x31\x07\x25\x34\xc8\x20\x88\x03\x45\x49\x78\xde\x85\xf8\x2f\x55\x7e\xf5\x2e\xd4\x49\x5a\xc2\xac\xb3\x5b\xf2\x99\xff\x97\x95\x6c\x05\xf4\xf0\x7a\x7c\xd6\x36\x7a\x3d\x87\x64\x36\x2f\x07\x97\x12\xd0\x30\xa0\xac\x0b\xcc\x75\xb2\x84\xa2\x94\xcb\xd5\xb1\xf0\x44\xec\x30\x3c\xeb\x34\xb9\x43\xe5\x66\xf4\x21\x70\x48\x18\x04\x34\xe2\x31\xc6\x9e\x33\x6a\x1f\x7c\x90\x35\x7c\x9c\x30\xeb\x9e\xd2\x7d\x67\x92\x7f\x29\x26\x69\x26\xbe\x3b\x9e\x9c\x6d\xd5\xb2\x25\xea\x1e\x52\x52\xc1\x94\x92\x21\x06\xcb\x39\x17\x22\xb6\x96\xc8\x20\xe2\x60\xb0\x0a\x84\x09\x21\x8c\x68\xc4\x09\x63\x9c\x6b\x86\x0d\x04\xc2\x70\xa2\xc1\x98\xc8\xc6\x56\x32\xce\xcf\x5b\xa0\xfa\xf8\xcc\xd7\x80\x9b\x55\x23\xa0\xd7\x3e\x18\xb3\x8f\xfd\x8c\x62\x98\x72\xc6\xb9\xa2\x52\x58\x60\x5a\x04\x3a\x32\xd2\x02\xb7\x22\x8a\xb8\x50\x8a\x28\x21\x85\xa9\xb5\xf0\x4f\xdb\x03\xac\x61\xb1\x49\x9f\x09\xff\x25\x66\x04\xee\x36\x20\xd4\x22\x3a\x56\xa6\x9f\x5c\x92\x8b\xe4\xff\x0d\x58\xd9\xc7\xa2\xf0\xd3\xaf\x1f\x11\xa4\xce\x03\x31\xf5\x52\xdc\xf8\xce\xf7\xaa\xd6\x3d\x88\x4c\xbe\x0d\xeb\xaf\x64\x0e\x69\x39\x4a\x74\x46\xe2\xd3\x8f\x58\xc3\x72\xf5\xee\x30\x3a\x15\x0f\xb0\x51\x26\xc6\x16\x0c\x8e\x0d\x89\x42\x65\x8d\x0d\x02\xad\x31\x80\x61\x1c\x34\x8e\x44\x1c\x08\x1b\x01\x70\xc5\x35\xa1\x92\x81\x8c\x1d\xc7\x36\xd0\x3e\x2b\x35\x34\x93\xc5\xaf\xc9\x32\x29\x4f\x0d\xcc\x4c\x16\x68\xe1\x06\x46\xaf\x97\xf2\x0e\xc9\xc5\x22\xbb\x05\xe3\x1c\xd9\x75\x55\x75\x23\xb9\x69\x97\xc3\x70\x5b\xc2\xd6\x35\xc5\x41\x91\x22\x84\x86\x41\xc8\xe3\xad\x52\x87\x14\x6c\xa2\x13\xb7\x11\x3d\x19\x37\xb4\xc2\xa0\x1b\xa7\xbb\xcc\xfc\xbd\xd2\xcd\x05\x34\x94\xc3\xad\xcc\xcd\x1e\x46\x51\x0c\xc7\x4c\xd3\xd0\x8a\xc8\x44\x54\x58\x63\x42\x4e\xa4\xd5\x0c\x73\x6e\xb1\xc1\x24\x8e\xa4\x55\xac\xb5\x41\x98\xc9\xe2\x3f\x0a\x18\xd0\x10\x8f\xa5\xc0\x38\x24\x0f\xc1\x4f\x09\x6e\x9b\xa8\xac\x94\x8b\xcf\x3a\xcb\x8f\x16\xff\xfd\xb0\x15\xeb\x65\x85\xdb\xc5\x02\xb9\x8d\x60\x51\xe6\x72\x51\x87\xfd\xce\x51\xe1\xe6\x1a\xa4\x3d\xa6\x71\x2c\x44\xcb\x22\x15\x9f\xb2\x6c\x80\x69\x1f\x4b\xf6\x3c\xcb\xaa\xdd\xd5\xbc\x8f\x25\xa7\x98\xb6\x97\xd4\xf6\xd0\x5c\xc4\xc6\x9a\xd8\x6a\x43\xb0\x8e\x21\x0c\x4c\x24\xc2\x98\x6a\x2b\x54\xc8\xb0\xa2\x02\x2b\x4e\x4d\x20\x88\x12\x91\x08\x69\x40\x69\x10\xc7\xd4\x06\x80\x63\x29\x70\xa4\x54\x4b\xd7\x96\xb2\x84\x27\x5c\xda\xa6\x18\x84\x9f\x68\xdf\x72\x22\xa5\x75\x64\x28\x61\x4a\xc7\x46\x18\x6c\xc0\x28\x49\x30\xa1\x32\x0a\xb4\x08\x08\x37\x24\xd6\x10\x73\x1b\x61\x2d\x24\x05\x1b\xea\x30\x56\xca\x30\x6c\x18\x8d\xc8\x76\x39\xf5\x7d\xec\x6f\x44\xac\x66\xba\x3d\xeb\x22\x21\x17\x1c\x68\x18\x04\x9a\x71\x0c\x42\x46\x42\x40\xa4\x0d\xe1\x92\x00\x10\x6a\x04\x0b\x9d\xaf\x64\x42\x2b\xa8\xa1\x9a\xe0\x18\xa8\x89\x28\x8d\x8c\x80\x90\x41\xdb\x24\x3a\x2f\xe6\xd8\x15\x51\xbc\xd7\x53\x9a\x03\xca\x52\x40\xb7\x73\x7f\x81\x1a\x0c\x2a\xe7\x49\xd1\xb9\xf0\xda\x5f\x8d\x54\x5c\x51\x6e\x75\x0c\xdc\xd0\xd8\xc6\x96\x42\xa8\x4c\x10\x11\xce\xb8\x0c\x43\x12\x1a\xac\x35\x35\x2d\x6a\xec\xde\x1b\x6f\xc3\xde\x4f\x34\xdb\xe7\xca\x15\xe8\xea\x5d\x1b\xc3\x03\x79\x49\x7b\x90\x71\x88\xc0\x07\x5c\xc7\x8e\x4d\x3e\xb5\x8f\xeb\xe3\x25\x55\x88\xf9\x90\x23\x59\x66\xc7\x3a\xbf\xe7\xcd\xf9\x59\x55\x0b\xad\x9a\xe1\x0d\x4a\xd7\x8b\x45\x15\xd7\x1e\x2a\xfe\xd4\x6c\xce\xce\xf7\x90\x3c\xc4\x01\x93\x32\x8c\x31\xa1\xa1\x8a\x18\xa6\x81\xc4\x34\xa2\x84\x50\x15\x0b\xc3\x29\x04\x5a\x00\xc3\x2d\x46\x1d\x1b\x22\xe9\x80\x3e\x87\xbb\x8a\x52\xdb\xb3\x40\x5f\xc1\xa9\xb9\x26\x01\xfb\x8c\x1f\xe3\xd6\xa8\x40\x07\x96\x85\x91\xc6\x18\xe3\x2d\x24\x46\x76\xcf\xb5\xc6\x00\x92\xa4\xab\x75\x59\xf5\xac\x71\xb3\x6f\xdf\xd0\x44\x65\xda\xc1\xe2\xc1\xc8\xd7\x5c\x26\xe9\xb5\x9c\x1d\x6b\xd0\xc4\x3e\x10\x17\xb2\x28\x2b\x76\x76\xc8\x9a\x41\x0a\xc5\x46\x6c\xf7\xf8\x92\x41\xdc\xdd\x95\x7e\x02\x7b\x2c\x5a\x84\x97\x1f\xb4\xca\xc1\x26\x77\x6e\xe2\x22\x5b\xc2\xb1\x1e\x6c\x2b\x68\x7a\xb7\x4a\x72\xd9\x3d\x7b\xf9\x5a\x37\xff\x7c\x3b\x28\xca\xa1\xf6\x45\x36\x85\xd3\x3e\x81\x7d\xd3\x84\x80\x55\x3f\x0d\xae\x01\x9a\xb7\x14\xa6\x17\xa0\x11\x6a\x6b\x40\x1d\x1d\xac\x93\x54\x8d\xdb\x71\xc6\x3e\xe6\x89\x86\x9f\xb3\x21\xba\x3c\x92\x49\x74\x06\xd6\x79\xaa\x4e\xc8\xd7\x85\xaf\x97\xa6\xe5\x42\xfb\xf2\x73\x4e\xf9\xdb\x24\x95\x8b\xca\x57\x5b\xb9\xd9\x87\xb0\xd1\xf1\xd9\x4f\xe7\x90\x55\xde\xf9\xd2\x57\x3a\xb6\x15\x04\x75\x79\x57\x9d\xa5\xc5\x7a\xe9\x81\x85\xba\xb8\x5e\x65\x94\x76\xab\x85\xa0\xfd\x3e\xa4\x81\x15\xa4\xa6\xf8\x30\x26\x78\x30\xd2\xfc\x5f\xbd\xdb\x1c\x21\x75\x02\x0c\x69\xbb\xf4\xdc\x3a\xaf\x36\x75\xed\x06\x35\x24\x28\x4b\x27\x9b\x25\x3a\x6d\x3c\x19\x5a\x83\x7b\xb1\x0d\x22\x64\xe3\x0e\x3a\x3a\x86\x29\xd6\x34\xe4\x10\x44\x20\x23\xe0\x54\x6e\x82\xda\x75\x91\x90\xcd\x68\xbd\xf3\xdc\x07\x92\x17\x2a\xed\xd6\x4e\x9f\xd9\x93\x72\xb0\x2f\xcd\xa0\x29\xcd\xd2\x3f\x37\xda\x6b\xaf\x07\x32\x69\x7c\x6d\x97\xc1\xe3\x90\x3e\x12\x22\xae\x8d\x08\x89\x8a\xb1\x55\x98\x44\x2c\xe4\x4a\x05\x58\x6b\x65\xa4\x0c\x18\x0e\x6d\x60\x54\x14\x71\x23\x41\xc5\x21\x0d\x05\x10\x11\xc6\x3a\x64\xa1\x82\x00\x6b\x82\x2d\xe1\x02\x33\x1e\x59\xae\x23\x25\x29\xd3\x3c\x34\x34\xd2\xc2\x12\x19\x1b\x1b\xc6\x16\x44\xac\x08\x0e\x75\x64\x45\xc4\x83\x40\x13\x13\x6a\xa2\x39\xb3\x84\x69\x13\xd3\x56\xb4\x7e\x53\x08\xe9\x7f\x06\xf1\xdd\xf0\xcf\x31\x18\x6f\x85\x6e\x77\x79\xfe\x00\xea\x4f\x17\xfc\x73\xbf\x6c\x27\xfc\x77\xcc\x1a\x06\x9d\xdb\xb1\x0b\x19\x1f\x11\x6c\x8f\xd0\x0f\x59\xa1\x03\x6a\x12\x1d\xb2\x69\x3b\x8b\xa9\x4c\x7d\x15\xb1\x1a\xd0\x41\x55\x92\x4a\x52\xb4\x63\x5c\xfb\x96\x46\x02\xdc\x79\x35\x94\xf6\x73\x98\x27\x9b\x4c\x1f\x84\xaa\x5a\x5f\x87\xdc\x9e\x5c\xde\x7e\x8d\x13\xb8\x89\xd7\x3d\xa0\xf9\xcf\xf1\x9d\x15\x51\x2c\x88\x92\x02\x63\x69\xa4\x89\x63\x36\xe6\x54\x8d\xb3\xc8\x0a\x4a\x39\xc1\x02\x63\x22\x68\x48\xb1\x70\x7f\x69\xac\x04\x23\x8c\xc7\x54\xc7\x2c\x88\xc3\x98\xe1\x58\x04\x34\x88\x31\x86\x88\x71\xcc\x19\xd5\x46\x70\x0e\x3a\xb6\x71\x8c\x23\xa5\x25\x0e\x43\x82\x81\x51\x62\x03\x85\x49\x00\x86\x52\x12\x50\x06\x9c\x6b\x49\xb0\x09\x58\x14\xa9\x80\x2a\x22\x30\xd6\x9c\x02\xa1\x9c\xc4\x8a\x92\xc0\x12\xc3\x74\xc0\x71\x80\xc3\x20\x8e\x8d\xa1\x5c\xda\x38\xa2\x11\x8d\x98\xf3\x62\xb7\x68\xee\x6b\x92\xef\xe8\x7e\x02\x74\xef\x93\x8a\xd1\x12\xf1\xfe\x06\x0e\xe7\x2f\xd4\x71\xbe\xa3\x8f\x34\xe6\xd0\x0e\x11\x36\xbb\x38\xef\x7a\xd4\x05\x22\x7c\x3a\xa9\x3f\xec\x7b\x5d\xef\xfc\xf7\xed\x5c\x78\x18\x71\x23\x02\xc5\x95\x30\x02\x4b\x63\xb4\xa2\x82\x48\x4e\x4c\xc8\xac\xe6\x2a\x08\x22\x66\x2d\xb4\xe3\xc7\xd9\x2a\xd1\x8f\x73\x84\xf7\x6a\xec\xce\x1e\xce\x00\x27\x96\x9a\x50\x08\x29\x85\x24\x20\x31\xb6\x20\x02\x42\x4d\x4c\xe3\x28\x32\x92\x51\x66\xe2\x38\x88\x65\x48\x88\xd5\x58\x81\x20\x10\x85\x56\x9a\x90\x4a\x2b\x8e\xde\xf2\x9d\x76\x72\x6f\xf0\x3b\x59\xd5\xc3\x1c\xe0\xf3\x6c\x8f\x65\x80\x0d\xf1\x2b\x55\x5f\x54\x0e\x65\xb5\x45\xde\x17\x73\x3a\xde\x7e\x35\x71\x83\xaf\x02\xad\x8e\x58\x3f\x00\xdd\xf1\x01\x05\xbf\x55\x38\x1a\xb4\x66\x83\x71\x10\x9c\x81\xf0\x81\x57\xbc\xed\x32\x95\xc3\xd4\x3c\x45\x10\x7d\xcf\x16\xc6\x6d\x09\xe5\xfd\xe3\x59\xa5\x75\x94\xe0\x5c\xa0\x95\x4c\x8c\xdf\x05\xce\xe4\xe9\xb8\xc6\x8d\xfa\x35\x36\x67\x4b\xa1\x0a\x3e\x9f\x11\xb5\x07\x3a\x42\x83\x08\xac\x56\x5a\xa9\x80\x75\xa3\x3c\xfe\x68\xe4\x34\x80\x1c\x3c\x66\x09\x79\x04\x44\xc4\x16\x33\x1b\xf5\x41\xb8\x81\xbc\x1c\x62\x85\x07\x12\xae\xca\x7c\x0d\x68\x09\xb2\x7d\x1d\xa0\x76\xec\x6e\x65\xd1\x8c\x3b\x04\x90\xcf\xbd\xda\x3c\xce\xd6\xe5\x6a\x5d\x3e\x4e\x45\xef\x4f\x11\xdf\xd8\x9a\x1f\x77\x2d\xd7\x41\x4c\x0f\xac\xf4\x9f\x3b\xaf\x51\x53\x9e\x7d\x6b\xd3\x6a\xfe\x7d\x83\x12\x5b\x97\xc6\xce\x7d\xa6\x63\x55\x10\xdf\x07\x64\xaa\xaf\xc7\x0c\x8c\x36\x14\xde\xec\x24\xf2\x0e\x20\xb1\xb3\xe9\xae\xdf\xb5\x8a\x53\xec\x2e\xf5\xc8\xab\xd1\x47\x5f\x47\xea\x5d\xd4\x7f\x52\x00\x76\x6f\x26\x1c\xe3\xfb\xb4\x13\xff\x11\xda\x14\xa7\x3f\x45\xee\xdc\x21\x35\x7e\x20\x2c\xfc\x95\xd1\xde\x4e\x84\x5c\xcb\x16\x5f\x9c\x3e\xf6\x55\x9f\x4c\xcf\x64\x51\x4d\xdb\x7c\x86\xa4\xa3\xff\xf3\xe4\xf8\x5c\x57\xa9\x8a\x6c\xb1\xae\x3f\xfb\xd0\x0d\xeb\xb9\x25\x1d\x6f\x50\x7c\xaf\xc6\xae\xbc\x5e\x16\xb3\x89\xf7\x62\x36\xde\xe5\x46\x96\x7a\x64\xae\x4c\x0a\x60\x15\xa9\x40\xf2\x88\x0d\x04\xe6\x2b\x95\x1a\x45\x21\x0b\x22\x11\x91\x28\x8e\x80\xe2\x90\x45\x22\xb2\x9c\xb6\xb8\xca\x7f\x3b\xe0\x10\x5f\x3d\x86\xf0\x55\x80\xa0\xd2\x99\x55\xf7\x7d\x56\x07\x07\x61\x18\x49\x1e\x68\x82\x21\x10\xd6\x02\xb5\xda\x79\x2f\xd8\xea\xd8\xb0\x48\x1a\x4c\x98\xb0\x98\x03\x8d\x18\xe1\x40\x08\x57\x86\x80\x86\xd8\xc4\x4c\xa8\x56\x42\xcb\xae\x56\x39\x49\x28\xb9\xa7\x43\x06\xb5\xc7\x49\x26\xda\xd5\x15\x27\x4f\x21\xf0\x59\x03\x60\x90\x59\x3b\xca\x0d\x48\xc5\x5e\x77\xe9\x18\xfb\xbb\xc7\x80\xde\x2c\xdf\xe7\x79\x36\x46\x3e\xb6\x0c\xb2\x49\x09\x6b\x7f\x65\xe7\xe0\x49\xd0\xb7\x3b\x50\xf8\xae\xb0\xc6\x2b\xac\x01\xb2\xbc\x45\x65\xf6\xc8\xdd\x0a\x1a\xa7\x02\xd1\x28\x35\xe8\xdb\xf5\xd8\xac\xab\x11\x77\x39\xa8\xc7\x3d\xa3\x3f\xce\x52\xf5\xa8\x6b\xf0\xac\x3a\x27\xf6\x43\xcc\x9c\x59\x5b\xc0\xa8\x1c\xae\x81\xe3\xa4\x83\xce\xa1\x1f\x19\x25\x29\x5a\xba\x25\x83\xa9\x8b\xe7\xa1\x02\xb6\x91\xef\xc5\xd8\x0c\xb2\x56\x42\xcf\xb8\xe9\x7d\x0a\x59\xb5\x19\x70\xb3\x56\x1f\xab\xf4\xa6\xe2\xec\x60\xdf\x95\xac\x36\xc2\x50\x40\xeb\x2a\xa0\x73\x64\xef\xb3\x35\x4a\x01\x4c\x7d\xb1\xb0\x5a\x8f\x43\x79\x81\x56\x72\x06\x66\x82\x60\x32\x9b\x6c\xf3\x7c\xa6\xd3\x69\xf3\xf7\x6f\x2d\xc8\x5e\x65\x9e\x28\xaf\x2e\x3b\x8f\xdd\x8b\x0a\x61\xaf\x2e\x11\x7e\xd3\x7d\x51\x2d\xe5\x95\x5b\x7a\xf7\x4e\xf8\x7f\x9f\xed\xfe\xd5\x9e\xb6\x0a\x39\xa9\xec\x06\x50\x0e\xb6\xb9\x0a\xb9\xf2\x19\x5d\x9e\x38\x05\xc2\xfe\xce\xa4\x6b\x5b\xbd\xf1\x39\x95\x05\x22\x78\xd2\xc5\x49\x0d\x37\x9a\x3a\x6f\x7b\xba\xc1\x88\xc9\xd2\xf3\xd2\xe3\xa5\xcc\x90\x81\xa5\x1b\x6c\x25\x67\x55\x3d\xc4\x16\x2b\x7e\xda\x5e\x15\x1b\x66\xc4\x75\x3a\xc4\x08\xbb\x6a\x3b\x5d\x2f\xbb\x2a\xf5\xed\x4e\xae\x4b\x25\xf8\xc9\x12\xce\x86\xf8\xa7\xdf\xf8\x00\x0b\x19\xb0\x49\x5a\xc7\xe4\xaa\x03\xe7\xcc\xa2\xa9\xcd\xb3\x65\xfd\x0d\xcd\x32\x9b\x4e\x3a\x1d\xa6\xd5\xe0\xd3\x7a\x2b\xd8\x4e\xf9\x7d\x83\xa6\x0e\xa2\xee\xab\x26\xe3\xf2\x8d\x9b\x4a\xae\x17\x55\xc6\x60\x3d\x48\x77\xe4\xe6\x1f\x6e\xfa\xd3\x84\x2a\xda\x72\xb4\x65\x99\x81\x6c\x95\xc7\x0c\x4e\xaa\x70\xf1\xd9\x61\x51\x6b\xe3\xb7\xba\xfd\xe7\x96\x5f\x17\xfd\x4a\x52\x2f\x50\x0f\xcb\x53\xd5\x73\x57\x9a\x1c\xc1\x5e\x5d\xa2\x57\x15\x36\x5f\xf5\x24\xca\x61\xb1\x12\xa8\xde\xf3\x32\x7b\xe5\x61\x3f\x42\xca\x36\xb2\x95\xb5\xd6\xe1\xc6\xaf\x89\x4c\xf0\xf6\xab\x6f\x6e\xe4\xd6\x8a\xbc\x20\x15\xa5\x4c\x8d\x37\xc8\xd5\x79\xfe\x7a\xb1\xf0\xa3\xb4\x0a\xdd\x54\x7f\x5d\x67\xab\x44\x7f\x86\xd2\x97\x1d\x3b\x9c\x73\xb4\x4a\x34\x7e\x50\x9a\x7c\x31\x96\x71\xcd\xe8\xb8\x66\xc1\xb8\x66\xec\x81\x66\x7b\x18\x46\x3a\xdb\xe1\x37\x91\xab\x44\x17\xe8\xef\x59\x92\x6e\xae\xd9\x4d\x65\x6a\xa6\xc8\xe1\x42\x96\x59\x3e\xd9\x60\xb7\x6e\x29\x73\x40\xc9\x2c\xcd\xf2\x23\x14\xb5\xc7\xa2\xe3\x21\x7c\x67\x8c\xa5\x21\x95\x86\x28\xa0\x5a\xc4\x2a\x8a\x35\x55\x38\x12\x56\x07\x5c\x18\x29\xe3\x90\x2a\xc9\x2d\x89\x02\xcd\x24\x21\x11\x15\x36\x0c\x25\x33\x36\xa4\x81\x0a\xc0\x76\x18\xd0\x8f\x4c\x5e\xf5\x02\x17\xc3\xec\xe5\x8d\x67\x51\x6f\x3d\xd0\xed\x3c\x2b\xc0\xa9\x1e\x07\xdb\x14\xc1\x3f\xd6\x72\x51\xa0\xe9\xd7\x43\xd8\x28\x9c\x1d\xc7\xaa\xe6\xa6\xca\x0f\xfa\xca\x49\xda\x67\x2c\xed\x1a\x7a\x87\x8f\xc4\x5a\x96\x03\x3d\xe0\x09\xb5\x8c\xcd\xd6\x49\xcb\x56\x3b\x89\x8b\x0f\x8f\x51\xfb\x4e\xbd\xd3\x93\xcf\xf0\x04\xdb\xbf\xae\x60\xd7\x38\xaa\x83\x75\xe3\xe4\x7d\xfc\x35\x9b\xf6\xbe\x18\xc2\xd8\x30\x1e\x4a\x05\x51\x1c\x6a\x6e\x23\x2e\x85\xa4\x01\x25\x36\x08\xa4\x08\x23\x85\x15\xd3\x9c\xb4\x62\xc5\xa3\x4f\x3e\xbe\x6e\x9a\x63\x0e\x32\x1e\x77\x24\xe6\x15\xea\x40\x35\xc7\x97\xc0\x89\xb2\x61\x8d\xd3\xf3\x62\x9f\xed\xce\x77\xdd\x90\x4a\x7a\x7f\xae\x0b\xfd\x3c\xc1\x49\xe9\x83\xe5\xd1\x7e\xaf\xe6\xad\x29\x9e\xb4\x75\x83\xb2\x75\xe9\x91\x30\x41\x3f\x2e\x16\xc8\x26\xb0\x30\xde\x9a\x8d\xb0\x7d\x55\xeb\x47\x99\xbe\x9a\x04\xde\xf6\x8d\x95\xdf\x01\x1b\x77\x2a\xeb\x79\x9c\x8d\xf4\xfc\xe2\x3f\xb6\x30\x1e\x7c\xef\xd4\x7b\x7c\x7e\x4b\xf3\xba\x91\x92\xa3\x50\xfd\x34\xc6\x19\xb5\x7e\x7d\x43\xfd\x12\x14\xe3\x46\x80\x3e\x0f\x45\x34\x4e\x11\xa3\xdd\x68\xbd\x16\xe0\x79\xcf\x20\x1e\x8a\x88\xb8\xb6\x4e\xad\xd5\xa5\x89\x9a\x6d\x5f\xb5\x7b\x98\xca\x42\x4f\x1f\xb7\x01\x96\x85\xee\x3d\x71\x50\xec\x92\x73\x63\xf0\xc6\x28\xef\xef\x3e\xc5\x09\x7c\x8a\x7f\x75\xa1\xe9\x33\xdc\xcb\x91\x9b\xea\x7f\x4d\x0d\xe4\x43\x74\xac\x8a\xec\x1d\xc3\x53\xe5\x3c\xcb\x2f\x6e\xc8\x04\x4f\xf0\xdb\x28\x12\x58\xc5\xe2\xad\x81\x9b\x8b\x45\x92\xae\xef\x2e\x66\x19\x99\x10\x3c\x09\x5a\xf5\x10\xa0\x28\x7f\x1a\x5d\xc5\xa1\x5f\xb2\x44\x70\x15\x48\x66\x98\x36\x96\x68\x1d\x52\x13\x46\x2a\xe6\x98\x59\xa6\x89\xb0\x98\x62\x20\x8a\x09\xa3\x94\x65\x92\x06\x86\x00\x30\x4b\xac\x0c\xad\x8d\x59\x7b\xf3\x73\xcc\x85\xce\x06\x86\x48\xb0\x98\x37\x2f\x56\x00\xf9\x91\x6b\x08\x31\x10\x4a\x65\x88\x43\x80\x30\x54\x82\x05\x01\xc1\x91\x90\xda\x1a\x11\x72\x08\xb8\x34\xa1\xb0\x2c\x0a\x24\xb6\x52\xc5\x52\x5a\x4b\x35\x01\xa6\x28\x50\x43\xa9\x04\x4e\x8c\x26\xcc\x1a\x69\x23\x00\x69\x38\x53\x26\xb0\x11\x0e\x63\x16\x31\x26\x65\x10\xea\x50\x08\x1b\x6b\x19\x29\x08\x02\x46\x80\x6a\x20\xc2\x18\xcd\x48\x10\xd0\xd6\x15\xb8\x14\xaa\xfc\x89\xa3\xa0\x27\x54\x4c\xc8\x24\x88\x27\x84\xe2\x4b\x42\x68\xd0\x3a\x4a\x4c\x52\x95\xad\xd3\xaf\x39\xeb\x32\xeb\xf1\x57\x6f\xb6\x27\x6e\x62\x93\xd8\xde\xfb\xd0\xef\x66\x94\x2c\x85\x63\xf2\xdb\xb7\xdf\x09\x1e\xd7\xa3\xf7\x71\xe1\x2e\x74\x5b\xe4\x98\x13\x27\x14\x36\xb7\xb7\xd0\xb6\xb2\x71\x73\x89\xaa\x55\xdd\x87\x6c\xc6\x19\xbc\xe3\x84\x82\xdd\x6b\x45\xe8\x3f\xff\xab\x79\xd6\xb9\x02\x84\x08\x15\xed\x37\xfd\xd3\xce\x3a\x35\xfe\x71\xa9\x9c\xfe\x66\x49\xe5\xf5\xf5\x30\xd1\x3a\x9e\x6f\x2e\xd0\x74\xe3\x4c\x55\x8a\x3b\x22\x62\x0b\x4a\x3f\x61\x64\x53\x33\xa6\x8d\x18\xcd\x42\x11\xb3\x38\x16\xa1\x8c\x8c\x88\x14\x27\x41\x1c\xc5\x58\x09\x41\x88\x31\x81\x62\x11\xe3\x1a\x53\xc3\x2c\x23\xda\x80\x55\xdc\x04\x34\xa0\x9d\xfb\x00\xed\xca\x2e\x88\xf4\x5f\x6c\xab\xac\x20\x12\xd2\x80\x84\x11\xe5\xa4\xc9\x9f\x1e\xfa\x62\xee\xa3\x78\xb6\xe2\xc0\xb1\xec\xfa\xb9\xf7\x6d\xde\xe3\x2e\x7c\xec\xf0\x75\x2e\x6f\x7f\xd7\xd9\xd7\xd5\x42\x7b\x1f\x06\xde\x4b\xa4\xa7\xb9\x0a\xf3\xa8\xbb\x4d\x3d\x50\x0f\x4c\xf0\xb4\xaa\x6a\xfb\xbf\x4d\xf9\xcc\x83\x67\xb7\xbd\x36\x68\x6c\xbe\x62\xd7\xbd\x4a\x52\x93\x68\x59\x42\x31\xf0\x6d\xdd\xba\xda\x6a\x92\xce\xfc\x0d\xbe\x2a\xc7\x4a\x81\xae\x6e\x8d\xe6\x32\xd5\xf3\xfa\x40\x6d\xe3\xfc\x36\x45\x2d\x4f\xe1\x32\x0d\xb8\x6c\x4c\xdd\x97\xd0\x7b\xa6\x92\x59\x2e\x97\xbd\x87\x9d\xdc\x2f\xff\x08\x6e\x96\x26\x29\x7a\x0f\xd3\x2c\x5b\xf5\x1e\x65\xab\x2a\xe7\xb6\xf7\x74\x95\x43\xbf\xf4\x41\xc5\x6d\xf9\xd0\xec\xeb\xb4\xff\xf4\x00\x01\x1c\x3a\xea\x82\x04\x1a\xf2\x09\x7a\xbf\x5c\x95\xf7\xfe\x69\xeb\x70\x68\x73\x44\xe8\xbf\x8c\x8e\x16\xd9\x6c\xe6\xbf\xda\xe3\xfa\x0c\x99\xfa\x57\xad\x28\x81\xcc\x67\x43\xee\xfd\xe1\x40\x5c\x17\xca\xfa\x14\xd4\x26\x60\xd0\x4a\x96\xbe\x84\x42\x35\xee\x36\x9b\x4f\x83\xe9\x9e\x5b\xfe\xec\xaf\x53\x2e\xee\xdf\xa0\x2c\x5d\xdc\xb7\x72\x3f\x8b\xf5\x6a\x95\xe5\x25\x98\x09\xfa\x37\x7f\x9c\xd8\xe9\x38\xad\x6d\xd0\xc5\xeb\xf2\xee\x2a\x35\x70\xf7\xcf\xf2\xee\xca\xfc\x70\xe1\x07\xa8\x9e\x4c\xf7\x7b\xbd\x46\x2a\xc5\x4c\x64\xb1\x74\xe6\x94\x4b\xc3\xb5\xc1\x80\xb9\x24\x96\x62\x15\xb2\xc8\x28\xcc\x03\x6c\x44\x14\x9b\x50\x6b\x85\x8d\xa1\x92\x44\xc0\xc3\x38\x54\x17\xf8\x02\x77\x4b\x09\xb6\x2a\x77\x3e\x41\xbc\xb3\x8b\xe6\xdd\xdc\xef\x3d\xcb\x94\x2c\xa2\x1c\x07\x11\x50\x1c\x87\xa0\x38\xd1\x34\x60\x04\x87\xcc\x48\x19\x05\x21\xe7\x1a\x47\x94\xb5\xeb\x49\x7e\x81\xfb\xcf\xa5\xcc\x8f\xdd\x01\x8f\x31\x4d\x87\x7e\xad\xc4\x54\x79\xd7\xcd\x7a\xd9\x42\xe0\x8f\xc9\x87\x20\x68\x25\x7c\x8c\x66\xe3\x1e\xf8\xe0\xfc\x11\xc6\x44\x24\x42\x1b\x6b\x4e\xad\xa6\x2a\x66\x51\x2c\x30\xd8\x90\x18\x61\x28\x16\x4a\x49\xc9\x4c\x60\x8d\xb6\x58\x87\xdc\x30\xc1\xb8\xd4\x92\xc2\x1e\x76\x38\xa8\xdf\xe0\xae\xfc\x13\x8c\xa9\x2d\xb4\x63\x4e\xaa\xee\x1d\x6f\xad\x5b\xcd\x72\x3b\xd6\x8e\x81\x1b\x1c\xeb\x1c\xdf\x05\x01\x30\x1a\xc4\x02\xeb\x58\x05\xdc\x60\x26\x94\x71\x76\x47\x19\x26\x69\x75\x83\x96\xb0\x28\xa6\x14\xb3\x90\xe1\x50\x6a\xad\xa9\x65\x91\x30\x18\x6c\x1c\xc5\x42\x9c\xf7\xcd\xe2\x97\xee\xd2\x9a\x89\xbe\x92\x4f\x48\x3f\x8b\x7a\x27\xd3\xf8\x44\x33\xe9\x5a\x26\x7e\x02\x79\x30\x23\xf5\x7b\x0d\xb8\x13\xd4\x80\xfb\x5e\x76\x6d\x2f\x15\x8e\x29\xbb\xb6\x79\xd5\xde\xbd\x0e\x65\x02\xed\x45\xea\x1c\xee\xc6\xdb\xf9\x76\x05\xfd\x11\xb5\xf3\x9f\xc8\x70\x7c\xff\xbd\xec\x5f\xcb\xf3\x38\x9d\x12\xdd\x65\xd6\x5a\xa1\x66\xd6\x57\xf6\xb2\xeb\xb4\xae\x36\xe5\xbc\xe6\x36\x27\x0f\xaa\xda\xed\x33\x67\xe3\xfb\x5f\x7f\x68\x3e\x00\xfb\x51\x96\xcd\xf6\x70\xfb\x81\xb0\x6d\xc9\xf9\xa4\x52\x4c\xe5\xfc\x6c\x00\xe4\xbd\x2e\xdd\x60\x49\xfa\x7e\x81\xf6\x41\xb9\x1e\xae\x0e\xf6\xb8\x0b\x9a\x9b\x08\x4b\xfd\xf1\x8a\xee\x2a\x73\x79\xdb\x5a\x61\xfb\x93\x31\x83\x25\xc7\xf3\x4d\x55\x7f\xe9\x7a\xb6\x6f\xc2\x4d\x76\xd6\xdc\x8e\x66\x0e\x2f\x7a\xb3\x8d\xf5\x10\xc2\x4d\x52\x6c\xbf\xb1\xd1\x03\xb3\x7e\x39\x06\xd6\xba\x84\x4b\xc7\x1a\x67\x39\xba\x7a\x37\xa9\x42\xed\x1b\x13\x59\x20\x59\xf8\x32\x36\x89\x45\x99\x3f\x52\x9e\x8c\xa1\x51\x0f\xda\x5d\xce\x19\x00\x76\x1f\xeb\xfc\xb3\xad\xef\x2b\x73\xe7\x40\xdd\xe4\x94\x66\x39\x3a\x77\x20\x9f\xb7\xf7\x89\x0b\xb7\x95\x2f\x3b\x79\xae\x8f\xe5\xb3\x6d\xd2\x2c\x14\xa5\x5f\xd7\x2f\x20\xcd\x20\x05\xe6\x20\xcd\x18\xec\xfb\x1a\x3c\xae\xb5\x07\xf1\x61\xa4\x8f\xc6\x79\xed\x9e\xff\x09\xee\xbb\x58\x3f\x84\x60\xa7\x40\xbe\xc0\xfd\xeb\x55\xfd\x05\x97\x1f\xdc\x66\x56\x6a\xed\xe4\x75\x73\xf7\xb6\x76\xc1\x0f\x21\xd3\xe3\xe0\x0b\xdc\x3f\x02\xb9\xa7\xab\x2d\x3f\xf4\xd1\xea\x87\x94\xd6\x5e\x42\x0d\x5e\x42\x4e\xf4\xdc\x11\xa9\xa9\x52\x50\xf4\x92\x73\x8e\x91\xee\x47\x61\x83\x85\x11\x44\x21\xa7\x11\xe7\x71\x67\xd5\xed\xef\x0d\x77\xd7\x5c\x1d\x1f\x8e\x59\x71\xeb\x43\x23\xa3\x4f\x1c\x1f\xbd\xe0\xdd\xf0\x56\xff\x3c\xb2\x73\x8a\xdf\xe0\xc7\xb5\xa9\x9e\x5d\xdf\x5d\xbd\x1b\xcf\xe7\x75\xe9\xab\x9d\xba\x20\x07\xb8\x39\x19\x25\x79\xbb\xe4\x8b\x95\xd6\x51\x48\x23\xc9\x23\x09\x61\x84\x29\x63\xd6\xed\x23\x71\xa8\x35\xc6\x24\xe6\x9c\xb2\x48\xab\x98\x6a\xaa\x98\x25\x40\x15\x97\x14\x33\x60\x6e\xff\x19\x83\xec\x7c\xae\xb5\xf7\x65\xa5\x2e\x65\x57\xd9\x28\x4e\xee\x24\x10\xcb\x9b\xa6\x7c\xf4\xd5\xbb\x4a\x61\xe6\x50\xac\x97\x3e\xc2\x09\xa8\xfd\xed\xab\x8e\x6a\xba\x7a\xf7\x15\x26\xc1\x3f\xfa\xff\x01\x00\x00\xff\xff\x34\xb3\xdf\xe0\x88\xa7\x00\x00")
+var _thorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xed\x7d\x69\x97\xdb\x36\xb2\xe8\x77\xff\x0a\x9e\xcc\x3b\xaf\x9d\xb9\x6a\x35\xf7\xc5\xdf\x1c\xdb\x33\xe9\x3b\x49\xdc\xd7\xee\xc9\xbc\x73\x72\x72\x2c\x90\x00\xbb\x39\x96\x48\x5d\x92\xea\x65\x32\xf3\xdf\x5f\x15\x00\x92\xa0\xb8\x88\x52\xab\x3d\xdd\x19\xfb\x2e\xb1\x29\x12\x28\x14\x0a\xb5\xa1\x96\x6c\xcd\x52\xb2\x4e\x5e\x69\xd6\x5c\x9f\x1b\x2f\x92\x34\xce\x5e\xbd\xd0\xb4\x32\x29\x97\xec\x95\x76\x79\x9d\xe5\xac\x28\xe1\x01\x65\x45\x94\x27\xeb\x32\xc9\xd2\x57\xda\x3f\xe1\x81\xa6\x7d\x78\xf7\xf1\x32\xde\x2c\xb5\xd7\x17\xe7\x5a\x99\x69\x24\x8a\x58\x51\x68\x3f\xb3\x37\xd7\x24\x49\xf9\xa7\xda\x4f\xac\xbc\xcd\xf2\xcf\x2f\xf8\xfb\xaf\x29\x85\xc1\x0a\x56\x68\xf0\x33\xfc\x6d\x9d\xa5\xf8\x0f\x92\x33\x4d\xbf\x3b\x5d\xe7\x2c\x4e\xee\x18\xd5\xae\xd9\xdd\x4c\xbb\x4d\xca\x6b\x2d\xba\x66\xd1\xe7\x62\xb3\xd2\x58\x1a\x65\x14\x7e\x82\xef\x96\xac\x2c\x59\xae\x45\xa4\x60\x1a\x29\x00\xac\x38\x49\xe1\x97\xf0\x5e\x7b\x77\x7e\x71\xea\x38\xf3\xbe\xa9\xfe\x77\x03\x8b\x28\xb4\x15\xb9\xd7\x42\xa6\x31\x18\x1b\x87\x90\xa3\xaf\x18\x9d\x69\x00\x2b\x59\x2e\xf9\x04\xd9\x2d\xfc\x08\xff\xde\xac\xd7\x72\xa2\xb9\x80\xff\x43\x0d\x72\xca\x6e\xf8\x00\x24\xbd\x62\x33\x2d\x99\xb3\xb9\x16\x2e\x33\x18\x4d\x23\x29\x85\xf9\x22\x06\x98\x2a\xb4\x2c\xd6\x00\x3a\xb2\x4c\xfe\x81\x10\x8a\x17\x24\x30\x02\xe4\x74\xb3\x0a\xc5\x64\xe7\x6f\x67\x30\x55\x9e\xdf\x6b\x45\x99\x67\xe9\x95\xb6\x78\x77\x49\xae\x16\x7c\x5e\x1c\x73\xf1\x86\x00\xbc\xa7\x6f\xb2\x14\x7e\x5e\x2e\x00\x49\x84\xb2\xbc\x98\x69\x45\xa6\x95\xd7\xa4\x84\xff\xc7\xee\x61\x84\x14\x17\x18\xe1\xbb\x7c\x82\x37\x6f\x7f\x12\x30\xad\xf3\xec\x2e\x61\x05\x5f\x68\x96\x2e\xef\xf1\xc7\x68\x99\xb0\x14\xc0\x4c\x62\xfc\x5a\x4b\x01\xc5\x1c\xbc\x04\x50\x07\x1b\xfa\x99\xa5\x85\xc0\xe6\xc2\xd2\x6d\xed\xa7\xac\xd4\x7e\xcc\x68\x12\x27\x8c\x2e\xb4\xa4\x90\x3b\xc8\xb7\x25\xd6\x16\xe7\xf1\xe9\x4f\x59\xca\x4e\x7f\x24\x65\x74\xbd\x00\x54\xc3\x7f\x58\x21\x31\xf7\xcb\x45\x9e\xfd\x9d\x45\xa5\xf6\x7d\xb6\x62\xbf\xbe\xbc\x2e\xcb\x75\xf1\xea\xec\xec\x0a\x36\x62\x13\xce\xa3\x6c\x75\x76\xc3\x22\xa4\x9a\xb3\x12\xa8\xe6\x5b\xf8\x66\x99\x44\x30\x3d\x7b\xc5\x3f\x4f\xc9\x0a\x68\xf1\x87\x3f\x5f\xfc\x80\x54\xca\x1f\x6d\xf2\xe5\x2b\xed\xa4\x1a\xe8\xf6\xf6\x76\x7e\x95\x6e\xe6\x59\x7e\x75\x26\xbf\x2c\xce\x96\x57\xeb\xe5\x29\x52\x35\x4b\xe7\xd7\xe5\x6a\x79\x02\x1f\xc2\xb6\x15\x9c\x82\x8d\xb9\x01\x23\xbd\x28\x58\x8e\x8f\x70\x9a\x53\x39\xe6\xd9\x09\x9f\xa0\x45\xef\xb0\x75\x64\xa9\x21\x6c\x1c\x4b\x2f\x5e\x94\xe4\x4a\x7e\x24\x60\x7b\x1d\x45\xd9\x06\x70\xd9\xfd\xf4\xb5\x38\x15\xe2\x7c\xe0\x3b\x5a\x16\x22\x2a\x0a\xe5\xeb\xcb\x9c\xa4\x05\x89\xf0\x83\xd1\x11\xca\xf6\x7b\xd5\xe7\xdf\x71\xca\x1a\xfb\x30\xac\xde\xa8\x3e\xf9\x21\xbb\x1a\xfd\x00\xe8\x1b\x20\xfd\xbf\x62\xc6\x18\x48\x74\x29\x3e\xa8\xbe\xff\x09\xb1\x30\xf2\x3d\xa7\xa5\xa2\x24\xe5\x06\x4f\x60\x9c\x29\x9f\xfe\x89\xb1\x9e\xa9\xff\x0c\x67\x79\x9d\xc3\xd6\x69\xc5\xe6\xea\x0a\x0e\x08\x3c\xe5\x84\x1b\x33\x31\x50\x02\x8f\x22\x15\x04\x7e\x14\x48\xd4\x87\xf3\x9f\x59\xce\xc9\x54\x8b\xe4\x3b\x70\x4a\x36\x79\xc4\xc4\x51\x78\xfd\xdd\xb9\x3a\xce\x6b\xe0\x27\x7c\x82\x1d\xc8\x27\xfc\x3d\x75\x50\x8e\x24\x38\x52\xe4\x86\x24\x4b\x12\x2e\x19\x1e\x04\xe0\xa6\xf0\x37\xaa\x4c\xf0\x71\x13\xd6\x03\xf6\xcc\x20\x78\xa9\x56\xbd\x06\xc7\x37\x49\x81\xc3\x89\xb9\x8a\x8d\x20\x16\x2d\x43\x86\x73\xcb\xc2\x02\x36\x92\x95\x92\x3f\xae\x00\x34\x02\xc8\x02\x90\x56\x6b\xce\xef\xf8\x59\x04\xb6\x25\x7f\x39\x05\xf6\xb8\x24\x25\x9c\x6d\x76\x95\x95\x09\xfc\x8d\xce\xe5\x74\x17\x49\x7a\x25\x78\x6f\x81\x5b\x0d\x0b\xfc\xcc\xd8\x5a\x4b\x28\x2c\x03\x56\x98\x32\x41\x66\xc0\x15\x93\x1b\xe0\x71\x88\x38\xf5\x71\x0a\xdc\x40\x32\x00\x18\x88\x8f\x13\x2d\x33\x04\x80\xc4\xc8\x9f\x91\xa1\xf0\xb1\xca\x64\xc5\xb2\x4d\x89\xbc\xb0\x62\x32\x15\x08\xef\x80\x4d\xe1\x0a\x6b\x64\x68\x25\xf9\x8c\x9b\xa4\xcc\x54\xcf\x1c\x6d\xf2\x1c\x01\x55\x81\x90\xfc\x7c\x99\xac\x92\x12\x99\x38\x59\x02\x37\x44\xa6\x9d\xb3\x55\x06\xab\x3e\xbf\x98\xab\xc7\x04\x59\x52\x17\xff\xef\xee\x58\xb4\x81\x97\x57\x9b\x65\x99\xac\x97\xac\x11\x17\x20\x0c\x88\x96\xc3\x99\xa5\xa7\x25\xbc\xae\x0c\xf5\x96\x85\x9b\xab\xee\x50\xfc\xb1\xb6\x29\x93\x65\x52\x26\x92\xca\x5f\xac\x49\x79\xcd\x79\xc5\x99\x64\x00\xc5\xd9\x6f\x44\x88\xa7\x7f\x09\xf6\xb6\x26\x39\x8c\x5a\x4a\x3e\x84\x7f\x4e\xb5\xff\x03\xd2\x10\x98\xd1\x1f\xce\x70\x6b\x81\xaf\xe2\x67\xcd\x7b\x67\x52\xbe\x9d\xa7\x17\x30\xfa\xc9\xd4\xaf\x3e\xb0\x9b\x04\xd9\xdf\x79\xfa\x3f\x1b\x96\xdf\x8b\xef\xae\x58\x59\x4d\x5b\x71\xb5\x6a\xb8\x16\x57\xd3\x34\x94\x95\x24\xbf\x7f\x05\x82\x10\xf0\x01\xd4\x5f\xb3\x34\xca\x4a\x38\x02\xf2\xb5\x5e\xea\xd6\x00\x9b\xd1\x72\x03\xbf\x69\x8b\x90\x2c\x49\x1a\xb1\xc5\x4c\x5b\xb0\x94\xe5\x57\xf7\x0b\x21\xe2\xae\x49\xf1\x06\xc8\x03\x9e\x83\x70\xaa\x86\x5e\x48\x5c\x2d\xe6\xda\xeb\xb4\x7e\xca\xc9\xbf\xfe\x00\x89\xe0\x8f\x65\xbe\x61\x7f\x44\xb9\x44\xea\x13\x2a\xa5\x0f\xfe\xf9\x1e\xf8\x47\x06\xfc\x05\xd8\x78\x1b\xe8\x4a\x66\xc2\x9e\xe7\x89\x10\x9a\xc5\x9a\x45\x49\x7c\x8f\x74\xbd\xc8\x25\xca\x16\xfc\x05\x2e\x9d\xe1\x79\x45\xc1\xb5\x12\xd3\x60\xed\xc4\xd4\xf5\x93\xe6\x9f\x5b\xe8\x78\xff\x17\xe5\x17\x04\x13\xb6\x48\x7d\x19\x84\xfd\x7a\x0d\x12\x8c\xb3\xa3\xb3\xbf\x17\xf0\x4d\xeb\x57\xd8\x04\x10\xab\x2b\xb2\xfd\x54\xeb\xdd\x7a\xf1\x2e\x50\x8b\x58\xf1\x89\x40\xc7\x3a\x2b\xf6\xde\xf1\xea\x90\x54\xb8\x8b\x2a\xfe\x3f\xb8\xdd\xc0\x50\x8a\x04\xce\x14\x72\x9f\x9a\x63\x02\x1d\x5e\x67\x70\x9c\x41\xd5\x12\x2c\x0c\x39\x03\xf0\x1f\xce\x43\x14\xe9\x56\xcb\x2c\x8d\x6b\x05\xf3\x7a\xd4\xfa\x2f\xe7\xe5\x49\xa1\x6d\x0a\x86\xfa\x27\xca\x2b\x90\x0e\x2b\x9c\xea\x8a\xe0\x63\x60\x7d\x9c\xa4\x18\x07\x1b\x07\x84\x9d\x82\xf3\x8d\x5c\x08\xc8\x63\x49\x36\x05\x6b\xf6\x90\x1f\xf7\xef\x32\x7a\xdf\x60\xa2\xb5\x28\x92\x5f\x6d\x56\x5c\x53\xe2\x63\xa6\x37\x09\x68\x68\xf8\xa0\x7e\x5d\x6a\x4d\xf4\x95\x86\x54\xf8\x62\x64\x83\xc7\xb7\xb7\x7f\x73\xc7\xb6\xf6\x0d\xa0\xf2\x2d\x29\xc9\xc9\xf3\xa2\x48\x04\xfb\x03\xdf\x92\x93\x16\x67\xfc\xe3\xab\x0e\x89\x76\xb9\xe3\xa1\x9c\xee\x00\x72\xd7\x42\x14\x1a\x48\x36\x48\xf1\xc5\x74\x92\x6f\x28\x8f\x93\x9c\x42\xdb\xbf\x0f\xba\xe3\xc2\xf4\x99\x12\x5f\x0d\x7b\x45\x81\x2a\x09\x3e\x2d\x02\x0c\xef\x4b\xb6\x27\xe5\xd5\xcc\x96\xb2\xf5\x32\xbb\x47\x7a\xf9\x12\xac\xb6\x6f\xda\x61\xa6\xab\x0c\xff\x87\x3f\xfc\x41\xbb\x3c\xbf\xf8\xa8\xee\xe1\xa9\xb6\xa0\x40\x57\x0b\xc5\x7a\xd7\x42\x38\x28\x28\xde\x51\x8b\xac\xd1\x22\xc7\x96\x73\x0f\x8e\x20\xc8\xb2\x35\x44\x0e\x68\x07\xd5\x54\x19\x8a\x14\x45\x72\x85\xbe\x04\xc5\x56\xbb\xbd\x4e\xe0\xf8\xe3\xfb\xf5\xfa\x10\x5f\x4c\xae\x92\xeb\xf9\x5f\x85\xc8\x13\x10\x22\xfd\xfa\xf5\x19\xee\xec\x53\x50\xb2\x1b\xd3\x81\x26\x05\x10\x1a\x5b\x81\x91\xa8\xa8\xc6\xaf\x84\x7a\xd9\x4f\x3a\xb7\xd7\x8c\x3b\xac\x80\xf2\xa4\x12\xad\x65\x6b\x5c\x19\x58\x3e\x70\x18\xd1\xfc\x02\x92\x02\x75\x16\xec\x22\x20\xdf\x78\x93\x8a\x93\x5d\xb0\x25\x3c\xc9\xf2\xa2\x87\xc4\x62\xb0\x95\x1a\x00\xba\xd8\x2f\xef\xd7\x00\x6c\x98\x65\x4b\x46\xd2\xd6\xb6\xc7\x04\x10\xae\x0e\x70\x0c\x03\x62\xb7\x3e\x09\x76\x2d\x49\xef\xe7\xda\xf7\x60\x1a\xcb\x03\x09\x08\x40\x37\xd4\xf6\x41\x7e\x66\xca\x39\x5a\x30\x83\xf4\x8b\x46\x0b\x70\xd8\xa7\x45\xc2\x60\x8a\x17\x59\x3e\x95\x7a\xc5\xdb\xb0\x1b\xe5\x26\x97\x9e\xda\x35\x5a\x55\xd9\xa6\x80\x15\xa1\xff\x34\x03\xf3\x9d\x13\x6e\x26\xfc\x06\x71\x92\x03\xbf\xc7\xdf\xe6\xda\x47\x90\x5b\x4b\xaa\x1a\x68\xc2\xd7\xa9\x15\x00\x8a\x56\x59\x67\x07\x13\xb8\x30\xe7\xb6\xd6\xc7\xfd\x09\x53\x97\xb7\x22\x77\xb5\x1b\x17\xbd\x3f\x48\xd8\xd2\x75\x20\x56\x87\xb2\x17\xfe\xf9\x8b\x31\xd3\x0c\x5d\xd7\x7f\x3d\x18\x56\x74\x0b\x5d\xb1\xbc\xef\x30\xc2\xc0\x87\x1e\xc5\x73\xd8\x71\xa2\x58\x76\x92\xe2\xc6\x0f\xa3\xb2\xcc\x2c\xa7\x62\xe9\x60\x8c\xa3\xd3\xf9\x33\xbb\x97\xde\x29\x58\x7e\x92\x92\xb6\xca\xfb\x2c\x4e\xe4\x47\x81\x82\x0b\xf8\xbf\x5d\x07\xf3\xec\x37\x58\xef\x97\x76\xe3\x48\xf8\xfe\xc2\xee\x9f\x8a\xff\x47\x62\x43\xbb\x21\xcb\xcd\x0e\xd2\xc1\x43\x7e\x95\xdc\xb0\x14\x29\xe5\x79\x12\x86\x20\x0a\xd5\x19\x7f\xf6\x5b\x42\x0f\xa7\x82\xcb\xbb\xf3\xb7\xfb\xee\x24\xb9\xed\x30\xe7\x1d\x9f\x7c\xcf\x08\x9d\xba\xf1\x9d\x0b\x89\xbe\xcd\x57\x10\x30\xbe\xe5\xc0\xf1\xcf\xdf\x3e\xb3\xad\xbe\xbc\x7b\x9f\x03\x92\x2f\xef\xfe\x06\xac\xec\x47\x86\xba\x71\xef\xa6\x9f\xc9\xcb\xbe\x2f\xb9\xf9\x8f\xb9\x93\xd5\xe5\xe5\xef\x6f\x47\x3f\x88\x85\x0d\xed\xe3\x3a\xcf\xb2\xf8\x59\xef\x22\xb7\x0d\x90\xbd\x6b\x7c\x2d\xe3\x3b\x28\xaf\x63\xd4\x9d\x47\x23\x22\x29\x8b\x8a\x02\xe6\xda\x25\xbc\xc0\x87\x12\xf7\x44\x2b\x96\x7f\x5e\xc2\x13\xbc\xcf\xd0\xe2\x3c\x5b\xe1\x08\x8d\x36\xb3\x5c\xd7\xd7\xf4\xe5\x9d\xf6\x52\x8e\xf2\x2d\x5a\x2d\x8b\xf2\xae\xf8\x90\x65\xe5\x42\x7b\xb9\xa8\x2e\xc7\xf9\xbf\xbf\xad\xe0\xe0\x1e\x88\x19\x8a\x04\xae\x21\x0e\x8d\x9a\xa4\x94\xdd\x09\xc0\xa4\xad\x9e\x93\x5b\x79\x17\x8e\xb6\x80\x34\x8f\xb8\x09\x7f\x83\x97\x80\xf7\xc2\xd6\x87\xb9\x8a\x67\xc7\x80\x2e\x10\xf5\x5d\x72\x7d\xb5\xd3\x89\x3f\x46\x2d\x6f\xb2\x15\x28\xb7\xd3\x79\x37\xba\x4f\x00\xc5\x20\xb4\x41\x55\xde\x44\xa0\xc3\x0b\x45\x7d\x45\x80\x40\xce\x63\x2d\xcd\xf8\x4e\x10\xfc\x01\x5f\xee\xbc\x35\xab\x87\x5a\xe0\x8b\xa0\x6d\x7f\x0f\x8a\xa2\x0c\x20\x90\x26\xc1\xb6\x8f\x46\xb9\xb7\x41\xfb\x3e\x44\xfb\x80\x9b\xb9\x48\x03\x64\x99\xc3\x7e\xdf\xe3\x47\xb8\xb7\x6b\x30\x51\x11\xbc\x7a\xeb\xe5\x73\xee\xcd\xc2\x33\x55\xa8\xe6\x82\x9c\x84\x14\x8a\xa1\x81\xd6\x63\x03\x65\x01\x46\x36\x3a\xbc\x0a\x12\x33\x24\x23\x00\x32\xaf\xf5\x94\x7e\x57\xa0\xb0\x1a\xee\x4e\x13\xca\x60\x1b\x81\x3c\xa2\xfb\x53\xa0\x64\x65\xbb\xd1\x86\x10\x54\xaa\x3c\x1c\xd2\xff\xfb\xe9\xa5\xc7\x5e\x19\xd9\x36\x4e\xaa\x3c\xbc\xe3\xb4\xd8\x20\x75\x0a\xcd\xbc\x3a\x6e\x1c\xa7\x45\xc1\xef\x57\x3f\xb3\x75\xc9\xb5\x32\xd3\xd6\xc0\xd8\xca\x8b\x79\x85\x74\xfe\x82\xd0\xe5\x6b\x14\xe2\x20\x35\x52\xb3\x3c\x41\x15\x7f\x59\x23\x76\xd6\x02\xe0\xf6\x3a\x59\xca\xb9\xe4\xfe\xa5\x99\x70\x64\xdc\x35\xa3\xe2\x80\x9c\x16\xfe\x2e\xbc\x17\xfc\x07\x5b\x0f\xe6\x1d\x04\xdf\x92\xa4\xdc\xc2\x69\xdb\x2e\x3b\x0c\xa5\x7d\x3e\x8e\x41\x9c\x2a\xae\x98\xeb\x0c\xec\x52\xce\x5d\xa4\x83\x12\xfd\x10\x4b\xc1\x55\xef\x1a\x72\x44\x0f\x6b\xbe\x49\x3f\xcf\x64\x68\x10\xbf\x32\x17\xab\x54\x99\x6d\x6b\x16\x11\x68\x44\x8b\x0a\x19\x3e\x0f\xd1\x01\x32\x85\xe1\x36\x65\x17\x33\xf2\x8e\xfd\x11\x90\xd3\xa1\x37\xb0\xfd\xee\xc8\x6a\x8d\xb1\x61\x96\x5e\x4c\x43\x1a\x9a\xc8\x74\x93\x93\xca\xdd\x8c\x1b\x39\xc3\xcf\x51\x8f\x90\x36\xec\x0c\x39\xc9\x2a\xe3\xbe\x1d\x92\x6a\xce\xaa\x8d\x91\x73\xb0\x31\x6a\x4f\xc0\x92\x47\x64\xe0\x6b\x88\x6c\x1e\x7d\xd6\x0d\x32\x98\x29\xbe\x5b\x39\x87\xa0\x33\xba\x89\x84\x80\x88\x81\x0f\x26\xe3\xd7\x31\xff\x3e\x97\x2c\xe8\x9e\xef\xf3\x8f\x5c\xc6\xbd\xcf\xff\x9a\x0a\x69\x77\x79\xf7\xcc\x3c\xb4\xe7\x6f\xc5\x22\x24\xd7\x3f\x69\x80\xb5\xc7\x80\xfd\x8e\xa0\xb4\xff\xf7\x28\x89\x82\x0d\x35\x98\xe6\xb0\x5a\xc3\xb0\x5e\xde\x35\xbc\x0b\xc8\x19\x8e\x3e\x4a\xa4\x27\x04\xbb\x3f\x0a\xbb\xe4\xce\x08\x3c\x9c\x1f\x8c\xea\xa9\x39\x57\xc8\x62\x7e\x1e\x3b\xcc\xe5\x49\x90\x4f\x30\xbc\xac\xf3\x46\x0e\x73\xf1\x52\x69\x0c\x9b\x42\xec\x51\x23\x86\xba\xb6\x40\x75\x8d\x76\xb0\x25\xd0\xeb\x63\x39\x54\x5b\xfb\x58\x5d\xea\x11\xd8\x9e\x14\xc3\xa9\x90\xc3\xdd\xed\xb8\x0d\xbc\xbc\x13\x0a\xbb\xb8\x83\x16\x2a\x91\x70\xd3\x6d\xd6\x99\x60\x9a\x18\xf0\xc6\x2a\x76\x59\x79\x51\x67\xf8\x22\xdf\xdc\xbb\x86\x95\xe2\xdf\xa5\x76\x5e\x85\x9a\x22\x44\x88\x3d\x50\x92\x58\xc3\x14\x59\x1c\x8b\x48\xb5\x58\x63\x24\x07\x8d\x03\x03\x4d\x19\xd7\x26\xb8\x88\x13\xb7\x84\x3c\x64\x96\xd6\x8e\x23\xee\x30\x12\xd0\xd6\xda\x20\xea\x22\x0c\x43\xc3\xca\x3b\x21\x34\x71\xbb\x50\x7d\x48\x78\xa0\x9b\x20\xd7\xb9\xb2\x5a\x0c\xe1\x39\x29\x79\x64\xaf\x5c\xf1\x4c\x63\xf3\xab\xb9\xd4\x4f\xe4\xcf\x24\x86\x81\x29\xde\x5a\xce\x84\x20\x58\x67\x79\xad\x70\x2c\x58\x9e\x67\xf9\x42\xcc\x57\x7c\x4e\xd6\x6b\xf9\x0b\x4a\x15\xc2\x57\x86\x10\x70\xba\x29\x14\xf5\xf4\x9d\x80\x53\xd8\x1e\x78\x41\x21\xb5\x5e\x1e\x0c\xbd\x6e\x78\x42\xa3\x4f\xcd\xb5\x8a\x9b\xe3\x73\x58\x0f\x93\x62\x7e\x21\xa0\x5d\x34\x2b\xfb\x49\x95\x88\xae\xcd\x31\xce\x1d\xcb\x82\x16\xa4\x9b\xb5\xcc\x4a\xd0\xc0\xf0\x32\xb6\x25\x41\xb9\x0e\x8c\x21\xcd\xf8\x0b\xf7\x68\xf7\x85\xe0\x3d\x31\xa9\xf7\x1d\x5f\xd8\x13\x14\x72\x42\x1b\x22\x79\x4e\xee\x3b\xbf\x25\x25\x5b\x15\xdd\x4f\x76\xb8\x12\xe5\xc9\xde\x47\xd2\xd4\x1b\xcd\xee\x22\xc6\xa8\xdc\xd6\x2e\x0f\x43\x01\x74\xc6\x43\x96\x25\x58\x0f\xf5\x2c\xc8\xf0\xe7\x5d\x7c\xa7\x91\x25\x37\x09\x98\x6a\xd7\x49\x51\x69\x62\x52\xd9\x4f\x72\xad\x0a\xe3\x14\xb1\xc1\x73\xed\x87\x6a\x68\xce\x03\xd6\x79\x56\x5d\x62\xe6\xd9\xaa\x61\x2d\x37\x49\xe3\xa2\xc8\x59\x98\x67\x84\x46\x04\xef\x88\xc0\xc6\xcf\x28\x46\xf5\x2d\xef\xa5\xfe\xbd\xe2\xe9\x00\xc8\x42\xee\xd6\x48\xc4\xf3\xff\x00\x62\xe2\x48\x44\x42\xea\x27\x05\xc4\xf5\x91\x28\x41\xaa\x37\xed\x88\xec\x67\xa4\x90\x5e\x00\xf0\x1f\x11\x1d\x02\x57\x22\x2e\xfe\xec\xb7\x4a\x02\xfe\xeb\x08\x62\xbf\x71\x02\x8e\x20\x5b\x09\xd9\xef\x43\x33\x87\x6b\x82\x0b\x16\xe9\x5c\x5c\x3e\xf2\xc4\x8e\x93\x10\x78\xf9\x09\x17\xa0\xc8\x5b\x0a\x29\xb9\x9f\xe0\x11\x80\x03\xfb\x3e\xee\x23\xf3\xd3\x71\xf9\x80\xcb\x39\xe9\xfd\x4c\x1c\x2a\x91\x5b\xd1\xf3\x82\x86\xbc\x05\xd8\x05\xc6\x65\xbf\xea\xfd\x1d\xce\x5e\x71\x89\x96\xfa\xd0\xcf\xc3\x0e\x83\xf6\x9f\xfe\xd8\x8d\xca\xc9\x89\xaa\x02\x57\xc2\x84\x5b\xa0\x9f\x0c\xab\x5b\x85\xe2\x89\xd0\xa3\x9a\xd1\x34\x81\x36\x51\xed\x50\x3f\x91\x8a\x8b\xe2\xe8\x55\x2f\x8e\xe1\xd7\xb9\xb6\x48\x37\xcb\xe5\x42\x71\x09\x2a\x7e\x61\x6e\x96\xc4\x18\x87\xff\x9f\xc0\xcc\x5b\xf7\x14\x98\x76\x73\xc6\xf3\x4c\x76\xbb\x7d\xeb\x9c\x1e\x65\x07\xff\x94\x2c\x51\x57\x17\xe9\x3c\xcb\xe6\x85\x81\x8d\x7b\x57\xbf\x57\x89\x63\xe1\x2d\x01\x66\xb3\x78\x7f\xf1\xe9\x87\xf7\x7f\xe6\x01\x75\xef\x7e\xfe\x51\xd1\x81\x2f\x33\x94\xb5\xa0\x4c\xe3\x4f\xe1\x66\x09\xdb\x5b\xb9\xc4\x84\x62\xfb\x9a\xeb\xc2\xaf\x5a\x28\xbe\x3b\x4d\x29\xa2\x79\x81\x7c\xab\x7e\x03\x2d\x8f\xb3\xa8\xb8\x51\x94\x60\x9e\x4f\x06\x20\x70\xa0\xb8\x37\x1a\x0c\x08\x24\x9b\x45\x26\x32\x6a\x16\xda\x4b\x9e\xb9\x17\x73\x32\x29\x58\xf9\xad\xc8\x6a\x29\xc1\xe8\x13\xa9\x7d\x29\x6a\x30\x57\x3c\x31\x64\x09\xda\xc1\x8c\xbf\x28\x93\x46\xb8\x7e\xde\xf6\x22\x35\x73\x9f\x0b\x22\x94\xab\x8b\x49\xb2\x2c\x64\x7a\x8b\x18\x1d\x4d\x02\x90\x84\x39\xb7\x38\xf8\x9b\x68\x45\x70\x3b\x05\x08\x42\x66\x13\x80\x3c\x4e\x96\xf0\xc9\xe2\xff\x9d\x62\x4a\xe4\xe9\x3b\x3e\xda\xe9\x3b\x6e\x70\x34\x73\xbd\xf9\xf8\x33\x10\xe6\x72\xb3\x4a\x05\xee\x17\x9c\xf4\xcf\xdf\xce\xf8\x7f\x7f\x12\x4c\x9e\xff\xfd\x12\xc0\x84\x59\x57\xeb\x59\x79\x07\xbf\x97\x77\xef\xb9\xe1\x30\x93\xb1\x09\xb3\x32\x5b\x27\x91\x2e\xfe\x63\x88\xff\x98\xe2\x3f\x96\xf8\x8f\x3d\xe3\xa1\x91\x4f\xd4\x06\xe0\x34\x28\xe8\xf6\xf7\x62\x08\x0c\x4a\xbb\x5d\xf2\x8e\xe3\xe2\x64\xe0\xc3\x9d\x12\x6f\x8a\xcc\xd3\x30\x27\x83\x0c\xff\xba\x4b\xf1\xbc\x6a\xee\xc6\x39\xaf\xaa\x12\x06\x1f\xc4\xae\xb6\xb3\x0e\xc7\x7c\x1d\xea\xab\xd2\x8f\x10\xa1\x68\xe1\x9e\xf7\x9f\xdf\x5d\xd6\x83\x89\xbc\x9d\xaf\x5c\xeb\x89\x71\x2d\x0c\x49\x87\x77\x60\xd7\x92\x35\xde\x57\xcd\xc8\x0a\x3d\x43\x0b\x69\x35\x8a\x7f\x21\x0a\x29\xbc\xb1\x22\xcb\x27\xca\xb5\x2a\x3a\xfc\xca\xb8\x5a\xe8\x78\x06\xbc\x6b\xe8\xdb\x86\xa7\xa1\x51\x7c\xc3\x33\x3a\x1f\x27\x73\x73\x44\x2f\xef\x63\x92\x4a\xf4\x5d\x05\x17\x4f\x83\x10\x2e\xd5\x71\x76\xf9\xba\xf9\x04\x8f\xab\xea\x2b\xd0\xca\x6c\x13\x71\x3f\x2b\xf2\x04\x39\xda\xac\xba\x2a\x17\xce\xc9\x59\x9d\xc8\xa1\x35\x07\x56\x23\xa9\x54\x30\x19\x77\xff\xf0\x7a\x06\xa4\x61\xe2\xb0\xf8\x32\x81\x97\x49\xaa\x30\xa9\xd7\x6a\xce\x74\x53\x7d\x60\x93\x56\xde\xd0\xd3\x53\xb9\xbc\xfb\x53\x1e\xf5\x01\x0c\x81\x7b\x5f\x6f\x13\x98\xdc\xd1\x8d\xa6\xfc\x40\x33\xa8\xb0\x62\x1a\x27\x71\x7d\x85\x81\xc9\xc8\x38\x48\x95\x9d\xcd\x17\x8f\xfc\x34\xc2\xbc\xea\x66\x88\x83\x52\x7d\xc4\x91\x7f\x8f\xe6\xcc\x56\x4c\x5e\x73\x43\x9b\xc5\x31\xf0\xf8\x1d\x17\xb4\xed\xb0\x69\x51\x2b\x20\x56\x77\x19\xd3\x7c\x3e\xf3\x44\xe4\xdd\x37\xb7\xdd\x68\x61\x25\x5e\x58\xef\x00\xd8\x8e\x79\x9e\x00\x1f\x5e\xea\xf6\xc0\xd8\x8a\x77\x36\x1d\xf7\xd7\x87\x03\x6b\x74\xa1\x6d\x79\x07\x27\x00\xdb\xbe\x8f\x16\x15\x3e\x42\x34\x28\x6b\x83\xb6\x76\xa3\xc8\x28\xaa\x1b\xd6\xbe\x80\xb6\x75\xbd\x5d\xf0\x82\x07\x89\x84\x0c\xc6\x52\x0c\x55\x71\xe5\xac\xd8\x4f\x9b\xb4\xa6\xc2\xf9\x41\x98\xa8\xaf\xf8\xb3\x7d\xd6\x2b\x4a\x97\xec\x5a\xe1\x01\x00\xfd\x9e\x0d\x6f\xc9\x1b\xef\xb7\x2d\xef\x9e\x88\x2b\xca\xd6\xc0\xff\xd0\x7d\xdf\xd2\x34\xfe\xcd\x16\xf9\xa3\xf1\xb0\x49\x1f\xd7\x82\xad\xf5\xf9\xee\xc4\x38\x81\x89\x58\xa0\x05\x1e\xc3\x7f\x12\xf2\xb4\xec\xd0\x1f\xd8\x15\x89\xee\xbf\x5a\xa3\xcf\xd6\x1a\x7d\x94\x23\xfc\x88\x56\xea\xa3\x9c\xe4\xdd\x47\x51\x5d\xd1\x13\x3c\x91\x6d\x1b\xeb\xeb\xa1\x7c\x6e\x96\xd6\x8b\x01\x23\xeb\x0b\x4a\xd9\xaf\xc2\xf1\xab\x70\xfc\x2a\x1c\xbf\xbc\x5c\xfc\x2a\xca\xbe\x8a\xb2\xdf\x95\x28\xc3\x53\x84\x2e\xab\xb3\xaa\x60\xea\xa8\x1b\xef\xa7\xa6\xbc\x41\xd7\x8d\x97\x8a\x1a\xa9\x5a\x42\x61\x2a\xb0\x3f\x77\x07\x77\xae\x36\x45\x29\xab\x85\x36\xa9\x2e\x30\x67\x15\xf3\x2e\x4b\x9c\x2c\x31\x44\x0a\xcb\x22\xa0\x0f\xe0\x8a\xa5\xac\x80\x1f\x84\x2f\x00\xcb\x8d\x8a\x42\x26\x55\xa0\xe2\x33\x4b\x8f\x3a\x07\xb4\x2b\xbb\x20\x71\x78\xb6\x66\x35\x8b\x39\x74\x3b\x64\x81\x41\x50\xcd\xf9\x60\x4f\x0f\x2d\x07\x39\x37\x2e\x60\x2d\x4a\xe0\x13\x47\x1a\xbb\x41\x92\x8b\xd8\x03\x11\x56\x0f\x83\x64\x46\xb3\x0d\x3a\x75\x65\xaa\x17\x1c\x56\x5e\x61\x55\x5e\x58\xc9\x80\xc0\xdf\x09\x4a\xdf\xc9\x75\x2b\x18\xe5\xb9\x56\xf7\xc7\x8d\x1d\x3f\x74\x5b\x44\x5c\xb0\x80\x08\x77\x06\xad\x4c\x51\x7a\x08\x4b\x2e\x3e\xb3\xc4\x7b\xbe\x0a\x05\xd1\xe5\x1d\x86\x21\x3e\x8c\x6e\x95\xa8\xa4\x76\xd6\xc6\x00\xe7\xbd\xca\xb3\xcd\x5a\x90\xb2\xb8\x0d\x99\xcb\x32\x5d\xfc\x1a\x03\x47\xc3\x68\x6e\x91\x54\x38\xab\x46\x16\x51\x4e\x3c\x51\x91\x44\x9f\xe1\xaf\x84\x66\xeb\xe7\x98\x8c\x0a\xe8\x79\x23\xa6\x53\xb6\x41\xac\xe9\x8c\xe6\xf7\xa7\xf9\x26\x3d\x68\x3b\x5e\xcb\x62\x48\x18\xd6\xce\x45\x53\x95\x59\x5c\xc7\x9a\x56\x61\xf8\xc2\xf7\xc9\x13\x01\x76\xdc\x72\xd5\x69\x0e\x61\x1d\x03\x59\x5f\x64\xc9\x6d\xb8\xe5\xc9\x64\x34\xab\xca\xc9\xf0\x3c\x87\x62\x99\xc9\xb4\xe7\x3a\x54\x2f\x95\xc5\xc2\x65\xc8\x7e\x9a\xe5\x5a\x1d\x7e\xdc\x64\x3b\xe2\xb9\xba\xc8\x5e\x73\xdc\xd2\xcd\x52\x26\x2b\xc8\x3c\x82\x99\xc8\x29\xd5\x50\x40\x15\xdc\xa2\x6b\xdd\x79\x25\xa2\x98\x2e\x49\x35\xb2\xc1\x0a\xd3\xa0\x01\x6c\x85\xe8\x3f\x0b\x12\x79\x9b\xdf\x7f\xd8\xa4\x32\x40\x73\x9b\x40\x10\xb1\x0f\x3c\xac\xf5\xa6\x08\x32\x10\x05\xae\x04\xba\x77\x54\x1c\x21\x3c\x4f\x25\xad\x62\x26\x38\xd2\x7b\x29\x44\x64\x3e\x55\x37\xa0\x9b\x35\xac\x92\xc7\x4a\x2c\xb3\xb2\x4e\x8e\x47\x05\x33\x2b\xf0\x1a\x45\x8d\xec\xc4\x57\x9a\xc8\x5c\xb6\xcc\xb0\xb6\x32\x16\xec\xae\x93\x11\xf1\x7b\xae\x93\xf1\x78\xf7\x88\xaf\x44\x5c\x0a\x82\x3e\x4a\x0a\x9e\x30\x0d\x00\xfe\x74\x79\x31\xc7\x64\xc7\x6b\xb6\x5c\x17\x0a\x41\xa0\x56\x4b\xb0\x94\x97\x48\x5c\x4c\x79\x22\x68\x63\xb0\xe0\x8d\x2c\x17\xbf\x98\x64\x82\x65\xa3\x97\xcf\x2f\xe1\xfd\x23\xc0\xac\x50\x0e\x49\xc9\xf2\x1e\x03\xcc\xcf\xaa\xca\x7b\x0f\x54\x53\x44\xa9\x42\x5e\xc9\x53\x2d\x1c\x3e\x4c\x36\x57\x57\x39\xd8\x65\xa8\x08\xf2\xe2\xdb\x18\xd0\x9a\x96\x20\x4b\x95\xeb\x65\x71\xdf\xfc\x92\x84\x3c\x4d\x48\xa3\xe4\xfe\xdb\x99\x88\x55\x29\x22\x59\x6a\xb1\x0e\x73\x15\xe5\x12\xd5\xeb\xea\x37\x4b\xb1\x6f\xfc\xc2\x1b\x43\xf2\x78\x10\x77\x94\x33\xc2\x93\x8d\x6a\x38\x67\xd2\x63\x7c\x45\xb8\xc7\x98\x14\x4d\x31\x42\xcc\x7c\x28\xa6\x64\xa7\x3f\xec\x7e\x57\x01\x65\xb0\x9c\xd5\xc1\xf7\xbb\xa6\xfe\xdc\x0a\xb6\x49\x64\x7c\x14\x34\xd6\x4b\xb4\x15\x9f\x78\x20\xd1\x76\x58\x1e\x5e\xb6\x4b\xae\x90\xb0\x83\x28\x58\xc4\x9d\xef\x47\xbb\x7d\x6c\x45\xd3\xf0\xc4\xe2\x1d\x78\x79\x8b\xec\xb5\x92\xdf\x35\xa7\x24\x3c\xd1\x67\x9b\x74\xe5\x50\xbc\x0a\x9c\x44\x51\xcd\xc7\xeb\x12\x70\xab\xb6\x68\x2d\x45\x21\x92\x35\xb9\x12\x39\xe4\x94\x2d\x49\x5d\xc8\x93\xc0\xd2\xf0\x64\x8b\x22\x80\x12\x18\x01\x4a\x59\xc5\x9c\xd5\xa3\x88\xe7\x3c\x36\xfd\x46\x66\x19\x09\x33\xef\x99\x31\xcb\x8b\x0a\x77\x5d\x1a\xe4\x7c\xee\x48\xac\xf2\xf5\xc5\x39\x4f\xd2\xc7\x50\x45\xd1\x10\x64\x47\x78\xd1\x84\xa8\x9e\x75\x72\xca\xc7\x5f\xcc\xb5\xf7\x29\x67\xa7\x69\x9c\x5c\x71\x01\x28\xa6\xe0\x24\x23\xe3\x8f\x40\x7b\xaa\x07\xaf\x8a\xc3\x68\xe7\x6f\xb1\xef\x4b\xde\xec\xa0\xda\xab\xe4\x09\xfa\xcf\x06\x3c\x56\x84\xd2\x04\x47\x24\xcb\x8b\x51\xaf\xd5\x18\x1d\xfc\xb5\xa8\xeb\x91\x3d\xc6\xbe\x73\x35\x1c\x91\x5b\x79\x5c\x9b\x62\x10\x47\xa1\x80\x17\x4d\x78\x8d\xd1\x0e\xaf\x49\x33\x39\xf1\x1a\xab\xa5\x52\xa1\x40\xd9\xba\x55\x0d\x2b\x7e\x94\xba\x73\x43\x42\xcf\x8d\x06\xc6\xfd\x95\x09\xed\xf7\x53\x0e\xd6\xe6\xe8\x4f\x87\x7f\xbb\x75\x5a\x2a\xf4\x71\x9b\x48\x9c\x2a\xe0\xfb\x0b\x85\x7d\x2c\x7a\x47\xad\x2b\x7f\x9c\xe8\x77\x41\x6c\x46\x06\x75\x99\x4e\xbc\xd0\x8a\x6c\xa7\xcf\x67\xab\x50\xe4\x81\x34\x1d\x33\x86\xa2\x34\xe1\x4a\xf0\x4e\xda\xae\x3b\xcb\xa8\x19\xf4\xa2\x9b\x0c\xd7\xbe\x44\x7f\x99\x28\x63\xf1\xee\x12\x85\x68\xce\x0b\x07\x29\x37\x61\x38\x86\xb8\x8d\x18\xb3\x5b\x29\x3b\xe7\xa2\x32\x76\x48\x0a\x71\x8d\xdf\x9e\x02\x05\x60\x22\x4b\x1b\x71\xb1\x1b\x6e\x8a\x7b\xf9\x65\x57\xba\x85\x30\x09\xde\x54\x60\xf2\x7c\xdb\xa7\xd0\xf6\x50\x3c\x3b\x31\x25\xb6\x4e\xd9\xcd\x6b\xde\x88\xe3\xb0\xcd\xac\x19\x15\x36\x05\x92\x03\xed\x2e\x73\xa6\xa8\x3b\x5c\xc1\x21\x5d\xfd\xa6\x1d\x20\x57\x80\xae\xbc\x24\xbc\xc8\x0f\x98\x78\x9f\x60\x32\xd1\x3d\x64\x52\x19\x28\x3e\xd4\x1b\x25\x12\x77\x48\xdb\x1e\xb8\x7c\xda\x5a\x4a\x53\x5a\x56\x2a\x6c\x92\x1e\x72\xd1\x7a\x6c\xb3\x46\x28\x0d\xdd\xb4\x1f\x14\x5d\x98\xb2\x5b\xbc\x4e\x53\x32\xfb\x26\xd9\x08\x0d\x70\xc2\x85\x72\x5b\x1b\xd3\x5b\x60\x4a\x5d\x5f\x1e\xa9\xea\xa5\xc3\x2a\x10\xd5\x5c\x28\x14\x8d\xe9\xda\x2b\xc9\xd9\x2d\xa8\x9a\x17\x2c\xc7\x33\x97\x2c\x59\x71\x78\x98\x28\xda\x79\x44\x2b\x18\xee\xb6\xb8\x0e\xa8\x07\xed\x21\xa3\x99\x6a\xe3\xe1\xef\xbc\x84\x85\xf4\x2c\x60\xe0\x2e\x87\x7a\x9b\x49\x3c\x10\x05\x86\x3e\x73\xf4\x59\xf0\xcc\x0c\x28\x79\x9a\x64\x6d\x5d\xa5\xfd\xd6\x4e\xa6\xd0\xe9\xd5\xd5\x1b\x16\xdf\x7d\x69\x98\x3b\x88\xd8\x13\x19\xb9\xce\xf7\x4d\x9e\x33\x69\xe7\x72\xc2\x16\x64\xde\x3a\x72\xa6\xe3\x8a\x5b\x83\x29\x3c\x61\xdf\x70\x65\x9e\x9d\xd3\x62\x4a\xda\xcb\x3a\x6a\xf7\xdb\x2f\x18\x47\x0c\x04\x7e\x4c\x30\x7e\xd7\xd1\xc3\x0d\xd5\x75\x09\xfb\xec\x37\xac\x8e\xfd\x80\xa4\x91\x66\x2c\x2c\x84\x34\x31\x79\x64\xdf\xd3\xb2\xb3\xe0\x80\x88\x46\xc2\xa5\x3c\xb7\x06\x58\x13\x36\xe7\xac\xae\x63\x59\x3c\xc6\x3e\x8d\x76\xdd\x1a\xd9\xa8\xd7\x94\x36\x15\x36\x77\xb2\xb3\xce\x2d\x82\xf0\xfc\xf0\x2c\xbf\x9e\xcd\xfb\xe2\x29\x74\x63\xf6\x4e\xbd\xca\xbe\xa3\xd7\x23\x09\x1f\x42\x7b\xe3\x95\x76\x9a\x8a\xa6\x55\x69\x37\x51\xa6\x01\x93\x3a\x77\x27\xb6\x36\x7d\x06\xc7\x3b\x4a\x55\x7d\x06\xc7\x37\xf5\x43\xab\x1b\xa1\xf8\x98\xe4\x22\x9f\x69\x8d\xb9\x93\x98\x81\x55\x57\xfa\x6a\x1a\x25\x2e\xab\x32\xfc\xb2\x53\x6c\x5d\x69\x97\x8f\x20\xe7\x9e\xab\x75\x56\x95\x76\xbc\x6a\x65\xd6\x5a\x49\xae\xe0\x9d\x77\x0a\x53\xe5\x5d\x18\xdb\x26\x8e\xda\xe4\xb1\xa8\x93\xaf\xc8\x12\x33\x71\xe8\xbf\x91\x1c\x87\xa5\xc0\x80\x0c\xd8\xd9\x56\x4a\x6e\xd7\x7f\x42\x09\x2b\xb9\x5e\xb1\x40\xc1\x56\x5b\xfb\x2c\xca\x9a\xec\xd4\xe9\xba\xed\x51\x95\x63\xf3\xf2\x6f\x55\xcb\xd3\x6f\x95\x06\xa9\x69\x6d\x83\x8f\x9f\x9d\xbf\x71\x8f\x13\xf7\x0e\x61\x51\x36\x9e\x77\x3d\xab\x3b\x35\x57\xad\x4f\x79\x3b\x64\x38\x37\x70\x36\x36\x3c\xfd\x79\x93\x8a\x3b\x7b\x52\x6a\x2b\x2c\xe9\x56\xd9\x8e\x59\xde\xb4\x73\x9e\x09\x25\x0d\xd5\xff\x96\x76\x27\xe2\xae\x78\xed\x1d\x39\x6f\x75\x4f\xc4\xbb\x24\x03\xdf\x58\x54\xfd\x4e\xe6\xb0\xa2\xca\x44\x90\x15\xb6\x49\x8e\xae\x86\x0c\x96\xbc\x64\x18\xb6\x85\x90\x25\x78\xf2\x80\x89\xcb\xb4\x6f\x02\x8b\x65\xa2\xc7\x69\x96\x5f\x35\xc7\x4c\x44\x7e\x89\xc6\xd4\xd7\x40\x23\x2c\xad\xee\x0a\x65\xff\x69\x5e\x8c\xef\x21\xe1\x98\x17\x59\xc1\xfd\x95\x83\x49\x8e\x2d\x44\x1f\x5c\x8c\x76\xd4\x22\x93\xf7\x51\x15\xce\x24\x66\x5b\xfb\x26\x73\x3e\x0b\xce\x18\x3b\x25\xf1\xd0\xd1\x82\xb7\x37\xb2\x6b\xac\x28\x9b\x54\x23\xe9\x64\x7f\x7b\xec\x3f\xa1\xb6\xd2\xd8\x67\xef\x25\xb1\xaa\x5f\x76\x59\x81\x52\xc2\xe6\xf8\xac\x40\xd8\x70\xe3\xac\x40\x1c\x8f\x02\xab\x96\xc5\xf7\x75\x24\x30\x0a\x2c\x4e\x99\x9d\xbe\x74\x8f\x73\x42\x30\x7b\x7c\xc7\xc1\x38\xb4\xf6\xb7\x4c\x4c\xaf\x6d\xda\x2a\x19\xbb\x6b\x01\xea\x8f\x04\x81\xa8\x3b\x53\x03\xd0\x9d\xd8\x78\xcc\x89\x8d\x91\x89\xcd\xc7\x9c\xd8\x1c\x99\xd8\x7a\xcc\x89\xad\x91\x89\xed\xc7\x9c\xd8\xde\x9e\xf8\xf9\x33\xbf\xc1\xec\x8d\xfd\x99\xdf\x1e\xf1\xea\xbb\xa3\xd5\xc7\x63\xd5\x0f\x4a\xba\x1a\xe5\xd3\xed\xf2\x3d\xc7\x67\xd5\x75\xe2\xc9\x51\xb8\xf5\xe3\x30\xe9\xaa\x34\xcd\x23\x1d\x21\x1e\x49\x98\xab\xfc\x1a\xdb\x21\xf0\x05\xe3\x49\x20\x49\x5a\x34\xad\x59\xe2\x1e\x06\x2e\x2a\xe6\x3c\xbe\x18\x11\x57\xac\x5b\xb3\x35\x7e\x76\x59\xfe\xe3\x4b\xc1\xb1\x3d\xe1\x73\xe0\x39\x0f\x4d\x78\x39\x94\xf5\x3c\xc5\x64\x99\x2d\xd3\x90\x91\x47\x51\x07\x95\x4e\xc6\xbc\x3c\x07\x99\xa6\x17\xca\x83\x57\x8d\x8e\x54\xd7\xd8\x98\x22\xf6\x00\xfe\x9e\xad\x64\x26\x59\x21\x8c\x43\xbe\xe4\x22\xa9\x8b\x88\x8b\x3a\xe1\x18\xe6\x24\x88\xf7\x71\xec\xad\xdf\x03\xe1\x7f\x07\x1b\xf3\x30\xa2\x47\x92\xaa\xa3\x12\xbf\x78\x59\xa6\x37\x5b\x21\xa4\x5d\xb7\x3a\xef\x3e\x95\x30\xba\xdd\x9b\x7a\x80\x0c\xb1\xfa\x69\x55\x0c\xae\xfa\xf4\x99\xf9\xd8\x7f\x96\x60\x57\xb8\x19\xdc\xa3\x33\xd1\x99\xeb\x98\x5b\x35\xe6\x8e\x1d\xdc\xab\x9f\x45\x83\xb0\x69\x1b\x44\xae\x50\x30\x97\x4d\xe7\x61\x52\x2a\x37\xd9\x73\xed\x63\xb6\xc9\x23\x56\x28\x85\xa5\x56\xeb\x64\xd9\x54\xea\x13\x51\xe0\x7d\x8d\xc7\x15\x9f\xa6\xfc\xa4\xee\x8d\x24\xfa\x64\x16\x8c\x77\x4f\x2a\xb4\x97\xbc\xa9\xc1\x09\xbb\x59\xcd\xab\xf6\xe3\xdf\xc9\x41\xe6\x82\xd1\x9f\xf0\x16\x5c\xd9\x32\x42\xef\x54\x4a\x49\x4e\xb5\xff\xfe\xf8\xfe\x27\x0c\x16\x5f\x6f\x80\x51\xf2\xbe\x07\xc2\xf1\xa2\xd4\x2a\x04\x1e\x8d\xd1\xc7\x1a\xf7\x1a\x51\x01\xb3\x04\x46\x74\x55\xbb\x4a\xb3\x5c\x38\x83\xf1\x31\xc9\x93\x02\x7b\xba\x36\x31\x5f\x5d\x6a\x6f\x5a\x32\xd4\x3f\x71\x0c\xce\xb4\x4d\xca\xdb\xf7\x60\x84\x28\xc7\xe3\x35\x86\x33\x8b\xae\x10\x32\xa0\x06\x9d\xc8\x74\x85\x0b\x89\x40\x3e\x71\xee\xdb\x72\xaf\x6d\x37\x84\xaf\x6a\x22\x8a\x5b\xd9\xbf\x7c\xf7\x44\x2b\x04\x0a\x72\x7b\xba\xfe\xe1\x29\xb0\x37\xbd\xd6\x29\x0b\x37\x57\x67\xdc\x93\x96\x4f\x68\x49\xf7\x16\x5f\xef\xf4\xa2\xc3\x48\x77\x26\x4a\xc5\x45\xb5\x8e\xa9\x2e\xb7\x15\x92\x55\x95\x9e\x7b\xb2\x05\x20\x61\x0d\xef\x39\xdc\xb2\xa6\xe0\x8b\x27\x1e\x8c\xd8\xd9\x47\xb5\x77\xc0\x71\x5b\xda\xee\x4d\x1b\x1c\x9d\x55\x15\xc1\xe9\x4d\x66\xdb\xc9\x5b\x39\x70\x49\x82\xe1\x8d\x22\x66\x63\xab\xe1\x25\x67\xc7\x32\x4e\x47\x16\x35\xc4\x90\x72\x9c\xb8\x27\x49\x43\x54\x50\x25\x8d\x2f\x1e\x0b\xb4\x56\xdc\xbe\xe9\x3b\x53\x64\xfc\x41\x7b\x94\x27\x76\xd1\xca\x2d\xb8\x69\x77\xac\x83\x41\xa5\x38\x86\x88\xae\x43\xca\x99\x6b\xef\x56\x6b\xbc\x71\xc6\xa7\x5c\xf4\x14\xfc\xc8\x56\x8d\xd7\x44\x57\x48\xac\xc5\x70\x25\x0a\x44\xe0\x37\x2f\xc6\x22\x4c\x31\xb1\xa5\xab\x21\xa2\xc4\x78\x28\xe4\xff\x4d\x6e\xc8\x47\xfe\x4f\x21\x80\x30\xbd\x65\x53\x94\x18\x18\xcb\xe1\xc2\xdb\x54\x19\xe3\x22\x24\x31\x2e\xea\x99\x15\x9e\xdf\x2e\x85\x21\x93\xb3\x23\x49\xcb\xd5\xad\xee\xf4\x7b\xc0\x01\xbe\x21\xfb\x7e\x9f\xf2\xd0\xa8\x03\xa5\x40\xad\x32\x57\x4d\xc4\xf9\x60\x93\xba\xd7\x56\x5d\xa4\xf8\x36\x09\x65\x49\x2a\x99\x4f\x53\x46\xc8\xfe\xe1\x1f\x70\x81\x52\x52\x3c\xcb\x06\xe8\x7c\x01\xa0\x07\x34\x6f\xe0\x30\xf2\x25\x31\xa2\x6c\x1d\x5f\x0d\xdf\xc7\x8e\x42\xb2\x24\x69\xd4\x3a\xcf\x53\x3c\x43\xf2\x33\x24\xe2\x4d\x9a\x94\xda\xdf\xde\x9d\xcf\x60\x7c\x86\x17\x7e\x95\xf2\x7c\xcd\xee\x46\xa2\x26\x4f\xf4\x3b\xdb\x8b\x63\x23\x0e\x74\xcb\xf4\x08\xd1\x63\x5f\x71\xa3\x88\xfc\xf8\x7d\xa1\x12\x5f\x71\xa0\x92\xf4\x40\xa0\xa2\xd8\x35\x6d\xc3\xf1\xa9\x13\x18\x56\xe0\x37\x20\x81\x8e\xfc\x66\x8b\xf3\x4d\xea\x5d\xaa\xa6\xa8\x56\x67\x85\xeb\xdb\xaa\xd9\xa1\xc0\x20\xae\x62\xf9\x2f\xea\x7c\x7d\x9b\x17\xf5\xc2\x33\xba\x3c\x57\xc7\xff\xb1\x75\xc7\x74\x75\x5d\xf7\xf5\x98\xea\x3a\x31\x5c\xc7\x85\x3d\x80\xff\x31\x2d\xdd\xf1\x4d\x3d\x32\x2d\x6a\x11\x66\xd2\xc8\x77\x09\x35\xe0\xa1\x6b\x10\xd3\x37\x03\xea\x7b\x91\x17\x85\xbe\x6d\x39\x96\xeb\xd8\x81\x19\x52\xc3\xb1\x7d\x16\x7a\xcc\x8b\x23\x3d\xb6\x5c\xcb\x0c\x59\xa0\xeb\x66\x70\xa2\xb4\x2f\x16\xa2\xa7\x89\x2e\x1d\x63\x9e\x2d\xe4\x7d\x23\xb7\x0f\xcd\x72\x9a\x14\x44\xe6\xa9\x63\x6f\x39\xd4\x18\x00\x8d\x27\xeb\x08\x78\xe2\x9a\x4b\x91\x5f\xd0\x82\xfa\xf5\xe4\x9b\x17\xa3\xcc\x74\x17\x96\x7e\x39\xd1\xf1\xcf\x2b\xed\xe2\xaf\x1f\xbf\x37\x34\xc4\xd9\xc9\x4c\xe3\x0f\xcd\xe6\xa1\x5d\x3f\xb4\x5f\x69\x3f\x7e\xbc\x7c\xff\xe1\xdd\x49\x93\x87\x59\xb0\x25\x30\xe9\x2c\xdf\x77\xbd\x83\xcb\x8d\x37\xa9\x4c\xb7\xae\x46\x86\x0f\x95\x36\x5d\xa2\xc5\x6a\x52\xac\x79\xcd\xfb\xfc\xc1\x18\xb8\x33\xed\xd0\x0f\x89\x13\xc3\xa2\xf8\x2b\x92\xeb\x8c\x91\x23\xef\x23\xb8\x27\x3d\xea\x0f\xfb\x63\x9c\x08\xe8\x5a\x76\xdd\x28\xbf\x93\xb6\xfa\xbe\xac\xa5\xf6\x0b\x0c\x7a\x0e\x76\x9f\xb3\xe6\x44\x90\x30\xd9\x4d\x18\x83\x1b\xb7\xe5\x4f\x2e\xb8\xd7\x63\xe7\x82\x2a\x67\xc2\x7e\x1b\x34\xb7\xe7\xa6\xfd\x5f\x22\xdb\x7a\xce\x5c\x2f\xd6\x0d\xdb\x3b\x51\xe8\x5c\xb8\x45\xba\x83\x76\x9c\xde\x7d\xe8\xcc\xeb\x01\x64\xb3\xc8\x93\xea\xdf\x43\x4e\x94\x24\x5d\x6f\xca\xf6\x9e\xa3\x3d\x3c\x4a\x96\xd2\xf9\xb1\x9b\x73\xf3\x1e\x0c\xfb\x52\x06\xd8\xcf\x20\xd8\xb7\xfd\x86\xbd\xb9\x12\x92\x64\x30\xfd\x76\xc5\xe3\x13\x9b\x75\x28\x3e\xbb\xb1\xb5\x3c\x69\xc2\x99\x4c\x0c\x88\x04\x0c\xcd\xdd\x17\xd5\x18\x37\x5b\xa9\x9d\x1c\x91\x6d\xbf\x96\x92\x89\x92\x6d\x4a\xca\xcb\xe7\x3d\x44\x5a\x6f\xbb\xc6\xb4\x22\x41\x9d\xa7\xde\x62\x95\x2f\x5e\xec\xe0\x8d\x45\x9b\x7d\x3e\x7c\xf3\xc6\xed\xcb\xcf\xec\x7e\xc8\x58\x19\x30\xd0\x8e\xc8\x94\xf5\x6d\x9b\xb1\x23\x18\xbe\x2c\x3c\x46\x03\x0f\x66\x0f\xbe\xd9\xe4\xc5\xfe\xc7\x1c\x69\x0f\x48\x00\x5b\x19\x97\x99\xb8\x61\x6d\x6a\xd6\xac\x09\x26\xa5\x34\xf7\x07\x22\xde\x0d\x04\x79\x9e\xb4\x92\x9f\xd4\x45\x99\x81\x4e\x59\x44\x03\x50\x9f\x42\xd7\x24\x3e\x75\x75\xcb\x76\x48\xe0\xfb\x96\xef\xc6\x91\x6f\x87\xc4\x0d\x23\xfc\xd9\x06\x01\x12\xbb\x96\x6b\xc6\x81\x65\xb8\x3a\x8b\x2d\xe6\xb8\x96\x94\x7c\x97\x77\x3f\x2a\xb7\x83\xdd\xf2\x8b\xc2\xcd\xc2\xaf\x10\x35\x2c\x93\x37\x26\x1b\x45\xdb\x98\xbd\x6d\x01\xe1\xe9\xe1\x85\xf3\x62\xec\x38\xfc\x12\x19\x5d\x61\x99\xdf\x0e\xcb\x7c\x3b\x76\xa3\xc8\xf7\xc3\xd0\x76\x4d\x97\x04\x80\x0b\xcf\x33\x7c\xe6\x9b\xb1\xe9\x38\xa1\x1f\x13\xc7\x30\x6c\xc7\x22\x1e\x3c\xf3\x02\x8f\x85\x7e\xc4\x88\x65\x05\x56\x68\x1a\x4a\x9a\xab\xd2\xe0\xa6\x0b\x75\xb7\xde\x85\x68\x0b\xfc\x8a\x5b\x07\x96\x39\xbe\x9e\x2a\xd7\xe6\x9a\x25\x57\xd7\x65\xef\x52\x2c\xd3\xb1\x94\x9c\xbf\x76\x87\x9d\x7d\xe1\x71\xed\x71\x78\xc0\xcc\xba\x6b\x6a\x29\xf4\xe6\xa1\x39\x96\x65\xba\x1e\x28\xdf\x82\x32\xe4\xcd\x6f\x2f\x69\x88\xe8\xb4\xac\x5d\x27\xf4\x2b\x91\xfc\x47\x11\x49\x3d\xf1\xdd\xfe\xdb\xa9\xb2\x96\x66\x53\x87\x38\x1d\xf0\x32\x30\x25\x80\x71\x79\x9e\xe7\xfb\x01\x58\xfd\xc4\x72\x3d\x46\xf5\xd0\x02\x3b\x1b\x98\x19\x40\x64\xd8\xb6\xe7\x45\x36\xf0\x44\x78\xe6\x19\x11\xa3\xd4\x8d\x83\x98\xc0\xd3\x13\x05\x54\x11\x15\xf4\x10\x70\x65\x3f\xf2\x97\x22\x04\x68\x88\xfc\x68\x68\xeb\xa6\x07\x93\x87\xc0\x9a\x63\x66\x47\xbe\x15\xb9\x94\xc4\x60\xe6\xfa\xae\xeb\x01\x51\x1a\xa1\x0f\x4c\x5b\x72\xe1\xaa\x6d\xc5\x4e\x3e\x5c\xf7\xf7\xc1\x24\xa1\x56\xb3\xa0\xaf\x87\xed\x3f\xe4\xb0\x61\x0f\xa4\xe3\xe1\x46\xb4\x54\x92\x4a\x71\xeb\x58\x2a\xbd\x5e\xfb\x80\x7b\x56\x0c\x80\x0f\xfc\x5d\x93\xca\xd2\x7f\x5c\xd2\x27\x42\x77\x09\x9d\x80\xce\x0a\x04\x79\x34\xa7\x9e\xe5\x47\x3f\xc1\x45\xf2\x0f\x76\x3c\x14\x7e\xf8\xe1\x02\xf4\x60\xb4\xa4\xaa\x0c\x1c\x1c\x9f\xa7\x78\xe3\xba\x7b\x91\xe9\x35\x11\xdb\xa2\x7e\xd5\x24\xf2\x9c\x88\x4f\x59\x11\xab\xaa\xc0\x3c\x8e\xce\xd0\xb3\x74\x1a\xd2\x40\x8f\x81\x56\x03\x6a\xb8\x4e\x18\xd3\xd8\xb2\xa2\x48\x67\x8c\xda\x1e\x8b\x74\xd7\x0f\x2c\xd0\xce\x19\xf3\x42\x2f\x32\x4c\x62\x33\x50\xe1\x95\x1c\x96\xf2\x49\xb1\x9f\x2b\x52\xfc\x80\x91\x1a\xc7\x06\x06\x2b\x2a\xf0\x10\x10\xed\x25\xd6\xac\x93\x49\x85\x28\xe1\x36\xab\xcd\x92\x94\x78\x8f\x27\xea\x32\xc8\xe2\x46\x6a\xd3\xbb\xde\x23\x65\x18\x70\xa6\x1c\x2f\x50\x2a\x3a\xa6\x2c\x4e\xa2\x84\xe4\xf7\xc7\xa3\x06\x25\xc2\xb5\xf2\xcd\x83\x75\x27\x4b\x93\xd5\x85\xca\xb0\x9a\xc5\x00\xa1\x80\x9a\x10\xd8\x91\xe9\x80\x56\x40\x5d\xd3\x8f\x29\x75\x3c\x83\xc4\xc0\xc7\x3c\x2f\xd6\xa9\x6e\x04\x2e\x89\x43\x5b\xb9\x47\x00\x34\xfc\xb5\xe8\xf3\x4c\x1c\xba\x03\xd3\x90\xdc\x07\xbf\x89\xc5\x03\x1b\x4a\xc5\x5a\xc5\x1f\xa3\x2c\x67\xc7\x83\xad\xd8\xac\x38\x6e\xc1\x30\xc6\xfb\x22\xd8\x26\xb2\x94\x11\x9d\x27\x18\x5a\x94\xb3\xfe\x8a\x1a\x66\x00\x76\xb0\x22\xa0\x8a\x0f\x59\x56\x1e\x6f\xdb\x73\x18\xad\xf1\x26\xa9\xfd\x17\x55\xa9\xa9\x0d\xec\xb9\x1f\xd0\x98\x06\x71\x44\x0d\x3d\x0a\x98\x63\x51\xd7\x77\x02\x33\x8a\xfd\xd0\xb1\xf5\xd0\xf4\xf5\xd0\x33\xa9\xe5\x83\x82\x08\x3f\x98\x96\x69\x5a\x41\x60\x82\xd1\xae\x07\xc4\xd7\xdd\x30\x54\x78\x6d\x49\x4a\xf6\x88\x4b\x93\x34\x5d\x88\x89\x86\x96\xe3\x86\x11\xe8\xb6\xa6\x61\x87\x51\x40\x7d\x0a\x12\x98\x86\xc4\xd0\x81\x99\xb9\x16\xe8\xbd\x86\x47\x8d\x20\x62\x81\x17\xbb\x7a\xe4\x13\x93\xc5\x4e\xe4\x04\x61\x48\x41\x56\xdb\xa6\xab\x78\x57\xaa\x76\xf3\x5f\x66\xb3\xea\xe9\x06\xd6\x65\x38\x9e\xef\x31\xe0\x22\x56\x64\x7b\x3a\xf3\x89\xeb\xfb\xcc\x85\x5d\xf3\x88\xc1\x98\x61\x52\xdf\x76\x50\x1f\xa1\x70\x78\x4d\x6a\x46\x86\x1e\x30\x13\x0e\xb1\xe9\x52\x9f\x39\x36\x53\x45\x22\x9a\x0a\xfb\xae\xc8\xd4\x07\x95\x27\xac\x49\x9d\x32\xed\xf6\x3a\xab\x6a\x1a\xf3\xba\xec\x83\xba\x1a\xac\x86\x84\x60\x8a\x78\x31\x10\x9c\x47\xcd\x00\x14\x23\x93\x39\x21\xb5\x5c\x03\x8c\x14\xe2\x38\x86\x43\xf5\x28\x32\xa9\xb2\x1b\x2a\x5d\xef\x79\x0d\xd5\x3a\x12\xe7\x6f\x8b\x83\xae\x93\xc6\x36\x78\x44\x9b\x6c\xc9\xe4\x47\xd1\x23\x45\x34\xd1\x98\x22\x59\x66\xfb\xea\xc3\x27\x75\x6a\x44\x13\xe3\x21\x3d\x82\x18\x83\x53\x87\x64\x8a\x98\xd1\x15\xbe\x57\x1b\x65\x27\x03\x5b\xee\xe8\x96\x4d\x88\x13\xc0\x49\x74\x42\x17\xec\x51\x8b\xe8\xa6\x6b\x82\x64\x0c\x41\xc5\xf0\x4c\x06\xa7\x93\xd9\xba\x42\xa8\x53\x6f\xe0\xda\x9e\x4d\xb0\x1f\x70\xa7\x9a\x34\x0f\x51\x73\xad\xee\x86\xc6\xe8\xf0\x05\x3e\x0d\xad\xc8\x8a\x6d\xc7\x8d\xda\x8e\x5f\xbc\x89\xdd\x17\x10\x7e\xb7\xc3\xbf\x94\xb8\x19\x32\x57\x6b\xd7\xa7\x1a\x53\xd2\x7b\x41\x8e\x39\x08\x97\xe4\x6a\x5f\x81\xe6\x0f\x81\x38\xda\xcd\xa3\x57\x99\x0d\xda\xd6\xe8\x07\x16\xef\x8b\x16\x5f\x9c\x1f\xbc\x1b\x8e\x13\x6e\xea\x15\x58\xe2\x7e\x4f\x0d\x56\x89\xad\xb8\x5b\x27\x39\x69\x87\x76\x3e\x54\xcd\x3f\x69\x06\x05\xb6\x2c\x75\x11\x24\x23\xb9\xe6\x59\x1d\x29\x12\x6e\x67\x38\xd7\x40\x7b\x0a\xc3\x94\x41\x52\x07\x5d\x97\x8c\x96\x0d\xe6\xe3\xb6\x94\xb1\x0b\x2c\x04\xf6\x26\xeb\xdb\x97\x03\x89\x04\x8b\x8a\xa1\xa6\x8a\x87\x9c\x17\x22\x03\x44\x44\x64\x19\xa1\x8e\x26\x8a\xa8\xf3\x5c\xf7\xa6\x0c\xd9\xb8\x79\x7e\x45\x8a\xe3\x29\x64\x5c\x3b\x5f\x55\x29\xfc\x08\x41\x44\x52\x3c\xed\xc0\xa1\x40\x59\x13\xc0\xca\x48\x4a\x21\x94\xba\xc1\x9f\x23\x3a\xa4\x28\x87\x52\xbc\x4f\x8f\x27\xfe\xcf\xdf\xf6\x79\x37\xe0\x7f\x45\xd6\x10\xbf\xa8\x13\xd5\x56\x5a\x2f\x48\x48\xe0\xc5\x79\xb5\x44\xe4\xc6\xf3\xbe\x35\xe0\x0f\x8d\x13\x21\x9b\x16\x0f\xd5\xbe\xcb\x01\x13\xc0\x63\x96\xcb\x88\xcb\x3c\x93\x48\x06\xf5\x91\xcb\xf6\xcb\xda\xdb\xb3\x95\xaa\xb3\x23\x2f\x8d\x73\x37\x35\x33\x72\xe0\x1e\x70\xe8\x16\x70\xb0\x94\xcf\xc8\xbd\xdb\x40\x05\x9e\xde\xa8\xa9\x4e\xc8\x83\x17\x51\xdf\x31\x42\xb0\x96\x43\xdd\x70\x41\xb9\x0a\x43\x0b\x94\x92\x90\x12\x62\xd9\xba\x13\x5b\x34\x74\x5d\x8f\x12\x16\x06\x8e\xe9\xf8\xcc\x00\xb5\x39\x72\x6c\x27\x64\xf0\x9a\xa1\xc7\x86\xe7\xeb\xb6\xe7\xc6\x5e\xe4\x86\xc4\xb4\x23\xcf\xa1\xa6\x1b\xf9\x20\xe4\x41\xe1\x76\x82\x98\xf9\x41\x68\xe8\x4e\xe4\x82\xb1\xe5\x81\x56\x67\x50\x27\x32\x22\xcf\x8e\x0d\x3b\xa2\x81\x59\x07\x83\x5c\xde\x61\xcd\x11\xf5\xee\xe3\xcb\x22\xbe\x5b\x31\x76\x2a\xc6\x15\x97\x6d\x97\xe6\x47\x50\x7f\x3c\x0f\x3b\xbf\x3c\xef\xf8\xd8\xf7\x59\x43\xaf\x72\x3b\x75\x21\xd3\xdd\xee\x6d\x4a\xff\xc7\x00\x91\xf7\xd5\xbc\x1f\x91\x69\x5d\xef\x06\x8a\x7a\xee\xb1\xea\xe1\x41\x3c\xff\x10\x38\xa4\xe2\xe3\x1a\x5a\x9a\x61\xe9\x2f\x76\x65\x74\x8e\xd3\x64\x9d\xc4\xa9\x69\x1f\xc8\x6d\xc3\x53\xfa\x88\x30\x27\xb7\x0f\x51\x02\x2b\x7f\xdd\x0e\xce\x0f\xdb\x05\x9b\x12\x80\x9d\x0b\x66\xad\x4e\x28\xa1\x41\x60\x4f\xb9\x8f\xf7\x6c\x38\xc1\xa6\xe9\x19\x3a\x7c\x67\xf8\xa6\x63\xea\x3e\xfe\x2d\xd2\x43\xdf\x36\x6c\x0f\x6c\xe9\xc0\xb6\x02\x07\x46\x0b\x7c\x0b\xac\x67\x5d\x67\x2e\x98\x70\x9e\x6d\x02\x87\xf1\x3c\x16\x81\xfd\x13\x80\x25\x1d\x11\x1d\x2c\x1f\x9d\xd9\xa6\x11\x5b\xc0\x73\x2c\x46\x4d\xd3\xb0\x4c\x9b\x01\xa1\x83\x05\x4b\x2d\xdb\x75\x43\xcb\x0c\x0d\x18\x3e\x02\x85\xd9\x80\x49\x83\x10\x5e\x89\x0d\x6a\x47\x96\xa7\x5b\xba\x03\xc6\x39\xa5\xa6\x47\xe2\x00\x0e\x89\x09\x6a\xb6\xae\xa2\x79\x9b\x93\x7c\x45\xf7\x23\xa0\x7b\xe8\x54\x4c\x3e\x11\xef\x6e\xd8\x78\x98\xb3\xf4\xf3\xed\x7d\xcb\x81\x31\xbb\x8d\x8b\xb0\xb6\xe2\x84\xea\x21\xfb\xc0\x17\x4a\x65\xbf\x97\xd2\xf2\x1f\xb2\x5c\x3c\x07\x04\xa0\x6f\x81\x2d\xef\x53\x1f\x36\x91\x46\xa1\xe9\x1b\xc4\x03\x51\x66\xc7\x91\x17\x5a\x96\x6b\xc7\xb1\x5a\x03\x89\x17\xfb\x28\x1e\x10\x37\xd4\xc3\xb1\x5b\x36\x1c\x65\x9e\x11\x9b\xd4\xf1\x7d\x42\x7c\x62\x30\xa2\xeb\x20\x69\x2d\xc3\x04\x91\x1a\xb8\xc0\x7c\x6d\xd3\x06\x52\xb3\x02\xbc\x3f\x88\x81\x68\x98\x6f\x30\xd7\x89\x09\x75\x4c\x12\xfb\x7b\x9b\x7c\xc7\x9d\x5c\x08\xfc\x56\xc1\x8c\x81\x00\x2c\x5e\x42\x61\x5f\x02\xa8\x36\x9f\xb3\xfa\x82\x2b\x94\xad\x16\x0c\x0f\x97\x5f\xb5\xdf\xe0\x41\xa0\x49\x8f\xf5\x0e\xe8\xf6\x77\x28\x08\x53\x61\x6f\xd0\x6a\x03\x63\x14\x9c\x1e\xf7\x81\x60\xbc\xc2\xaf\x37\xb6\x9b\xc7\x70\xa2\x0f\x98\x30\x68\x12\x92\xfb\xc3\x49\x45\xb9\x4a\x40\x15\x88\x17\xa0\xe7\x56\x20\x0c\x7c\x34\xaa\xc1\x51\x1f\x22\x73\x9a\x1d\xe2\xf0\xb5\xda\x11\x76\xfc\xa8\x26\xd8\x35\x71\x14\x46\xa0\xce\xdb\x6d\x2f\x8f\xb8\x1a\x39\x0e\x20\xa3\xd7\x2c\x8e\xe7\x82\xb9\x10\xc4\xe8\xd3\xd8\x06\x41\xa4\x02\xee\x1d\xe9\x89\x79\x47\xd8\xf6\x4b\xad\xf4\x22\x15\xbb\x5b\x52\xd4\xe3\x0e\xa7\x68\x28\xb1\xa6\xeb\x4d\x79\x18\x8b\x1e\x8e\xe0\xac\x64\xcd\xeb\xae\xe4\x9a\x10\x3d\x39\x52\xbf\xaf\x36\xd4\x79\xee\x7a\x23\xd3\x24\xfd\xce\xaa\xe6\x1e\x51\x96\xcb\x66\x20\xbc\x0f\x56\x9d\x9b\x49\x7a\x46\xeb\x73\x6f\xb6\xf2\x84\x77\x19\xdd\xf2\x37\xa5\x07\xfd\xd4\x2c\xbb\x03\xbb\x86\xf6\x54\x9a\xda\xea\xc7\xfd\xa8\x00\x74\x8b\xce\xec\xa3\xfb\xa8\x35\x5d\x34\xed\x0d\x58\xb7\x6f\xc9\xb8\x8a\x7a\x90\x63\x78\x8b\x8d\x8f\xb8\x85\x1f\xe8\xed\x6d\x79\xc8\x31\xe9\xf4\x11\x7d\x5f\xf2\x66\x1a\x3d\x5f\x38\xad\x70\x75\xa9\x2a\x77\xe5\x12\xdc\x1b\x5b\x58\x16\x05\xbd\x66\x5d\xb7\x1e\x2e\x69\x7f\x81\x22\xbe\xaa\xe5\xca\xcb\x55\x71\x35\x17\x5a\x4c\xa5\x5d\x56\x67\x69\x6b\x9b\xb9\x48\x61\x7a\x08\xba\x38\xf1\x5c\xbb\xc7\x31\xcf\x59\xaa\xeb\x3a\xb6\xe5\xfa\xae\xe1\x06\x2e\x33\x75\xc7\x86\xbf\xc7\x9e\xa9\x50\xd5\xee\xdc\x8a\x43\x36\x9e\x3b\x08\x38\xcf\xe4\x9f\x0f\x49\x1d\xdd\x72\x1c\x97\x78\x56\x04\x16\x87\xe5\x83\x52\x6c\xc6\x11\x6a\x2f\x7a\x1c\x05\xd4\x76\x09\xd5\x0d\xdb\x8f\x75\x8f\x81\x11\x61\x78\xcc\x30\xbc\x90\x1a\xa0\x39\x04\x34\xb0\xfd\x50\x09\x68\xe9\x72\x95\xa3\xb8\x92\xb7\x78\x48\x2f\xf7\x38\xca\x44\x5d\x5e\x71\xf4\x10\x82\xba\x65\x06\xdd\xe0\xce\xf5\x9c\x8a\x41\x75\x69\x1f\xf9\x3b\x20\x40\x6f\x56\xef\x26\x26\xde\x34\x04\x52\x85\x84\x61\x1a\xcd\x14\x06\xf8\x05\x2f\x14\xbe\x32\xac\xe9\x0c\xab\x67\x5b\x4e\xf1\xf6\xf5\x30\x6b\x65\x22\x0b\x9c\xc6\x06\xd5\xf2\x21\x35\x99\xb5\x39\x62\x97\x82\xb6\xa8\x67\x94\x72\xea\xe1\x54\x5a\x9e\x90\xc2\x08\x9a\xc2\x75\x46\xf7\x39\x2d\x7f\x7e\x77\x39\xb4\x67\x6a\x53\x20\xf5\xb5\x35\x29\xaf\xf7\x99\x42\xd4\x19\xc7\x9a\x72\x45\x39\x1c\x7a\x57\x5e\x8b\x2c\xec\x76\x81\xc2\xb0\x55\x19\x60\x5a\x02\x61\xbb\xfe\x50\xca\x93\x03\x5b\x68\x14\xa9\xfc\xe3\x29\x59\xa4\xdc\x4c\x3a\xad\x0d\xeb\xd3\x07\x63\x3a\xbe\xbf\xbc\xbc\x90\x43\xb6\x53\xbb\xb7\x57\xb7\xb5\x0c\x01\x27\x7f\x6b\xa6\xb1\x55\xc8\xa8\xec\xd1\x89\x35\x9f\xe2\x6a\x69\x33\x2d\xc3\xb4\xb4\xdb\x04\x5e\x25\x58\x73\x5b\xee\x84\x58\xf2\x9f\x78\x41\x3c\x51\xcc\xa0\x18\x5b\xb2\x68\x76\xbc\xd7\x92\xf5\x69\x55\xc1\x65\x1b\x65\x00\x97\x67\x36\x62\xa2\x2c\x03\x0b\x82\x62\x52\x60\xfd\xe2\x72\x6a\xe8\xa1\x12\x09\x36\x6d\x7a\x11\x7b\xc8\xad\x48\x9c\x95\x93\xb3\xd0\x31\xc6\x6b\x58\x60\x63\x43\x54\x48\x0a\xa6\x14\xd8\x41\xbc\xdf\x67\x1b\x2d\x65\x98\x5c\xcd\x71\xcb\xd7\x53\xf0\x83\x82\xb9\x5e\x74\x2e\xd2\x55\xeb\x71\x16\x8b\xa6\x5b\xdf\x6f\x0a\x64\xdf\x64\x62\x53\xbe\x79\xd5\x7a\x8c\x3f\x70\x84\xc1\x73\x7d\xd6\xfe\x81\x2f\xe5\x1b\x5c\xba\xd6\xaa\x13\xfb\xaf\x17\xdd\xbf\xa9\xd3\x72\x5f\x65\x98\xdd\x60\x6d\xae\xb8\x2e\x8f\xb8\x16\xa1\x80\x62\x73\x0a\x98\xac\xe9\xe5\x8a\xbf\x88\x60\xdc\x02\x26\x9b\xb7\x71\x22\xe1\xd6\x16\x68\xa6\x2d\x2a\x8c\xd0\x0c\x0b\x8a\x71\xbc\x00\x82\x29\xf0\x31\x18\x0c\x06\x02\x52\x9c\xab\xa4\xf8\xa1\x29\x45\xd2\x4f\x88\x18\x0a\x30\x85\xbd\xa4\x9b\x55\x5b\x16\x9f\x76\x82\xa4\xb8\xc4\x48\x56\xec\x45\x6f\xca\xed\xd6\xcb\x23\x24\x04\x9c\x30\x49\xa5\x33\x97\x47\x2a\x00\x35\x2d\x30\xb5\x7e\xc1\x51\xb6\x28\xb3\x45\xbb\x5a\xce\x82\x0f\xbe\x90\x3e\x84\x76\xf7\xba\x05\x42\xd4\xfe\xa9\x0e\xd5\xad\x3b\xb1\x21\x0e\xe5\x20\xed\x91\x31\x65\x41\x54\x60\xc1\xbd\x01\xcb\x48\x56\x3b\x82\x83\x92\x35\x1d\xdd\xb6\xfb\xea\xa1\xbb\xa9\x60\xcd\x3c\x05\xaa\x59\x4b\x3c\x92\x49\xd9\x1e\xff\x3c\xc6\x74\x2f\x51\x27\x6f\x0d\x23\x88\x6a\x73\x39\xc3\xfa\x25\xb2\xbf\x71\x53\x2e\x4f\xcc\x55\x37\x5a\x90\x95\xfd\xe1\x65\x92\xa4\x4d\xfb\x6d\x5e\xe8\x49\xb4\x69\x11\x2c\x1e\x64\x6e\x7b\xd2\xa6\x8c\x18\xe0\xf4\x38\x8e\x3b\xfd\x45\xcf\xf0\x7d\xb1\x5b\x87\x0c\x6e\xf0\xcb\x93\x17\xe3\xfc\x43\x25\x1a\x81\x28\xde\xee\x01\xcf\x00\x4c\x2a\xb8\xc4\x6e\x26\xc1\xbf\xec\xb2\x08\xa4\x42\x78\xfa\x0d\x47\xf1\x37\x5b\x6c\x02\xb1\xc8\xb9\xc4\xd6\xf3\x32\xfb\x46\xc0\xbe\x07\xeb\xa8\x18\x86\x4a\x5c\xbc\xa8\x84\xa0\x5c\xe0\x44\x55\x28\x0f\x1f\x59\x59\x91\xe0\x0e\x4a\xb1\x29\x1e\xdd\x82\x51\x6f\x7c\x14\xa5\xa2\xbf\x70\xd4\xe3\x65\xc6\x47\x56\xfe\xc0\xae\x48\x74\x3f\x1e\x81\x87\x75\xec\x77\xb2\x08\x51\x75\x7e\xda\x6b\xe6\xb4\xd7\xac\x69\xaf\xd9\x3b\x5e\x1b\x2a\x61\x89\x02\x51\xb8\x54\xf0\x5e\x47\xfb\x7b\xc6\x4f\x91\x68\xcb\x0b\x58\x5c\x68\x88\x0b\x52\x66\xf9\xbc\xc2\xae\x7c\x93\xf7\x1b\x12\x45\x20\x27\x4b\x1f\x81\x45\xa4\x21\x50\x87\x69\x6c\x3a\x26\xa1\x46\xc8\xcc\xc8\x0f\x42\x37\x88\xcc\x50\x77\xfd\x38\xb2\x3c\x9f\x12\x12\x38\x66\x48\xbc\xd8\x70\x2d\x30\xb3\x0d\x03\x83\xd9\x1d\x87\xd8\x34\x76\x4c\x2b\xb4\x58\xdc\x22\x40\x31\xb2\xf1\xcd\x96\x1b\xaf\x9f\xbc\x84\x46\x50\x54\x6d\xfe\x04\x9b\x5a\x08\xd8\x16\x1a\x28\x72\x60\x0d\x6a\x8b\x87\x43\x58\x73\xd1\x8e\x99\x21\xa9\x89\x5b\x05\x0f\x9c\x44\xbd\x71\x14\xc2\x6e\x37\x31\xe7\xaa\x38\xdc\x65\x17\x28\x12\xb4\x31\x59\xb2\x75\x27\x8c\x77\xf7\x18\x52\x21\xdc\xba\x4b\x84\xe3\xf7\x08\x3e\x8a\xd6\xc1\xae\x92\x22\x85\x21\x38\xed\xbc\x4f\xcf\xec\x54\xbd\x44\xcc\x09\xa8\xed\x39\x24\x64\x6e\xe0\x44\x5e\xec\x7a\xc4\x27\xa6\x85\x17\xd4\x16\xf1\x1d\x37\xd4\x43\x3b\xf2\x0c\x7a\xb2\xff\x3d\xe0\xc3\xa6\xd9\xe7\x5a\xef\xb0\x0b\xe2\xd6\xcd\xe7\x73\xa3\x44\x52\x93\xc6\xf1\x69\x71\x9b\xec\x4e\xba\x6a\x08\x3f\xbd\x6f\x64\x47\x83\x47\x88\x1b\xd8\xd9\x07\xe6\xf7\x2a\xde\xea\x2e\x11\x8d\x1a\x04\x66\x98\x40\xc2\x5c\x7b\x8d\xd1\xf0\x09\x5b\x52\x21\xcd\x26\xc8\x3e\xfe\xf6\x41\xa2\x4f\x6e\x81\x90\x7d\x53\xcf\x6f\x8f\x8c\x3b\x96\xf4\xdc\x4f\x46\x56\xad\x70\x41\x2d\x5f\x4c\x07\x5f\x58\x2a\x02\x9f\x5f\x52\xbc\x56\xa7\x64\x2f\x54\x3f\x8e\x70\xee\x3f\xea\x82\x0b\x3d\x07\xc6\x58\x1d\xa0\x8f\x7d\x6e\x9a\x63\xdc\x58\x54\x5c\x4f\x01\x3c\xdf\x12\x88\x63\x6e\x9e\xaa\x0f\xa5\xec\xc1\xd0\xee\x2a\xbe\x20\x45\xb4\x38\xcc\xaa\x87\x2f\xb7\x9e\x20\x14\xdd\xed\xac\x04\xde\x14\xe6\xfd\x55\xa7\x38\x82\x4e\xf1\x9f\x7e\x68\xb6\x09\xee\xf9\x9c\x1b\xfe\xff\xce\xd3\x38\x1b\x0d\xa4\x12\x39\x4c\xdf\x4d\x2e\x34\xd2\x57\x97\xcb\x77\x8c\x88\xc4\x56\x14\xd3\xd0\x65\x7e\x10\x44\xb1\x13\x38\x7e\x18\x87\x06\x89\x2c\xdb\xb0\x30\x30\x94\x62\xc9\xd0\xc0\x35\x3d\xe6\x86\xcc\x63\x91\x11\xda\x0a\x2e\xf7\x49\xd4\x6a\x12\x86\x6c\x41\xb0\x17\x8c\xe5\x1f\x4b\x52\x8e\xba\xbe\xb7\xeb\x6d\xef\x5c\x1e\x36\x70\x3e\xbb\x31\xe6\xfa\x5c\x3f\x75\x5d\x5f\x0f\x03\xff\x94\xb2\x9b\xb3\x65\x92\x6e\xee\xce\xae\x32\x63\x6e\xe8\x73\x4b\xa9\x7c\x82\x35\x8e\x0f\x46\xa3\x0f\xc7\x10\x04\x99\x1d\xd1\xd8\x88\x22\xc7\xa4\xc0\x00\x02\x4f\xb7\x63\x3b\x32\xfc\x58\x37\x75\x06\x08\xf3\x69\x18\xc6\x36\x30\x09\x6a\x30\x66\xc7\x46\x4c\x9c\x38\x0e\xec\x93\x03\x53\xb8\x6b\x18\x5c\xdf\x0e\xbc\xc6\xff\x0b\xe8\xdc\x73\x0d\x0e\x80\x67\x9a\xc4\xd1\x1d\xc6\xb0\xd6\x84\x6d\x59\x06\x88\x6d\x02\x14\xe1\x63\x5e\x8c\x47\xa8\xe3\xc7\xb6\x6b\x11\x3d\x26\x61\x40\x48\x1c\x9b\x91\xc1\xec\xd0\x64\x26\x85\x0f\x19\xf0\xa2\xc8\xb0\x63\x4a\xb0\x92\x02\xa1\x9e\x1d\x52\x2b\x76\x75\x27\xb0\x5d\xdb\x26\xc4\x72\x22\xc7\xf7\xe3\x20\x22\x40\x3c\x16\x90\x14\xa8\x07\xcc\xf0\x81\x93\x01\x75\x01\xcb\x54\x0b\xbc\xf1\x88\xa9\xbd\xa0\x37\x4c\x7f\x6e\xcc\xad\x60\x6e\x98\xfa\x2b\xc3\x30\x2d\x47\xad\x5d\x1b\x66\x9b\xf4\x21\xb7\xdb\x74\x33\x3d\xd9\xae\xb9\x68\xf2\x2b\x37\x03\xa6\x84\x44\xe3\xf7\x58\x53\xb3\x93\x07\x7b\x7b\xe1\x6d\x00\x0c\x9c\x15\xc0\xa3\xd4\xb4\x8d\xdb\xac\x72\xef\x56\xae\xbd\x02\x6b\xcb\xf3\xfa\xa7\xc5\x32\x2b\x87\x82\xf5\xe2\xd8\x85\x6d\xb4\x88\xc5\x88\x49\x42\x62\x22\x0d\x10\xdf\xf4\x5c\x06\x0c\xc2\x08\x74\x1a\x10\xc3\x55\x13\xc7\xf7\x2a\x92\xa1\xd6\xb7\xd0\x75\xc3\xb6\x15\x5f\xa7\x00\xf7\xc8\xa1\x78\xdd\x7c\x9e\x3d\x6b\x17\x1e\xe7\x70\x0f\x57\x44\x39\x0c\x24\x13\xce\x9f\x45\x81\x0d\xdb\x98\x5b\x6e\xe8\xc4\xf2\x23\x97\xea\xb1\x0e\x9a\x07\xd5\x5d\xd0\xb3\x43\x2b\x8e\x88\x1f\x3a\x4c\x0f\x3d\xe6\x44\xa1\xc1\xf4\x28\xd2\xe3\x6d\x90\x46\x7a\xc6\x4f\x86\xc9\x64\xa1\x19\xe9\xcc\x0f\x3d\x58\xbe\x47\xac\xd8\x21\x26\x3c\x31\x23\x9b\xb9\x88\x26\xa6\xc7\xa0\x15\x51\x2f\x0c\x40\xf3\x37\xe1\x1d\x7c\x03\xff\x65\x50\x8b\x39\xb1\x47\x82\xd0\x88\x2c\xea\x30\x2f\x06\xe2\x0a\xad\xc8\xa1\x1e\x0b\x30\x0d\x2a\x04\xe5\x8a\x06\x0c\xd4\x2a\xe2\x84\x5e\x14\x0c\x7d\x5b\xa7\x8f\xfd\xb5\xd8\x51\xcb\x13\xe3\x1c\x26\xdd\x1b\x6f\x65\x85\xca\x60\x3a\xfe\xf9\x40\xd9\x0b\x7b\xdf\x40\x92\x4e\x18\x4f\x9d\xc7\x09\xa6\x63\x91\xf0\xba\x20\x18\xe5\x89\x73\xce\xb0\xc9\xa1\x7c\x26\x7a\xc0\xa7\x3c\xeb\x24\x1a\x60\x8c\xa6\xb8\x02\xd1\x5b\xc5\x93\xf7\x87\x4a\x64\x2f\xf3\xbb\xd9\xaa\xbd\x3d\x30\x9a\x9b\xfe\xd0\x5a\xdb\x08\x6c\x4b\x97\x82\xfd\xe3\x66\xbd\x5e\x8e\xfa\xb3\xc2\x7f\xb3\xc0\xdd\xb3\xdc\x59\x93\x16\x6e\x7b\x4a\x66\xf8\x0d\xdb\x3b\xc4\x9e\x4b\x7a\xad\xe0\x08\x42\xdc\xfe\xfc\xee\xf2\x61\xc5\xf8\xcd\x88\x7a\x6e\xcc\x74\x1f\xd0\x60\x45\xcc\x8c\x3d\x90\xdf\xba\x1e\x82\x74\xde\xaa\xe9\x7a\x58\x6d\x7e\x01\x30\xaa\x9b\x39\xa7\x48\xa5\x56\xff\xe1\x0d\x04\x62\xe4\x04\x06\x6c\xa0\xef\x52\x23\x20\x16\xf0\xb2\x10\x78\xc6\x36\xac\xdf\x6d\xf2\x94\xd1\xc3\x20\x0e\xf9\xb7\x47\x01\xd7\x08\x23\xc3\xa5\xae\x67\xb3\xc8\x57\xd2\x1d\x2e\xef\x2e\x40\x97\x78\xd3\x6e\x1f\xd1\x7f\x27\x06\x00\xed\xa7\x46\x28\x49\xff\x18\x36\x46\xc2\xe5\x7e\x9a\x61\xd3\x2d\xba\x2a\x25\x73\xe0\xe7\x22\xa7\xf4\xd8\x92\xb9\x3f\x53\x75\x0f\xb1\xb3\x7f\x3e\x56\xe5\x5a\x38\x56\x94\xf8\xae\xfe\x9f\x7d\xca\xc7\x84\x45\x3e\x66\xa2\x97\xfa\x67\xa8\x80\xc2\xd4\x54\xdc\x2e\xc9\x98\xfe\xd0\x44\x47\x19\xdf\xdc\xba\x1b\x6f\xfe\xf4\xd5\xe7\x78\x00\xbe\x2b\xe3\x98\x90\x30\x8c\x22\x4a\xfb\xf1\xd7\x5f\x8c\xe3\xe0\xd5\x75\xb2\x99\x87\x13\xa4\x0f\xdb\x1d\x4b\x1f\x58\x46\x1f\x7b\xe9\x4e\xd3\xb5\x9a\x7a\xa7\xe1\x3d\x81\x84\x0a\xb0\xcc\x46\x79\x62\x9a\xdd\xee\xad\x90\xb4\x2b\xe7\x55\x16\x10\xec\x3e\xb0\xfb\x68\xa8\x04\x53\x65\x6c\x28\x45\x4a\x6a\x8b\xff\x10\x05\x60\xab\x52\x69\x35\xd4\xe5\x83\x2c\x21\x25\x5c\xae\x58\x66\xe5\xde\x98\xe9\x20\x65\xb3\x8e\xb2\x15\x86\xfd\x0c\x99\x7b\x3d\x68\x59\x25\x45\xc1\x28\x6e\xdc\x03\x94\x64\x9c\xaf\xe0\xa1\x68\x52\xbc\xe2\x35\x52\x55\x40\x12\xab\xf0\xf3\x2a\x6d\x75\xef\xcf\xf1\xe8\xa0\xca\xb8\xdd\x57\x03\xa8\x8d\x62\x74\x06\xd2\x0d\xb6\xff\xa8\x0c\xe1\x9d\x88\x39\x28\x80\x19\x23\xae\x7e\x24\x45\xb9\xb7\x33\xf9\x80\xcc\xce\x0d\x3a\xb8\x80\x2f\x3c\xac\x47\x42\xca\xfb\x59\x70\x90\x45\x1b\xd0\xa2\x14\xe1\xab\xa4\xc6\xde\x8b\xa1\x03\xde\x78\x48\x1e\xd6\x55\xa9\xb5\x17\x40\x14\xcb\x0c\x1b\xb5\xca\x68\xa6\x74\xa0\x47\x4b\x0b\x02\x2c\xe2\xff\x71\xe2\x81\xc1\x8b\x49\xce\xe8\xda\x43\xec\x3a\x49\xbc\x4f\x00\x07\xb0\x15\x5d\xa7\xf6\x1c\x6e\xb6\x64\xe2\x49\xe3\xcc\xea\xfd\xe4\x98\xde\x69\x90\xcb\x60\xd1\x2e\x57\xe4\xc1\xbd\xc9\x12\xb6\x98\x81\x91\x49\x8b\x36\xf0\x2b\x46\x8a\x0d\xc6\xc9\xde\xb3\xde\xf3\x70\x6a\x34\xa2\x29\x07\x45\xb6\xdf\x25\xd6\x09\xfa\x9e\x06\x33\x96\xfa\x41\x02\xa8\x06\x56\x71\x2c\xa9\x53\x85\x36\x65\x13\xaa\xb8\xa4\xec\xb6\x7b\x14\x77\xe8\x01\x0f\x57\x2d\x31\x03\xf5\xa6\x57\x1f\x18\x93\xd3\xed\xae\x90\xa2\x5e\xb1\x44\x81\xe0\x99\x3c\x9a\xaf\x29\x98\x84\x7d\xd5\xe5\xa1\x2d\xc9\x67\xb4\xce\x6f\x3a\x63\xaa\xa2\xca\xf5\xa5\x45\xfe\x36\xbf\xff\xb0\x49\x8f\x58\x88\x59\xb5\x88\x6d\xfd\x90\xba\xbf\x8f\xe4\x49\x3f\x54\x0e\x6f\x57\xdc\xdd\xaf\x6c\xed\xc3\x08\x68\x9f\xea\xbe\x5b\x71\xae\xed\x04\xf8\xa9\xd9\x65\x03\x5a\xf5\x92\xa4\xec\xcf\xd3\x47\xe9\x4f\x45\xc3\x9e\xdd\x77\x75\x41\xd6\x75\x9e\x00\x6b\x2c\xef\xf9\xd8\xe3\xd2\x1e\xdf\xf8\xc0\x84\x8b\xe9\xa0\xe9\x73\xf9\xb1\x10\xf6\x07\xc1\x70\x58\x62\xbc\xf0\x38\x88\x6f\x2b\xf9\xa5\xd0\xcf\xc3\xbc\x0f\x2e\x75\x99\xc9\x68\xe4\x47\xae\xa3\xba\x73\xf6\x2b\x13\xfa\xe5\x1c\xe7\xc7\xb6\x57\xc7\x2d\xd5\x71\x2b\x68\xc4\x3a\xad\x88\x62\x68\xc8\x21\x8b\xa7\x57\x9b\xd9\x41\x68\xa3\xf7\x4c\x83\x87\x77\xaf\x05\xf6\x99\xc7\x7d\x15\x31\xbe\x90\x9f\xa5\x7b\x8e\xf6\x9c\x78\x88\xea\x87\x93\x57\xa7\x6c\x5e\x7f\xb3\x4e\x91\xc0\x5e\x7c\x94\x4d\x75\x77\xb9\xad\x1f\x60\x1d\xc9\x5b\xb9\x28\x5b\x2e\x79\xca\x45\xdf\x91\xf7\x5d\x45\x9e\xa2\xfc\x6f\x49\xed\x69\x5c\xdd\x35\x74\x43\x71\x3f\xee\x3f\x42\xdb\xcf\x5d\xe5\xf8\x1f\x9b\xcf\x90\x83\x6a\x64\x4c\xed\x30\x66\x3b\x2e\xb0\x15\x0f\xe4\xba\x17\x6c\x13\x50\xe7\x2a\x68\x3f\x6e\xa2\x5e\xf8\xc8\x8d\x22\xc9\x12\xd4\xe8\xc3\xc7\xb4\xfa\x07\xfc\x40\xca\xc1\xeb\x39\xa1\xad\x0d\x0f\xa9\xcf\xb1\x9f\x2b\xf0\x5c\xdf\x73\x8e\xcc\x6e\x30\xf1\xd6\xae\xd3\x6c\x2e\xa4\xc5\xf8\xf5\x08\x0d\x1e\xa1\xca\xa8\x7e\x1a\x47\xa8\x6d\x77\x28\xae\x80\xaa\x37\xb8\xb0\x68\xcb\xfb\xd1\xc3\x77\x58\xa2\x77\x8d\x8b\xc3\xa9\xcf\xdf\x26\x67\xe1\xba\x3a\x5c\x78\xf6\x0c\xf7\xc0\x83\x67\x9a\x81\xdf\x01\x93\xdc\x5c\xbd\x65\x4b\x72\xbf\x2f\xa0\xed\x00\x10\x10\x7d\x98\x02\x8a\x58\x24\x57\x44\x16\xcd\x85\x51\xb7\xed\xfc\x61\xf8\xd0\x17\x21\x0f\x6e\x5b\x09\x1a\x28\xd9\xb5\x57\xa9\xe5\xad\x3e\x12\x57\x57\x8c\xbb\x96\xea\x62\x05\xbc\xcc\xf2\xb8\x16\x1e\x92\x02\xed\x90\x83\xaa\x23\xe0\xb7\xca\x64\x95\xdf\x8f\xbb\x71\x38\xef\xd8\x5f\x03\x0f\x0c\xdf\xc6\xea\xc0\xad\xdb\x54\xc9\x41\x3f\xe0\x06\x74\x61\xec\x50\x48\xef\x16\xd6\x36\x13\xbf\x49\x90\x69\xd0\x75\x97\x9b\xde\x58\x03\x7d\x6e\x3a\x4a\xc4\x17\x77\x9f\xfc\xf9\x80\x90\x03\x79\xad\x4b\x44\xae\x43\x7d\x0b\x50\x9b\x4d\x77\xda\x1a\x74\xa8\x61\x93\x91\xff\xf2\x7d\x82\xed\x51\x47\xa9\x27\x5b\xd2\xca\x45\xbe\x37\x8c\x6d\x87\x88\x18\xa9\xea\xab\x94\x36\x29\x8f\x03\xc6\x71\x2f\x35\xed\xdb\x50\x61\x8b\x9a\x78\x6a\x36\xa2\x08\x91\x86\x8d\xcc\x05\x34\x98\xe7\x10\x5d\x93\x1c\x7b\xcd\x6e\xd6\xad\xea\x2b\x07\x76\xf1\x56\x49\x6e\xb6\x4d\x83\xbf\xf6\x12\xe1\x43\x6a\x4d\x76\xc8\xb5\x01\x06\x09\x6e\x06\x64\xe7\xfc\xba\x65\x24\xef\x8b\xca\x36\x03\x28\x34\x5e\xfd\x90\x17\x83\x00\xac\x01\xdd\x20\xe1\x27\x4b\xb6\x85\xdb\x19\x96\x3b\x91\x9d\xd5\xd3\xac\xf5\x5e\xfd\xf5\x94\x15\x76\x6f\x77\x7b\x6f\x76\x77\x4a\xf5\x5f\x7e\xd1\x67\x98\xbc\x8b\x16\xe5\xaf\x33\x0d\xff\x05\xff\x6b\xea\xbf\xfe\x5a\x05\x05\xbc\xcf\x7b\x0b\xd0\x66\x29\xdb\xa7\x94\x75\xf5\xf9\xc9\xc4\x2f\x5a\x73\x9e\x0c\x25\x7c\x80\x61\x7f\x5c\x13\xbd\x8e\xff\x55\x42\x06\xea\xfb\x58\x45\x41\x37\xaa\x71\x7a\xdb\x19\x68\x56\xb7\x83\x80\xf6\xcb\xaf\xfd\x22\xa8\x65\xcb\xe3\xed\xf2\x96\xed\x2b\x63\x0b\x0e\xb3\x5e\x45\x11\x79\x9e\xd2\xb2\x85\x89\x93\x9e\x5a\xf9\xed\x24\x5a\x7e\x59\xab\x19\xbe\x3e\x58\x1b\xae\x8a\x7a\x52\x11\x13\xd9\x8e\x1f\xd8\x41\xe0\x3b\xc4\xa5\xbe\x1b\x7a\x86\x15\xb8\x81\x1e\xfa\xbe\x61\x50\x6a\x85\xb6\x6b\x7b\x91\x6e\x52\x3b\xb6\x8d\x88\xb2\x38\xf4\xa8\x65\x5a\x66\xab\xf4\xb7\x1a\xcd\xa4\x6c\x44\xa7\x91\xa2\x66\x38\xa6\x65\x38\xae\xe9\x19\x75\xa9\xe4\xf7\xb9\xa8\x76\xff\x3e\xff\x6b\x5a\x6c\xd5\xbd\xdf\x8b\x66\x39\x05\x4e\x25\xd7\xaa\xc2\xfe\xc9\x41\xb5\xdd\x3b\x74\x8d\x95\x9c\x7f\xf7\x75\xad\xbf\xdb\xa4\x74\x39\xde\xfe\xe6\xa1\x2e\xc1\xad\x7a\xfb\x13\xb7\xbd\x8f\x84\x4e\x3a\x83\x0c\x76\x43\xdf\x1d\x4d\x33\x14\x2e\x34\x29\xba\xa3\x7d\x49\x26\x9a\xc8\x82\x88\xd9\xa4\x55\xb4\xf4\x5d\xd5\xf4\x41\x06\x5d\x8e\xd7\x68\xdf\xbf\x7e\xeb\x3f\x58\x9e\x71\x3d\x54\x9d\xb2\xe6\x82\x13\x2b\xa8\x6d\x59\x6c\xe9\x29\x5b\xad\xcb\xfb\xaa\xe6\x27\xa8\x6b\x11\xc1\x12\x2f\x21\xab\x9a\x80\xd0\xed\xee\x5e\x53\x73\x75\x64\x15\xdf\xad\x66\x67\x6f\x93\x38\x3e\x7e\xc6\xaf\x88\x4d\xc3\xb1\xeb\x7e\xbc\xf5\x93\x57\xe3\x19\xab\xbc\x5e\x58\xab\x6a\x2f\xc8\xa2\xf4\x4a\x5e\x40\x70\x9c\xcc\xb5\x45\x48\x96\xd8\xbf\x6e\x31\xd3\x16\x22\x12\x50\x16\x85\x11\xe6\xee\x42\x56\x52\x61\x95\x86\x41\xd2\x7b\xa9\x6e\xae\xaa\xe1\x9a\xcc\xd2\x05\x96\x87\xe2\x25\x75\xaa\x9f\xc4\x58\xa8\x03\x83\x36\xbf\x10\xd6\x44\x05\xc5\x67\x76\x8f\x4d\x4c\x96\xf7\xf3\x23\xa4\x29\xcb\x65\xec\x7c\x6f\x62\x88\xe7\x6a\x5a\xa8\x02\xae\x77\xe7\x4b\x72\xf5\x13\xae\x84\x61\xb1\x09\xee\x22\x59\x5e\x0c\x9c\xf6\xd6\x04\x22\x0d\xea\xad\x60\x2e\xf0\xe0\x7b\x52\x5c\x0f\x0a\xa6\xc7\xe9\xf4\x71\x50\xeb\x96\x2d\x50\x8f\x3b\xc1\x1e\x2d\x4a\x7a\x8e\xfd\x9e\x47\xff\x71\xd5\xc7\xe6\xff\x7d\x90\x05\x88\x76\x34\xc7\x60\xa4\xc8\xd2\x43\x8b\x4f\x11\xfa\x49\xe1\xba\xe2\x21\x57\x5e\x3f\x95\xe4\xea\xd3\x2a\x29\x78\x22\xf7\xd6\x0b\xd5\x85\xe2\x27\x91\xfc\xfe\x29\xcd\xca\x4f\x9c\xef\x6e\xbd\x87\x9a\xdf\xa7\x32\xcb\x3e\x2d\xd1\x06\xdc\xfa\x11\x8c\x09\x00\xb0\x48\xa2\x4f\xa0\xac\x8a\xb7\xb2\xdb\xce\x44\x7f\xdf\x76\x66\xe2\x63\xae\x22\x77\x9e\x7e\x4e\xb3\xdb\xb4\xbb\x9a\x7a\xf4\x5e\x18\x8a\x4d\xd5\xda\xea\x53\xa7\x68\x38\xbe\xc1\x97\x56\xbb\x01\xb6\x7e\x44\x57\xc0\xa7\x78\xbb\xee\xf3\x69\xc5\x79\x3f\xfd\xef\x26\x2b\x09\x7c\x1e\x31\x46\x3b\xe0\xe6\x6c\xbd\x24\x11\xc3\xda\xd2\x9f\x36\x98\x6f\xcb\x8d\x40\xda\xc9\x7e\x4c\x93\xce\xc3\xf2\xee\x13\xaf\xaa\x36\x34\x74\x6b\x59\x92\x47\x0e\xd7\xe4\xc4\xfe\xed\xec\x14\xc8\x88\x72\x4f\x87\xa0\x27\xe1\x74\x41\xec\xab\xa5\x39\xa7\x48\xe5\xae\x12\x2a\x08\x54\x3b\xe9\xc1\xf6\xc9\xd6\xd0\xda\x09\x88\xec\x6a\xd7\x5f\xb5\x16\xa2\x55\x5f\xf0\x4f\x7e\xc0\x38\x9e\x63\x6b\x24\x30\xb7\xd2\x7f\x6e\xa8\x9e\xe2\xa4\x83\x35\x4c\x33\xc2\x37\xb5\xf5\x74\x85\x95\x23\x26\x51\x39\x85\x95\xae\xeb\xa7\x8f\x6f\xc9\x4a\x2c\x60\x47\xbc\x6a\x45\x72\x0b\x30\xca\x7f\x67\x9a\xea\xe4\x18\xff\xfe\x5b\x0d\x2c\xf8\xa6\x46\x2c\xa8\xbe\xb7\xfd\x52\x00\xfa\xc7\xc7\xb1\x15\x2f\x5f\x96\x0e\xc5\x61\xee\x93\x2e\xd0\x3f\x15\x4d\x8a\x32\x49\xa3\xb2\x4a\x1d\xd8\xbf\x8a\x64\xa7\xea\x34\xa2\x43\x94\x3c\xe4\x63\x0c\x57\x8b\xc2\x3d\xd0\x0c\x25\xd2\x4e\xc1\x5d\xcb\x27\x58\x2f\x53\x75\x3d\x08\x00\x45\x60\x8d\x54\x47\xcb\x12\x4d\x67\x35\xac\xab\x6f\xf3\xaf\xb7\xe4\xfd\x24\x3f\xf5\x92\x7c\x66\x66\x58\x37\xc8\xcd\x97\xeb\xba\xa3\x10\x2f\x26\x32\x93\x71\x5f\x49\x21\xf3\x3a\xdb\x85\xb1\x27\x28\x5c\x43\x4a\x44\x4f\xf2\xd5\xa8\x26\x31\x90\x2c\x35\x7e\x6f\x51\xde\xed\x31\x43\x02\x72\xe1\xee\xe0\xc0\x39\xfe\x75\xe5\x26\x16\x75\x7b\xd4\x76\xd0\x2f\x26\xdc\x97\x0d\x42\xd6\x6d\xe3\x33\x9e\x31\x32\x90\x2f\x32\x38\xfe\x76\x21\xf6\x61\x3d\xbb\x4a\xd6\x7c\x88\x9f\xb7\xc7\xfa\x1e\xb6\xbc\xbb\xf9\xc9\x3b\x2d\xee\xa9\x19\xa5\x55\xfe\x54\x9e\x65\xf1\xe8\xc1\x02\x61\xdd\x67\xa8\x3c\x86\xbe\x9c\xee\x4d\xe0\x9d\x7e\xd9\x53\xf4\xf1\x3d\x3f\x6a\xb7\x28\xdb\x81\xfe\x76\x89\x62\x85\xa1\x48\xbf\x83\x40\xe7\x8b\xc1\x53\x37\x89\x21\xb7\x4e\x1b\x68\x12\xbd\x47\xad\xbc\xdb\x97\x1f\xaa\xe0\x2a\xba\x6d\xd9\x26\x92\x03\x68\x7e\x8f\x69\xf3\x44\x84\x1e\x17\x22\xee\x96\x37\x30\x97\xe1\x7c\x0a\x48\x3d\x86\xd5\xbe\x33\xc9\x21\xb6\x87\x7c\x1a\x4b\xad\x80\xe3\xe3\xbc\xc7\x62\xf3\xac\x1c\x75\x3b\x66\x5b\xef\x4c\x4e\x06\xf8\xe7\xb6\x10\x48\x22\x82\x39\xc7\x6a\x92\x80\xb8\x61\xc3\x08\x24\x30\xd6\x30\x56\x9c\x37\xb7\xe5\xed\x47\x42\x16\xf1\x86\xca\x39\xe8\xfd\xf2\xba\xa8\xae\x84\x13\x55\xe5\x66\x8e\x51\x5b\xa4\x47\xef\xb5\x31\x3b\x7a\xdb\xc8\x4c\xae\x72\xb2\xda\x36\x32\x49\xc7\x6c\x62\x37\x2b\x50\x92\x3a\x06\x58\xb6\xde\x7a\x94\xad\xb9\x92\xb2\xad\x58\xe7\x5c\x25\xd9\x9e\x1c\x80\xee\x99\x7d\x93\x6e\x3f\x1d\xd9\x00\x44\x87\x28\x4e\x8c\xe8\x9b\x6b\xef\xb8\x8b\x91\x3f\x55\x2a\xc5\x56\x45\x90\x01\x4d\x1b\xd0\xf2\x96\xd9\xd5\x15\x6e\x95\xf8\xa6\x35\x1e\xc7\xd1\x8c\x63\x80\x7b\xca\x2a\xc8\xb9\xd7\x2d\xdf\xa4\xe8\xa9\x4b\x65\xcb\x69\xfe\x79\x21\xcb\xa5\x17\xf8\x4b\xb8\x49\x96\xe5\x29\xd6\x51\x27\x37\xe4\x23\x87\xb9\x7a\xad\xb7\x19\xf0\x37\xdf\xec\xe7\xb8\x1a\x45\x85\x32\x27\x0e\xc6\xd3\xff\x37\x45\x09\x27\x45\x80\x50\x29\x67\x0c\xdd\x90\x9c\x66\xe1\xf0\x90\x54\x0a\x26\xe1\x09\x3c\x29\x4a\xb6\xc6\xdb\x5b\x8e\xaf\x13\x8e\x82\x13\x51\x8d\xfc\x44\x8b\x37\xa9\xf0\xd3\xb7\x51\xf6\xee\x2e\x5a\x6e\x0a\xc4\x08\x1f\x02\x71\x3f\xd7\x2e\xaf\x59\xd3\x3e\x82\xb7\x72\x0a\x33\x5e\x57\x9a\xc4\x18\xb3\xe3\x68\x75\x62\x07\x4e\x21\x9b\x3f\x55\xd1\x0d\x8e\x6e\x35\x9d\xa1\x68\x9b\x6a\x68\xc6\x0a\xf4\x1a\xe7\x0c\x64\x76\x2a\xc2\xfc\x32\x5e\x79\xda\x6d\xc6\xe4\x75\xfb\x88\x56\x26\x57\xd7\xb8\xdb\xd9\xba\x1f\xfb\xbf\x71\x5a\xc5\xb2\xe8\x1a\xae\xfb\x55\xbd\xc2\x97\xdf\x6a\xbf\xf1\x43\x3b\xe7\x6f\xfc\xd7\x7f\x69\xff\x9a\x69\x1c\x25\xed\x77\xe0\xa9\x40\xce\xd6\xa7\x12\xb8\x66\x04\xed\x5f\xff\x52\x8a\xd0\xa1\xb7\xa3\x7c\xd8\x66\xcb\xca\xe1\x71\x82\x17\xd1\xd8\xeb\x00\xcf\x00\x1f\xb7\x69\x9d\x14\x31\xda\xde\xa9\x37\xa2\x77\xf5\xf2\x7e\xc6\xbd\xbc\x4a\xa3\x2d\x4c\xee\xe7\xfb\x33\xd7\xfe\x24\xaa\x55\xf7\x94\x1f\x3f\x7f\x7b\xf6\x12\x54\x64\x94\xa5\xff\x84\xff\xd2\x6f\xcf\xc4\x00\xfc\xc9\x62\x38\x4d\x82\x92\x30\xb4\xa9\x1b\xeb\x04\x2f\x34\x3d\xf8\xdf\x88\xea\x4c\xf7\x08\x58\xc1\x7a\xe8\xd8\x2e\x0d\x75\x6c\x1d\xef\xbb\x01\x75\xa2\x28\xd4\x29\x35\x89\xe1\x32\xcf\x09\x9c\xf0\x4c\x3f\xab\x2e\x93\x3e\x0a\xb7\x2d\x2f\xeb\xb5\x9b\x51\x1e\x58\x4e\xf3\x9f\x7d\xaa\xb7\xe2\xb2\x1f\x58\x26\xb1\x5d\xd3\xd3\x2d\xec\xea\x11\x38\x2c\xf4\x8c\xc8\xb4\x6c\x43\x77\x6c\x4a\x88\x6b\x39\x9e\x17\xe9\xae\x69\x07\x8a\xf1\xfe\x99\xdd\x7f\xc4\x42\xe7\x07\xd6\xc1\x3a\xf4\x8f\xd2\x05\x8c\xdc\xb5\x5b\x8c\x4c\x89\xad\x50\xb2\x3e\x27\x93\xf1\x16\xf8\x0c\x6f\x84\x6d\xdb\x77\x7d\x27\x0e\x22\xcf\x8c\x23\x33\x0c\x6c\x37\xf0\x75\x16\x3b\x06\xf5\xa9\xa9\xfb\x61\x48\x88\x4d\xad\x98\x46\xb1\x1e\x39\x1e\xb5\x7d\xdb\x23\x11\x31\x99\x20\x87\x7a\x7b\xe2\xde\x3b\x81\xbd\x44\x78\x2d\xb8\x33\x3c\xb6\xa0\x63\xdc\x88\x84\x4f\xc9\xf6\x39\xbb\xe2\xda\x94\x38\x5d\xf2\xcc\x54\x37\x56\x6a\xb3\x0c\x59\xae\xbe\xa7\x02\x3e\xe7\x64\xe2\xc3\x2a\x0c\x7c\x26\x6f\x5d\x8a\xca\x95\x22\x83\x08\xfa\x9a\x3f\x73\xd9\x23\xbf\x9b\xbf\x18\x0f\x0d\x57\x0f\xc9\xa8\x1e\xc1\xee\xca\xbf\xb0\x7d\xf2\x84\xb6\x2c\x0f\x35\x8a\x60\xf2\x7d\x4a\xef\x58\x40\x16\x96\xc5\x6c\xd3\x02\x12\x88\x82\xd0\xf2\xa8\x6e\xfb\x21\x45\x87\x57\x48\x6d\x62\xf2\x26\xee\x06\x50\x88\x69\xea\xb6\x63\xeb\x0e\x1c\xc5\xc8\x8c\x6d\xd7\x07\x36\x12\x07\x40\x39\xfe\xc9\xb6\xc5\xf1\x99\xf5\x44\x2c\x3e\xfc\xf8\x18\xdb\x77\xc4\x9d\x66\x77\x47\x9a\x29\x92\x9c\xe2\x3b\x46\xca\xe3\x64\xbf\x0d\x36\x22\xdf\x72\xf1\x34\xad\x25\xb4\x97\xd7\x0c\x25\xe8\xb7\x13\x92\xca\x27\x39\x74\x2b\x10\x78\x95\x9e\x5d\x30\x34\xfd\xe9\x87\x59\x89\x1d\xbb\x51\xe4\x03\xb7\x00\xee\xeb\x92\xc0\x0c\x74\xcf\x33\x7c\xe6\x9b\xb1\x89\xa5\xe1\x62\x74\xa0\xda\x8e\x45\x3c\x78\xe6\x05\x1e\x0b\xfd\x88\x11\xcb\x0a\xac\xd0\x34\x9c\x93\x43\x32\x00\x27\x2e\x41\x8c\x28\x57\xa2\xf8\xad\x7b\x57\x10\xa2\xf0\x0b\x69\xa0\xc7\x8c\xea\x01\x35\x5c\x27\x8c\x69\x6c\x59\x51\xa4\x33\x46\x6d\x8f\x81\xec\xf0\x03\xcb\xc7\x7a\x75\x5e\xe8\x45\x86\x49\x6c\x46\x02\xb5\x47\xeb\x5e\x29\x84\xd3\x1a\x82\x09\xd8\xdb\xf5\x0b\xc6\xf3\x10\xab\x9f\xd4\xa8\xaa\xbe\xf6\x1b\x83\x48\xbd\x66\x77\xd3\xb5\x1f\x3e\x78\x55\xf8\x99\x5f\x0c\x16\x49\x1d\x1f\x4b\xe2\x58\xf4\x07\x91\x02\x9c\x15\x8f\x24\x4e\xbf\xfe\x79\xde\x7f\x14\x7d\xec\x78\x4c\xb4\x4b\xac\x4d\x58\x30\xf7\x9d\xd7\x96\x14\xb7\x4e\x55\x4a\xee\x65\xb5\xcd\x33\x94\xf1\x4d\xf3\xa8\x57\x6a\xeb\x83\xf3\xf4\x42\xe9\xa3\xc6\xdd\x04\x15\xf5\x57\x0d\xe3\x64\x5f\xb4\xbe\x20\x95\x41\x45\x17\x23\x56\xf1\xaa\xab\x95\x14\x2f\x2e\xdf\x95\x5b\x8c\xbe\x73\xdd\x62\x95\xf5\xfd\xc6\x61\xc9\x18\x55\xe4\xdf\x79\xfa\x3f\xd8\xce\xad\xbd\xca\x9c\xdc\x2a\x2b\x54\xfb\xbd\xf5\x26\x3e\xd6\x5a\x1e\xc1\x2f\x55\x45\x6b\xde\x59\xb3\x9a\xf6\xd8\xbf\xe8\x4a\xd7\x94\x51\x01\x37\x49\x01\x03\xf5\x83\x29\x7f\x9c\x02\xab\x52\xe5\x1e\x2c\xf4\x90\xb5\xe5\x32\xd0\xcc\xf9\xdb\x19\xfe\xe7\x24\x4e\x52\xb2\xc4\x32\x0e\x27\xaa\xbf\x03\x63\xc2\x8a\x52\xab\x7f\x14\x9f\xcf\x95\xcb\x33\x6e\x92\x17\xa2\x7a\x1f\x98\xda\x99\xa8\x01\xdf\x28\x97\xb2\x37\x60\xc1\x73\xba\x04\x4f\x95\x0d\xe7\x6c\x60\xf4\xd2\x38\x17\x2a\xb2\x54\x58\xab\xe5\xe1\xc8\xbc\xc2\xc4\x0d\x7c\x89\xb7\x58\xd2\x1c\x17\xcd\x9f\xe6\x53\x28\x68\x0b\x97\x5d\xba\xee\x41\xe5\x10\x61\xff\xb3\x1d\xe3\x0b\x88\x43\xbc\x55\xbd\xb3\x10\x85\x88\x94\x3e\xec\xc9\x58\xee\x7d\xb1\xfc\xc0\x73\xd3\xb4\x13\xc3\xee\x86\x22\x65\x81\x11\xda\x4b\x51\xe8\x1b\x9f\x42\x4d\xb8\xe6\x98\xbf\x3d\x95\x10\x26\xef\x92\x34\x37\xc0\x92\x68\xef\xd3\xd8\x96\x20\xb5\x80\x7e\xfe\x92\x4b\x6c\x78\xf2\x2d\x77\x44\x45\x11\xf2\x9f\x2a\x30\x4e\x9a\x14\x63\xc8\x14\x38\x80\x81\x0e\x40\xee\x51\x2c\x01\xa5\x09\x5d\xcd\x83\x7b\x76\xa9\xcb\x84\x07\x37\xaa\xcb\x85\x9b\x0b\xd5\xfa\xa2\xb0\xd8\xea\xf0\xb1\x0f\xb7\x3a\x08\x1b\xed\xa4\x54\xb5\x0b\x24\x56\x1a\xef\x5d\x33\xaf\x41\xbe\x1f\xa3\x9b\x5e\xb6\xfc\xe0\x05\x77\xdd\xe2\xdb\x45\xcd\x5b\xad\x00\x6a\xfc\xe0\x3b\xdb\x77\xeb\x18\x2f\x37\x9d\xe4\xab\x1b\x73\xc2\x07\xa8\xae\xcb\x77\x53\x37\x7e\x37\xf9\x2c\x5e\xde\x9d\xbf\x9d\x0e\x92\x60\x0a\x8a\xf4\xdb\x0d\x4d\x42\x0f\x23\xae\x20\x8c\x22\xd7\x01\x0b\xcd\x73\x09\x73\x5c\xdd\xb4\xc1\xec\x01\xab\x5d\x77\xc0\xc4\xd1\x8d\xc0\xf3\x4c\x1b\xcc\xa0\xc0\x8c\xcc\xd0\x8e\x0d\x66\x86\x1e\x01\x53\x9f\xd9\x68\xed\x07\xac\xce\x0d\x91\xa1\x2d\x82\x6b\xf4\xd2\x1d\xb0\x94\xfd\xa8\x8e\x68\x05\xb9\xa9\x58\x37\xe2\x04\x19\x3b\xfa\x74\x57\xe2\xde\x06\x84\xdc\x26\xac\xbf\x6c\x31\x4e\x78\x79\x54\x88\x4e\x40\xd2\xff\x07\x1b\x85\x5a\x7b\x8c\x4d\x01\x00")
 
 func thorYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -12,22 +12,41 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/logdb"
 )
 
 type Events struct {
-	db *logdb.LogDB
+	chain *chain.Chain
+	db    *logdb.LogDB
 }
 
-func New(db *logdb.LogDB) *Events {
+func New(chain *chain.Chain, db *logdb.LogDB) *Events {
 	return &Events{
+		chain,
 		db,
 	}
 }
 
+// query queries events, with time range converted into block number range.
+func (e *Events) query(ctx context.Context, filter *logdb.EventFilter) ([]*logdb.Event, error) {
+	var err error
+	if filter.Range, err = utils.ConvertRange(e.chain, filter.Range); err != nil {
+		return nil, err
+	}
+	events, err := e.db.FilterEvents(ctx, filter)
+	if err != nil {
+		if logdb.IsPruned(err) {
+			return nil, utils.BadRequest(err)
+		}
+		return nil, err
+	}
+	return events, nil
+}
+
 //Filter query events with option
 func (e *Events) filter(ctx context.Context, ef *EventFilter) ([]*FilteredEvent, error) {
-	events, err := e.db.FilterEvents(ctx, convertEventFilter(ef))
+	events, err := e.query(ctx, convertEventFilter(ef))
 	if err != nil {
 		return nil, err
 	}
@@ -43,6 +62,9 @@ func (e *Events) handleFilter(w http.ResponseWriter, req *http.Request) error {
 	if err := utils.ParseJSON(req.Body, &filter); err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "body"))
 	}
+	if format := utils.ExportFormat(req); format != "" {
+		return e.export(req.Context(), w, &filter, format)
+	}
 	fes, err := e.filter(req.Context(), &filter)
 	if err != nil {
 		return err
@@ -50,6 +72,28 @@ func (e *Events) handleFilter(w http.ResponseWriter, req *http.Request) error {
 	return utils.WriteJSON(w, fes)
 }
 
+// export streams matched events in the format, page by page, each page after the last event of
+// the previous one.
+func (e *Events) export(ctx context.Context, w http.ResponseWriter, ef *EventFilter, format string) error {
+	filter := convertEventFilter(ef)
+	return utils.Export(w, format, csvHeader, filter.Options, func(offset, limit uint64) ([]utils.ExportRecord, error) {
+		paged := *filter
+		paged.Options = &logdb.Options{Offset: offset, Limit: limit}
+		events, err := e.query(ctx, &paged)
+		if err != nil {
+			return nil, err
+		}
+		records := make([]utils.ExportRecord, len(events))
+		for i, event := range events {
+			records[i] = convertEvent(event)
+		}
+		if n := len(events); n > 0 {
+			filter.After = &logdb.Cursor{BlockNumber: events[n-1].BlockNumber, Index: events[n-1].Index}
+		}
+		return records, nil
+	})
+}
+
 func (e *Events) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
 
@@ -7,17 +7,27 @@ package events_test
 
 import (
 	"bytes"
+	"encoding/binary"
+	"encoding/csv"
 	"encoding/json"
 	"io/ioutil"
+	"math"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"github.com/vechain/thor/api/events"
 	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
 	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
 )
@@ -31,6 +41,93 @@ func TestEvents(t *testing.T) {
 	getEvents(t)
 }
 
+func TestEventsTimeRange(t *testing.T) {
+	ts0 := initTimedEventServer(t)
+	defer ts.Close()
+
+	tests := []struct {
+		from, to uint64
+		want     int
+	}{
+		{ts0, ts0 + 100, 11},
+		{ts0 + 15, ts0 + 45, 3},
+		{ts0 + 20, ts0 + 20, 1},
+		{ts0 + 11, ts0 + 19, 0},
+		{ts0 + 85, math.MaxUint64, 2},
+		{ts0 + 101, math.MaxUint64, 0},
+		{0, ts0 - 1, 0},
+	}
+	for _, tt := range tests {
+		filter := &events.EventFilter{
+			Range: &logdb.Range{Unit: logdb.Time, From: tt.from, To: tt.to},
+		}
+		var logs []*events.FilteredEvent
+		if err := json.Unmarshal(httpPost(t, ts.URL+"/logs/event", filter), &logs); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tt.want, len(logs), "range [%v, %v]", tt.from, tt.to)
+		for _, log := range logs {
+			assert.True(t, log.Meta.BlockTimestamp >= tt.from && log.Meta.BlockTimestamp <= tt.to)
+		}
+	}
+}
+
+func TestExportEvents(t *testing.T) {
+	count := initExportServer(t)
+	defer ts.Close()
+
+	export := func(filter *events.EventFilter, accept string) *http.Response {
+		data, _ := json.Marshal(filter)
+		req, _ := http.NewRequest("POST", ts.URL+"/logs/event", bytes.NewReader(data))
+		req.Header.Set("Accept", accept)
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+	seq := func(data string) int {
+		b, err := hexutil.Decode(data)
+		assert.Nil(t, err)
+		return int(binary.BigEndian.Uint32(b))
+	}
+
+	// across pages
+	res := export(&events.EventFilter{Options: &logdb.Options{Offset: 10, Limit: math.MaxUint64}}, "application/x-ndjson")
+	body, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	assert.Equal(t, "application/x-ndjson", res.Header.Get("Content-Type"))
+	assert.Equal(t, "", res.Trailer.Get("X-Thor-Export-Error"))
+	lines := strings.Split(strings.TrimSpace(string(body)), "\n")
+	assert.Equal(t, count-10, len(lines))
+	for i, line := range lines {
+		var ev events.FilteredEvent
+		assert.Nil(t, json.Unmarshal([]byte(line), &ev))
+		if !assert.Equal(t, i+10, seq(ev.Data)) {
+			break
+		}
+	}
+
+	// in desc order, with limit
+	res = export(&events.EventFilter{Options: &logdb.Options{Offset: 5, Limit: 1500}, Order: logdb.DESC}, "text/csv")
+	records, err := csv.NewReader(res.Body).ReadAll()
+	res.Body.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, 1501, len(records), "header and rows")
+	for i, record := range records[1:] {
+		if !assert.Equal(t, count-6-i, seq(record[11])) {
+			break
+		}
+	}
+
+	// matching none
+	res = export(&events.EventFilter{Range: &logdb.Range{Unit: logdb.Block, From: 1000, To: 2000}}, "application/x-ndjson")
+	body, _ = ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "", string(body))
+}
+
 func getEvents(t *testing.T) {
 	t0 := thor.BytesToBytes32([]byte("topic0"))
 	t1 := thor.BytesToBytes32([]byte("topic1"))
@@ -91,8 +188,85 @@ func initEventServer(t *testing.T) {
 	}
 
 	router := mux.NewRouter()
-	events.New(db).Mount(router, "/logs/event")
+	events.New(nil, db).Mount(router, "/logs/event")
+	ts = httptest.NewServer(router)
+}
+
+// initExportServer serves events more than a page of export, with sequence numbers as data.
+// Count of events returned.
+func initExportServer(t *testing.T) int {
+	db, err := logdb.NewMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+	count := 0
+	header := new(block.Builder).Build().Header()
+	for i := 0; i < 100; i++ {
+		var evs tx.Events
+		for j := 0; j < 25; j++ {
+			data := make([]byte, 4)
+			binary.BigEndian.PutUint32(data, uint32(count))
+			evs = append(evs, &tx.Event{Address: contractAddr, Data: data})
+			count++
+		}
+		if err := db.Prepare(header).ForTransaction(thor.Bytes32{}, thor.Address{}).
+			Insert(evs, nil).Commit(); err != nil {
+			t.Fatal(err)
+		}
+		header = new(block.Builder).ParentID(header.ID()).Build().Header()
+	}
+
+	router := mux.NewRouter()
+	events.New(nil, db).Mount(router, "/logs/event")
+	ts = httptest.NewServer(router)
+	return count
+}
+
+// initTimedEventServer serves events of a chain, with one event per block, blocks packed every 10 seconds.
+// Timestamp of the genesis block returned.
+func initTimedEventServer(t *testing.T) uint64 {
+	kv, _ := lvldb.NewMem()
+	b0, _, err := genesis.NewDevnet().Build(state.NewCreator(kv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch, err := chain.New(kv, b0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := logdb.NewMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+	txEv := &tx.Event{Address: contractAddr}
+	insert := func(header *block.Header) {
+		if err := db.Prepare(header).ForTransaction(thor.Bytes32{}, thor.Address{}).
+			Insert(tx.Events{txEv}, nil).Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	insert(b0.Header())
+	parent := b0.Header()
+	for i := 0; i < 10; i++ {
+		b := new(block.Builder).
+			ParentID(parent.ID()).
+			TotalScore(parent.TotalScore() + 1).
+			Timestamp(parent.Timestamp() + 10).
+			Build()
+		sig, _ := crypto.Sign(b.Header().SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+		b = b.WithSignature(sig)
+		if _, err := ch.AddBlock(b, nil); err != nil {
+			t.Fatal(err)
+		}
+		insert(b.Header())
+		parent = b.Header()
+	}
+
+	router := mux.NewRouter()
+	events.New(ch, db).Mount(router, "/logs/event")
 	ts = httptest.NewServer(router)
+	return b0.Header().Timestamp()
 }
 
 func httpPost(t *testing.T, url string, obj interface{}) []byte {
@@ -7,6 +7,7 @@ package events
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/vechain/thor/api/transactions"
@@ -52,6 +53,29 @@ func convertEvent(event *logdb.Event) *FilteredEvent {
 	return &fe
 }
 
+// csvHeader header of exported events in CSV.
+var csvHeader = []string{"blockID", "blockNumber", "blockTimestamp", "txID", "txOrigin", "address", "topic0", "topic1", "topic2", "topic3", "topic4", "data"}
+
+// CSVRecord implements utils.ExportRecord.
+func (e *FilteredEvent) CSVRecord() []string {
+	record := []string{
+		e.Meta.BlockID.String(),
+		strconv.FormatUint(uint64(e.Meta.BlockNumber), 10),
+		strconv.FormatUint(e.Meta.BlockTimestamp, 10),
+		e.Meta.TxID.String(),
+		e.Meta.TxOrigin.String(),
+		e.Address.String(),
+	}
+	for i := 0; i < 5; i++ {
+		if i < len(e.Topics) {
+			record = append(record, e.Topics[i].String())
+		} else {
+			record = append(record, "")
+		}
+	}
+	return append(record, e.Data)
+}
+
 func (e *FilteredEvent) String() string {
 	return fmt.Sprintf(`
 		Event(
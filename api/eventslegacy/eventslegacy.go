@@ -12,16 +12,19 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/logdb"
 	"github.com/vechain/thor/thor"
 )
 
 type EventsLegacy struct {
-	db *logdb.LogDB
+	chain *chain.Chain
+	db    *logdb.LogDB
 }
 
-func New(db *logdb.LogDB) *EventsLegacy {
+func New(chain *chain.Chain, db *logdb.LogDB) *EventsLegacy {
 	return &EventsLegacy{
+		chain,
 		db,
 	}
 }
@@ -29,8 +32,15 @@ func New(db *logdb.LogDB) *EventsLegacy {
 //Filter query events with option
 func (e *EventsLegacy) filter(ctx context.Context, filter *FilterLegacy) ([]*FilteredEvent, error) {
 	f := convertFilter(filter)
+	var err error
+	if f.Range, err = utils.ConvertRange(e.chain, f.Range); err != nil {
+		return nil, err
+	}
 	events, err := e.db.FilterEvents(ctx, f)
 	if err != nil {
+		if logdb.IsPruned(err) {
+			return nil, utils.BadRequest(err)
+		}
 		return nil, err
 	}
 	fes := make([]*FilteredEvent, len(events))
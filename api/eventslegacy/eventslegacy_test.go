@@ -88,7 +88,7 @@ func initEventServer(t *testing.T) {
 	}
 
 	router := mux.NewRouter()
-	eventslegacy.New(db).Mount(router, "/logs/events")
+	eventslegacy.New(nil, db).Mount(router, "/logs/events")
 	ts = httptest.NewServer(router)
 }
 
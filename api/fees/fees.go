@@ -0,0 +1,304 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package fees
+
+import (
+	"math"
+	"math/big"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	ethmath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/gorilla/mux"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/txpool"
+)
+
+const (
+	windowSize     = 20  // number of recent blocks to suggest upon
+	busyRatio      = 0.8 // blocks with higher gas used ratio are regarded as busy
+	busyPercentile = 60  // percentile of coefs in busy blocks to compete with
+
+	maxHistoryBlocks      = 1024
+	maxHistoryPercentiles = 100
+)
+
+type Fees struct {
+	chain        *chain.Chain
+	stateCreator *state.Creator
+	txPool       *txpool.TxPool
+	cache        *lru.Cache // block ID -> *blockFees
+}
+
+func New(chain *chain.Chain, stateCreator *state.Creator, txPool *txpool.TxPool) *Fees {
+	cache, _ := lru.New(256)
+	return &Fees{
+		chain,
+		stateCreator,
+		txPool,
+		cache,
+	}
+}
+
+// blockFees summary of fees paid in a block.
+type blockFees struct {
+	baseGasPrice *big.Int
+	gasUsedRatio float64
+	txs          []txFee // in ascending order of coef
+}
+
+type txFee struct {
+	coef    uint8
+	gasUsed uint64
+}
+
+// percentile returns the coef, that txs paid no higher than it take p percent of gas used.
+func (bf *blockFees) percentile(p float64) uint8 {
+	var total uint64
+	for _, tx := range bf.txs {
+		total += tx.gasUsed
+	}
+	var (
+		threshold = uint64(float64(total) * p / 100)
+		sum       uint64
+	)
+	for _, tx := range bf.txs {
+		sum += tx.gasUsed
+		if sum >= threshold {
+			return tx.coef
+		}
+	}
+	return 0
+}
+
+func (f *Fees) baseGasPrice(root thor.Bytes32) (*big.Int, error) {
+	st, err := f.stateCreator.NewState(root)
+	if err != nil {
+		return nil, err
+	}
+	price := builtin.Params.Native(st).Get(thor.KeyBaseGasPrice)
+	if err := st.Err(); err != nil {
+		return nil, err
+	}
+	return price, nil
+}
+
+func (f *Fees) getBlockFees(header *block.Header) (*blockFees, error) {
+	if cached, ok := f.cache.Get(header.ID()); ok {
+		return cached.(*blockFees), nil
+	}
+
+	// txs are charged upon base gas price in state of the parent block
+	root := header.StateRoot()
+	if header.Number() > 0 {
+		parent, err := f.chain.GetBlockHeader(header.ParentID())
+		if err != nil {
+			return nil, err
+		}
+		root = parent.StateRoot()
+	}
+	baseGasPrice, err := f.baseGasPrice(root)
+	if err != nil {
+		return nil, err
+	}
+	body, err := f.chain.GetBlockBody(header.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	bf := &blockFees{
+		baseGasPrice: baseGasPrice,
+		gasUsedRatio: float64(header.GasUsed()) / float64(header.GasLimit()),
+	}
+	// receipts of genesis block not saved
+	var receipts tx.Receipts
+	if len(body.Txs) > 0 {
+		if receipts, err = f.chain.GetBlockReceipts(header.ID()); err != nil {
+			return nil, err
+		}
+	}
+	for i, tx := range body.Txs {
+		bf.txs = append(bf.txs, txFee{tx.GasPriceCoef(), receipts[i].GasUsed})
+	}
+	sort.Slice(bf.txs, func(i, j int) bool {
+		return bf.txs[i].coef < bf.txs[j].coef
+	})
+	f.cache.Add(header.ID(), bf)
+	return bf, nil
+}
+
+// suggestPriority suggests gas price coef for txs to be packed in next few blocks.
+// If recent blocks are busy, it competes with txs paid in them; if executable txs in pool
+// are more than the next block can take, it outbids the backlog.
+func (f *Fees) suggestPriority() (*Priority, error) {
+	best := f.chain.BestBlock().Header()
+
+	var (
+		header    = best
+		ratioSum  float64
+		n         int
+		busyCoefs []int
+	)
+	for n < windowSize {
+		bf, err := f.getBlockFees(header)
+		if err != nil {
+			return nil, err
+		}
+		n++
+		ratioSum += bf.gasUsedRatio
+		if bf.gasUsedRatio >= busyRatio {
+			busyCoefs = append(busyCoefs, int(bf.percentile(busyPercentile)))
+		}
+		if header.Number() == 0 {
+			break
+		}
+		if header, err = f.chain.GetBlockHeader(header.ParentID()); err != nil {
+			return nil, err
+		}
+	}
+
+	var coef int
+	if len(busyCoefs) > 0 {
+		sort.Ints(busyCoefs)
+		coef = busyCoefs[len(busyCoefs)/2]
+	}
+
+	// executables are sorted by overall gas price in descending order, as packer adopts
+	var (
+		pendingGas uint64
+		outbid     bool
+	)
+	for _, tx := range f.txPool.Executables() {
+		pendingGas += tx.Gas()
+		if pendingGas > best.GasLimit() && !outbid {
+			if c := int(tx.GasPriceCoef()) + 1; c > coef {
+				coef = c
+			}
+			outbid = true
+		}
+	}
+	if coef > math.MaxUint8 {
+		coef = math.MaxUint8
+	}
+
+	baseGasPrice, err := f.baseGasPrice(best.StateRoot())
+	if err != nil {
+		return nil, err
+	}
+	return &Priority{
+		GasPriceCoef: uint8(coef),
+		BaseGasPrice: (*ethmath.HexOrDecimal256)(baseGasPrice),
+		GasUsedRatio: ratioSum / float64(n),
+		PendingGas:   pendingGas,
+	}, nil
+}
+
+func (f *Fees) handleGetPriority(w http.ResponseWriter, req *http.Request) error {
+	priority, err := f.suggestPriority()
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, priority)
+}
+
+// getHistory returns fees of trunk blocks in range [newest-count+1, newest].
+func (f *Fees) getHistory(newest uint32, count uint32, percentiles []float64) (*History, error) {
+	if count > newest+1 {
+		count = newest + 1
+	}
+	history := &History{
+		OldestBlock:   newest + 1 - count,
+		BaseGasPrices: make([]*ethmath.HexOrDecimal256, 0, count),
+		GasUsedRatios: make([]float64, 0, count),
+	}
+	if len(percentiles) > 0 {
+		history.Rewards = make([][]uint, 0, count)
+	}
+	for num := history.OldestBlock; num <= newest; num++ {
+		header, err := f.chain.GetTrunkBlockHeader(num)
+		if err != nil {
+			return nil, err
+		}
+		bf, err := f.getBlockFees(header)
+		if err != nil {
+			return nil, err
+		}
+		history.BaseGasPrices = append(history.BaseGasPrices, (*ethmath.HexOrDecimal256)(bf.baseGasPrice))
+		history.GasUsedRatios = append(history.GasUsedRatios, bf.gasUsedRatio)
+		if len(percentiles) > 0 {
+			rewards := make([]uint, 0, len(percentiles))
+			for _, p := range percentiles {
+				rewards = append(rewards, uint(bf.percentile(p)))
+			}
+			history.Rewards = append(history.Rewards, rewards)
+		}
+	}
+	return history, nil
+}
+
+func (f *Fees) handleGetHistory(w http.ResponseWriter, req *http.Request) error {
+	query := req.URL.Query()
+	count, err := strconv.ParseUint(query.Get("blockCount"), 0, 32)
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "blockCount"))
+	}
+	if count == 0 || count > maxHistoryBlocks {
+		return utils.BadRequest(errors.Errorf("blockCount: should be within [1, %v]", maxHistoryBlocks))
+	}
+
+	best := f.chain.BestBlock().Header().Number()
+	newest := best
+	if str := query.Get("newestBlock"); str != "" && str != "best" {
+		n, err := strconv.ParseUint(str, 0, 32)
+		if err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "newestBlock"))
+		}
+		if uint32(n) > best {
+			return utils.BadRequest(errors.New("newestBlock: beyond best block"))
+		}
+		newest = uint32(n)
+	}
+
+	var percentiles []float64
+	if str := query.Get("rewardPercentiles"); str != "" {
+		for _, s := range strings.Split(str, ",") {
+			p, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return utils.BadRequest(errors.WithMessage(err, "rewardPercentiles"))
+			}
+			if p < 0 || p > 100 || (len(percentiles) > 0 && p < percentiles[len(percentiles)-1]) {
+				return utils.BadRequest(errors.New("rewardPercentiles: should be ascending values within [0, 100]"))
+			}
+			percentiles = append(percentiles, p)
+		}
+		if len(percentiles) > maxHistoryPercentiles {
+			return utils.BadRequest(errors.Errorf("rewardPercentiles: at most %v values", maxHistoryPercentiles))
+		}
+	}
+
+	history, err := f.getHistory(newest, uint32(count), percentiles)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, history)
+}
+
+func (f *Fees) Mount(root *mux.Router, pathPrefix string) {
+	sub := root.PathPrefix(pathPrefix).Subrouter()
+
+	sub.Path("/priority").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(f.handleGetPriority))
+	sub.Path("/history").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(f.handleGetHistory))
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package fees_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/api/fees"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/packer"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/txpool"
+)
+
+var ts *httptest.Server
+
+func TestFees(t *testing.T) {
+	initFeesServer(t)
+	defer ts.Close()
+
+	getPriority(t)
+	getHistory(t)
+}
+
+func getPriority(t *testing.T) {
+	var priority fees.Priority
+	if err := json.Unmarshal(httpGet(t, ts.URL+"/fees/priority"), &priority); err != nil {
+		t.Fatal(err)
+	}
+	// the busy block takes 60% gas at coef 20
+	assert.Equal(t, uint8(20), priority.GasPriceCoef)
+	assert.Equal(t, thor.InitialBaseGasPrice, (*big.Int)(priority.BaseGasPrice))
+	assert.Equal(t, 0.5, priority.GasUsedRatio)
+	assert.Equal(t, uint64(0), priority.PendingGas)
+}
+
+func getHistory(t *testing.T) {
+	var history fees.History
+	if err := json.Unmarshal(httpGet(t, ts.URL+"/fees/history?blockCount=10&rewardPercentiles=0,50,100"), &history); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, uint32(0), history.OldestBlock)
+	assert.Equal(t, []float64{0, 1}, history.GasUsedRatios)
+	assert.Equal(t, 2, len(history.BaseGasPrices))
+	assert.Equal(t, [][]uint{{0, 0, 0}, {10, 10, 20}}, history.Rewards)
+
+	if err := json.Unmarshal(httpGet(t, ts.URL+"/fees/history?blockCount=1&newestBlock=0"), &history); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []float64{0}, history.GasUsedRatios)
+
+	res, err := http.Get(ts.URL + "/fees/history?blockCount=1&rewardPercentiles=50,10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func initFeesServer(t *testing.T) {
+	db, _ := lvldb.NewMem()
+	stateC := state.NewCreator(db)
+	b0, _, err := genesis.NewDevnet().Build(stateC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, _ := chain.New(db, b0)
+
+	a0 := genesis.DevAccounts()[0]
+	to := thor.BytesToAddress([]byte("to"))
+	// the block is full of 2 txs
+	flow, err := packer.New(c, stateC, a0.Address, &a0.Address).Mock(b0.Header(), b0.Header().Timestamp()+thor.BlockInterval, 42000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, coef := range []uint8{10, 20} {
+		trx := new(tx.Builder).
+			ChainTag(c.Tag()).
+			GasPriceCoef(coef).
+			Expiration(10).
+			Gas(21000).
+			Nonce(uint64(i)).
+			Clause(tx.NewClause(&to).WithValue(big.NewInt(1))).
+			Build()
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), a0.PrivateKey)
+		if err := flow.Adopt(trx.WithSignature(sig)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	b1, stage, receipts, err := flow.Pack(a0.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stage.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.AddBlock(b1, receipts); err != nil {
+		t.Fatal(err)
+	}
+
+	router := mux.NewRouter()
+	pool := txpool.New(c, stateC, txpool.Options{Limit: 10000, LimitPerAccount: 16, MaxLifetime: 10 * time.Minute})
+	fees.New(c, stateC, pool).Mount(router, "/fees")
+	ts = httptest.NewServer(router)
+}
+
+func httpGet(t *testing.T, url string) []byte {
+	res, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	r, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
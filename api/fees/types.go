@@ -0,0 +1,25 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package fees
+
+import "github.com/ethereum/go-ethereum/common/math"
+
+// Priority suggested gas price coef, along with the conditions it's based on.
+type Priority struct {
+	GasPriceCoef uint8                 `json:"gasPriceCoef"`
+	BaseGasPrice *math.HexOrDecimal256 `json:"baseGasPrice"`
+	GasUsedRatio float64               `json:"gasUsedRatio"` // average of recent blocks
+	PendingGas   uint64                `json:"pendingGas"`   // total gas of executable txs in pool
+}
+
+// History fees of a range of blocks, in ascending order of block number.
+// Rewards are gas price coefs at requested percentiles of gas used in each block.
+type History struct {
+	OldestBlock   uint32                  `json:"oldestBlock"`
+	BaseGasPrices []*math.HexOrDecimal256 `json:"baseGasPrices"`
+	GasUsedRatios []float64               `json:"gasUsedRatios"`
+	Rewards       [][]uint                `json:"rewards,omitempty"`
+}
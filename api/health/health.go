@@ -0,0 +1,85 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package health
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/kv"
+)
+
+// probeKey is used to check if the db is accessible. Not necessary to exist.
+var probeKey = []byte("health-probe")
+
+// Health serves liveness and readiness probes.
+type Health struct {
+	chain         *chain.Chain
+	db            kv.Getter
+	syncTolerance time.Duration
+}
+
+// New create a Health instance.
+// The node is regarded as synced if the best block is not older than syncTolerance.
+// Sync check is disabled if syncTolerance is 0.
+func New(chain *chain.Chain, db kv.Getter, syncTolerance time.Duration) *Health {
+	return &Health{
+		chain,
+		db,
+		syncTolerance,
+	}
+}
+
+func (h *Health) checkDB() error {
+	if _, err := h.db.Has(probeKey); err != nil {
+		return errors.WithMessage(err, "db")
+	}
+	return nil
+}
+
+func (h *Health) handleHealthz(w http.ResponseWriter, req *http.Request) error {
+	if err := h.checkDB(); err != nil {
+		return utils.WriteJSONWithStatus(w, http.StatusServiceUnavailable, &Status{Error: err.Error()})
+	}
+	return utils.WriteJSON(w, &Status{OK: true})
+}
+
+func (h *Health) handleReadyz(w http.ResponseWriter, req *http.Request) error {
+	best := h.chain.BestBlock().Header()
+	status := &Status{
+		BestBlock: &BestBlock{
+			ID:        best.ID(),
+			Number:    best.Number(),
+			Timestamp: best.Timestamp(),
+		},
+	}
+	if err := h.checkDB(); err != nil {
+		status.Error = err.Error()
+		return utils.WriteJSONWithStatus(w, http.StatusServiceUnavailable, status)
+	}
+
+	lag := time.Since(time.Unix(int64(best.Timestamp()), 0))
+	if lag < 0 {
+		lag = 0
+	}
+	status.SyncLag = uint64(lag / time.Second)
+	if h.syncTolerance > 0 && lag > h.syncTolerance {
+		status.Error = "not synced"
+		return utils.WriteJSONWithStatus(w, http.StatusServiceUnavailable, status)
+	}
+	status.OK = true
+	return utils.WriteJSON(w, status)
+}
+
+// Mount mounts probes as /healthz and /readyz under pathPrefix.
+func (h *Health) Mount(root *mux.Router, pathPrefix string) {
+	root.Path(pathPrefix + "/healthz").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(h.handleHealthz))
+	root.Path(pathPrefix + "/readyz").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(h.handleReadyz))
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package health_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/api/health"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/state"
+)
+
+func httpGet(t *testing.T, url string) (int, *health.Status) {
+	res, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	var status health.Status
+	if err := json.NewDecoder(res.Body).Decode(&status); err != nil {
+		t.Fatal(err)
+	}
+	return res.StatusCode, &status
+}
+
+func TestHealth(t *testing.T) {
+	db, _ := lvldb.NewMem()
+	b, _, err := genesis.NewDevnet().Build(state.NewCreator(db))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, _ := chain.New(db, b)
+
+	router := mux.NewRouter()
+	// devnet genesis timestamp is far in the past
+	health.New(c, db, time.Minute).Mount(router, "")
+	health.New(c, db, 0).Mount(router, "/nocheck")
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	code, status := httpGet(t, ts.URL+"/healthz")
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, status.OK)
+
+	code, status = httpGet(t, ts.URL+"/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.False(t, status.OK)
+	assert.Equal(t, b.Header().ID(), status.BestBlock.ID)
+
+	code, status = httpGet(t, ts.URL+"/nocheck/readyz")
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, status.OK)
+
+	db.Close()
+	code, status = httpGet(t, ts.URL+"/healthz")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.NotEmpty(t, status.Error)
+}
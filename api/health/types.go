@@ -0,0 +1,23 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package health
+
+import "github.com/vechain/thor/thor"
+
+// Status result of probes.
+type Status struct {
+	OK        bool       `json:"ok"`
+	Error     string     `json:"error,omitempty"`
+	BestBlock *BestBlock `json:"bestBlock,omitempty"`
+	SyncLag   uint64     `json:"syncLag,omitempty"` // in seconds
+}
+
+// BestBlock brief of the best block.
+type BestBlock struct {
+	ID        thor.Bytes32 `json:"id"`
+	Number    uint32       `json:"number"`
+	Timestamp uint64       `json:"timestamp"`
+}
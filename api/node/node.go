@@ -7,18 +7,64 @@ package node
 
 import (
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/analytics"
 	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/evidence"
+	"github.com/vechain/thor/finality"
+	"github.com/vechain/thor/poa"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/txpool"
 )
 
 type Node struct {
-	nw Network
+	nw           Network
+	chain        *chain.Chain
+	stateCreator *state.Creator
+	finality     *finality.Finality
+	evidence     *evidence.Pool
+	txPool       *txpool.TxPool
+	contracts    *analytics.Contracts
+	proposers    *analytics.Proposers
+	assembler    BlockAssembler
 }
 
-func New(nw Network) *Node {
+const (
+	defaultAnalyticsLimit = 20
+	maxAnalyticsLimit     = 1000
+)
+
+// New create node API. contracts, proposers and assembler are optional, and endpoints relying on them are not served if nil.
+func New(
+	nw Network,
+	chain *chain.Chain,
+	stateCreator *state.Creator,
+	finality *finality.Finality,
+	evidence *evidence.Pool,
+	txPool *txpool.TxPool,
+	contracts *analytics.Contracts,
+	proposers *analytics.Proposers,
+	assembler BlockAssembler,
+) *Node {
 	return &Node{
 		nw,
+		chain,
+		stateCreator,
+		finality,
+		evidence,
+		txPool,
+		contracts,
+		proposers,
+		assembler,
 	}
 }
 
@@ -30,8 +76,161 @@ func (n *Node) handleNetwork(w http.ResponseWriter, req *http.Request) error {
 	return utils.WriteJSON(w, n.PeersStats())
 }
 
+func (n *Node) handleInfo(w http.ResponseWriter, req *http.Request) error {
+	return utils.WriteJSON(w, &Info{
+		GenesisBlockID: n.chain.GenesisBlock().Header().ID(),
+		ChainTag:       n.chain.Tag(),
+	})
+}
+
+func (n *Node) handleEvidences(w http.ResponseWriter, req *http.Request) error {
+	evs, err := n.evidence.All()
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, ConvertEvidences(evs))
+}
+
+func (n *Node) getSupply(header *block.Header) (*Supply, error) {
+	state, err := n.stateCreator.NewState(header.StateRoot())
+	if err != nil {
+		return nil, err
+	}
+	energy := builtin.Energy.Native(state, header.Timestamp())
+	vet := energy.TokenTotalSupply()
+	generated := energy.TotalSupply()
+	burned := energy.TotalBurned()
+	if err := state.Err(); err != nil {
+		return nil, err
+	}
+	return &Supply{
+		BlockID:      header.ID(),
+		BlockNumber:  header.Number(),
+		VET:          math.HexOrDecimal256(*vet),
+		Energy:       math.HexOrDecimal256(*generated),
+		EnergyBurned: math.HexOrDecimal256(*burned),
+	}, nil
+}
+
+func (n *Node) handleSupply(w http.ResponseWriter, req *http.Request) error {
+	h, err := utils.ParseRevision(n.chain, n.finality, req.URL.Query().Get("revision"))
+	if err != nil {
+		return err
+	}
+	supply, err := n.getSupply(h)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, supply)
+}
+
+func (n *Node) handleTxPool(w http.ResponseWriter, req *http.Request) error {
+	return utils.WriteJSON(w, ConvertTxPoolContent(n.txPool.Content()))
+}
+
+func (n *Node) handleDryRun(w http.ResponseWriter, req *http.Request) error {
+	flow, err := n.assembler.DryRun()
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, ConvertDryRunBlock(flow))
+}
+
+func (n *Node) handleSlot(w http.ResponseWriter, req *http.Request) error {
+	best := n.chain.BestBlock().Header()
+	st, err := n.stateCreator.NewState(best.StateRoot())
+	if err != nil {
+		return err
+	}
+	poa.ActivateRotations(st, best.Number()+1)
+	proposers := poa.ToProposers(poa.LoadCandidates(st))
+
+	var (
+		now    = uint64(time.Now().Unix())
+		master = n.assembler.NodeMaster()
+		// the scheduler sees from the node master if authorized, otherwise from any active proposer
+		scheduler *poa.Scheduler
+		slot      = &Slot{
+			Now:             now,
+			BestBlockNumber: best.Number(),
+			BestBlockTime:   best.Timestamp(),
+			NodeMaster:      master,
+		}
+	)
+	if scheduler, err = poa.NewScheduler(master, proposers, best.Number(), best.Timestamp()); err == nil {
+		slot.Authorized = true
+	} else {
+		for _, p := range proposers {
+			if p.Active {
+				scheduler, _ = poa.NewScheduler(p.Address, proposers, best.Number(), best.Timestamp())
+				break
+			}
+		}
+		if scheduler == nil {
+			return errors.New("no active proposer")
+		}
+	}
+
+	// the same alignment as the scheduler does
+	slot.Slot = best.Timestamp() + thor.BlockInterval
+	if now > slot.Slot {
+		slot.Slot += (now - slot.Slot + thor.BlockInterval - 1) / thor.BlockInterval * thor.BlockInterval
+	}
+	slot.MissedSlots = (slot.Slot-best.Timestamp())/thor.BlockInterval - 1
+	slot.Proposer = scheduler.WhoseTurn(slot.Slot).Address
+
+	if slot.Authorized {
+		next := scheduler.Schedule(now)
+		slot.NextSlot = &next
+		slot.Due = next == slot.Slot
+	}
+	if offset, ok := n.assembler.ClockOffset(); ok {
+		ms := int64(offset / time.Millisecond)
+		slot.ClockOffset = &ms
+	}
+	if r := builtin.Authority.Native(st).PendingRotation(master); r != nil {
+		slot.Rotation = &Rotation{r.NewMaster, r.Activation}
+	}
+	if err := st.Err(); err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, slot)
+}
+
+func (n *Node) handleContractsAnalytics(w http.ResponseWriter, req *http.Request) error {
+	limit := uint64(defaultAnalyticsLimit)
+	if str := req.URL.Query().Get("limit"); str != "" {
+		var err error
+		if limit, err = strconv.ParseUint(str, 0, 32); err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "limit"))
+		}
+		if limit == 0 || limit > maxAnalyticsLimit {
+			return utils.BadRequest(errors.Errorf("limit: should be within [1, %v]", maxAnalyticsLimit))
+		}
+	}
+	return utils.WriteJSON(w, ConvertContractsSummary(n.contracts.Summary(int(limit))))
+}
+
+func (n *Node) handleProposersAnalytics(w http.ResponseWriter, req *http.Request) error {
+	return utils.WriteJSON(w, ConvertProposersSummary(n.proposers.Summary()))
+}
+
 func (n *Node) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
 
+	sub.Path("/info").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(n.handleInfo))
 	sub.Path("/network/peers").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(n.handleNetwork))
+	sub.Path("/evidences").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(n.handleEvidences))
+	sub.Path("/supply").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(n.handleSupply))
+	sub.Path("/txpool").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(n.handleTxPool))
+	if n.assembler != nil {
+		sub.Path("/packer/dry-run").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(n.handleDryRun))
+		sub.Path("/packer/slot").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(n.handleSlot))
+	}
+	if n.contracts != nil {
+		sub.Path("/analytics/contracts").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(n.handleContractsAnalytics))
+	}
+	if n.proposers != nil {
+		sub.Path("/analytics/proposers").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(n.handleProposersAnalytics))
+	}
 }
@@ -7,24 +7,58 @@ package node_test
 import (
 	"encoding/json"
 	"io/ioutil"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/analytics"
 	"github.com/vechain/thor/api/node"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/comm"
+	"github.com/vechain/thor/evidence"
+	"github.com/vechain/thor/finality"
 	"github.com/vechain/thor/genesis"
 	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/packer"
 	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
 	"github.com/vechain/thor/txpool"
 )
 
 var ts *httptest.Server
 
+// assembler packs the tx into the dry run block.
+type assembler struct {
+	packer *packer.Packer
+	chain  *chain.Chain
+	tx     *tx.Transaction
+}
+
+func (a *assembler) DryRun() (*packer.Flow, error) {
+	flow, err := a.packer.DryRun(a.chain.BestBlock().Header(), uint64(time.Now().Unix()))
+	if err != nil {
+		return nil, err
+	}
+	if err := flow.Adopt(a.tx); err != nil {
+		return nil, err
+	}
+	return flow, nil
+}
+
+func (a *assembler) NodeMaster() thor.Address {
+	return genesis.DevAccounts()[0].Address
+}
+
+func (a *assembler) ClockOffset() (time.Duration, bool) {
+	return 0, false
+}
+
 func TestNode(t *testing.T) {
 	initCommServer(t)
 	res := httpGet(t, ts.URL+"/node/network/peers")
@@ -33,6 +67,88 @@ func TestNode(t *testing.T) {
 		t.Fatal(err)
 	}
 	assert.Equal(t, 0, len(peersStats), "count should be zero")
+
+	res = httpGet(t, ts.URL+"/node/info")
+	var info node.Info
+	if err := json.Unmarshal(res, &info); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, genesis.NewDevnet().ID(), info.GenesisBlockID)
+	assert.Equal(t, info.GenesisBlockID[31], info.ChainTag)
+
+	res = httpGet(t, ts.URL+"/node/evidences")
+	var evs []*node.Evidence
+	if err := json.Unmarshal(res, &evs); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, len(evs))
+
+	res = httpGet(t, ts.URL+"/node/supply")
+	var supply node.Supply
+	if err := json.Unmarshal(res, &supply); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, uint32(0), supply.BlockNumber)
+	assert.NotEqual(t, 0, (*big.Int)(&supply.VET).Sign(), "vet supply should not be zero")
+	assert.Equal(t, 0, (*big.Int)(&supply.EnergyBurned).Sign(), "no energy burned at genesis")
+
+	res = httpGet(t, ts.URL+"/node/supply?revision=100")
+	assert.Contains(t, string(res), "revision")
+
+	res = httpGet(t, ts.URL+"/node/txpool")
+	var content node.TxPoolContent
+	if err := json.Unmarshal(res, &content); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, content.Total)
+	assert.Equal(t, 1, content.Accounts)
+	assert.Equal(t, genesis.DevAccounts()[0].Address, content.Origins[0].Origin)
+	assert.Equal(t, uint8(10), content.Origins[0].Txs[0].GasPriceCoef)
+	assert.Equal(t, uint32(10), content.Origins[0].Txs[0].Expiration)
+	assert.NotZero(t, content.Origins[0].Txs[0].Size)
+
+	res = httpGet(t, ts.URL+"/node/packer/dry-run")
+	var dryRun node.DryRunBlock
+	if err := json.Unmarshal(res, &dryRun); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, uint32(1), dryRun.Number)
+	assert.Equal(t, genesis.DevAccounts()[0].Address, dryRun.Beneficiary)
+	assert.Equal(t, 1, len(dryRun.Transactions))
+	assert.Equal(t, uint64(21000), dryRun.GasUsed)
+	assert.Equal(t, (*big.Int)(dryRun.Transactions[0].Reward), (*big.Int)(dryRun.Reward))
+
+	res = httpGet(t, ts.URL+"/node/packer/slot")
+	var slot node.Slot
+	if err := json.Unmarshal(res, &slot); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, slot.Authorized)
+	assert.Equal(t, genesis.DevAccounts()[0].Address, slot.NodeMaster)
+	assert.True(t, slot.Slot >= slot.Now)
+	assert.Equal(t, uint64(0), (slot.Slot-slot.BestBlockTime)%thor.BlockInterval)
+	assert.True(t, slot.NextSlot != nil && *slot.NextSlot >= slot.Slot)
+	assert.Equal(t, slot.Proposer == slot.NodeMaster, slot.Due)
+	assert.Nil(t, slot.ClockOffset)
+
+	res = httpGet(t, ts.URL+"/node/analytics/contracts?limit=10")
+	var summary node.ContractsSummary
+	if err := json.Unmarshal(res, &summary); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, summary.Blocks)
+	assert.Equal(t, 0, len(summary.Contracts))
+
+	res = httpGet(t, ts.URL+"/node/analytics/contracts?limit=0")
+	assert.Contains(t, string(res), "limit")
+
+	res = httpGet(t, ts.URL+"/node/analytics/proposers")
+	var proposers node.ProposersSummary
+	if err := json.Unmarshal(res, &proposers); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, proposers.Blocks)
+	assert.Equal(t, 0, len(proposers.Proposers))
 }
 
 func initCommServer(t *testing.T) {
@@ -45,13 +161,31 @@ func initCommServer(t *testing.T) {
 		t.Fatal(err)
 	}
 	chain, _ := chain.New(db, b)
-	comm := comm.New(chain, txpool.New(chain, stateC, txpool.Options{
+	pool := txpool.New(chain, stateC, txpool.Options{
 		Limit:           10000,
 		LimitPerAccount: 16,
 		MaxLifetime:     10 * time.Minute,
-	}))
+	})
+	comm := comm.New(chain, stateC, pool)
+
+	a0 := genesis.DevAccounts()[0]
+	to := thor.BytesToAddress([]byte("to"))
+	trx := new(tx.Builder).
+		ChainTag(chain.Tag()).
+		GasPriceCoef(10).
+		Expiration(10).
+		Gas(21000).
+		Clause(tx.NewClause(&to).WithValue(big.NewInt(1))).
+		Build()
+	sig, _ := crypto.Sign(trx.SigningHash().Bytes(), a0.PrivateKey)
+	trx = trx.WithSignature(sig)
+	if err := pool.Add(trx); err != nil {
+		t.Fatal(err)
+	}
+
 	router := mux.NewRouter()
-	node.New(comm).Mount(router, "/node")
+	asm := &assembler{packer.New(chain, stateC, a0.Address, &a0.Address), chain, trx}
+	node.New(comm, chain, stateC, finality.New(chain, stateC), evidence.New(db), pool, analytics.NewContracts(10), analytics.NewProposers(chain, stateC, db, 10), asm).Mount(router, "/node")
 	ts = httptest.NewServer(router)
 }
 
@@ -6,22 +6,50 @@
 package node
 
 import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/vechain/thor/analytics"
 	"github.com/vechain/thor/comm"
+	"github.com/vechain/thor/evidence"
+	"github.com/vechain/thor/packer"
 	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/txpool"
 )
 
 type Network interface {
 	PeersStats() []*comm.PeerStats
 }
 
+// BlockAssembler assembles a would-be block from the tx pool, without signing or broadcasting it.
+// It also tells the node master and the clock offset, to report the block production schedule.
+type BlockAssembler interface {
+	DryRun() (*packer.Flow, error)
+	// NodeMaster returns address of the node master.
+	NodeMaster() thor.Address
+	// ClockOffset returns offset of the local clock measured by NTP, false if not measured yet.
+	ClockOffset() (time.Duration, bool)
+}
+
+// Info identifies the network the node is running on.
+// Txs must carry the chain tag, which is the last byte of genesis block ID, to be accepted.
+type Info struct {
+	GenesisBlockID thor.Bytes32 `json:"genesisBlockID"`
+	ChainTag       byte         `json:"chainTag"`
+}
+
 type PeerStats struct {
-	Name        string       `json:"name"`
-	BestBlockID thor.Bytes32 `json:"bestBlockID"`
-	TotalScore  uint64       `json:"totalScore"`
-	PeerID      string       `json:"peerID"`
-	NetAddr     string       `json:"netAddr"`
-	Inbound     bool         `json:"inbound"`
-	Duration    uint64       `json:"duration"`
+	Name         string            `json:"name"`
+	BestBlockID  thor.Bytes32      `json:"bestBlockID"`
+	TotalScore   uint64            `json:"totalScore"`
+	PeerID       string            `json:"peerID"`
+	NetAddr      string            `json:"netAddr"`
+	Inbound      bool              `json:"inbound"`
+	Duration     uint64            `json:"duration"`
+	Penalty      float64           `json:"penalty"`
+	Misbehaviors map[string]uint64 `json:"misbehaviors"`
 }
 
 func ConvertPeersStats(ss []*comm.PeerStats) []*PeerStats {
@@ -31,14 +59,268 @@ func ConvertPeersStats(ss []*comm.PeerStats) []*PeerStats {
 	peersStats := make([]*PeerStats, len(ss))
 	for i, peerStats := range ss {
 		peersStats[i] = &PeerStats{
-			Name:        peerStats.Name,
-			BestBlockID: peerStats.BestBlockID,
-			TotalScore:  peerStats.TotalScore,
-			PeerID:      peerStats.PeerID,
-			NetAddr:     peerStats.NetAddr,
-			Inbound:     peerStats.Inbound,
-			Duration:    peerStats.Duration,
+			Name:         peerStats.Name,
+			BestBlockID:  peerStats.BestBlockID,
+			TotalScore:   peerStats.TotalScore,
+			PeerID:       peerStats.PeerID,
+			NetAddr:      peerStats.NetAddr,
+			Inbound:      peerStats.Inbound,
+			Duration:     peerStats.Duration,
+			Penalty:      peerStats.Penalty,
+			Misbehaviors: peerStats.Misbehaviors,
 		}
 	}
 	return peersStats
 }
+
+// Supply total supply of VET and energy at a given block.
+type Supply struct {
+	BlockID      thor.Bytes32         `json:"blockID"`
+	BlockNumber  uint32               `json:"blockNumber"`
+	VET          math.HexOrDecimal256 `json:"vet"`
+	Energy       math.HexOrDecimal256 `json:"energy"`
+	EnergyBurned math.HexOrDecimal256 `json:"energyBurned"`
+}
+
+// Evidence proof of double signing.
+type Evidence struct {
+	Signer    thor.Address     `json:"signer"`
+	Timestamp uint64           `json:"timestamp"`
+	Blocks    [2]EvidenceBlock `json:"blocks"`
+}
+
+// EvidenceBlock brief of block in evidence.
+type EvidenceBlock struct {
+	ID        thor.Bytes32 `json:"id"`
+	ParentID  thor.Bytes32 `json:"parentID"`
+	Signature string       `json:"signature"`
+}
+
+func ConvertEvidences(evs []*evidence.Evidence) []*Evidence {
+	converted := make([]*Evidence, len(evs))
+	for i, ev := range evs {
+		converted[i] = &Evidence{
+			Signer:    ev.Signer,
+			Timestamp: ev.Timestamp,
+		}
+		for j, h := range ev.Headers {
+			converted[i].Blocks[j] = EvidenceBlock{
+				ID:        h.ID(),
+				ParentID:  h.ParentID(),
+				Signature: hexutil.Encode(h.Signature()),
+			}
+		}
+	}
+	return converted
+}
+
+// ContractsSummary usage of contracts in recently committed blocks.
+type ContractsSummary struct {
+	Blocks    int              `json:"blocks"`
+	FromBlock uint32           `json:"fromBlock"`
+	ToBlock   uint32           `json:"toBlock"`
+	Contracts []*ContractStats `json:"contracts"`
+}
+
+// ContractStats aggregated usage of a contract.
+type ContractStats struct {
+	Address     thor.Address `json:"address"`
+	Calls       uint64       `json:"calls"`
+	Failures    uint64       `json:"failures"`
+	FailureRate float64      `json:"failureRate"`
+	GasUsed     uint64       `json:"gasUsed"`
+}
+
+func ConvertContractsSummary(s *analytics.Summary) *ContractsSummary {
+	converted := &ContractsSummary{
+		Blocks:    s.Blocks,
+		FromBlock: s.FromBlock,
+		ToBlock:   s.ToBlock,
+		Contracts: make([]*ContractStats, len(s.Contracts)),
+	}
+	for i, c := range s.Contracts {
+		converted.Contracts[i] = &ContractStats{
+			Address:  c.Address,
+			Calls:    c.Calls,
+			Failures: c.Failures,
+			GasUsed:  c.GasUsed,
+		}
+		if c.Calls > 0 {
+			converted.Contracts[i].FailureRate = float64(c.Failures) / float64(c.Calls)
+		}
+	}
+	return converted
+}
+
+// ProposersSummary block production of authorities in recent trunk blocks.
+type ProposersSummary struct {
+	Blocks    int              `json:"blocks"`
+	FromBlock uint32           `json:"fromBlock"`
+	ToBlock   uint32           `json:"toBlock"`
+	Proposers []*ProposerStats `json:"proposers"`
+}
+
+// ProposerStats block production of an authority.
+type ProposerStats struct {
+	Address  thor.Address `json:"address"`
+	Proposed uint64       `json:"proposed"`
+	Missed   uint64       `json:"missed"`
+	MissRate float64      `json:"missRate"`
+	AvgDelay uint64       `json:"avgDelay"` // in milliseconds
+}
+
+func ConvertProposersSummary(s *analytics.ProposersSummary) *ProposersSummary {
+	converted := &ProposersSummary{
+		Blocks:    s.Blocks,
+		FromBlock: s.FromBlock,
+		ToBlock:   s.ToBlock,
+		Proposers: make([]*ProposerStats, len(s.Proposers)),
+	}
+	for i, p := range s.Proposers {
+		converted.Proposers[i] = &ProposerStats{
+			Address:  p.Address,
+			Proposed: p.Proposed,
+			Missed:   p.Missed,
+			AvgDelay: uint64(p.AvgDelay() / time.Millisecond),
+		}
+		if slots := p.Proposed + p.Missed; slots > 0 {
+			converted.Proposers[i].MissRate = float64(p.Missed) / float64(slots)
+		}
+	}
+	return converted
+}
+
+// TxPoolContent txs in the pool grouped by origin.
+// Groups are in the order of their first txs, and executable txs come first in the order the packer adopts.
+type TxPoolContent struct {
+	Total      int              `json:"total"`
+	Executable int              `json:"executable"`
+	Accounts   int              `json:"accounts"`
+	Origins    []*TxPoolAccount `json:"origins"`
+}
+
+// TxPoolAccount pending txs of an origin.
+type TxPoolAccount struct {
+	Origin thor.Address `json:"origin"`
+	Txs    []*PendingTx `json:"txs"`
+}
+
+// PendingTx brief of a tx in the pool.
+type PendingTx struct {
+	ID           thor.Bytes32 `json:"id"`
+	GasPriceCoef uint8        `json:"gasPriceCoef"`
+	Gas          uint64       `json:"gas"`
+	BlockRef     string       `json:"blockRef"`
+	Expiration   uint32       `json:"expiration"`
+	Size         uint32       `json:"size"`
+	Executable   bool         `json:"executable"`
+}
+
+func ConvertTxPoolContent(content []*txpool.PendingTx) *TxPoolContent {
+	converted := &TxPoolContent{
+		Total:   len(content),
+		Origins: []*TxPoolAccount{},
+	}
+	accounts := make(map[thor.Address]*TxPoolAccount)
+	for _, p := range content {
+		acc, ok := accounts[p.Origin]
+		if !ok {
+			acc = &TxPoolAccount{Origin: p.Origin}
+			accounts[p.Origin] = acc
+			converted.Origins = append(converted.Origins, acc)
+		}
+		if p.Executable {
+			converted.Executable++
+		}
+		br := p.Tx.BlockRef()
+		acc.Txs = append(acc.Txs, &PendingTx{
+			ID:           p.Tx.ID(),
+			GasPriceCoef: p.Tx.GasPriceCoef(),
+			Gas:          p.Tx.Gas(),
+			BlockRef:     hexutil.Encode(br[:]),
+			Expiration:   p.Tx.Expiration(),
+			Size:         uint32(p.Tx.Size()),
+			Executable:   p.Executable,
+		})
+	}
+	converted.Accounts = len(accounts)
+	return converted
+}
+
+// DryRunBlock the block would be packed from the tx pool.
+type DryRunBlock struct {
+	Number       uint32                `json:"number"`
+	ParentID     thor.Bytes32          `json:"parentID"`
+	Timestamp    uint64                `json:"timestamp"`
+	Beneficiary  thor.Address          `json:"beneficiary"`
+	GasLimit     uint64                `json:"gasLimit"`
+	GasUsed      uint64                `json:"gasUsed"`
+	LaneGasUsed  uint64                `json:"laneGasUsed"`
+	LaneReserved uint64                `json:"laneReserved"`
+	Reward       *math.HexOrDecimal256 `json:"reward"`
+	Transactions []*DryRunTx           `json:"transactions"`
+}
+
+// DryRunTx tx would be packed, with its execution result.
+type DryRunTx struct {
+	ID           thor.Bytes32          `json:"id"`
+	GasPriceCoef uint8                 `json:"gasPriceCoef"`
+	Priority     bool                  `json:"priority"`
+	GasUsed      uint64                `json:"gasUsed"`
+	GasPayer     thor.Address          `json:"gasPayer"`
+	Reward       *math.HexOrDecimal256 `json:"reward"`
+	Reverted     bool                  `json:"reverted"`
+}
+
+func ConvertDryRunBlock(flow *packer.Flow) *DryRunBlock {
+	parent := flow.ParentHeader()
+	converted := &DryRunBlock{
+		Number:       parent.Number() + 1,
+		ParentID:     parent.ID(),
+		Timestamp:    flow.When(),
+		Beneficiary:  flow.Beneficiary(),
+		GasLimit:     flow.GasLimit(),
+		GasUsed:      flow.GasUsed(),
+		Transactions: make([]*DryRunTx, 0, len(flow.Txs())),
+	}
+	converted.LaneGasUsed, converted.LaneReserved = flow.LaneUtilization()
+	reward := new(big.Int)
+	receipts := flow.Receipts()
+	for i, tx := range flow.Txs() {
+		r := receipts[i]
+		reward.Add(reward, r.Reward)
+		converted.Transactions = append(converted.Transactions, &DryRunTx{
+			ID:           tx.ID(),
+			GasPriceCoef: tx.GasPriceCoef(),
+			Priority:     flow.IsPriority(tx),
+			GasUsed:      r.GasUsed,
+			GasPayer:     r.GasPayer,
+			Reward:       (*math.HexOrDecimal256)(r.Reward),
+			Reverted:     r.Reverted,
+		})
+	}
+	converted.Reward = (*math.HexOrDecimal256)(reward)
+	return converted
+}
+
+// Slot block production schedule at the moment, as seen by the node.
+type Slot struct {
+	Now             uint64       `json:"now"`
+	BestBlockNumber uint32       `json:"bestBlockNumber"`
+	BestBlockTime   uint64       `json:"bestBlockTimestamp"`
+	Slot            uint64       `json:"slot"`        // the upcoming slot, >= now
+	MissedSlots     uint64       `json:"missedSlots"` // slots passed without block since the best block
+	Proposer        thor.Address `json:"proposer"`    // proposer scheduled for the upcoming slot
+	NodeMaster      thor.Address `json:"nodeMaster"`
+	Authorized      bool         `json:"authorized"`
+	Due             bool         `json:"due"`         // whether the upcoming slot belongs to the node
+	NextSlot        *uint64      `json:"nextSlot"`    // next slot of the node, null if not authorized
+	ClockOffset     *int64       `json:"clockOffset"` // in milliseconds, null if not measured yet
+	Rotation        *Rotation    `json:"rotation"`    // pending rotation of the node master, null if none
+}
+
+// Rotation pending rotation of a node master.
+type Rotation struct {
+	NewMaster  thor.Address `json:"newMaster"`
+	Activation uint32       `json:"activation"` // number of the block from which the new master takes over
+}
@@ -6,6 +6,7 @@
 package subscriptions
 
 import (
+	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/thor"
 )
@@ -37,3 +38,65 @@ func (br *blockReader) Read() ([]interface{}, bool, error) {
 	}
 	return msgs, len(blocks) > 0, nil
 }
+
+// confirmedBlockReader reads trunk blocks only once they are confirmed, i.e. buried under enough blocks,
+// or finalized. Blocks read are reported obsolete only if reverted by a reorg deeper than confirmations.
+type confirmedBlockReader struct {
+	chain     *chain.Chain
+	confirmed func() (*block.Header, error) // returns the latest confirmed block, nil if none
+	position  thor.Bytes32
+}
+
+func newConfirmedBlockReader(chain *chain.Chain, position thor.Bytes32, confirmed func() (*block.Header, error)) *confirmedBlockReader {
+	return &confirmedBlockReader{
+		chain:     chain,
+		confirmed: confirmed,
+		position:  position,
+	}
+}
+
+func (br *confirmedBlockReader) Read() ([]interface{}, bool, error) {
+	head, err := br.confirmed()
+	if err != nil || head == nil {
+		return nil, false, err
+	}
+	best := br.chain.BestBlock().Header()
+	seeker := br.chain.NewSeeker(best.ID())
+
+	var msgs []interface{}
+	// walk back to trunk, if the position reverted
+	num := block.Number(br.position)
+	for num > best.Number() || seeker.GetID(num) != br.position {
+		if err := seeker.Err(); err != nil {
+			return nil, false, err
+		}
+		blk, err := br.chain.GetBlock(br.position)
+		if err != nil {
+			return nil, false, err
+		}
+		msg, err := convertBlock(&chain.Block{Block: blk, Obsolete: true})
+		if err != nil {
+			return nil, false, err
+		}
+		msgs = append(msgs, msg)
+		br.position = blk.Header().ParentID()
+		num--
+	}
+
+	if num < head.Number() {
+		blk, err := br.chain.GetBlock(seeker.GetID(num + 1))
+		if err != nil {
+			return nil, false, err
+		}
+		msg, err := convertBlock(&chain.Block{Block: blk, Obsolete: false})
+		if err != nil {
+			return nil, false, err
+		}
+		msgs = append(msgs, msg)
+		br.position = blk.Header().ID()
+	}
+	if err := seeker.Err(); err != nil {
+		return nil, false, err
+	}
+	return msgs, len(msgs) > 0, nil
+}
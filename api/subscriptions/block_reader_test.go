@@ -0,0 +1,90 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package subscriptions
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+func TestConfirmedBlockReader(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	b0, _, err := genesis.NewDevnet().Build(state.NewCreator(kv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch, err := chain.New(kv, b0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// adds n blocks after the parent, with the score and interval per block
+	addBlocks := func(parent *block.Header, n int, score uint64, interval uint64) []*block.Header {
+		var headers []*block.Header
+		for i := 0; i < n; i++ {
+			b := new(block.Builder).
+				ParentID(parent.ID()).
+				TotalScore(parent.TotalScore() + score).
+				Timestamp(parent.Timestamp() + interval).
+				Build()
+			sig, _ := crypto.Sign(b.Header().SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+			b = b.WithSignature(sig)
+			if _, err := ch.AddBlock(b, nil); err != nil {
+				t.Fatal(err)
+			}
+			parent = b.Header()
+			headers = append(headers, parent)
+		}
+		return headers
+	}
+	// confirmed if buried under 1 block
+	confirmed := func() (*block.Header, error) {
+		best := ch.BestBlock().Header()
+		if best.Number() < 1 {
+			return nil, nil
+		}
+		return ch.GetTrunkBlockHeader(best.Number() - 1)
+	}
+	read := func(br *confirmedBlockReader) (ids []thor.Bytes32, obsolete []bool) {
+		for {
+			msgs, ok, err := br.Read()
+			assert.Nil(t, err)
+			if !ok {
+				return
+			}
+			for _, msg := range msgs {
+				ids = append(ids, msg.(*BlockMessage).ID)
+				obsolete = append(obsolete, msg.(*BlockMessage).Obsolete)
+			}
+		}
+	}
+
+	trunk := addBlocks(b0.Header(), 3, 1, 10)
+	br := newConfirmedBlockReader(ch, b0.Header().ID(), confirmed)
+	ids, obsolete := read(br)
+	assert.Equal(t, []thor.Bytes32{trunk[0].ID(), trunk[1].ID()}, ids, "the best block not confirmed")
+	assert.Equal(t, []bool{false, false}, obsolete)
+
+	// reorg deeper than confirmations
+	fork := addBlocks(b0.Header(), 4, 2, 20)
+	assert.Equal(t, fork[3].ID(), ch.BestBlock().Header().ID())
+	ids, obsolete = read(br)
+	assert.Equal(t, []thor.Bytes32{trunk[1].ID(), trunk[0].ID(), fork[0].ID(), fork[1].ID(), fork[2].ID()}, ids, "walked back to trunk")
+	assert.Equal(t, []bool{true, true, false, false, false}, obsolete)
+
+	// reorg within confirmations not seen
+	fork2 := addBlocks(fork[2], 2, 3, 30)
+	ids, obsolete = read(br)
+	assert.Equal(t, []thor.Bytes32{fork2[0].ID()}, ids)
+	assert.Equal(t, []bool{false}, obsolete)
+}
@@ -6,8 +6,12 @@
 package subscriptions
 
 import (
+	"net"
 	"net/http"
+	"strconv"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
@@ -16,13 +20,31 @@ import (
 	"github.com/vechain/thor/api/utils"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/finality"
 	"github.com/vechain/thor/thor"
 )
 
+// Options options of subscriptions.
+type Options struct {
+	// IdleTimeout closes connections silent for the duration, while pings are sent to idle ones
+	// to keep alive. Disabled if 0.
+	IdleTimeout time.Duration
+	// MaxSubscriptions max count of concurrent subscriptions, each over a connection. Unlimited if 0.
+	MaxSubscriptions int
+	// MaxSubscriptionsPerClient max count of concurrent subscriptions of a client, i.e. connections
+	// from the same remote IP. Unlimited if 0.
+	MaxSubscriptionsPerClient int
+}
+
 type Subscriptions struct {
 	backtraceLimit uint32
 	chain          *chain.Chain
+	finality       *finality.Finality
 	upgrader       *websocket.Upgrader
+	options        Options
+	count          int32 // count of active subscriptions, accessed atomically
+	clientsLock    sync.Mutex
+	clients        map[string]int // count of active subscriptions per client
 	done           chan struct{}
 	wg             sync.WaitGroup
 }
@@ -35,10 +57,12 @@ var (
 	log = log15.New("pkg", "subscriptions")
 )
 
-func New(chain *chain.Chain, allowedOrigins []string, backtraceLimit uint32) *Subscriptions {
+func New(chain *chain.Chain, finality *finality.Finality, allowedOrigins []string, backtraceLimit uint32, options Options) *Subscriptions {
 	return &Subscriptions{
 		backtraceLimit: backtraceLimit,
 		chain:          chain,
+		finality:       finality,
+		options:        options,
 		upgrader: &websocket.Upgrader{
 			EnableCompression: true,
 			CheckOrigin: func(r *http.Request) bool {
@@ -54,16 +78,102 @@ func New(chain *chain.Chain, allowedOrigins []string, backtraceLimit uint32) *Su
 				return false
 			},
 		},
-		done: make(chan struct{}),
+		clients: make(map[string]int),
+		done:    make(chan struct{}),
 	}
 }
 
-func (s *Subscriptions) handleBlockReader(w http.ResponseWriter, req *http.Request) (*blockReader, error) {
-	position, err := s.parsePosition(req.URL.Query().Get("pos"))
+// acquire counts in the subscription of the client, and returns false if any limit exceeded.
+// The returned func should be called to count it out, whatever acquired or not.
+func (s *Subscriptions) acquire(client string) (bool, func()) {
+	n := atomic.AddInt32(&s.count, 1)
+
+	s.clientsLock.Lock()
+	s.clients[client]++
+	perClient := s.clients[client]
+	s.clientsLock.Unlock()
+
+	release := func() {
+		atomic.AddInt32(&s.count, -1)
+		s.clientsLock.Lock()
+		if s.clients[client]--; s.clients[client] == 0 {
+			delete(s.clients, client)
+		}
+		s.clientsLock.Unlock()
+	}
+	if s.options.MaxSubscriptions > 0 && int(n) > s.options.MaxSubscriptions {
+		return false, release
+	}
+	if s.options.MaxSubscriptionsPerClient > 0 && perClient > s.options.MaxSubscriptionsPerClient {
+		return false, release
+	}
+	return true, release
+}
+
+// clientOf returns the client of the request, i.e. the remote IP.
+func clientOf(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
+
+func (s *Subscriptions) handleBlockReader(w http.ResponseWriter, req *http.Request) (msgReader, error) {
+	confirmed, err := s.parseConfirmations(req.URL.Query().Get("confirmations"))
 	if err != nil {
 		return nil, err
 	}
-	return newBlockReader(s.chain, position), nil
+	posStr := req.URL.Query().Get("pos")
+	if confirmed == nil {
+		position, err := s.parsePosition(posStr)
+		if err != nil {
+			return nil, err
+		}
+		return newBlockReader(s.chain, position), nil
+	}
+
+	var position thor.Bytes32
+	if posStr == "" {
+		// starts from the latest confirmed block
+		head, err := confirmed()
+		if err != nil {
+			return nil, err
+		}
+		if head == nil {
+			head = s.chain.GenesisBlock().Header()
+		}
+		position = head.ID()
+	} else if position, err = s.parsePosition(posStr); err != nil {
+		return nil, err
+	}
+	return newConfirmedBlockReader(s.chain, position, confirmed), nil
+}
+
+// parseConfirmations parses confirmations of blocks to subscribe, either the depth of blocks buried,
+// or 'finalized'. It returns the func to get the latest confirmed block, nil if confirmations not required.
+func (s *Subscriptions) parseConfirmations(str string) (func() (*block.Header, error), error) {
+	switch str {
+	case "", "0":
+		return nil, nil
+	case "finalized":
+		return s.finality.Finalized, nil
+	}
+	n, err := strconv.ParseUint(str, 10, 32)
+	if err != nil {
+		return nil, utils.BadRequest(errors.WithMessage(err, "confirmations"))
+	}
+	if uint32(n) > s.backtraceLimit {
+		return nil, utils.Forbidden(errors.New("confirmations: backtrace limit exceeded"))
+	}
+	depth := uint32(n)
+	return func() (*block.Header, error) {
+		best := s.chain.BestBlock().Header()
+		if best.Number() < depth {
+			return nil, nil
+		}
+		return s.chain.GetTrunkBlockHeader(best.Number() - depth)
+	}, nil
 }
 
 func (s *Subscriptions) handleEventReader(w http.ResponseWriter, req *http.Request) (*eventReader, error) {
@@ -168,6 +278,12 @@ func (s *Subscriptions) handleSubject(w http.ResponseWriter, req *http.Request)
 		return utils.HTTPError(errors.New("not found"), http.StatusNotFound)
 	}
 
+	ok, release := s.acquire(clientOf(req))
+	defer release()
+	if !ok {
+		return utils.HTTPError(errors.New("too many subscriptions"), http.StatusServiceUnavailable)
+	}
+
 	conn, err := s.upgrader.Upgrade(w, req, nil)
 	// since the conn is hijacked here, no error should be returned in lines below
 	if err != nil {
@@ -195,6 +311,19 @@ func (s *Subscriptions) handleSubject(w http.ResponseWriter, req *http.Request)
 }
 
 func (s *Subscriptions) pipe(conn *websocket.Conn, reader msgReader) error {
+	idleTimeout := s.options.IdleTimeout
+	// the peer is regarded alive as long as it reads messages, or responds to pings
+	extendDeadline := func() {
+		if idleTimeout > 0 {
+			conn.SetReadDeadline(time.Now().Add(idleTimeout))
+		}
+	}
+	extendDeadline()
+	conn.SetPongHandler(func(string) error {
+		extendDeadline()
+		return nil
+	})
+
 	closed := make(chan struct{})
 	// start read loop to handle close event
 	s.wg.Add(1)
@@ -206,8 +335,43 @@ func (s *Subscriptions) pipe(conn *websocket.Conn, reader msgReader) error {
 				close(closed)
 				break
 			}
+			extendDeadline()
 		}
 	}()
+
+	// ping once idle for a while, before the peer regarded idle
+	var (
+		pingC     <-chan time.Time
+		resetPing = func() {}
+	)
+	if idleTimeout > 0 {
+		pingInterval := idleTimeout * 9 / 10
+		pingTimer := time.NewTimer(pingInterval)
+		defer pingTimer.Stop()
+		pingC = pingTimer.C
+		resetPing = func() {
+			if !pingTimer.Stop() {
+				select {
+				case <-pingTimer.C:
+				default:
+				}
+			}
+			pingTimer.Reset(pingInterval)
+		}
+	}
+	write := func(msg interface{}) error {
+		if idleTimeout > 0 {
+			// writes stuck if the peer stops reading
+			conn.SetWriteDeadline(time.Now().Add(idleTimeout))
+		}
+		if err := conn.WriteJSON(msg); err != nil {
+			return err
+		}
+		extendDeadline()
+		resetPing()
+		return nil
+	}
+
 	ticker := s.chain.NewTicker()
 	for {
 		msgs, hasMore, err := reader.Read()
@@ -215,17 +379,26 @@ func (s *Subscriptions) pipe(conn *websocket.Conn, reader msgReader) error {
 			return err
 		}
 		for _, msg := range msgs {
-			if err := conn.WriteJSON(msg); err != nil {
+			if err := write(msg); err != nil {
 				return err
 			}
 		}
 		if !hasMore {
-			select {
-			case <-s.done:
-				return nil
-			case <-closed:
-				return nil
-			case <-ticker.C():
+		wait:
+			for {
+				select {
+				case <-s.done:
+					return nil
+				case <-closed:
+					return nil
+				case <-ticker.C():
+					break wait
+				case <-pingC:
+					if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(idleTimeout)); err != nil {
+						return err
+					}
+					resetPing()
+				}
 			}
 		} else {
 			select {
@@ -0,0 +1,135 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package subscriptions_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/api/subscriptions"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/finality"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/state"
+)
+
+func newServer(t *testing.T, options subscriptions.Options) (*httptest.Server, func()) {
+	db, _ := lvldb.NewMem()
+	stateC := state.NewCreator(db)
+	b0, _, err := genesis.NewDevnet().Build(stateC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch, err := chain.New(db, b0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	subs := subscriptions.New(ch, finality.New(ch, stateC), nil, 1000, options)
+	router := mux.NewRouter()
+	subs.Mount(router, "/subscriptions")
+	ts := httptest.NewServer(router)
+	return ts, func() {
+		subs.Close()
+		ts.Close()
+	}
+}
+
+func dial(ts *httptest.Server, subject string) (*websocket.Conn, *http.Response, error) {
+	url := "ws" + strings.TrimPrefix(ts.URL, "http") + "/subscriptions/" + subject
+	return websocket.DefaultDialer.Dial(url, nil)
+}
+
+func TestSubscriptionLimits(t *testing.T) {
+	ts, closer := newServer(t, subscriptions.Options{MaxSubscriptions: 3, MaxSubscriptionsPerClient: 2})
+	defer closer()
+
+	var conns []*websocket.Conn
+	for i := 0; i < 2; i++ {
+		conn, _, err := dial(ts, "block")
+		if err != nil {
+			t.Fatal(err)
+		}
+		conns = append(conns, conn)
+	}
+	// all from the same client
+	_, res, err := dial(ts, "beat")
+	assert.Equal(t, websocket.ErrBadHandshake, err)
+	assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+
+	// counted out once closed
+	conns[0].Close()
+	for i := 0; ; i++ {
+		conn, _, err := dial(ts, "beat")
+		if err == nil {
+			conns[0] = conn
+			break
+		}
+		if i == 100 {
+			t.Fatal("subscription not counted out")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	for _, conn := range conns {
+		conn.Close()
+	}
+}
+
+func TestIdleTimeout(t *testing.T) {
+	const idleTimeout = 200 * time.Millisecond
+	ts, closer := newServer(t, subscriptions.Options{IdleTimeout: idleTimeout})
+	defer closer()
+
+	// pinged while idle, and kept alive by pongs
+	conn, _, err := dial(ts, "block")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	var pings int32
+	conn.SetPingHandler(func(data string) error {
+		atomic.AddInt32(&pings, 1)
+		return conn.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(time.Second))
+	})
+	readErr := make(chan error, 1)
+	go func() {
+		_, _, err := conn.ReadMessage()
+		readErr <- err
+	}()
+	select {
+	case err := <-readErr:
+		t.Fatalf("connection closed: %v", err)
+	case <-time.After(3 * idleTimeout):
+	}
+	assert.True(t, atomic.LoadInt32(&pings) >= 2, "pinged periodically")
+
+	// closed if not responding
+	silent, _, err := dial(ts, "block")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer silent.Close()
+	silent.SetPingHandler(func(string) error { return nil })
+	silentErr := make(chan error, 1)
+	go func() {
+		_, _, err := silent.ReadMessage()
+		silentErr <- err
+	}()
+	select {
+	case err := <-silentErr:
+		assert.NotNil(t, err)
+	case <-time.After(5 * idleTimeout):
+		t.Fatal("silent connection not closed")
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package transactions
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/vechain/thor/cache"
+	"github.com/vechain/thor/thor"
+)
+
+const (
+	idempotencyKeyHeader = "x-idempotency-key"
+	idempotencyKeyTTL    = 24 * time.Hour
+	maxIdempotencyKeys   = 100000
+)
+
+// idempotencyKeys maps client-supplied idempotency keys, prefixed by the client, to txs submitted with them.
+// Keys expire after TTL, and the oldest ones are evicted if count exceeds the limit.
+type idempotencyKeys struct {
+	lock  sync.Mutex
+	cache *cache.PrioCache
+}
+
+// idempotencyEntry is the tx a key is reserved for.
+type idempotencyEntry struct {
+	txID     thor.Bytes32
+	done     chan struct{} // closed once the tx is submitted or failed
+	accepted bool
+}
+
+func newIdempotencyKeys() *idempotencyKeys {
+	return &idempotencyKeys{cache: cache.NewPrioCache(maxIdempotencyKeys)}
+}
+
+// reserve reserves the key for the tx ahead of submission, unless the key is already used.
+// A key being used by a pending submission is waited for, and reserved again if the submission failed.
+// It returns the entry of the key, and whether it's reserved for the caller, who should resolve it then.
+func (k *idempotencyKeys) reserve(ctx context.Context, key string, txID thor.Bytes32) (*idempotencyEntry, bool, error) {
+	for {
+		k.lock.Lock()
+		entry, ok := k.get(key)
+		if !ok {
+			entry = &idempotencyEntry{txID: txID, done: make(chan struct{})}
+			k.set(key, entry)
+			k.lock.Unlock()
+			return entry, true, nil
+		}
+		k.lock.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		case <-entry.done:
+		}
+		if entry.accepted {
+			return entry, false, nil
+		}
+	}
+}
+
+// resolve resolves the entry reserved, with whether the tx is accepted.
+// The key is unmapped if the tx failed to be submitted.
+func (k *idempotencyKeys) resolve(key string, entry *idempotencyEntry, accepted bool) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	entry.accepted = accepted
+	if !accepted {
+		if current, ok := k.get(key); ok && current == entry {
+			k.cache.Remove(key)
+		}
+	}
+	close(entry.done)
+}
+
+// get returns entry of the key.
+func (k *idempotencyKeys) get(key string) (*idempotencyEntry, bool) {
+	value, reservedAt, ok := k.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	if time.Since(time.Unix(int64(reservedAt), 0)) > idempotencyKeyTTL {
+		k.cache.Remove(key)
+		return nil, false
+	}
+	return value.(*idempotencyEntry), true
+}
+
+// set records the entry of the key.
+func (k *idempotencyKeys) set(key string, entry *idempotencyEntry) {
+	k.cache.Set(key, entry, float64(time.Now().Unix()))
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package transactions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/thor"
+)
+
+func TestIdempotencyKeys(t *testing.T) {
+	keys := newIdempotencyKeys()
+	ctx := context.Background()
+	tx1, tx2 := thor.BytesToBytes32([]byte("tx1")), thor.BytesToBytes32([]byte("tx2"))
+
+	entry, reserved, err := keys.reserve(ctx, "key", tx1)
+	assert.Nil(t, err)
+	assert.True(t, reserved)
+
+	// pending submission waited for
+	waiting := make(chan *idempotencyEntry, 1)
+	go func() {
+		entry, reserved, err := keys.reserve(ctx, "key", tx2)
+		assert.Nil(t, err)
+		assert.False(t, reserved)
+		waiting <- entry
+	}()
+	select {
+	case <-waiting:
+		t.Fatal("pending submission not waited for")
+	case <-time.After(50 * time.Millisecond):
+	}
+	keys.resolve("key", entry, true)
+	assert.Equal(t, tx1, (<-waiting).txID)
+
+	// reserved again if the submission failed
+	entry, reserved, _ = keys.reserve(ctx, "other", tx1)
+	assert.True(t, reserved)
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		keys.resolve("other", entry, false)
+	}()
+	entry, reserved, err = keys.reserve(ctx, "other", tx2)
+	assert.Nil(t, err)
+	assert.True(t, reserved)
+	assert.Equal(t, tx2, entry.txID)
+
+	// waiting canceled
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, _, err = keys.reserve(ctx, "other", tx1)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
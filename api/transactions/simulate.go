@@ -0,0 +1,218 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package transactions
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/usage"
+	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/runtime"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/trie"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/xenv"
+)
+
+// maxBundleSize max count of txs in a bundle to simulate.
+const maxBundleSize = 64
+
+// Bundle ordered txs to simulate, each in any format accepted by tx submission.
+// Unsigned tx should come with 'origin', as it can't be recovered from signature.
+type Bundle struct {
+	Transactions []json.RawMessage `json:"transactions"`
+}
+
+type unsignedTxWithOrigin struct {
+	UnSignedTx
+	Origin thor.Address `json:"origin"`
+}
+
+// SimulatedTx result of a tx in the bundle.
+type SimulatedTx struct {
+	ID        thor.Bytes32   `json:"id"`
+	Error     string         `json:"error"` // non-empty if the tx can't be executed, e.g. insufficient energy
+	Receipt   *Receipt       `json:"receipt"`
+	StateDiff []*AccountDiff `json:"stateDiff"`
+}
+
+// AccountDiff values of account changed by the tx.
+// Balance, energy and master are present if any field of the account changed.
+type AccountDiff struct {
+	Address thor.Address                  `json:"address"`
+	Balance *math.HexOrDecimal256         `json:"balance,omitempty"`
+	Energy  *math.HexOrDecimal256         `json:"energy,omitempty"`
+	Master  *thor.Address                 `json:"master,omitempty"`
+	Code    *string                       `json:"code,omitempty"`
+	Storage map[thor.Bytes32]thor.Bytes32 `json:"storage,omitempty"`
+}
+
+// decodeBundleTx decodes the tx with its origin.
+func decodeBundleTx(data json.RawMessage) (*tx.Transaction, thor.Address, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, thor.Address{}, err
+	}
+	var (
+		trx *tx.Transaction
+		err error
+	)
+	reader := bytes.NewReader(data)
+	switch {
+	case hasKey(m, "raw"):
+		var rawTx *RawTx
+		if err := utils.ParseJSON(reader, &rawTx); err != nil {
+			return nil, thor.Address{}, err
+		}
+		trx, err = rawTx.decode()
+	case hasKey(m, "signature"):
+		var stx *SignedTx
+		if err := utils.ParseJSON(reader, &stx); err != nil {
+			return nil, thor.Address{}, err
+		}
+		trx, err = stx.decode()
+	default:
+		var ustx *unsignedTxWithOrigin
+		if err := utils.ParseJSON(reader, &ustx); err != nil {
+			return nil, thor.Address{}, err
+		}
+		if !hasKey(m, "origin") {
+			return nil, thor.Address{}, errors.New("origin: required for unsigned tx")
+		}
+		trx, err = ustx.decode()
+		return trx, ustx.Origin, err
+	}
+	if err != nil {
+		return nil, thor.Address{}, err
+	}
+	origin, err := trx.Signer()
+	if err != nil {
+		return nil, thor.Address{}, errors.WithMessage(err, "signature")
+	}
+	return trx, origin, nil
+}
+
+// simulate executes txs in order, upon the state of the block, in context of the next block.
+// Txs can't be executed are skipped, without affecting later ones.
+func (t *Transactions) simulate(req *http.Request, txs []*tx.Transaction, origins []thor.Address, header *block.Header) ([]*SimulatedTx, error) {
+	st, err := t.stateCreator.NewState(header.StateRoot())
+	if err != nil {
+		return nil, err
+	}
+	next := &xenv.BlockContext{
+		Beneficiary: header.Beneficiary(),
+		Number:      header.Number() + 1,
+		Time:        header.Timestamp() + thor.BlockInterval,
+		GasLimit:    header.GasLimit(),
+		TotalScore:  header.TotalScore(),
+	}
+	rt := runtime.New(t.chain.NewSeeker(header.ID()), st, next)
+
+	results := make([]*SimulatedTx, 0, len(txs))
+	for i, trx := range txs {
+		checkpoint := st.NewCheckpoint()
+		executed, err := rt.ExecuteTransactionAs(req.Context(), trx, origins[i])
+		if err := req.Context().Err(); err != nil {
+			return nil, err
+		}
+		if err := rt.Seeker().Err(); err != nil {
+			return nil, err
+		}
+		if err := st.Err(); err != nil {
+			return nil, err
+		}
+		if err != nil {
+			st.RevertTo(checkpoint)
+			results = append(results, &SimulatedTx{ID: trx.ID(), Error: err.Error()})
+			continue
+		}
+		usage.AddGas(req.Context(), executed.Receipt.GasUsed)
+		// id of the block unknown yet
+		meta := LogMeta{BlockNumber: next.Number, BlockTimestamp: next.Time, TxID: trx.ID(), TxOrigin: origins[i]}
+		results = append(results, &SimulatedTx{
+			ID:        trx.ID(),
+			Receipt:   convertReceipt(executed.Receipt, meta, trx),
+			StateDiff: convertDiff(executed.Diff, st, next.Time),
+		})
+	}
+	return results, nil
+}
+
+// convertDiff converts changes of accounts into values of the current state, sorted by address.
+func convertDiff(diff state.Diff, st *state.State, blockTime uint64) []*AccountDiff {
+	accDiffs := make([]*AccountDiff, 0, len(diff))
+	for addr, d := range diff {
+		accDiff := &AccountDiff{Address: addr}
+		if d.Account != nil {
+			balance := math.HexOrDecimal256(*st.GetBalance(addr))
+			energy := math.HexOrDecimal256(*st.GetEnergy(addr, blockTime))
+			master := st.GetMaster(addr)
+			accDiff.Balance, accDiff.Energy, accDiff.Master = &balance, &energy, &master
+		}
+		if d.Code != nil {
+			code := hexutil.Encode(d.Code)
+			accDiff.Code = &code
+		}
+		if len(d.Storage) > 0 {
+			accDiff.Storage = make(map[thor.Bytes32]thor.Bytes32, len(d.Storage))
+			for key := range d.Storage {
+				accDiff.Storage[key] = st.GetStorage(addr, key)
+			}
+		}
+		accDiffs = append(accDiffs, accDiff)
+	}
+	sort.Slice(accDiffs, func(i, j int) bool {
+		return bytes.Compare(accDiffs[i].Address[:], accDiffs[j].Address[:]) < 0
+	})
+	return accDiffs
+}
+
+func (t *Transactions) handleSimulate(w http.ResponseWriter, req *http.Request) error {
+	var bundle Bundle
+	if err := utils.ParseJSON(req.Body, &bundle); err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "body"))
+	}
+	if len(bundle.Transactions) > maxBundleSize {
+		return utils.BadRequest(errors.Errorf("transactions: too many txs, should be no more than %v", maxBundleSize))
+	}
+	header, err := utils.ParseRevision(t.chain, t.finality, req.URL.Query().Get("revision"))
+	if err != nil {
+		return err
+	}
+
+	txs := make([]*tx.Transaction, len(bundle.Transactions))
+	origins := make([]thor.Address, len(bundle.Transactions))
+	totalGas := new(big.Int)
+	for i, data := range bundle.Transactions {
+		if txs[i], origins[i], err = decodeBundleTx(data); err != nil {
+			return utils.BadRequest(errors.WithMessage(err, fmt.Sprintf("transactions[%d]", i)))
+		}
+		totalGas.Add(totalGas, new(big.Int).SetUint64(txs[i].Gas()))
+	}
+	if totalGas.Cmp(new(big.Int).SetUint64(t.callGasLimit)) > 0 {
+		return utils.Forbidden(errors.New("transactions: total gas exceeds limit"))
+	}
+
+	results, err := t.simulate(req, txs, origins, header)
+	if err != nil {
+		if trie.IsMissingNode(err) {
+			return utils.BadRequest(errors.WithMessage(err,
+				fmt.Sprintf("revision: state of block %v unavailable, may be pruned", header.ID())))
+		}
+		return err
+	}
+	return utils.WriteJSON(w, results)
+}
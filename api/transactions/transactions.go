@@ -7,9 +7,11 @@ package transactions
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -17,20 +19,35 @@ import (
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/api/utils"
 	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/finality"
+	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
 	"github.com/vechain/thor/txpool"
 )
 
+const (
+	defaultWaitTimeout = 30 * time.Second
+	maxWaitTimeout     = 5 * time.Minute
+)
+
 type Transactions struct {
-	chain *chain.Chain
-	pool  *txpool.TxPool
+	chain        *chain.Chain
+	stateCreator *state.Creator
+	pool         *txpool.TxPool
+	finality     *finality.Finality
+	callGasLimit uint64
+	keys         *idempotencyKeys
 }
 
-func New(chain *chain.Chain, pool *txpool.TxPool) *Transactions {
+func New(chain *chain.Chain, stateCreator *state.Creator, pool *txpool.TxPool, finality *finality.Finality, callGasLimit uint64) *Transactions {
 	return &Transactions{
 		chain,
+		stateCreator,
 		pool,
+		finality,
+		callGasLimit,
+		newIdempotencyKeys(),
 	}
 }
 
@@ -104,8 +121,63 @@ func (t *Transactions) getTransactionReceiptByID(txID thor.Bytes32, blockID thor
 	if err != nil {
 		return nil, err
 	}
-	return convertReceipt(receipt, h, tx)
+	return ConvertReceipt(receipt, h, tx)
 }
+func (t *Transactions) getTransactionProof(txID thor.Bytes32, blockID thor.Bytes32) (*TxProof, error) {
+	txMeta, err := t.chain.GetTransactionMeta(txID, blockID)
+	if err != nil {
+		if t.chain.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	block, err := t.chain.GetBlock(txMeta.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := t.chain.GetBlockReceipts(txMeta.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	txs := block.Transactions()
+	index := int(txMeta.Index)
+	txProof, err := txs.Proof(index)
+	if err != nil {
+		return nil, err
+	}
+	receiptProof, err := receipts.Proof(index)
+	if err != nil {
+		return nil, err
+	}
+	header := block.Header()
+	rawHeader, err := rlp.EncodeToBytes(header)
+	if err != nil {
+		return nil, err
+	}
+	rawTx, err := rlp.EncodeToBytes(txs[index])
+	if err != nil {
+		return nil, err
+	}
+	rawReceipt, err := rlp.EncodeToBytes(receipts[index])
+	if err != nil {
+		return nil, err
+	}
+	return &TxProof{
+		Header: ProofHeader{
+			ID:           header.ID(),
+			Number:       header.Number(),
+			TxsRoot:      header.TxsRoot(),
+			ReceiptsRoot: header.ReceiptsRoot(),
+			Raw:          hexutil.Encode(rawHeader),
+		},
+		Index:        txMeta.Index,
+		Tx:           hexutil.Encode(rawTx),
+		TxProof:      encodeProof(txProof),
+		Receipt:      hexutil.Encode(rawReceipt),
+		ReceiptProof: encodeProof(receiptProof),
+	}, nil
+}
+
 func (t *Transactions) handleSendTransaction(w http.ResponseWriter, req *http.Request) error {
 	data, err := ioutil.ReadAll(req.Body)
 	if err != nil {
@@ -118,18 +190,58 @@ func (t *Transactions) handleSendTransaction(w http.ResponseWriter, req *http.Re
 	if m == nil {
 		return utils.BadRequest(errors.New("body: empty body"))
 	}
+	wait, timeout, err := parseWait(req)
+	if err != nil {
+		return err
+	}
 	var sendTx = func(tx *tx.Transaction) error {
-		if err := t.pool.Add(tx); err != nil {
-			if txpool.IsBadTx(err) {
-				return utils.BadRequest(err)
+		key := req.Header.Get(idempotencyKeyHeader)
+		var entry *idempotencyEntry
+		reserved := true
+		if key != "" {
+			// keys are scoped per client, so that clients can't collide or probe each other's keys
+			key = utils.ClientOf(req) + "\x00" + key
+			var err error
+			if entry, reserved, err = t.keys.reserve(req.Context(), key, tx.ID()); err != nil {
+				return err
 			}
-			if txpool.IsTxRejected(err) {
-				return utils.Forbidden(err)
+			if entry.txID != tx.ID() {
+				return utils.HTTPError(errors.Errorf("idempotency key already used by tx %v", entry.txID), http.StatusConflict)
 			}
+		}
+		// resubmission with the same idempotency key gets the original response
+		if reserved {
+			err := t.submit(tx)
+			if entry != nil {
+				t.keys.resolve(key, entry, err == nil)
+			}
+			if err != nil {
+				if txpool.IsBadTx(err) {
+					return utils.WriteJSONWithStatus(w, http.StatusBadRequest, &RejectedTx{txpool.Reason(err), err.Error()})
+				}
+				if txpool.IsTxRejected(err) {
+					return utils.WriteJSONWithStatus(w, http.StatusForbidden, &RejectedTx{txpool.Reason(err), err.Error()})
+				}
+				return err
+			}
+		}
+		if !wait {
+			return utils.WriteJSON(w, map[string]string{
+				"id": tx.ID().String(),
+			})
+		}
+		receipt, err := t.waitForReceipt(req.Context(), tx.ID(), timeout)
+		if err != nil {
 			return err
 		}
-		return utils.WriteJSON(w, map[string]string{
-			"id": tx.ID().String(),
+		if receipt == nil {
+			return utils.WriteJSONWithStatus(w, http.StatusRequestTimeout, map[string]string{
+				"id": tx.ID().String(),
+			})
+		}
+		return utils.WriteJSON(w, map[string]interface{}{
+			"id":      tx.ID().String(),
+			"receipt": receipt,
 		})
 	}
 	reader := bytes.NewReader(data)
@@ -168,6 +280,68 @@ func (t *Transactions) handleSendTransaction(w http.ResponseWriter, req *http.Re
 	}
 }
 
+// submit adds the tx into pool, unless it's already included.
+// Tx included must have been accepted before, so it's not an error to resubmit it.
+func (t *Transactions) submit(tx *tx.Transaction) error {
+	if _, err := t.chain.GetTransactionMeta(tx.ID(), t.chain.BestBlock().Header().ID()); err != nil {
+		if !t.chain.IsNotFound(err) {
+			return err
+		}
+		return t.pool.AddLocal(tx)
+	}
+	return nil
+}
+
+// parseWait parses 'wait' and 'timeout' query of tx submission.
+func parseWait(req *http.Request) (bool, time.Duration, error) {
+	query := req.URL.Query()
+	wait := query.Get("wait")
+	if wait != "" && wait != "false" && wait != "true" {
+		return false, 0, utils.BadRequest(errors.WithMessage(errors.New("should be boolean"), "wait"))
+	}
+	timeout := defaultWaitTimeout
+	if s := query.Get("timeout"); s != "" {
+		var err error
+		if timeout, err = time.ParseDuration(s); err != nil {
+			return false, 0, utils.BadRequest(errors.WithMessage(err, "timeout"))
+		}
+		if timeout <= 0 || timeout > maxWaitTimeout {
+			return false, 0, utils.BadRequest(errors.Errorf("timeout: should be positive and no more than %v", maxWaitTimeout))
+		}
+	}
+	// the response should be written before the API timeout
+	if deadline, ok := req.Context().Deadline(); ok {
+		if remaining := time.Until(deadline); timeout >= remaining {
+			if query.Get("timeout") != "" {
+				return false, 0, utils.BadRequest(errors.New("timeout: should be less than the API timeout"))
+			}
+			timeout = remaining - remaining/10
+		}
+	}
+	return wait == "true", timeout, nil
+}
+
+// waitForReceipt waits until the tx included on trunk, by watching head block change.
+// Nil receipt returned if timed out.
+func (t *Transactions) waitForReceipt(ctx context.Context, txID thor.Bytes32, timeout time.Duration) (*Receipt, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		ticker := t.chain.NewTicker()
+		receipt, err := t.getTransactionReceiptByID(txID, t.chain.BestBlock().Header().ID())
+		if err != nil || receipt != nil {
+			return receipt, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+			return nil, nil
+		case <-ticker.C():
+		}
+	}
+}
+
 func (t *Transactions) handleGetTransactionByID(w http.ResponseWriter, req *http.Request) error {
 	id := mux.Vars(req)["id"]
 	txID, err := thor.ParseBytes32(id)
@@ -225,9 +399,71 @@ func (t *Transactions) handleGetTransactionReceiptByID(w http.ResponseWriter, re
 	if err != nil {
 		return err
 	}
+	if receipt != nil {
+		// receipt in finalized block never changes
+		finalized, err := t.finality.Finalized()
+		if err != nil {
+			return err
+		}
+		if receipt.Meta.BlockNumber <= finalized.Number() {
+			ancestorID, err := t.chain.GetAncestorBlockID(finalized.ID(), receipt.Meta.BlockNumber)
+			if err != nil {
+				return err
+			}
+			if ancestorID == receipt.Meta.BlockID {
+				return utils.WriteImmutableJSON(w, req, receipt)
+			}
+		}
+	}
 	return utils.WriteJSON(w, receipt)
 }
 
+func (t *Transactions) handleGetTransactionProof(w http.ResponseWriter, req *http.Request) error {
+	id := mux.Vars(req)["id"]
+	txID, err := thor.ParseBytes32(id)
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "id"))
+	}
+	head, err := t.parseHead(req.URL.Query().Get("head"))
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "head"))
+	}
+	h, err := t.chain.GetBlockHeader(head)
+	if err != nil {
+		if t.chain.IsNotFound(err) {
+			return utils.BadRequest(errors.WithMessage(err, "head"))
+		}
+		return err
+	}
+	proof, err := t.getTransactionProof(txID, h.ID())
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, proof)
+}
+
+func (t *Transactions) handleGetPoolStats(w http.ResponseWriter, req *http.Request) error {
+	stats := t.pool.Stats()
+	return utils.WriteJSON(w, &PoolStats{
+		Total:      stats.Total,
+		Executable: stats.Executable,
+		Accounts:   stats.Accounts,
+		Limit:      stats.Limit,
+	})
+}
+
+func (t *Transactions) handleGetLocalTxs(w http.ResponseWriter, req *http.Request) error {
+	locals, err := t.pool.Locals()
+	if err != nil {
+		return err
+	}
+	txs := make([]*LocalTx, 0, len(locals))
+	for _, local := range locals {
+		txs = append(txs, &LocalTx{local.Tx.ID(), local.Status})
+	}
+	return utils.WriteJSON(w, txs)
+}
+
 func (t *Transactions) parseHead(head string) (thor.Bytes32, error) {
 	if head == "" {
 		return t.chain.BestBlock().Header().ID(), nil
@@ -243,6 +479,10 @@ func (t *Transactions) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
 
 	sub.Path("").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(t.handleSendTransaction))
+	sub.Path("/simulate").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(t.handleSimulate))
+	sub.Path("/pool/locals").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(t.handleGetLocalTxs))
+	sub.Path("/pool/stats").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(t.handleGetPoolStats))
 	sub.Path("/{id}").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(t.handleGetTransactionByID))
 	sub.Path("/{id}/receipt").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(t.handleGetTransactionReceiptByID))
+	sub.Path("/{id}/proof").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(t.handleGetTransactionProof))
 }
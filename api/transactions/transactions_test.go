@@ -7,8 +7,10 @@ package transactions_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io/ioutil"
+	"math"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
@@ -21,14 +23,18 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"github.com/vechain/thor/api/transactions"
+	"github.com/vechain/thor/api/usage"
+	"github.com/vechain/thor/api/utils"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/finality"
 	"github.com/vechain/thor/genesis"
 	"github.com/vechain/thor/logdb"
 	"github.com/vechain/thor/lvldb"
 	"github.com/vechain/thor/packer"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/trie"
 	"github.com/vechain/thor/tx"
 	"github.com/vechain/thor/txpool"
 )
@@ -36,13 +42,23 @@ import (
 var c *chain.Chain
 var ts *httptest.Server
 var transaction *tx.Transaction
+var meter = usage.NewMeter()
+
+const apiTimeout = 10 * time.Second
 
 func TestTransaction(t *testing.T) {
 	initTransactionServer(t)
 	defer ts.Close()
 	getTx(t)
 	getTxReceipt(t)
+	getTxProof(t)
 	senTx(t)
+	sendRejectedTx(t)
+	sendIdempotentTx(t)
+	sendTxAndWait(t)
+	simulateBundle(t)
+	getPoolStats(t)
+	getLocalTxs(t)
 }
 
 func getTx(t *testing.T) {
@@ -74,6 +90,26 @@ func getTxReceipt(t *testing.T) {
 	assert.Equal(t, uint64(receipt.GasUsed), transaction.Gas(), "gas should be equal")
 }
 
+func getTxProof(t *testing.T) {
+	r := httpGet(t, ts.URL+"/transactions/"+transaction.ID().String()+"/proof")
+	var proof *transactions.TxProof
+	if err := json.Unmarshal(r, &proof); err != nil {
+		t.Fatal(err)
+	}
+	var nodes [][]byte
+	for _, node := range proof.TxProof {
+		nodes = append(nodes, hexutil.MustDecode(node))
+	}
+	data, err := trie.VerifyDerivedProof(proof.Header.TxsRoot, int(proof.Index), nodes)
+	assert.Nil(t, err)
+	assert.Equal(t, hexutil.MustDecode(proof.Tx), data)
+
+	var header block.Header
+	assert.Nil(t, rlp.DecodeBytes(hexutil.MustDecode(proof.Header.Raw), &header))
+	assert.Equal(t, proof.Header.ID, header.ID())
+	assert.Equal(t, proof.Header.ReceiptsRoot, header.ReceiptsRoot())
+}
+
 func senTx(t *testing.T) {
 	var blockRef = tx.NewBlockRef(0)
 	var chainTag = c.Tag()
@@ -126,6 +162,205 @@ func senTx(t *testing.T) {
 	assert.Equal(t, tx.ID().String(), txObj["id"], "should be the same transaction id")
 }
 
+func sendRejectedTx(t *testing.T) {
+	tx := new(tx.Builder).
+		ChainTag(c.Tag() + 1).
+		Expiration(10).
+		Gas(21000).
+		Build()
+	sig, err := crypto.Sign(tx.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rlpTx, err := rlp.EncodeToBytes(tx.WithSignature(sig))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := httpPost(t, ts.URL+"/transactions", transactions.RawTx{Raw: hexutil.Encode(rlpTx)})
+	var rejected transactions.RejectedTx
+	if err = json.Unmarshal(res, &rejected); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, txpool.ReasonChainTagMismatch, rejected.Reason)
+}
+
+func sendIdempotentTx(t *testing.T) {
+	send := func(trx *tx.Transaction, key string, client ...string) (int, []byte) {
+		rlpTx, err := rlp.EncodeToBytes(trx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := json.Marshal(transactions.RawTx{Raw: hexutil.Encode(rlpTx)})
+		req, _ := http.NewRequest("POST", ts.URL+"/transactions", bytes.NewReader(data))
+		req.Header.Set("x-idempotency-key", key)
+		if len(client) > 0 {
+			req.Header.Set("x-test-client", client[0])
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, err := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.StatusCode, r
+	}
+
+	// tx already included
+	status, res := send(transaction, "")
+	assert.Equal(t, http.StatusOK, status)
+	var txObj map[string]string
+	if err := json.Unmarshal(res, &txObj); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, transaction.ID().String(), txObj["id"])
+
+	status, _ = send(transaction, "key")
+	assert.Equal(t, http.StatusOK, status)
+	status, _ = send(transaction, "key")
+	assert.Equal(t, http.StatusOK, status)
+
+	// key used by another tx
+	other := new(tx.Builder).ChainTag(c.Tag()).Expiration(10).Gas(21000).Nonce(100).Build()
+	sig, err := crypto.Sign(other.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other = other.WithSignature(sig)
+	status, _ = send(other, "key")
+	assert.Equal(t, http.StatusConflict, status)
+
+	// keys scoped per client
+	status, res = send(other, "key", "another")
+	assert.Equal(t, http.StatusOK, status, string(res))
+	status, _ = send(transaction, "key", "another")
+	assert.Equal(t, http.StatusConflict, status)
+}
+
+func sendTxAndWait(t *testing.T) {
+	rlpTx, err := rlp.EncodeToBytes(transaction)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := httpPost(t, ts.URL+"/transactions?wait=true&timeout=1s", transactions.RawTx{Raw: hexutil.Encode(rlpTx)})
+	var result struct {
+		ID      thor.Bytes32
+		Receipt *transactions.Receipt
+	}
+	if err := json.Unmarshal(res, &result); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, transaction.ID(), result.ID)
+	assert.NotNil(t, result.Receipt)
+	assert.Equal(t, transaction.ID(), result.Receipt.Meta.TxID)
+
+	for _, timeout := range []string{"1h", "10s"} {
+		res = httpPost(t, ts.URL+"/transactions?wait=true&timeout="+timeout, transactions.RawTx{Raw: hexutil.Encode(rlpTx)})
+		assert.Contains(t, string(res), "timeout", timeout)
+	}
+
+	// timed out waiting for the tx in pool
+	pending := new(tx.Builder).ChainTag(c.Tag()).Expiration(10).Gas(21000).Nonce(100).Build()
+	sig, err := crypto.Sign(pending.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pending = pending.WithSignature(sig)
+	rlpTx, err = rlp.EncodeToBytes(pending)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := json.Marshal(transactions.RawTx{Raw: hexutil.Encode(rlpTx)})
+	r, err := http.Post(ts.URL+"/transactions?wait=true&timeout=100ms", "application/json", bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Body.Close()
+	assert.Equal(t, http.StatusRequestTimeout, r.StatusCode)
+	var timedOut map[string]string
+	assert.Nil(t, json.NewDecoder(r.Body).Decode(&timedOut))
+	assert.Equal(t, pending.ID().String(), timedOut["id"])
+}
+
+func simulateBundle(t *testing.T) {
+	to := thor.BytesToAddress([]byte("bundle"))
+	signed := new(tx.Builder).
+		ChainTag(c.Tag()).
+		Expiration(10).
+		Gas(21000).
+		Nonce(1).
+		Clause(tx.NewClause(&to).WithValue(big.NewInt(1))).
+		Build()
+	sig, err := crypto.Sign(signed.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rlpTx, err := rlp.EncodeToBytes(signed.WithSignature(sig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	unsigned := map[string]interface{}{
+		"chainTag":   c.Tag(),
+		"blockRef":   "0x0000000000000000",
+		"expiration": 10,
+		"clauses":    []interface{}{map[string]interface{}{"to": to, "value": "0x1", "data": "0x"}},
+		"gas":        21000,
+		"origin":     genesis.DevAccounts()[1].Address,
+	}
+
+	gas := meter.Get("test").Gas
+	res := httpPost(t, ts.URL+"/transactions/simulate", map[string]interface{}{
+		"transactions": []interface{}{transactions.RawTx{Raw: hexutil.Encode(rlpTx)}, unsigned},
+	})
+	var results []*transactions.SimulatedTx
+	if err := json.Unmarshal(res, &results); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(results))
+	for i, result := range results {
+		assert.Empty(t, result.Error)
+		assert.False(t, result.Receipt.Reverted)
+		// effects of the previous tx are visible
+		for _, diff := range result.StateDiff {
+			if diff.Address == to {
+				assert.Equal(t, int64(i+1), (*big.Int)(diff.Balance).Int64())
+			}
+		}
+	}
+	assert.Equal(t, genesis.DevAccounts()[1].Address, results[1].Receipt.Meta.TxOrigin)
+	assert.Equal(t, gas+results[0].Receipt.GasUsed+results[1].Receipt.GasUsed, meter.Get("test").Gas, "simulated gas metered")
+
+	delete(unsigned, "origin")
+	res = httpPost(t, ts.URL+"/transactions/simulate", map[string]interface{}{
+		"transactions": []interface{}{unsigned},
+	})
+	assert.Contains(t, string(res), "origin")
+}
+
+func getPoolStats(t *testing.T) {
+	res := httpGet(t, ts.URL+"/transactions/pool/stats")
+	var stats transactions.PoolStats
+	if err := json.Unmarshal(res, &stats); err != nil {
+		t.Fatal(err)
+	}
+	// the tx sent and the one sent with idempotency key
+	assert.Equal(t, 2, stats.Total)
+	assert.Equal(t, 1, stats.Accounts)
+}
+
+func getLocalTxs(t *testing.T) {
+	res := httpGet(t, ts.URL+"/transactions/pool/locals")
+	var locals []*transactions.LocalTx
+	if err := json.Unmarshal(res, &locals); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(locals))
+	assert.Equal(t, txpool.LocalTxPending, locals[0].Status)
+}
+
 func httpPost(t *testing.T, url string, obj interface{}) []byte {
 	data, err := json.Marshal(obj)
 	if err != nil {
@@ -208,8 +443,17 @@ func initTransactionServer(t *testing.T) {
 		t.Fatal(err)
 	}
 	router := mux.NewRouter()
-	transactions.New(c, txpool.New(c, stateC, txpool.Options{Limit: 10000, LimitPerAccount: 16, MaxLifetime: 10 * time.Minute})).Mount(router, "/transactions")
-	ts = httptest.NewServer(router)
+	transactions.New(c, stateC, txpool.New(c, stateC, txpool.Options{Limit: 10000, LimitPerAccount: 16, MaxLifetime: 10 * time.Minute}), finality.New(c, stateC), math.MaxUint64).Mount(router, "/transactions")
+	// clients identified by header for tests, and requests limited by API timeout as of the node
+	withClient := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if client := req.Header.Get("x-test-client"); client != "" {
+			req = utils.WithClient(req, client)
+		}
+		ctx, cancel := context.WithTimeout(req.Context(), apiTimeout)
+		defer cancel()
+		router.ServeHTTP(w, req.WithContext(ctx))
+	})
+	ts = httptest.NewServer(meter.Handler(withClient, func(*http.Request) string { return "test" }))
 
 }
 
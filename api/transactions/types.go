@@ -91,6 +91,9 @@ func (ustx *UnSignedTx) decode() (*tx.Transaction, error) {
 			return nil, errors.WithMessage(err, "data")
 		}
 		v := big.Int(clause.Value)
+		if !thor.IsUint256(&v) {
+			return nil, errors.New("value: must be non-negative and within 256 bits")
+		}
 		txBuilder.Clause(tx.NewClause(clause.To).WithData(data).WithValue(&v))
 	}
 	blockRef, err := hexutil.Decode(ustx.BlockRef)
@@ -227,27 +230,31 @@ type Transfer struct {
 	Amount    *math.HexOrDecimal256 `json:"amount"`
 }
 
-//ConvertReceipt convert a raw clause into a jason format clause
-func convertReceipt(txReceipt *tx.Receipt, header *block.Header, tx *tx.Transaction) (*Receipt, error) {
-	reward := math.HexOrDecimal256(*txReceipt.Reward)
-	paid := math.HexOrDecimal256(*txReceipt.Paid)
+// ConvertReceipt convert a raw receipt into a json format receipt.
+func ConvertReceipt(txReceipt *tx.Receipt, header *block.Header, tx *tx.Transaction) (*Receipt, error) {
 	signer, err := tx.Signer()
 	if err != nil {
 		return nil, err
 	}
+	return convertReceipt(txReceipt, LogMeta{
+		header.ID(),
+		header.Number(),
+		header.Timestamp(),
+		tx.ID(),
+		signer,
+	}, tx), nil
+}
+
+func convertReceipt(txReceipt *tx.Receipt, meta LogMeta, tx *tx.Transaction) *Receipt {
+	reward := math.HexOrDecimal256(*txReceipt.Reward)
+	paid := math.HexOrDecimal256(*txReceipt.Paid)
 	receipt := &Receipt{
 		GasUsed:  txReceipt.GasUsed,
 		GasPayer: txReceipt.GasPayer,
 		Paid:     &paid,
 		Reward:   &reward,
 		Reverted: txReceipt.Reverted,
-		Meta: LogMeta{
-			header.ID(),
-			header.Number(),
-			header.Timestamp(),
-			tx.ID(),
-			signer,
-		},
+		Meta:     meta,
 	}
 	receipt.Outputs = make([]*Output, len(txReceipt.Outputs))
 	for i, output := range txReceipt.Outputs {
@@ -283,5 +290,54 @@ func convertReceipt(txReceipt *tx.Receipt, header *block.Header, tx *tx.Transact
 		}
 		receipt.Outputs[i] = otp
 	}
-	return receipt, nil
+	return receipt
+}
+
+// RejectedTx responded when tx is rejected by tx pool.
+type RejectedTx struct {
+	Reason string `json:"reason"`
+	Error  string `json:"error"`
+}
+
+// PoolStats statistics of tx pool.
+type PoolStats struct {
+	Total      int `json:"total"`
+	Executable int `json:"executable"`
+	Accounts   int `json:"accounts"`
+	Limit      int `json:"limit"`
+}
+
+// LocalTx tx submitted via this node, and its current status.
+type LocalTx struct {
+	ID     thor.Bytes32 `json:"id"`
+	Status string       `json:"status"`
+}
+
+// TxProof merkle proofs of inclusion of the tx and its receipt in the block.
+// Proofs are lists of rlp encoded trie nodes, keyed by rlp encoded index.
+type TxProof struct {
+	Header       ProofHeader `json:"header"`
+	Index        uint64      `json:"index"`
+	Tx           string      `json:"tx"`
+	TxProof      []string    `json:"txProof"`
+	Receipt      string      `json:"receipt"`
+	ReceiptProof []string    `json:"receiptProof"`
+}
+
+// ProofHeader header of the block which includes the tx.
+// Raw is the rlp encoded signed header, to verify roots and signer.
+type ProofHeader struct {
+	ID           thor.Bytes32 `json:"id"`
+	Number       uint32       `json:"number"`
+	TxsRoot      thor.Bytes32 `json:"txsRoot"`
+	ReceiptsRoot thor.Bytes32 `json:"receiptsRoot"`
+	Raw          string       `json:"raw"`
+}
+
+func encodeProof(proof [][]byte) []string {
+	nodes := make([]string, 0, len(proof))
+	for _, node := range proof {
+		nodes = append(nodes, hexutil.Encode(node))
+	}
+	return nodes
 }
@@ -12,22 +12,41 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/logdb"
 )
 
 type Transfers struct {
-	db *logdb.LogDB
+	chain *chain.Chain
+	db    *logdb.LogDB
 }
 
-func New(db *logdb.LogDB) *Transfers {
+func New(chain *chain.Chain, db *logdb.LogDB) *Transfers {
 	return &Transfers{
+		chain,
 		db,
 	}
 }
 
+// query queries transfers, with time range converted into block number range.
+func (t *Transfers) query(ctx context.Context, filter *logdb.TransferFilter) ([]*logdb.Transfer, error) {
+	var err error
+	if filter.Range, err = utils.ConvertRange(t.chain, filter.Range); err != nil {
+		return nil, err
+	}
+	transfers, err := t.db.FilterTransfers(ctx, filter)
+	if err != nil {
+		if logdb.IsPruned(err) {
+			return nil, utils.BadRequest(err)
+		}
+		return nil, err
+	}
+	return transfers, nil
+}
+
 //Filter query logs with option
 func (t *Transfers) filter(ctx context.Context, filter *logdb.TransferFilter) ([]*FilteredTransfer, error) {
-	transfers, err := t.db.FilterTransfers(ctx, filter)
+	transfers, err := t.query(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -43,6 +62,9 @@ func (t *Transfers) handleFilterTransferLogs(w http.ResponseWriter, req *http.Re
 	if err := utils.ParseJSON(req.Body, &filter); err != nil {
 		return utils.BadRequest(errors.WithMessage(err, "body"))
 	}
+	if format := utils.ExportFormat(req); format != "" {
+		return t.export(req.Context(), w, &filter, format)
+	}
 	tLogs, err := t.filter(req.Context(), &filter)
 	if err != nil {
 		return err
@@ -50,6 +72,27 @@ func (t *Transfers) handleFilterTransferLogs(w http.ResponseWriter, req *http.Re
 	return utils.WriteJSON(w, tLogs)
 }
 
+// export streams matched transfers in the format, page by page, each page after the last transfer of
+// the previous one.
+func (t *Transfers) export(ctx context.Context, w http.ResponseWriter, filter *logdb.TransferFilter, format string) error {
+	return utils.Export(w, format, csvHeader, filter.Options, func(offset, limit uint64) ([]utils.ExportRecord, error) {
+		paged := *filter
+		paged.Options = &logdb.Options{Offset: offset, Limit: limit}
+		transfers, err := t.query(ctx, &paged)
+		if err != nil {
+			return nil, err
+		}
+		records := make([]utils.ExportRecord, len(transfers))
+		for i, trans := range transfers {
+			records[i] = convertTransfer(trans)
+		}
+		if n := len(transfers); n > 0 {
+			filter.After = &logdb.Cursor{BlockNumber: transfers[n-1].BlockNumber, Index: transfers[n-1].Index}
+		}
+		return records, nil
+	})
+}
+
 func (t *Transfers) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
 
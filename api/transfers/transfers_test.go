@@ -7,18 +7,26 @@ package transfers_test
 
 import (
 	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"io/ioutil"
+	"math"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"github.com/vechain/thor/api/transfers"
 	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
 	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
 )
@@ -29,6 +37,38 @@ func TestTransfers(t *testing.T) {
 	initLogServer(t)
 	defer ts.Close()
 	getTransfers(t)
+	exportTransfers(t)
+}
+
+func TestTransfersTimeRange(t *testing.T) {
+	ts0 := initTimedLogServer(t)
+	defer ts.Close()
+
+	tests := []struct {
+		from, to uint64
+		want     int
+	}{
+		{ts0, ts0 + 100, 11},
+		{ts0 + 15, ts0 + 45, 3},
+		{ts0 + 20, ts0 + 20, 1},
+		{ts0 + 11, ts0 + 19, 0},
+		{ts0 + 85, math.MaxUint64, 2},
+		{ts0 + 101, math.MaxUint64, 0},
+		{0, ts0 - 1, 0},
+	}
+	for _, tt := range tests {
+		tf := &logdb.TransferFilter{
+			Range: &logdb.Range{Unit: logdb.Time, From: tt.from, To: tt.to},
+		}
+		var tLogs []*transfers.FilteredTransfer
+		if err := json.Unmarshal(httpPost(t, ts.URL+"/logs/transfer", tf), &tLogs); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tt.want, len(tLogs), "range [%v, %v]", tt.from, tt.to)
+		for _, tLog := range tLogs {
+			assert.True(t, tLog.Meta.BlockTimestamp >= tt.from && tLog.Meta.BlockTimestamp <= tt.to)
+		}
+	}
 }
 
 func getTransfers(t *testing.T) {
@@ -61,6 +101,45 @@ func getTransfers(t *testing.T) {
 	assert.Equal(t, limit, len(tLogs), "should be `limit` transfers")
 }
 
+func exportTransfers(t *testing.T) {
+	tf := &logdb.TransferFilter{
+		Options: &logdb.Options{
+			Offset: 10,
+			Limit:  50,
+		},
+	}
+	data, _ := json.Marshal(tf)
+
+	post := func(accept string) (string, string) {
+		req, _ := http.NewRequest("POST", ts.URL+"/logs/transfer", bytes.NewReader(data))
+		req.Header.Set("Accept", accept)
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		body, _ := ioutil.ReadAll(res.Body)
+		return res.Header.Get("Content-Type"), string(body)
+	}
+
+	contentType, body := post("application/x-ndjson")
+	assert.Equal(t, "application/x-ndjson", contentType)
+	lines := strings.Split(strings.TrimSpace(body), "\n")
+	assert.Equal(t, 50, len(lines))
+	var first transfers.FilteredTransfer
+	assert.Nil(t, json.Unmarshal([]byte(lines[0]), &first))
+	// transfers are in blocks from number 2
+	assert.Equal(t, uint32(12), first.Meta.BlockNumber)
+
+	contentType, body = post("text/csv")
+	assert.Equal(t, "text/csv; charset=utf-8", contentType)
+	records, err := csv.NewReader(strings.NewReader(body)).ReadAll()
+	assert.Nil(t, err)
+	assert.Equal(t, 51, len(records), "header and rows")
+	assert.Equal(t, "amount", records[0][7])
+	assert.Equal(t, "10", records[1][7])
+}
+
 func initLogServer(t *testing.T) {
 	db, err := logdb.NewMem()
 	if err != nil {
@@ -86,8 +165,55 @@ func initLogServer(t *testing.T) {
 	}
 
 	router := mux.NewRouter()
-	transfers.New(db).Mount(router, "/logs/transfer")
+	transfers.New(nil, db).Mount(router, "/logs/transfer")
+	ts = httptest.NewServer(router)
+}
+
+// initTimedLogServer serves transfers of a chain, with one transfer per block, blocks packed every 10 seconds.
+// Timestamp of the genesis block returned.
+func initTimedLogServer(t *testing.T) uint64 {
+	kv, _ := lvldb.NewMem()
+	b0, _, err := genesis.NewDevnet().Build(state.NewCreator(kv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch, err := chain.New(kv, b0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := logdb.NewMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+	transLog := &tx.Transfer{Amount: big.NewInt(10)}
+	insert := func(header *block.Header) {
+		if err := db.Prepare(header).ForTransaction(thor.Bytes32{}, thor.Address{}).
+			Insert(nil, tx.Transfers{transLog}).Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	insert(b0.Header())
+	parent := b0.Header()
+	for i := 0; i < 10; i++ {
+		b := new(block.Builder).
+			ParentID(parent.ID()).
+			TotalScore(parent.TotalScore() + 1).
+			Timestamp(parent.Timestamp() + 10).
+			Build()
+		sig, _ := crypto.Sign(b.Header().SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+		b = b.WithSignature(sig)
+		if _, err := ch.AddBlock(b, nil); err != nil {
+			t.Fatal(err)
+		}
+		insert(b.Header())
+		parent = b.Header()
+	}
+
+	router := mux.NewRouter()
+	transfers.New(ch, db).Mount(router, "/logs/transfer")
 	ts = httptest.NewServer(router)
+	return b0.Header().Timestamp()
 }
 
 func httpPost(t *testing.T, url string, obj interface{}) []byte {
@@ -6,6 +6,9 @@
 package transfers
 
 import (
+	"math/big"
+	"strconv"
+
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/vechain/thor/api/transactions"
 	"github.com/vechain/thor/logdb"
@@ -34,3 +37,20 @@ func convertTransfer(transfer *logdb.Transfer) *FilteredTransfer {
 		},
 	}
 }
+
+// csvHeader header of exported transfers in CSV.
+var csvHeader = []string{"blockID", "blockNumber", "blockTimestamp", "txID", "txOrigin", "sender", "recipient", "amount"}
+
+// CSVRecord implements utils.ExportRecord. Amount is in decimal.
+func (t *FilteredTransfer) CSVRecord() []string {
+	return []string{
+		t.Meta.BlockID.String(),
+		strconv.FormatUint(uint64(t.Meta.BlockNumber), 10),
+		strconv.FormatUint(t.Meta.BlockTimestamp, 10),
+		t.Meta.TxID.String(),
+		t.Meta.TxOrigin.String(),
+		t.Sender.String(),
+		t.Recipient.String(),
+		(*big.Int)(t.Amount).String(),
+	}
+}
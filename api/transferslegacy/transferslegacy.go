@@ -12,23 +12,33 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/logdb"
 )
 
 type TransfersLegacy struct {
-	db *logdb.LogDB
+	chain *chain.Chain
+	db    *logdb.LogDB
 }
 
-func New(db *logdb.LogDB) *TransfersLegacy {
+func New(chain *chain.Chain, db *logdb.LogDB) *TransfersLegacy {
 	return &TransfersLegacy{
+		chain,
 		db,
 	}
 }
 
 //Filter query logs with option
 func (t *TransfersLegacy) filter(ctx context.Context, filter *logdb.TransferFilter) ([]*FilteredTransfer, error) {
+	var err error
+	if filter.Range, err = utils.ConvertRange(t.chain, filter.Range); err != nil {
+		return nil, err
+	}
 	transfers, err := t.db.FilterTransfers(ctx, filter)
 	if err != nil {
+		if logdb.IsPruned(err) {
+			return nil, utils.BadRequest(err)
+		}
 		return nil, err
 	}
 	tLogs := make([]*FilteredTransfer, len(transfers))
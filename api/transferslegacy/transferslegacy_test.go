@@ -86,7 +86,7 @@ func initLogServer(t *testing.T) {
 	}
 
 	router := mux.NewRouter()
-	transferslegacy.New(db).Mount(router, "/logs/transfers")
+	transferslegacy.New(nil, db).Mount(router, "/logs/transfers")
 	ts = httptest.NewServer(router)
 }
 
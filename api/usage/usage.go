@@ -0,0 +1,123 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package usage
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// Usage of APIs by an API key.
+type Usage struct {
+	Calls uint64 `json:"calls"`
+	Gas   uint64 `json:"gas"`   // gas of contract calls, tx simulations and tracing
+	Bytes uint64 `json:"bytes"` // bytes of responses served
+}
+
+type contextKey struct{}
+
+// AddGas accounts gas simulated for the request to the key it belongs to.
+// It's no-op if the request is not metered.
+func AddGas(ctx context.Context, gas uint64) {
+	if u, ok := ctx.Value(contextKey{}).(*Usage); ok {
+		atomic.AddUint64(&u.Gas, gas)
+	}
+}
+
+// Meter accounts usage of APIs per key.
+// It's thread-safe.
+type Meter struct {
+	lock   sync.Mutex
+	usages map[string]*Usage
+}
+
+// NewMeter creates a meter.
+func NewMeter() *Meter {
+	return &Meter{usages: make(map[string]*Usage)}
+}
+
+// Get returns usage of the key.
+func (m *Meter) Get(key string) Usage {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if u, ok := m.usages[key]; ok {
+		return *u
+	}
+	return Usage{}
+}
+
+// All returns usages of all keys.
+func (m *Meter) All() map[string]Usage {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	all := make(map[string]Usage, len(m.usages))
+	for key, u := range m.usages {
+		all[key] = *u
+	}
+	return all
+}
+
+func (m *Meter) add(key string, u *Usage) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	total, ok := m.usages[key]
+	if !ok {
+		total = &Usage{}
+		m.usages[key] = total
+	}
+	total.Calls += u.Calls
+	total.Gas += atomic.LoadUint64(&u.Gas)
+	total.Bytes += u.Bytes
+}
+
+// Handler returns the middleware to account requests to the keys returned by keyOf.
+// Requests without key are not accounted.
+func (m *Meter) Handler(h http.Handler, keyOf func(*http.Request) string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := keyOf(r)
+		if key == "" {
+			h.ServeHTTP(w, r)
+			return
+		}
+		u := &Usage{Calls: 1}
+		cw := &countingWriter{w, u}
+		defer m.add(key, u)
+		h.ServeHTTP(cw, r.WithContext(context.WithValue(r.Context(), contextKey{}, u)))
+	})
+}
+
+// countingWriter counts bytes written.
+type countingWriter struct {
+	http.ResponseWriter
+	usage *Usage
+}
+
+func (w *countingWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.usage.Bytes += uint64(n)
+	return n, err
+}
+
+// Flush implements http.Flusher, for streaming responses.
+func (w *countingWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker, for websocket connections.
+// Bytes written to hijacked connections are not counted.
+func (w *countingWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if h, ok := w.ResponseWriter.(http.Hijacker); ok {
+		return h.Hijack()
+	}
+	return nil, nil, errors.New("hijack not supported")
+}
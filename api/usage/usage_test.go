@@ -0,0 +1,36 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package usage_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/api/usage"
+)
+
+func TestMeter(t *testing.T) {
+	meter := usage.NewMeter()
+	h := meter.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		usage.AddGas(r.Context(), 100)
+		w.Write([]byte("hello"))
+	}), func(r *http.Request) string {
+		return r.Header.Get("x-api-key")
+	})
+
+	for _, key := range []string{"a", "a", "b", ""} {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.Header.Set("x-api-key", key)
+		h.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	assert.Equal(t, usage.Usage{Calls: 2, Gas: 200, Bytes: 10}, meter.Get("a"))
+	assert.Equal(t, usage.Usage{Calls: 1, Gas: 100, Bytes: 5}, meter.Get("b"))
+	assert.Equal(t, usage.Usage{}, meter.Get("c"))
+	assert.Equal(t, 2, len(meter.All()), "requests without key not accounted")
+}
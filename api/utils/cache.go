@@ -0,0 +1,69 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package utils
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/vechain/thor/thor"
+)
+
+// immutableCacheControl lets caches in between keep the response for a year without revalidation.
+const immutableCacheControl = "public, max-age=31536000, immutable"
+
+// privateImmutableCacheControl lets only the client keep the response.
+const privateImmutableCacheControl = "private, max-age=31536000, immutable"
+
+type privateCacheKey struct{}
+
+// WithPrivateCache returns the request, to which responses are cached only by the client,
+// e.g. if the request is authenticated, so that caches in between never serve them to others.
+func WithPrivateCache(req *http.Request) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), privateCacheKey{}, true))
+}
+
+// WriteImmutableJSON responses an object never changes in JSON encoding, with a strong ETag and
+// cache-control headers, so that it can be cached by CDNs and proxies, or by the client only if
+// the request is marked by WithPrivateCache.
+// 304 Not Modified is responded if the ETag matches 'If-None-Match' of the request.
+func WriteImmutableJSON(w http.ResponseWriter, req *http.Request, obj interface{}) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return HTTPError(err, 500)
+	}
+	hash := thor.Blake2b(data)
+	etag := `"` + hex.EncodeToString(hash[:16]) + `"`
+
+	w.Header().Set("ETag", etag)
+	if private, _ := req.Context().Value(privateCacheKey{}).(bool); private {
+		w.Header().Set("Cache-Control", privateImmutableCacheControl)
+	} else {
+		w.Header().Set("Cache-Control", immutableCacheControl)
+	}
+	if matchETag(req.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	}
+	w.Header().Set("Content-Type", JSONContentType)
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+	return nil
+}
+
+// matchETag weakly compares etag with the list in 'If-None-Match' header, as RFC 7232 requires.
+func matchETag(ifNoneMatch string, etag string) bool {
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+	return false
+}
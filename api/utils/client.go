@@ -0,0 +1,31 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package utils
+
+import (
+	"context"
+	"net"
+	"net/http"
+)
+
+type clientKey struct{}
+
+// WithClient returns the request identified as from the client, e.g. by the token authenticated.
+func WithClient(req *http.Request, client string) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), clientKey{}, client))
+}
+
+// ClientOf returns the client of the request, which is set by WithClient, or the remote IP otherwise.
+func ClientOf(req *http.Request) string {
+	if client, ok := req.Context().Value(clientKey{}).(string); ok {
+		return client
+	}
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package utils
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"math"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/vechain/thor/logdb"
+)
+
+// content types of export
+const (
+	NDJSONContentType = "application/x-ndjson"
+	CSVContentType    = "text/csv; charset=utf-8"
+)
+
+// exportPageSize count of records fetched at a time when exporting.
+const exportPageSize = 1000
+
+// ExportRecord record to be exported, encoded in JSON as a line of NDJSON.
+type ExportRecord interface {
+	// CSVRecord returns fields of the record as a CSV row.
+	CSVRecord() []string
+}
+
+// ExportFormat returns the content type of export accepted by the request, i.e. NDJSON or CSV.
+// Empty string returned if neither accepted.
+func ExportFormat(req *http.Request) string {
+	for _, accept := range strings.Split(req.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case NDJSONContentType:
+			return NDJSONContentType
+		case "text/csv":
+			return CSVContentType
+		}
+	}
+	return ""
+}
+
+// ExportErrorTrailer trailer to carry the error ending the export, after the response started.
+const ExportErrorTrailer = "X-Thor-Export-Error"
+
+// Export streams records in the format, fetching page by page in the range of options.
+// Only the first page is fetched with the offset of options, and the following pages with offset 0,
+// so fetch is expected to continue after the last record fetched, i.e. paging by keyset, which
+// costs the same for deep pages.
+// A page is fetched only after the previous one written and flushed, so that slow clients
+// hold back fetching, rather than piling records up in memory.
+// Errors after the response started can't be responded, so they're put in the ExportErrorTrailer
+// trailer, which is absent if the export completed.
+func Export(
+	w http.ResponseWriter,
+	format string,
+	csvHeader []string,
+	options *logdb.Options,
+	fetch func(offset, limit uint64) ([]ExportRecord, error),
+) error {
+	offset, limit := uint64(0), uint64(math.MaxUint64)
+	if options != nil {
+		offset, limit = options.Offset, options.Limit
+	}
+
+	var (
+		started   bool
+		csvWriter *csv.Writer
+		encoder   *json.Encoder
+	)
+	start := func() {
+		started = true
+		w.Header().Set("Content-Type", format)
+		w.Header().Set("Trailer", ExportErrorTrailer)
+		w.WriteHeader(http.StatusOK)
+		if format == CSVContentType {
+			csvWriter = csv.NewWriter(w)
+			csvWriter.Write(csvHeader)
+		} else {
+			encoder = json.NewEncoder(w)
+		}
+	}
+
+	for limit > 0 {
+		size := uint64(exportPageSize)
+		if limit < size {
+			size = limit
+		}
+		records, err := fetch(offset, size)
+		if err != nil {
+			if !started {
+				return err
+			}
+			log.Debug("export ended by error", "err", err)
+			w.Header().Set(ExportErrorTrailer, err.Error())
+			return nil
+		}
+		if !started {
+			start()
+		}
+		for _, r := range records {
+			if csvWriter != nil {
+				err = csvWriter.Write(r.CSVRecord())
+			} else {
+				err = encoder.Encode(r)
+			}
+			if err != nil {
+				// client gone
+				return nil
+			}
+		}
+		if csvWriter != nil {
+			csvWriter.Flush()
+			if csvWriter.Error() != nil {
+				return nil
+			}
+		}
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+		if uint64(len(records)) < size {
+			break
+		}
+		offset = 0
+		limit -= size
+	}
+	if !started {
+		start()
+		if csvWriter != nil {
+			csvWriter.Flush()
+		}
+	}
+	return nil
+}
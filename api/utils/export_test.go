@@ -0,0 +1,65 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package utils
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/logdb"
+)
+
+type testRecord int
+
+func (r testRecord) CSVRecord() []string {
+	return []string{strconv.Itoa(int(r))}
+}
+
+func TestExport(t *testing.T) {
+	// fetches records from n, failing at fail if positive
+	newFetch := func(n, fail int, offsets *[]uint64) func(offset, limit uint64) ([]ExportRecord, error) {
+		next := 0
+		return func(offset, limit uint64) ([]ExportRecord, error) {
+			*offsets = append(*offsets, offset)
+			next += int(offset)
+			var records []ExportRecord
+			for uint64(len(records)) < limit && next < n {
+				if next == fail {
+					return nil, errors.New("fetch failed")
+				}
+				records = append(records, testRecord(next))
+				next++
+			}
+			return records, nil
+		}
+	}
+
+	// only the first page fetched with offset
+	var offsets []uint64
+	rec := httptest.NewRecorder()
+	assert.Nil(t, Export(rec, CSVContentType, []string{"n"}, &logdb.Options{Offset: 10, Limit: 2500}, newFetch(5000, -1, &offsets)))
+	lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+	assert.Equal(t, 2501, len(lines))
+	assert.Equal(t, "10", lines[1])
+	assert.Equal(t, "2509", lines[2500])
+	assert.Equal(t, []uint64{10, 0, 0}, offsets)
+	assert.Equal(t, "", rec.Result().Trailer.Get(ExportErrorTrailer))
+
+	// error before started
+	rec = httptest.NewRecorder()
+	assert.NotNil(t, Export(rec, NDJSONContentType, nil, nil, newFetch(5000, 0, &offsets)))
+
+	// error after started
+	rec = httptest.NewRecorder()
+	assert.Nil(t, Export(rec, NDJSONContentType, nil, nil, newFetch(5000, 1500, &offsets)))
+	lines = strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+	assert.Equal(t, 1000, len(lines))
+	assert.Equal(t, "fetch failed", rec.Result().Trailer.Get(ExportErrorTrailer))
+}
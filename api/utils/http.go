@@ -9,8 +9,12 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+
+	"github.com/inconshreveable/log15"
 )
 
+var log = log15.New("pkg", "api")
+
 type httpError struct {
 	cause  error
 	status int
@@ -61,6 +65,7 @@ func WrapHandlerFunc(f HandlerFunc) http.HandlerFunc {
 					w.WriteHeader(he.status)
 				}
 			} else {
+				log.Warn("failed to handle request", "method", r.Method, "url", r.URL.String(), "err", err)
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 			}
 		}
@@ -82,11 +87,17 @@ func ParseJSON(r io.Reader, v interface{}) error {
 
 // WriteJSON reponse a object in JSON enconding.
 func WriteJSON(w http.ResponseWriter, obj interface{}) error {
+	return WriteJSONWithStatus(w, http.StatusOK, obj)
+}
+
+// WriteJSONWithStatus reponse a object in JSON enconding, with the given http status code.
+func WriteJSONWithStatus(w http.ResponseWriter, status int, obj interface{}) error {
 	data, err := json.Marshal(obj)
 	if err != nil {
 		return HTTPError(err, 500)
 	}
 	w.Header().Set("Content-Type", JSONContentType)
+	w.WriteHeader(status)
 	w.Write(data)
 	return nil
 }
@@ -0,0 +1,43 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package utils
+
+import (
+	"math"
+
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/logdb"
+)
+
+// emptyRange block number range matches nothing, as block number never reaches it.
+var emptyRange = &logdb.Range{Unit: logdb.Block, From: math.MaxUint32, To: math.MaxUint32}
+
+// ConvertRange converts time range into block number range via trunk headers, so that logs are
+// queried by indexed block number. Block number range is returned as is.
+func ConvertRange(chain *chain.Chain, r *logdb.Range) (*logdb.Range, error) {
+	if r == nil || r.Unit != logdb.Time {
+		return r, nil
+	}
+	from, err := chain.FindTrunkBlockNumber(r.From)
+	if err != nil {
+		return nil, err
+	}
+	to := uint64(math.MaxUint32) // no upper bound
+	if r.To >= r.From && r.To < math.MaxUint64 {
+		next, err := chain.FindTrunkBlockNumber(r.To + 1)
+		if err != nil {
+			return nil, err
+		}
+		if next == 0 {
+			return emptyRange, nil
+		}
+		to = uint64(next) - 1
+	}
+	if to < uint64(from) {
+		return emptyRange, nil
+	}
+	return &logdb.Range{Unit: logdb.Block, From: uint64(from), To: to}, nil
+}
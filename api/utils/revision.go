@@ -0,0 +1,57 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package utils
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/finality"
+	"github.com/vechain/thor/thor"
+)
+
+// ParseRevision parses the revision into header of the block, which can be 'best' (default),
+// 'finalized', block id or number of trunk block.
+func ParseRevision(chain *chain.Chain, finality *finality.Finality, revision string) (*block.Header, error) {
+	if revision == "" || revision == "best" {
+		return chain.BestBlock().Header(), nil
+	}
+	if revision == "finalized" {
+		return finality.Finalized()
+	}
+	if len(revision) == 66 || len(revision) == 64 {
+		blockID, err := thor.ParseBytes32(revision)
+		if err != nil {
+			return nil, BadRequest(errors.WithMessage(err, "revision"))
+		}
+		h, err := chain.GetBlockHeader(blockID)
+		if err != nil {
+			if chain.IsNotFound(err) {
+				return nil, BadRequest(errors.WithMessage(err, "revision"))
+			}
+			return nil, err
+		}
+		return h, nil
+	}
+	n, err := strconv.ParseUint(revision, 0, 0)
+	if err != nil {
+		return nil, BadRequest(errors.WithMessage(err, "revision"))
+	}
+	if n > math.MaxUint32 {
+		return nil, BadRequest(errors.WithMessage(errors.New("block number out of max uint32"), "revision"))
+	}
+	h, err := chain.GetTrunkBlockHeader(uint32(n))
+	if err != nil {
+		if chain.IsNotFound(err) {
+			return nil, BadRequest(errors.WithMessage(err, "revision"))
+		}
+		return nil, err
+	}
+	return h, nil
+}
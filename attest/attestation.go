@@ -0,0 +1,75 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package attest
+
+import (
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/thor"
+)
+
+// Event a contract event to be attested.
+type Event struct {
+	BlockID     thor.Bytes32
+	BlockNumber uint32
+	TxID        thor.Bytes32
+	Index       uint32 // index of the event in the block
+	Address     thor.Address
+	Topics      []thor.Bytes32
+	Data        []byte
+}
+
+// Hash returns the hash of the event, which is signed by authority nodes.
+func (e *Event) Hash() thor.Bytes32 {
+	data, err := rlp.EncodeToBytes(e)
+	if err != nil {
+		panic(err)
+	}
+	return thor.Blake2b(data)
+}
+
+// Signature signature of an authority node over the event hash.
+type Signature struct {
+	Signer    thor.Address
+	Signature []byte
+}
+
+// Attestation the event along with collected signatures.
+type Attestation struct {
+	Event      Event
+	Signatures []*Signature
+}
+
+// HasSigner returns whether the signer has signed.
+func (a *Attestation) HasSigner(signer thor.Address) bool {
+	for _, s := range a.Signatures {
+		if s.Signer == signer {
+			return true
+		}
+	}
+	return false
+}
+
+type rejectedError string
+
+func (err rejectedError) Error() string {
+	return string(err)
+}
+
+// IsRejected returns whether the error indicates the signature is rejected.
+func IsRejected(err error) bool {
+	_, ok := err.(rejectedError)
+	return ok
+}
+
+// recoverSigner recovers signer of the signature over the hash.
+func recoverSigner(hash thor.Bytes32, sig []byte) (thor.Address, error) {
+	pub, err := crypto.SigToPub(hash[:], sig)
+	if err != nil {
+		return thor.Address{}, err
+	}
+	return thor.Address(crypto.PubkeyToAddress(*pub)), nil
+}
@@ -0,0 +1,391 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package attest watches events of configured contracts, signs them by node master if it's
+// an authority node, and collects signatures of other authority nodes.
+// Only events in finalized blocks are attested, so attestations are never reverted.
+package attest
+
+import (
+	"crypto/ecdsa"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/inconshreveable/log15"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/co"
+	"github.com/vechain/thor/finality"
+	"github.com/vechain/thor/kv"
+	"github.com/vechain/thor/poa"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+var log = log15.New("pkg", "attest")
+
+var (
+	attestPosPrefix  = []byte("attestpos")  // (prefix, block number, index) -> attestation
+	attestHashPrefix = []byte("attesthash") // (prefix, hash) -> (block number, index)
+	attestHeadKey    = []byte("attesthead") // number of the last processed block
+)
+
+const (
+	maxBlocksPerRound = 1000
+	pullInterval      = 10 * time.Second
+	pullPeriod        = time.Hour // to give up collecting signatures
+)
+
+// Peer a remote authority node to collect signatures from.
+type Peer interface {
+	GetSignatures(hash thor.Bytes32) ([]*Signature, error)
+}
+
+// Attester attests events of configured contracts.
+// It's thread-safe.
+type Attester struct {
+	chain        *chain.Chain
+	stateCreator *state.Creator
+	finality     *finality.Finality
+	kv           kv.GetPutter
+	keyOf        func(thor.Address) *ecdsa.PrivateKey
+	contracts    map[thor.Address]bool
+	peers        []Peer
+
+	lock    sync.Mutex
+	pending map[thor.Bytes32]time.Time // hash -> time created
+	done    chan struct{}
+	goes    co.Goes
+}
+
+// New create an attester and start it.
+// keyOf returns the master key of the node master, used to sign events if it's an authority node,
+// and can be nil.
+func New(
+	chain *chain.Chain,
+	stateCreator *state.Creator,
+	kv kv.GetPutter,
+	keyOf func(thor.Address) *ecdsa.PrivateKey,
+	contracts []thor.Address,
+	peers []Peer,
+) *Attester {
+	a := &Attester{
+		chain:        chain,
+		stateCreator: stateCreator,
+		finality:     finality.New(chain, stateCreator),
+		kv:           kv,
+		keyOf:        keyOf,
+		contracts:    make(map[thor.Address]bool),
+		peers:        peers,
+		pending:      make(map[thor.Bytes32]time.Time),
+		done:         make(chan struct{}),
+	}
+	for _, c := range contracts {
+		a.contracts[c] = true
+	}
+	a.goes.Go(a.loop)
+	return a
+}
+
+// Close stops the attester.
+func (a *Attester) Close() {
+	close(a.done)
+	a.goes.Wait()
+}
+
+func (a *Attester) loop() {
+	ticker := a.chain.NewTicker()
+	pullTicker := time.NewTicker(pullInterval)
+	defer pullTicker.Stop()
+
+	for {
+		select {
+		case <-a.done:
+			return
+		case <-ticker.C():
+			if err := a.processFinalized(); err != nil {
+				log.Warn("failed to process finalized blocks", "err", err)
+			}
+		case <-pullTicker.C:
+			a.pullSignatures()
+		}
+	}
+}
+
+// processFinalized attests events of newly finalized blocks.
+// On first run, it starts from the current finalized block, without back-filling history.
+func (a *Attester) processFinalized() error {
+	finalized, err := a.finality.Finalized()
+	if err != nil {
+		return err
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	head, found, err := a.loadHead()
+	if err != nil {
+		return err
+	}
+	if !found {
+		return a.saveHead(finalized.Number())
+	}
+	for num := head + 1; num <= finalized.Number() && num-head <= maxBlocksPerRound; num++ {
+		if err := a.processBlock(num); err != nil {
+			return err
+		}
+		if err := a.saveHead(num); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *Attester) processBlock(num uint32) error {
+	b, err := a.chain.GetTrunkBlock(num)
+	if err != nil {
+		return err
+	}
+	receipts, err := a.chain.GetBlockReceipts(b.Header().ID())
+	if err != nil {
+		return err
+	}
+
+	var (
+		index   uint32
+		signer  *ecdsa.PrivateKey
+		checked bool // whether master checked as authority
+	)
+	txs := b.Transactions()
+	for i, receipt := range receipts {
+		for _, output := range receipt.Outputs {
+			for _, ev := range output.Events {
+				index++
+				if !a.contracts[ev.Address] {
+					continue
+				}
+				if !checked && a.keyOf != nil {
+					// load authorities only if the block has events to attest
+					authorities, err := a.authorities(b.Header().StateRoot())
+					if err != nil {
+						return err
+					}
+					// the key active at the block, as the master key may be rotated
+					for addr := range authorities {
+						if key := a.keyOf(addr); key != nil && thor.Address(crypto.PubkeyToAddress(key.PublicKey)) == addr {
+							signer = key
+							break
+						}
+					}
+					checked = true
+				}
+				if err := a.attest(&Event{
+					BlockID:     b.Header().ID(),
+					BlockNumber: num,
+					TxID:        txs[i].ID(),
+					Index:       index - 1,
+					Address:     ev.Address,
+					Topics:      ev.Topics,
+					Data:        ev.Data,
+				}, signer); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (a *Attester) attest(ev *Event, signer *ecdsa.PrivateKey) error {
+	at := &Attestation{Event: *ev}
+	hash := ev.Hash()
+	if signer != nil {
+		sig, err := crypto.Sign(hash[:], signer)
+		if err != nil {
+			return err
+		}
+		at.Signatures = append(at.Signatures, &Signature{
+			thor.Address(crypto.PubkeyToAddress(signer.PublicKey)),
+			sig,
+		})
+	}
+	if err := a.save(at); err != nil {
+		return err
+	}
+	a.pending[hash] = time.Now()
+	log.Debug("event attested", "hash", hash, "number", ev.BlockNumber, "signed", signer != nil)
+	return nil
+}
+
+// authorities returns node masters of authority nodes in the state.
+func (a *Attester) authorities(root thor.Bytes32) (map[thor.Address]bool, error) {
+	st, err := a.stateCreator.NewState(root)
+	if err != nil {
+		return nil, err
+	}
+	candidates := poa.LoadCandidates(st)
+	if err := st.Err(); err != nil {
+		return nil, err
+	}
+	authorities := make(map[thor.Address]bool, len(candidates))
+	for _, c := range candidates {
+		authorities[c.NodeMaster] = true
+	}
+	return authorities, nil
+}
+
+// AddSignature adds the signature over the event hash.
+// The signer must be an authority node at the block where the event emitted.
+func (a *Attester) AddSignature(hash thor.Bytes32, sig []byte) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	at, err := a.get(hash)
+	if err != nil {
+		return err
+	}
+	if at == nil {
+		return rejectedError("attestation not found")
+	}
+	signer, err := recoverSigner(hash, sig)
+	if err != nil {
+		return rejectedError(fmt.Sprintf("invalid signature: %v", err))
+	}
+	if at.HasSigner(signer) {
+		return nil
+	}
+	header, err := a.chain.GetBlockHeader(at.Event.BlockID)
+	if err != nil {
+		return err
+	}
+	authorities, err := a.authorities(header.StateRoot())
+	if err != nil {
+		return err
+	}
+	if !authorities[signer] {
+		return rejectedError(fmt.Sprintf("signer %v is not authority", signer))
+	}
+	at.Signatures = append(at.Signatures, &Signature{signer, sig})
+	return a.save(at)
+}
+
+// pullSignatures collects signatures of pending attestations from peers.
+func (a *Attester) pullSignatures() {
+	if len(a.peers) == 0 {
+		return
+	}
+	a.lock.Lock()
+	var hashes []thor.Bytes32
+	for hash, created := range a.pending {
+		if time.Since(created) > pullPeriod {
+			delete(a.pending, hash)
+			continue
+		}
+		hashes = append(hashes, hash)
+	}
+	a.lock.Unlock()
+
+	for _, hash := range hashes {
+		for _, peer := range a.peers {
+			sigs, err := peer.GetSignatures(hash)
+			if err != nil {
+				log.Debug("failed to get signatures from peer", "hash", hash, "err", err)
+				continue
+			}
+			for _, sig := range sigs {
+				if err := a.AddSignature(hash, sig.Signature); err != nil {
+					log.Debug("invalid signature from peer", "hash", hash, "err", err)
+				}
+			}
+		}
+	}
+}
+
+// Get returns attestation by the event hash, or nil if not found.
+func (a *Attester) Get(hash thor.Bytes32) (*Attestation, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.get(hash)
+}
+
+// Range returns attestations of events emitted in blocks within the number range.
+// At most limit attestations returned.
+func (a *Attester) Range(from, to uint32, limit int) ([]*Attestation, error) {
+	var ats []*Attestation
+	iter := a.kv.NewIterator(*kv.NewRange(posKey(from, 0), posKey(to, math.MaxUint32)))
+	defer iter.Release()
+	for len(ats) < limit && iter.Next() {
+		var at Attestation
+		if err := rlp.DecodeBytes(iter.Value(), &at); err != nil {
+			return nil, err
+		}
+		ats = append(ats, &at)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	return ats, nil
+}
+
+func (a *Attester) get(hash thor.Bytes32) (*Attestation, error) {
+	pos, err := a.kv.Get(append(append([]byte(nil), attestHashPrefix...), hash[:]...))
+	if err != nil {
+		if a.kv.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	data, err := a.kv.Get(append(append([]byte(nil), attestPosPrefix...), pos...))
+	if err != nil {
+		return nil, err
+	}
+	var at Attestation
+	if err := rlp.DecodeBytes(data, &at); err != nil {
+		return nil, err
+	}
+	return &at, nil
+}
+
+func (a *Attester) save(at *Attestation) error {
+	data, err := rlp.EncodeToBytes(at)
+	if err != nil {
+		return err
+	}
+	key := posKey(at.Event.BlockNumber, at.Event.Index)
+	hash := at.Event.Hash()
+	batch := a.kv.NewBatch()
+	batch.Put(key, data)
+	batch.Put(append(append([]byte(nil), attestHashPrefix...), hash[:]...), key[len(attestPosPrefix):])
+	return batch.Write()
+}
+
+func (a *Attester) loadHead() (uint32, bool, error) {
+	data, err := a.kv.Get(attestHeadKey)
+	if err != nil {
+		if a.kv.IsNotFound(err) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	return binary.BigEndian.Uint32(data), true, nil
+}
+
+func (a *Attester) saveHead(num uint32) error {
+	var b4 [4]byte
+	binary.BigEndian.PutUint32(b4[:], num)
+	return a.kv.Put(attestHeadKey, b4[:])
+}
+
+func posKey(num uint32, index uint32) []byte {
+	key := make([]byte, len(attestPosPrefix)+8)
+	copy(key, attestPosPrefix)
+	binary.BigEndian.PutUint32(key[len(attestPosPrefix):], num)
+	binary.BigEndian.PutUint32(key[len(attestPosPrefix)+4:], index)
+	return key
+}
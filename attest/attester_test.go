@@ -0,0 +1,59 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package attest
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+func TestAddSignature(t *testing.T) {
+	db, _ := lvldb.NewMem()
+	stateC := state.NewCreator(db)
+	b0, _, _ := genesis.NewDevnet().Build(stateC)
+	c, _ := chain.New(db, b0)
+
+	a := New(c, stateC, db, nil, nil, nil)
+	defer a.Close()
+
+	ev := &Event{
+		BlockID: b0.Header().ID(),
+		Address: thor.BytesToAddress([]byte("bridge")),
+		Data:    []byte("data"),
+	}
+	hash := ev.Hash()
+	assert.Nil(t, a.save(&Attestation{Event: *ev}))
+
+	sign := func(i int) []byte {
+		sig, _ := crypto.Sign(hash[:], genesis.DevAccounts()[i].PrivateKey)
+		return sig
+	}
+
+	// the authority of devnet
+	assert.Nil(t, a.AddSignature(hash, sign(0)))
+	assert.Nil(t, a.AddSignature(hash, sign(0)), "duplicated signature should be ignored")
+	assert.True(t, IsRejected(a.AddSignature(hash, sign(1))))
+	assert.True(t, IsRejected(a.AddSignature(thor.Bytes32{}, sign(0))))
+
+	at, err := a.Get(hash)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(at.Signatures))
+	assert.True(t, at.HasSigner(genesis.DevAccounts()[0].Address))
+
+	ats, err := a.Range(0, 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(ats))
+	ats, err = a.Range(1, 10, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(ats))
+}
@@ -6,6 +6,7 @@
 package block
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"sync/atomic"
@@ -15,18 +16,22 @@ import (
 	"github.com/vechain/thor/tx"
 )
 
+var errEndorsementsEmpty = errors.New("block body: empty endorsements not omitted")
+
 // Block is an immutable block type.
 type Block struct {
-	header *Header
-	txs    tx.Transactions
-	cache  struct {
+	header       *Header
+	txs          tx.Transactions
+	endorsements Endorsements
+	cache        struct {
 		size atomic.Value
 	}
 }
 
 // Body defines body of a block.
 type Body struct {
-	Txs tx.Transactions
+	Txs          tx.Transactions
+	Endorsements Endorsements
 }
 
 // Compose compose a block with all needed components
@@ -42,8 +47,20 @@ func Compose(header *Header, txs tx.Transactions) *Block {
 // WithSignature create a new block object with signature set.
 func (b *Block) WithSignature(sig []byte) *Block {
 	return &Block{
-		header: b.header.withSignature(sig),
-		txs:    b.txs,
+		header:       b.header.withSignature(sig),
+		txs:          b.txs,
+		endorsements: b.endorsements,
+	}
+}
+
+// WithEndorsements create a new block object with endorsements set.
+// Note: The endorsements root is not updated, and this method is usually to recover a block by its portions.
+// To build up a block with endorsements, use a Builder.
+func (b *Block) WithEndorsements(endorsements Endorsements) *Block {
+	return &Block{
+		header:       b.header,
+		txs:          b.txs,
+		endorsements: append(Endorsements(nil), endorsements...),
 	}
 }
 
@@ -57,34 +74,77 @@ func (b *Block) Transactions() tx.Transactions {
 	return append(tx.Transactions(nil), b.txs...)
 }
 
+// Endorsements returns a copy of endorsements.
+func (b *Block) Endorsements() Endorsements {
+	return append(Endorsements(nil), b.endorsements...)
+}
+
 // Body returns body of a block.
 func (b *Block) Body() *Body {
-	return &Body{append(tx.Transactions(nil), b.txs...)}
+	return &Body{
+		Txs:          append(tx.Transactions(nil), b.txs...),
+		Endorsements: append(Endorsements(nil), b.endorsements...),
+	}
 }
 
 // EncodeRLP implements rlp.Encoder.
+// Blocks without endorsements keep the legacy encoding, otherwise endorsements follow the version tag.
 func (b *Block) EncodeRLP(w io.Writer) error {
+	if len(b.endorsements) == 0 {
+		return rlp.Encode(w, []interface{}{
+			b.header,
+			b.txs,
+		})
+	}
 	return rlp.Encode(w, []interface{}{
 		b.header,
 		b.txs,
+		uint(endorsementsVersion),
+		b.endorsements,
 	})
 }
 
 // DecodeRLP implements rlp.Decoder.
 func (b *Block) DecodeRLP(s *rlp.Stream) error {
 	_, size, _ := s.Kind()
-	payload := struct {
-		Header Header
-		Txs    tx.Transactions
-	}{}
 
-	if err := s.Decode(&payload); err != nil {
+	var (
+		header       Header
+		txs          tx.Transactions
+		endorsements Endorsements
+	)
+	if _, err := s.List(); err != nil {
+		return err
+	}
+	if err := s.Decode(&header); err != nil {
+		return err
+	}
+	if err := s.Decode(&txs); err != nil {
+		return err
+	}
+	// the version tag is optional, and followed by endorsements if present
+	if version, err := s.Raw(); err != rlp.EOL {
+		if err != nil {
+			return err
+		}
+		if _, err := decodeVersion("body", version, BodyVersion); err != nil {
+			return err
+		}
+		if err := s.Decode(&endorsements); err != nil {
+			return err
+		}
+		if len(endorsements) == 0 {
+			return errEndorsementsEmpty
+		}
+	}
+	if err := s.ListEnd(); err != nil {
 		return err
 	}
 
 	*b = Block{
-		header: &payload.Header,
-		txs:    payload.Txs,
+		header:       &header,
+		txs:          txs,
+		endorsements: endorsements,
 	}
 	b.cache.size.Store(metric.StorageSize(rlp.ListSize(size)))
 	return nil
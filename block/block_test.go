@@ -85,3 +85,154 @@ func TestBlock(t *testing.T) {
 	fmt.Println(b.Header().ID())
 	fmt.Println(&b)
 }
+
+func TestEndorsements(t *testing.T) {
+	tx1 := new(tx.Builder).Clause(tx.NewClause(&thor.Address{})).Build()
+	proposal := new(Builder).Transaction(tx1).Build()
+
+	key, _ := crypto.GenerateKey()
+	proof := []byte("proof")
+	sig, _ := crypto.Sign(EndorsementSigningHash(proposal.Header(), proof).Bytes(), key)
+	endorsements := Endorsements{{Proof: proof, Signature: sig}}
+	blk := new(Builder).Transaction(tx1).Endorsements(endorsements).Build()
+
+	// endorsements committed by header
+	assert.Equal(t, endorsements.RootHash(), blk.Header().EndorsementsRoot())
+	assert.NotEqual(t, proposal.Header().SigningHash(), blk.Header().SigningHash())
+	assert.Equal(t, thor.Bytes32{}, proposal.Header().EndorsementsRoot())
+
+	endorser, err := blk.Endorsements()[0].Endorser(blk.Header())
+	assert.Nil(t, err)
+	assert.Equal(t, key.PublicKey, *endorser)
+
+	data, err := rlp.EncodeToBytes(blk)
+	assert.Nil(t, err)
+
+	var decoded Block
+	assert.Nil(t, rlp.DecodeBytes(data, &decoded))
+	assert.Equal(t, blk.Header().ID(), decoded.Header().ID())
+	assert.Equal(t, blk.Header().EndorsementsRoot(), decoded.Header().EndorsementsRoot())
+	assert.Equal(t, blk.Endorsements(), decoded.Endorsements())
+
+	body, err := Raw(data).DecodeBody()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(body.Txs))
+	assert.Equal(t, blk.Endorsements(), body.Endorsements)
+
+	// blocks without endorsements keep the legacy encoding
+	legacy, _ := rlp.EncodeToBytes(proposal)
+	var fields []rlp.RawValue
+	rlp.DecodeBytes(legacy, &fields)
+	assert.Equal(t, 2, len(fields))
+	assert.Nil(t, rlp.DecodeBytes(legacy, &decoded))
+	assert.Equal(t, 0, len(decoded.Endorsements()))
+	body, err = Raw(legacy).DecodeBody()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(body.Endorsements))
+
+	// empty endorsements must be omitted
+	data, _ = rlp.EncodeToBytes([]interface{}{proposal.Header(), proposal.Transactions(), uint(1), Endorsements(nil)})
+	assert.NotNil(t, rlp.DecodeBytes(data, &decoded))
+	_, err = Raw(data).DecodeBody()
+	assert.NotNil(t, err)
+}
+
+func TestEncodingVersion(t *testing.T) {
+	blk := new(Builder).Transaction(new(tx.Builder).Build()).Build()
+
+	var fields []rlp.RawValue
+	data, _ := rlp.EncodeToBytes(blk.Header())
+	rlp.DecodeBytes(data, &fields)
+
+	var header Header
+	assert.Nil(t, rlp.DecodeBytes(data, &header))
+	assert.Equal(t, blk.Header().ID(), header.ID())
+
+	// tagged by an unknown version
+	data, _ = rlp.EncodeToBytes(append(fields, rlp.RawValue{0x02}))
+	assert.True(t, IsUnsupportedVersion(rlp.DecodeBytes(data, &header)))
+
+	data, _ = rlp.EncodeToBytes([]interface{}{blk.Header(), blk.Transactions(), uint(2), Endorsements(nil)})
+	var decoded Block
+	assert.True(t, IsUnsupportedVersion(rlp.DecodeBytes(data, &decoded)))
+	_, err := Raw(data).DecodeBody()
+	assert.True(t, IsUnsupportedVersion(err))
+
+	// version 0 must not be tagged
+	data, _ = rlp.EncodeToBytes([]interface{}{blk.Header(), blk.Transactions(), uint(0), Endorsements(nil)})
+	assert.True(t, IsUnsupportedVersion(rlp.DecodeBytes(data, &decoded)))
+}
+
+func TestHeaderExtension(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	sign := func(blk *Block) *Block {
+		sig, _ := crypto.Sign(blk.Header().SigningHash().Bytes(), key)
+		return blk.WithSignature(sig)
+	}
+	legacy := sign(new(Builder).ParentID(thor.Bytes32{0, 0, 0, 1}).Build())
+	extended := sign(new(Builder).ParentID(legacy.Header().ID()).Extension(Extension{Alpha: []byte("alpha")}).Build())
+
+	const numLegacyHeaderFields = 10
+
+	// empty extension omitted
+	var fields []rlp.RawValue
+	data, _ := rlp.EncodeToBytes(legacy.Header())
+	rlp.DecodeBytes(data, &fields)
+	assert.Equal(t, numLegacyHeaderFields, len(fields))
+	legacyID := legacy.Header().ID()
+
+	// mixed history
+	for _, blk := range []*Block{legacy, extended} {
+		data, err := rlp.EncodeToBytes(blk)
+		assert.Nil(t, err)
+		var decoded Block
+		assert.Nil(t, rlp.DecodeBytes(data, &decoded))
+		assert.Equal(t, blk.Header().ID(), decoded.Header().ID())
+		assert.Equal(t, blk.Header().Extension(), decoded.Header().Extension())
+		signer, _ := decoded.Header().Signer()
+		assert.Equal(t, thor.Address(crypto.PubkeyToAddress(key.PublicKey)), signer)
+
+		header, err := Raw(data).DecodeHeader()
+		assert.Nil(t, err)
+		assert.Equal(t, blk.Header().ID(), header.ID())
+	}
+	assert.Equal(t, legacyID, legacy.Header().ID())
+	assert.NotEqual(t,
+		new(Builder).Build().Header().SigningHash(),
+		new(Builder).Extension(Extension{COM: true}).Build().Header().SigningHash())
+
+	// trailing empty field trimmed
+	data, _ = rlp.EncodeToBytes(extended.Header())
+	fields = nil
+	rlp.DecodeBytes(data, &fields)
+	assert.Equal(t, numLegacyHeaderFields+2, len(fields))
+	var ext []rlp.RawValue
+	rlp.DecodeBytes(fields[numLegacyHeaderFields+1], &ext)
+	assert.Equal(t, 1, len(ext))
+
+	var header Header
+	decode := func(ext ...interface{}) error {
+		data, _ := rlp.EncodeToBytes(append(append([]rlp.RawValue(nil), fields[:numLegacyHeaderFields+1]...), mustEncode(ext)))
+		return rlp.DecodeBytes(data, &header)
+	}
+	assert.Nil(t, decode([]byte("alpha"), true))
+	assert.Equal(t, Extension{Alpha: []byte("alpha"), COM: true}, header.Extension())
+	assert.Nil(t, decode([]byte(nil), true))
+	assert.NotNil(t, decode([]byte("alpha"), false), "not trimmed")
+	assert.NotNil(t, decode(), "empty not omitted")
+	root := thor.BytesToBytes32([]byte("root"))
+	assert.Nil(t, decode([]byte(nil), false, root))
+	assert.Equal(t, Extension{Endorsements: root}, header.Extension())
+	assert.Equal(t, root, header.EndorsementsRoot())
+	assert.NotNil(t, decode([]byte(nil), false, []byte("root")), "malformed root")
+	assert.NotNil(t, decode([]byte(nil), false, thor.Bytes32{}), "zero root not omitted")
+	assert.NotNil(t, decode([]byte("alpha"), true, root, uint(1)), "unknown fields")
+}
+
+func mustEncode(v interface{}) rlp.RawValue {
+	data, err := rlp.EncodeToBytes(v)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
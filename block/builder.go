@@ -12,8 +12,10 @@ import (
 
 // Builder to make it easy to build a block object.
 type Builder struct {
-	headerBody headerBody
-	txs        tx.Transactions
+	headerBody   headerBody
+	ext          Extension
+	txs          tx.Transactions
+	endorsements Endorsements
 }
 
 // ParentID set parent id.
@@ -64,6 +66,21 @@ func (b *Builder) ReceiptsRoot(hash thor.Bytes32) *Builder {
 	return b
 }
 
+// Extension set header extension.
+func (b *Builder) Extension(ext Extension) *Builder {
+	b.ext = Extension{
+		Alpha: append([]byte(nil), ext.Alpha...),
+		COM:   ext.COM,
+	}
+	return b
+}
+
+// Endorsements set endorsements, whose root is committed by header extension.
+func (b *Builder) Endorsements(endorsements Endorsements) *Builder {
+	b.endorsements = append(Endorsements(nil), endorsements...)
+	return b
+}
+
 // Transaction add a transaction.
 func (b *Builder) Transaction(tx *tx.Transaction) *Builder {
 	b.txs = append(b.txs, tx)
@@ -72,11 +89,13 @@ func (b *Builder) Transaction(tx *tx.Transaction) *Builder {
 
 // Build build a block object.
 func (b *Builder) Build() *Block {
-	header := Header{body: b.headerBody}
+	header := Header{body: b.headerBody, ext: b.ext}
 	header.body.TxsRoot = b.txs.RootHash()
+	header.ext.Endorsements = b.endorsements.RootHash()
 
 	return &Block{
-		header: &header,
-		txs:    b.txs,
+		header:       &header,
+		txs:          b.txs,
+		endorsements: b.endorsements,
 	}
 }
@@ -0,0 +1,60 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package block
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/trie"
+)
+
+// Endorsement is the signature of a committee member on a block.
+// The member is selected by VRF over its master key, with parent block ID as seed.
+type Endorsement struct {
+	Proof     []byte // VRF proof of committee membership
+	Signature []byte
+}
+
+// Endorsements slice of endorsements.
+type Endorsements []*Endorsement
+
+// RootHash computes merkle root hash of endorsements.
+// It's zero if no endorsements, so that the header extension can be omitted.
+func (es Endorsements) RootHash() thor.Bytes32 {
+	if len(es) == 0 {
+		return thor.Bytes32{}
+	}
+	return trie.DeriveRoot(derivableEndorsements(es))
+}
+
+// implements types.DerivableList
+type derivableEndorsements Endorsements
+
+func (es derivableEndorsements) Len() int {
+	return len(es)
+}
+
+func (es derivableEndorsements) GetRlp(i int) []byte {
+	data, err := rlp.EncodeToBytes(es[i])
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+// EndorsementSigningHash computes hash for the endorser to sign.
+// The header is signed as proposed, that the endorsements root is excluded.
+func EndorsementSigningHash(header *Header, proof []byte) thor.Bytes32 {
+	return thor.Blake2b(header.proposalHash().Bytes(), proof)
+}
+
+// Endorser recovers public key of the endorser of the header.
+func (e *Endorsement) Endorser(header *Header) (*ecdsa.PublicKey, error) {
+	return crypto.SigToPub(EndorsementSigningHash(header, e.Proof).Bytes(), e.Signature)
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package block
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/thor"
+)
+
+// numExtensionFields count of fields of extension known by this version.
+const numExtensionFields = 3
+
+var (
+	errExtensionNotTrimmed = errors.New("header extension: trailing empty fields not trimmed")
+	errExtensionEmpty      = errors.New("header extension: empty extension not omitted")
+	errExtensionUnknown    = errors.New("header extension: unknown fields, node upgrade may be required")
+	errExtensionMalformed  = errors.New("header extension: malformed endorsements root")
+)
+
+// emptyValue RLP encoding of empty string, zero uint and false.
+var emptyValue = []byte{0x80}
+
+// Extension fields of header introduced after the legacy ones, e.g. VRF and committee data.
+//
+// It's encoded as a RLP list following version tag 1, after legacy fields of header. Trailing
+// empty fields are trimmed, and the extension is omitted entirely if all fields are empty.
+// So that headers without extension keep the legacy encoding, and hash identically.
+// Fields introduced later can only be appended.
+type Extension struct {
+	Alpha        []byte       // VRF input to select the committee, i.e. parent ID, empty if not endorsed
+	COM          bool         // whether endorsed by the committee, i.e. endorsements root not zero
+	Endorsements thor.Bytes32 // root of endorsements carried in block body, zero if none
+}
+
+// IsEmpty returns whether all fields are empty.
+func (e *Extension) IsEmpty() bool {
+	return len(e.Alpha) == 0 && !e.COM && e.Endorsements.IsZero()
+}
+
+// EncodeRLP implements rlp.Encoder.
+// Zero endorsements root is encoded as empty string, so that it can be trimmed.
+func (e *Extension) EncodeRLP(w io.Writer) error {
+	var root []byte
+	if !e.Endorsements.IsZero() {
+		root = e.Endorsements.Bytes()
+	}
+	fields := make([]rlp.RawValue, 0, numExtensionFields)
+	for _, v := range []interface{}{e.Alpha, e.COM, root} {
+		data, err := rlp.EncodeToBytes(v)
+		if err != nil {
+			return err
+		}
+		fields = append(fields, data)
+	}
+	for len(fields) > 0 && bytes.Equal(fields[len(fields)-1], emptyValue) {
+		fields = fields[:len(fields)-1]
+	}
+	return rlp.Encode(w, fields)
+}
+
+// DecodeRLP implements rlp.Decoder.
+// Trailing empty fields are rejected, to keep the encoding unique.
+func (e *Extension) DecodeRLP(s *rlp.Stream) error {
+	var fields []rlp.RawValue
+	if err := s.Decode(&fields); err != nil {
+		return err
+	}
+	if len(fields) > numExtensionFields {
+		return errExtensionUnknown
+	}
+	if len(fields) > 0 && bytes.Equal(fields[len(fields)-1], emptyValue) {
+		return errExtensionNotTrimmed
+	}
+
+	var (
+		ext  Extension
+		root []byte
+	)
+	for i, ptr := range []interface{}{&ext.Alpha, &ext.COM, &root} {
+		if i >= len(fields) {
+			break
+		}
+		if err := rlp.DecodeBytes(fields[i], ptr); err != nil {
+			return err
+		}
+	}
+	switch len(root) {
+	case 0:
+	case len(ext.Endorsements):
+		ext.Endorsements = thor.BytesToBytes32(root)
+		if ext.Endorsements.IsZero() {
+			return errExtensionMalformed
+		}
+	default:
+		return errExtensionMalformed
+	}
+	*e = ext
+	return nil
+}
@@ -7,6 +7,7 @@ package block
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 	"sync/atomic"
@@ -20,6 +21,7 @@ import (
 // It's immutable.
 type Header struct {
 	body headerBody
+	ext  Extension
 
 	cache struct {
 		signingHash atomic.Value
@@ -96,6 +98,20 @@ func (h *Header) ReceiptsRoot() thor.Bytes32 {
 	return h.body.ReceiptsRoot
 }
 
+// Extension returns a copy of header extension.
+func (h *Header) Extension() Extension {
+	return Extension{
+		Alpha:        append([]byte(nil), h.ext.Alpha...),
+		COM:          h.ext.COM,
+		Endorsements: h.ext.Endorsements,
+	}
+}
+
+// EndorsementsRoot returns merkle root of endorsements carried in block body, zero if none.
+func (h *Header) EndorsementsRoot() thor.Bytes32 {
+	return h.ext.Endorsements
+}
+
 // ID computes id of block.
 // The block ID is defined as: blockNumber + hash(signingHash, signer)[4:].
 func (h *Header) ID() (id thor.Bytes32) {
@@ -128,8 +144,7 @@ func (h *Header) SigningHash() (hash thor.Bytes32) {
 	}
 	defer func() { h.cache.signingHash.Store(hash) }()
 
-	hw := thor.NewBlake2b()
-	rlp.Encode(hw, []interface{}{
+	fields := []interface{}{
 		h.body.ParentID,
 		h.body.Timestamp,
 		h.body.GasLimit,
@@ -141,11 +156,29 @@ func (h *Header) SigningHash() (hash thor.Bytes32) {
 		h.body.TxsRoot,
 		h.body.StateRoot,
 		h.body.ReceiptsRoot,
-	})
+	}
+	if !h.ext.IsEmpty() {
+		// extension covered only if present, so legacy headers hash identically
+		fields = append(fields, &h.ext)
+	}
+
+	hw := thor.NewBlake2b()
+	rlp.Encode(hw, fields)
 	hw.Sum(hash[:0])
 	return
 }
 
+// proposalHash computes signing hash of the header, as it's proposed to committee members.
+// It excludes committee data of extension, which is filled after endorsements collected.
+func (h *Header) proposalHash() thor.Bytes32 {
+	if h.ext.IsEmpty() {
+		return h.SigningHash()
+	}
+	cpy := Header{body: h.body, ext: h.ext}
+	cpy.ext.Alpha, cpy.ext.COM, cpy.ext.Endorsements = nil, false, thor.Bytes32{}
+	return cpy.SigningHash()
+}
+
 // Signature returns signature.
 func (h *Header) Signature() []byte {
 	return append([]byte(nil), h.body.Signature...)
@@ -153,7 +186,7 @@ func (h *Header) Signature() []byte {
 
 // withSignature create a new Header object with signature set.
 func (h *Header) withSignature(sig []byte) *Header {
-	cpy := Header{body: h.body}
+	cpy := Header{body: h.body, ext: h.ext}
 	cpy.body.Signature = append([]byte(nil), sig...)
 	return &cpy
 }
@@ -185,17 +218,69 @@ func (h *Header) Signer() (signer thor.Address, err error) {
 
 // EncodeRLP implements rlp.Encoder
 func (h *Header) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, &h.body)
+	if h.ext.IsEmpty() {
+		return rlp.Encode(w, &h.body)
+	}
+	data, err := rlp.EncodeToBytes(&h.body)
+	if err != nil {
+		return err
+	}
+	var fields []rlp.RawValue
+	if err := rlp.DecodeBytes(data, &fields); err != nil {
+		return err
+	}
+	for _, v := range []interface{}{uint(extensionVersion), &h.ext} {
+		if data, err = rlp.EncodeToBytes(v); err != nil {
+			return err
+		}
+		fields = append(fields, data)
+	}
+	return rlp.Encode(w, fields)
 }
 
 // DecodeRLP implements rlp.Decoder.
 func (h *Header) DecodeRLP(s *rlp.Stream) error {
-	var body headerBody
+	raw, err := s.Raw()
+	if err != nil {
+		return err
+	}
+	content, _, err := rlp.SplitList(raw)
+	if err != nil {
+		return err
+	}
+	n, err := rlp.CountValues(content)
+	if err != nil {
+		return err
+	}
+	var ext Extension
+	if n > numLegacyHeaderFields {
+		var fields []rlp.RawValue
+		if err := rlp.DecodeBytes(raw, &fields); err != nil {
+			return err
+		}
+		if _, err := decodeVersion("header", fields[numLegacyHeaderFields], HeaderVersion); err != nil {
+			return err
+		}
+		// version 1 is followed by exactly the extension
+		if len(fields) != numLegacyHeaderFields+2 {
+			return errors.New("header extension: missing or redundant fields")
+		}
+		if err := rlp.DecodeBytes(fields[numLegacyHeaderFields+1], &ext); err != nil {
+			return err
+		}
+		if ext.IsEmpty() {
+			return errExtensionEmpty
+		}
+		if raw, err = rlp.EncodeToBytes(fields[:numLegacyHeaderFields]); err != nil {
+			return err
+		}
+	}
 
-	if err := s.Decode(&body); err != nil {
+	var body headerBody
+	if err := rlp.DecodeBytes(raw, &body); err != nil {
 		return err
 	}
-	*h = Header{body: body}
+	*h = Header{body: body, ext: ext}
 	return nil
 }
 
@@ -219,9 +304,11 @@ func (h *Header) String() string {
 	TxsRoot:		%v
 	StateRoot:		%v
 	ReceiptsRoot:	%v
+	Alpha:			0x%x
+	COM:			%v
 	Signature:		0x%x`, h.ID(), h.Number(), h.body.ParentID, h.body.Timestamp, signerStr,
 		h.body.Beneficiary, h.body.GasLimit, h.body.GasUsed, h.body.TotalScore,
-		h.body.TxsRoot, h.body.StateRoot, h.body.ReceiptsRoot, h.body.Signature)
+		h.body.TxsRoot, h.body.StateRoot, h.body.ReceiptsRoot, h.ext.Alpha, h.ext.COM, h.body.Signature)
 }
 
 // Number extract block number from block id.
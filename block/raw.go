@@ -40,9 +40,29 @@ func (r Raw) DecodeBody() (*Body, error) {
 	if err != nil {
 		return nil, err
 	}
+	_, _, rest2, err := rlp.Split(rest)
+	if err != nil {
+		return nil, err
+	}
 	var txs tx.Transactions
-	if err := rlp.Decode(bytes.NewReader(rest), &txs); err != nil {
+	if err := rlp.Decode(bytes.NewReader(rest[:len(rest)-len(rest2)]), &txs); err != nil {
 		return nil, err
 	}
-	return &Body{txs}, nil
+	var endorsements Endorsements
+	if len(rest2) > 0 {
+		_, _, rest3, err := rlp.Split(rest2)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := decodeVersion("body", rest2[:len(rest2)-len(rest3)], BodyVersion); err != nil {
+			return nil, err
+		}
+		if err := rlp.DecodeBytes(rest3, &endorsements); err != nil {
+			return nil, err
+		}
+		if len(endorsements) == 0 {
+			return nil, errEndorsementsEmpty
+		}
+	}
+	return &Body{txs, endorsements}, nil
 }
@@ -0,0 +1,59 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package block
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// Encoding versions of headers and bodies.
+//
+// Version 0 is the legacy encoding, without version tag. A later version appends the version
+// number followed by fields introduced, to the legacy RLP list. So that version 0 encoding
+// keeps unchanged, and blocks of mixed versions can be stored and decoded side by side.
+// Versions above the supported ones are rejected, rather than dropping unknown fields.
+const (
+	// HeaderVersion the highest supported version of header encoding.
+	HeaderVersion = extensionVersion
+	// BodyVersion the highest supported version of body encoding.
+	BodyVersion = endorsementsVersion
+
+	numLegacyHeaderFields = 10
+	// version of header encoding with extension
+	extensionVersion = 1
+	// version of body encoding with endorsements
+	endorsementsVersion = 1
+)
+
+type unsupportedVersionError struct {
+	component string
+	version   uint64
+}
+
+func (e unsupportedVersionError) Error() string {
+	return fmt.Sprintf("unsupported %v encoding version %v, node upgrade may be required", e.component, e.version)
+}
+
+// IsUnsupportedVersion returns whether the error is caused by unknown encoding version.
+func IsUnsupportedVersion(err error) bool {
+	_, ok := err.(unsupportedVersionError)
+	return ok
+}
+
+// decodeVersion decodes and checks the version tag.
+// Version 0 must not be tagged.
+func decodeVersion(component string, data []byte, max uint64) (uint64, error) {
+	var version uint64
+	if err := rlp.DecodeBytes(data, &version); err != nil {
+		return 0, err
+	}
+	if version == 0 || version > max {
+		return 0, unsupportedVersionError{component, version}
+	}
+	return version, nil
+}
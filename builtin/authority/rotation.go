@@ -0,0 +1,167 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package authority
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/thor"
+)
+
+var (
+	rotationsKey = thor.Blake2b([]byte("rotations"))
+
+	// rotationIntentPrefix prefixes clause data of a rotation intent.
+	rotationIntentPrefix = []byte("thor:rotate-master")
+)
+
+// Rotation pending rotation of a node master.
+type Rotation struct {
+	NodeMaster thor.Address
+	NewMaster  thor.Address
+	Activation uint32 // number of the block from which the new master takes over
+}
+
+func (a *Authority) getRotations() (rotations []*Rotation) {
+	a.state.DecodeStorage(a.addr, rotationsKey, func(raw []byte) error {
+		if len(raw) == 0 {
+			return nil
+		}
+		return rlp.DecodeBytes(raw, &rotations)
+	})
+	return
+}
+
+func (a *Authority) setRotations(rotations []*Rotation) {
+	a.state.EncodeStorage(a.addr, rotationsKey, func() ([]byte, error) {
+		if len(rotations) == 0 {
+			return nil, nil
+		}
+		return rlp.EncodeToBytes(rotations)
+	})
+}
+
+// PendingRotation returns the pending rotation of the node master, nil if none.
+func (a *Authority) PendingRotation(nodeMaster thor.Address) *Rotation {
+	for _, r := range a.getRotations() {
+		if r.NodeMaster == nodeMaster {
+			return r
+		}
+	}
+	return nil
+}
+
+// ScheduleRotation schedules the node master to be replaced by the new master at the activation block.
+// It replaces the pending rotation of the node master if any.
+// False returned if the node master not listed, or the new master already has an entry, i.e. listed or revoked.
+func (a *Authority) ScheduleRotation(nodeMaster thor.Address, newMaster thor.Address, activation uint32) bool {
+	if listed, _, _, _ := a.Get(nodeMaster); !listed {
+		return false
+	}
+	if !a.getEntry(newMaster).IsEmpty() {
+		return false
+	}
+
+	rotations := a.getRotations()
+	pending := make([]*Rotation, 0, len(rotations)+1)
+	for _, r := range rotations {
+		if r.NewMaster == newMaster && r.NodeMaster != nodeMaster {
+			// taken by other node master
+			return false
+		}
+		if r.NodeMaster != nodeMaster {
+			pending = append(pending, r)
+		}
+	}
+	a.setRotations(append(pending, &Rotation{nodeMaster, newMaster, activation}))
+	return true
+}
+
+// ActivateRotations rotates node masters whose rotations are activated at the block with given number.
+// The new master takes over the entry in place, so the candidate keeps its position, endorsor, identity
+// and status. Rotations no longer applicable are dropped.
+func (a *Authority) ActivateRotations(blockNumber uint32) (activated []*Rotation) {
+	rotations := a.getRotations()
+	if len(rotations) == 0 {
+		return nil
+	}
+	pending := make([]*Rotation, 0, len(rotations))
+	for _, r := range rotations {
+		if r.Activation > blockNumber {
+			pending = append(pending, r)
+			continue
+		}
+		if a.rotate(r.NodeMaster, r.NewMaster) {
+			activated = append(activated, r)
+		}
+	}
+	a.setRotations(pending)
+	return
+}
+
+func (a *Authority) rotate(nodeMaster thor.Address, newMaster thor.Address) bool {
+	if listed, _, _, _ := a.Get(nodeMaster); !listed {
+		return false
+	}
+	if !a.getEntry(newMaster).IsEmpty() {
+		return false
+	}
+
+	e := a.getEntry(nodeMaster)
+	if e.Prev == nil {
+		a.setAddressPtr(headKey, &newMaster)
+	} else {
+		prevEntry := a.getEntry(*e.Prev)
+		prevEntry.Next = &newMaster
+		a.setEntry(*e.Prev, prevEntry)
+	}
+	if e.Next == nil {
+		a.setAddressPtr(tailKey, &newMaster)
+	} else {
+		nextEntry := a.getEntry(*e.Next)
+		nextEntry.Prev = &newMaster
+		a.setEntry(*e.Next, nextEntry)
+	}
+
+	a.setEntry(newMaster, e)
+	a.setEntry(nodeMaster, &entry{})
+	return true
+}
+
+func rotationSigningHash(genesisID thor.Bytes32, nodeMaster thor.Address, newMaster thor.Address) thor.Bytes32 {
+	return thor.Blake2b(rotationIntentPrefix, genesisID[:], nodeMaster[:], newMaster[:])
+}
+
+// EncodeRotationIntent returns clause data of the intent to rotate the node master to the new key, i.e.
+// the new master followed by its signature, which proves possession of the new key on the chain of the genesis.
+// It should be sent in a tx by the endorsor of the node master to approve, with the only clause to the node master.
+func EncodeRotationIntent(genesisID thor.Bytes32, nodeMaster thor.Address, newKey *ecdsa.PrivateKey) ([]byte, error) {
+	newMaster := thor.Address(crypto.PubkeyToAddress(newKey.PublicKey))
+	sig, err := crypto.Sign(rotationSigningHash(genesisID, nodeMaster, newMaster).Bytes(), newKey)
+	if err != nil {
+		return nil, err
+	}
+	data := append(append([]byte(nil), rotationIntentPrefix...), newMaster[:]...)
+	return append(data, sig...), nil
+}
+
+// DecodeRotationIntent returns the new master in clause data of a rotation intent of the node master,
+// if signed by the new master for the chain of the genesis.
+func DecodeRotationIntent(genesisID thor.Bytes32, nodeMaster thor.Address, data []byte) (newMaster thor.Address, ok bool) {
+	if len(data) != len(rotationIntentPrefix)+20+65 || !bytes.HasPrefix(data, rotationIntentPrefix) {
+		return thor.Address{}, false
+	}
+	data = data[len(rotationIntentPrefix):]
+	newMaster = thor.BytesToAddress(data[:20])
+	pub, err := crypto.SigToPub(rotationSigningHash(genesisID, nodeMaster, newMaster).Bytes(), data[20:])
+	if err != nil || thor.Address(crypto.PubkeyToAddress(*pub)) != newMaster {
+		return thor.Address{}, false
+	}
+	return newMaster, true
+}
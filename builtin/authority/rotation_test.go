@@ -0,0 +1,95 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package authority
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+func TestRotation(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	st, _ := state.New(thor.Bytes32{}, kv)
+
+	p1 := thor.BytesToAddress([]byte("p1"))
+	p2 := thor.BytesToAddress([]byte("p2"))
+	p3 := thor.BytesToAddress([]byte("p3"))
+	n1 := thor.BytesToAddress([]byte("n1"))
+	n3 := thor.BytesToAddress([]byte("n3"))
+	unlisted := thor.BytesToAddress([]byte("unlisted"))
+
+	aut := New(thor.BytesToAddress([]byte("aut")), st)
+	aut.Add(p1, p1, thor.BytesToBytes32([]byte("id1")))
+	aut.Add(p2, p2, thor.Bytes32{})
+	aut.Add(p3, p3, thor.Bytes32{})
+	aut.Update(p1, false)
+
+	tests := []struct {
+		ret      interface{}
+		expected interface{}
+	}{
+		{aut.ScheduleRotation(unlisted, n1, 10), false},
+		{aut.ScheduleRotation(p1, p2, 10), false},
+		{aut.ScheduleRotation(p1, n3, 10), true},
+		{aut.ScheduleRotation(p3, n3, 20), false},
+		// replaces the pending one
+		{aut.ScheduleRotation(p1, n1, 10), true},
+		{aut.ScheduleRotation(p3, n3, 20), true},
+		{aut.PendingRotation(p1), &Rotation{p1, n1, 10}},
+		{aut.PendingRotation(p2), (*Rotation)(nil)},
+		{aut.ActivateRotations(9), ([]*Rotation)(nil)},
+		{aut.ActivateRotations(10), []*Rotation{{p1, n1, 10}}},
+		{aut.PendingRotation(p1), (*Rotation)(nil)},
+		{M(aut.Get(p1)), []interface{}{false, thor.Address{}, thor.Bytes32{}, false}},
+		{M(aut.Get(n1)), []interface{}{true, p1, thor.BytesToBytes32([]byte("id1")), false}},
+		{M(aut.Candidates(&big.Int{}, thor.MaxBlockProposers)), []interface{}{
+			[]*Candidate{{n1, p1, thor.BytesToBytes32([]byte("id1")), false}, {p2, p2, thor.Bytes32{}, true}, {p3, p3, thor.Bytes32{}, true}},
+		}},
+		// dropped if revoked before activated
+		{aut.Revoke(p3), true},
+		{aut.ActivateRotations(20), ([]*Rotation)(nil)},
+		{aut.PendingRotation(p3), (*Rotation)(nil)},
+		{M(aut.Candidates(&big.Int{}, thor.MaxBlockProposers)), []interface{}{
+			[]*Candidate{{n1, p1, thor.BytesToBytes32([]byte("id1")), false}, {p2, p2, thor.Bytes32{}, true}},
+		}},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, tt.ret)
+	}
+
+	assert.Nil(t, st.Err())
+}
+
+func TestRotationIntent(t *testing.T) {
+	genesisID := thor.BytesToBytes32([]byte("genesis"))
+	nodeMaster := thor.BytesToAddress([]byte("master"))
+	newKey, _ := crypto.GenerateKey()
+
+	data, err := EncodeRotationIntent(genesisID, nodeMaster, newKey)
+	assert.Nil(t, err)
+
+	newMaster, ok := DecodeRotationIntent(genesisID, nodeMaster, data)
+	assert.True(t, ok)
+	assert.Equal(t, thor.Address(crypto.PubkeyToAddress(newKey.PublicKey)), newMaster)
+
+	// signed for another node master
+	_, ok = DecodeRotationIntent(genesisID, thor.BytesToAddress([]byte("other")), data)
+	assert.False(t, ok)
+
+	// signed for another chain
+	_, ok = DecodeRotationIntent(thor.BytesToBytes32([]byte("other")), nodeMaster, data)
+	assert.False(t, ok)
+
+	_, ok = DecodeRotationIntent(genesisID, nodeMaster, data[1:])
+	assert.False(t, ok)
+}
@@ -85,6 +85,23 @@ func (e *Energy) TotalSupply() *big.Int {
 	return acc.CalcEnergy(e.blockTime)
 }
 
+// BurnToken records amount of VET destroyed, to keep total supply of VET and energy growth of it up to date.
+// Energy grown by the total supply so far is settled, as an account does when its balance changed.
+func (e *Energy) BurnToken(amount *big.Int) {
+	if amount.Sign() == 0 {
+		return
+	}
+	supply := e.getInitialSupply()
+	grown := e.TotalSupply()
+	e.state.EncodeStorage(e.addr, initialSupplyKey, func() ([]byte, error) {
+		return rlp.EncodeToBytes(&initialSupply{
+			Token:     new(big.Int).Sub(supply.Token, amount),
+			Energy:    grown,
+			BlockTime: e.blockTime,
+		})
+	})
+}
+
 // TotalBurned returns energy totally burned.
 func (e *Energy) TotalBurned() *big.Int {
 	total := e.getTotalAddSub()
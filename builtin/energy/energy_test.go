@@ -63,3 +63,29 @@ func TestEnergyGrowth(t *testing.T) {
 	assert.Nil(t, st.Err())
 
 }
+
+func TestBurnToken(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	st, _ := state.New(thor.Bytes32{}, kv)
+
+	vet := big.NewInt(1e18)
+	New(thor.Address{}, st, 10).SetInitialSupply(vet, big.NewInt(1))
+
+	grown := func(amount *big.Int, d uint64) *big.Int {
+		x := new(big.Int).Mul(thor.EnergyGrowthRate, amount)
+		x.Mul(x, new(big.Int).SetUint64(d))
+		return x.Div(x, big.NewInt(1e18))
+	}
+
+	eng := New(thor.Address{}, st, 1000)
+	eng.BurnToken(big.NewInt(4e17))
+	assert.Equal(t, big.NewInt(6e17), eng.TokenTotalSupply())
+	assert.Equal(t, new(big.Int).Add(big.NewInt(1), grown(vet, 1000-10)), eng.TotalSupply())
+
+	// grows by the remained supply since burned
+	want := new(big.Int).Add(big.NewInt(1), grown(vet, 1000-10))
+	want.Add(want, grown(big.NewInt(6e17), 2000-1000))
+	assert.Equal(t, want, New(thor.Address{}, st, 2000).TotalSupply())
+
+	assert.Nil(t, st.Err())
+}
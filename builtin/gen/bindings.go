@@ -0,0 +1,243 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package gen
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/vechain/thor/abi"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+// Typed bindings of builtin contracts' ABI.
+// They pack call data and unpack outputs/events without touching raw ABI bytes.
+var (
+	ParamsABI    = &ParamsBinding{mustLoadBinding("Params")}
+	AuthorityABI = &AuthorityBinding{mustLoadBinding("Authority")}
+	EnergyABI    = &EnergyBinding{mustLoadBinding("Energy")}
+	ExecutorABI  = &ExecutorBinding{mustLoadBinding("Executor")}
+)
+
+var errEventMismatch = errors.New("event mismatch")
+
+type binding struct {
+	ABI *abi.ABI
+}
+
+func mustLoadBinding(name string) binding {
+	abi, err := abi.New(MustAsset("compiled/" + name + ".abi"))
+	if err != nil {
+		panic("load ABI for '" + name + "': " + err.Error())
+	}
+	return binding{abi}
+}
+
+func (b binding) method(name string) *abi.Method {
+	m, found := b.ABI.MethodByName(name)
+	if !found {
+		panic("method not found: " + name)
+	}
+	return m
+}
+
+func (b binding) event(name string) *abi.Event {
+	e, found := b.ABI.EventByName(name)
+	if !found {
+		panic("event not found: " + name)
+	}
+	return e
+}
+
+func (b binding) pack(name string, args ...interface{}) ([]byte, error) {
+	return b.method(name).EncodeInput(args...)
+}
+
+func (b binding) unpack(name string, output []byte, v interface{}) error {
+	return b.method(name).DecodeOutput(output, v)
+}
+
+// decodeEvent checks event ID and topics count, then decodes the non-indexed data into v.
+func (b binding) decodeEvent(name string, event *tx.Event, nTopics int, v interface{}) error {
+	e := b.event(name)
+	if len(event.Topics) != nTopics || event.Topics[0] != e.ID() {
+		return errEventMismatch
+	}
+	return e.Decode(event.Data, v)
+}
+
+// ParamsBinding binding of Params contract.
+type ParamsBinding struct{ binding }
+
+// PackSet packs call data of 'set(key, value)'.
+func (p *ParamsBinding) PackSet(key thor.Bytes32, value *big.Int) ([]byte, error) {
+	return p.pack("set", key, value)
+}
+
+// PackGet packs call data of 'get(key)'.
+func (p *ParamsBinding) PackGet(key thor.Bytes32) ([]byte, error) {
+	return p.pack("get", key)
+}
+
+// UnpackGet unpacks output of 'get(key)'.
+func (p *ParamsBinding) UnpackGet(output []byte) (*big.Int, error) {
+	var value *big.Int
+	if err := p.unpack("get", output, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// DecodeSetEvent decodes event 'Set(key, value)'.
+func (p *ParamsBinding) DecodeSetEvent(event *tx.Event) (key thor.Bytes32, value *big.Int, err error) {
+	if err := p.decodeEvent("Set", event, 2, &value); err != nil {
+		return thor.Bytes32{}, nil, err
+	}
+	return event.Topics[1], value, nil
+}
+
+// AuthorityBinding binding of Authority contract.
+type AuthorityBinding struct{ binding }
+
+// PackAdd packs call data of 'add(nodeMaster, endorsor, identity)'.
+func (a *AuthorityBinding) PackAdd(nodeMaster thor.Address, endorsor thor.Address, identity thor.Bytes32) ([]byte, error) {
+	return a.pack("add", nodeMaster, endorsor, identity)
+}
+
+// PackRevoke packs call data of 'revoke(nodeMaster)'.
+func (a *AuthorityBinding) PackRevoke(nodeMaster thor.Address) ([]byte, error) {
+	return a.pack("revoke", nodeMaster)
+}
+
+// PackGet packs call data of 'get(nodeMaster)'.
+func (a *AuthorityBinding) PackGet(nodeMaster thor.Address) ([]byte, error) {
+	return a.pack("get", nodeMaster)
+}
+
+// UnpackGet unpacks output of 'get(nodeMaster)'.
+func (a *AuthorityBinding) UnpackGet(output []byte) (listed bool, endorsor thor.Address, identity thor.Bytes32, active bool, err error) {
+	var out struct {
+		Listed   bool
+		Endorsor common.Address
+		Identity common.Hash
+		Active   bool
+	}
+	if err := a.unpack("get", output, &out); err != nil {
+		return false, thor.Address{}, thor.Bytes32{}, false, err
+	}
+	return out.Listed, thor.Address(out.Endorsor), thor.Bytes32(out.Identity), out.Active, nil
+}
+
+// PackFirst packs call data of 'first()'.
+func (a *AuthorityBinding) PackFirst() ([]byte, error) {
+	return a.pack("first")
+}
+
+// UnpackFirst unpacks output of 'first()'.
+func (a *AuthorityBinding) UnpackFirst(output []byte) (thor.Address, error) {
+	var addr common.Address
+	if err := a.unpack("first", output, &addr); err != nil {
+		return thor.Address{}, err
+	}
+	return thor.Address(addr), nil
+}
+
+// PackNext packs call data of 'next(nodeMaster)'.
+func (a *AuthorityBinding) PackNext(nodeMaster thor.Address) ([]byte, error) {
+	return a.pack("next", nodeMaster)
+}
+
+// UnpackNext unpacks output of 'next(nodeMaster)'.
+func (a *AuthorityBinding) UnpackNext(output []byte) (thor.Address, error) {
+	var addr common.Address
+	if err := a.unpack("next", output, &addr); err != nil {
+		return thor.Address{}, err
+	}
+	return thor.Address(addr), nil
+}
+
+// DecodeCandidateEvent decodes event 'Candidate(nodeMaster, action)'.
+func (a *AuthorityBinding) DecodeCandidateEvent(event *tx.Event) (nodeMaster thor.Address, action thor.Bytes32, err error) {
+	var data common.Hash
+	if err := a.decodeEvent("Candidate", event, 2, &data); err != nil {
+		return thor.Address{}, thor.Bytes32{}, err
+	}
+	return thor.BytesToAddress(event.Topics[1].Bytes()), thor.Bytes32(data), nil
+}
+
+// EnergyBinding binding of Energy contract.
+type EnergyBinding struct{ binding }
+
+// PackBalanceOf packs call data of 'balanceOf(owner)'.
+func (e *EnergyBinding) PackBalanceOf(owner thor.Address) ([]byte, error) {
+	return e.pack("balanceOf", owner)
+}
+
+// UnpackBalanceOf unpacks output of 'balanceOf(owner)'.
+func (e *EnergyBinding) UnpackBalanceOf(output []byte) (*big.Int, error) {
+	var balance *big.Int
+	if err := e.unpack("balanceOf", output, &balance); err != nil {
+		return nil, err
+	}
+	return balance, nil
+}
+
+// PackTransfer packs call data of 'transfer(to, amount)'.
+func (e *EnergyBinding) PackTransfer(to thor.Address, amount *big.Int) ([]byte, error) {
+	return e.pack("transfer", to, amount)
+}
+
+// PackTotalSupply packs call data of 'totalSupply()'.
+func (e *EnergyBinding) PackTotalSupply() ([]byte, error) {
+	return e.pack("totalSupply")
+}
+
+// UnpackTotalSupply unpacks output of 'totalSupply()'.
+func (e *EnergyBinding) UnpackTotalSupply(output []byte) (*big.Int, error) {
+	var supply *big.Int
+	if err := e.unpack("totalSupply", output, &supply); err != nil {
+		return nil, err
+	}
+	return supply, nil
+}
+
+// DecodeTransferEvent decodes event 'Transfer(from, to, amount)'.
+func (e *EnergyBinding) DecodeTransferEvent(event *tx.Event) (from thor.Address, to thor.Address, amount *big.Int, err error) {
+	if err := e.decodeEvent("Transfer", event, 3, &amount); err != nil {
+		return thor.Address{}, thor.Address{}, nil, err
+	}
+	return thor.BytesToAddress(event.Topics[1].Bytes()), thor.BytesToAddress(event.Topics[2].Bytes()), amount, nil
+}
+
+// ExecutorBinding binding of Executor contract.
+type ExecutorBinding struct{ binding }
+
+// PackAddApprover packs call data of 'addApprover(approver, identity)'.
+func (e *ExecutorBinding) PackAddApprover(approver thor.Address, identity thor.Bytes32) ([]byte, error) {
+	return e.pack("addApprover", approver, identity)
+}
+
+// PackRevokeApprover packs call data of 'revokeApprover(approver)'.
+func (e *ExecutorBinding) PackRevokeApprover(approver thor.Address) ([]byte, error) {
+	return e.pack("revokeApprover", approver)
+}
+
+// PackPropose packs call data of 'propose(target, data)'.
+func (e *ExecutorBinding) PackPropose(target thor.Address, data []byte) ([]byte, error) {
+	return e.pack("propose", target, data)
+}
+
+// PackApprove packs call data of 'approve(proposalID)'.
+func (e *ExecutorBinding) PackApprove(proposalID thor.Bytes32) ([]byte, error) {
+	return e.pack("approve", proposalID)
+}
+
+// PackExecute packs call data of 'execute(proposalID)'.
+func (e *ExecutorBinding) PackExecute(proposalID thor.Bytes32) ([]byte, error) {
+	return e.pack("execute", proposalID)
+}
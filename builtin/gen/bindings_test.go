@@ -0,0 +1,95 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package gen_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/builtin/gen"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestParamsBinding(t *testing.T) {
+	key := thor.BytesToBytes32([]byte("key"))
+	value := big.NewInt(12345)
+
+	data, err := gen.ParamsABI.PackSet(key, value)
+	assert.Nil(t, err)
+
+	method, _ := gen.ParamsABI.ABI.MethodByName("set")
+	var args struct {
+		Key   common.Hash
+		Value *big.Int
+	}
+	assert.Nil(t, method.DecodeInput(data, &args))
+	assert.Equal(t, key, thor.Bytes32(args.Key))
+	assert.Equal(t, value, args.Value)
+
+	method, _ = gen.ParamsABI.ABI.MethodByName("get")
+	output, _ := method.EncodeOutput(value)
+	got, err := gen.ParamsABI.UnpackGet(output)
+	assert.Nil(t, err)
+	assert.Equal(t, value, got)
+
+	event, _ := gen.ParamsABI.ABI.EventByName("Set")
+	eventData, _ := event.Encode(value)
+	k, v, err := gen.ParamsABI.DecodeSetEvent(&tx.Event{
+		Topics: []thor.Bytes32{event.ID(), key},
+		Data:   eventData,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, key, k)
+	assert.Equal(t, value, v)
+
+	_, _, err = gen.ParamsABI.DecodeSetEvent(&tx.Event{Topics: []thor.Bytes32{{}}})
+	assert.NotNil(t, err)
+}
+
+func TestAuthorityBinding(t *testing.T) {
+	master := thor.BytesToAddress([]byte("master"))
+	endorsor := thor.BytesToAddress([]byte("endorsor"))
+	identity := thor.BytesToBytes32([]byte("identity"))
+
+	_, err := gen.AuthorityABI.PackAdd(master, endorsor, identity)
+	assert.Nil(t, err)
+
+	method, _ := gen.AuthorityABI.ABI.MethodByName("get")
+	output, _ := method.EncodeOutput(true, endorsor, identity, false)
+	listed, e, id, active, err := gen.AuthorityABI.UnpackGet(output)
+	assert.Nil(t, err)
+	assert.True(t, listed)
+	assert.Equal(t, endorsor, e)
+	assert.Equal(t, identity, id)
+	assert.False(t, active)
+
+	method, _ = gen.AuthorityABI.ABI.MethodByName("first")
+	output, _ = method.EncodeOutput(master)
+	first, err := gen.AuthorityABI.UnpackFirst(output)
+	assert.Nil(t, err)
+	assert.Equal(t, master, first)
+}
+
+func TestEnergyBinding(t *testing.T) {
+	from := thor.BytesToAddress([]byte("from"))
+	to := thor.BytesToAddress([]byte("to"))
+	amount := big.NewInt(100)
+
+	event, _ := gen.EnergyABI.ABI.EventByName("Transfer")
+	data, _ := event.Encode(amount)
+
+	f, tt, a, err := gen.EnergyABI.DecodeTransferEvent(&tx.Event{
+		Topics: []thor.Bytes32{event.ID(), thor.BytesToBytes32(from.Bytes()), thor.BytesToBytes32(to.Bytes())},
+		Data:   data,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, from, f)
+	assert.Equal(t, to, tt)
+	assert.Equal(t, amount, a)
+}
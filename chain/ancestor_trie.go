@@ -77,11 +77,7 @@ func (at *ancestorTrie) GetAncestor(descendantID thor.Bytes32, ancestorNum uint3
 		return descendantID, nil
 	}
 
-	root, err := at.rootsCache.GetOrLoad(descendantID)
-	if err != nil {
-		return thor.Bytes32{}, errors.WithMessage(err, "load index root")
-	}
-	tr, err := at.trieCache.Get(root.(thor.Bytes32), at.kv, false)
+	tr, err := at.getIndexTrie(descendantID)
 	if err != nil {
 		return thor.Bytes32{}, err
 	}
@@ -93,6 +89,45 @@ func (at *ancestorTrie) GetAncestor(descendantID thor.Bytes32, ancestorNum uint3
 	return thor.BytesToBytes32(id), nil
 }
 
+// GetAncestors batch version of GetAncestor, which resolves the index trie only once.
+func (at *ancestorTrie) GetAncestors(descendantID thor.Bytes32, ancestorNums []uint32) ([]thor.Bytes32, error) {
+	descendantNum := block.Number(descendantID)
+	for _, num := range ancestorNums {
+		if num > descendantNum {
+			return nil, errNotFound
+		}
+	}
+
+	var tr *trie.Trie
+	ids := make([]thor.Bytes32, 0, len(ancestorNums))
+	for _, num := range ancestorNums {
+		if num == descendantNum {
+			ids = append(ids, descendantID)
+			continue
+		}
+		if tr == nil {
+			var err error
+			if tr, err = at.getIndexTrie(descendantID); err != nil {
+				return nil, err
+			}
+		}
+		id, err := tr.TryGet(numberAsKey(num))
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, thor.BytesToBytes32(id))
+	}
+	return ids, nil
+}
+
+func (at *ancestorTrie) getIndexTrie(descendantID thor.Bytes32) (*trie.Trie, error) {
+	root, err := at.rootsCache.GetOrLoad(descendantID)
+	if err != nil {
+		return nil, errors.WithMessage(err, "load index root")
+	}
+	return at.trieCache.Get(root.(thor.Bytes32), at.kv, false)
+}
+
 ///
 type trieCache struct {
 	cache *lru.Cache
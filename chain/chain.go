@@ -9,7 +9,9 @@ import (
 	"bytes"
 	"sync"
 
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/inconshreveable/log15"
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/co"
@@ -23,6 +25,8 @@ const (
 	receiptsCacheLimit = 512
 )
 
+var log = log15.New("pkg", "chain")
+
 var errNotFound = errors.New("not found")
 var errBlockExist = errors.New("block already exists")
 
@@ -37,6 +41,16 @@ type Chain struct {
 	caches       caches
 	rw           sync.RWMutex
 	tick         co.Signal
+	reorgFeed    event.Feed
+	reorgs       reorgQueue
+	txAudit      bool
+}
+
+// reorgQueue queues reorg events to be sent in order, out of the chain lock.
+type reorgQueue struct {
+	lock    sync.Mutex
+	pending []*Fork
+	sending bool
 }
 
 type caches struct {
@@ -144,6 +158,16 @@ func (c *Chain) BestBlock() *block.Block {
 	return c.bestBlock
 }
 
+// SetTxAudit enables or disables auditing txs of blocks being added, which asserts that no tx
+// is included twice on a chain, to check the replay protection without nonce.
+// It scans the tx index for each tx, and is intended for dev and test.
+// Blocks failed the audit are rejected.
+func (c *Chain) SetTxAudit(enabled bool) {
+	c.rw.Lock()
+	defer c.rw.Unlock()
+	c.txAudit = enabled
+}
+
 // AddBlock add a new block into block chain.
 // Once reorg happened (len(Trunk) > 0 && len(Branch) >0), Fork.Branch will be the chain transitted from trunk to branch.
 // Reorg happens when isTrunk is true.
@@ -170,6 +194,13 @@ func (c *Chain) AddBlock(newBlock *block.Block, receipts tx.Receipts) (*Fork, er
 		return nil, err
 	}
 
+	if c.txAudit {
+		if err := c.auditTxs(newBlock); err != nil {
+			log.Error("tx audit failed", "number", newBlock.Header().Number(), "id", newBlockID, "err", err)
+			return nil, err
+		}
+	}
+
 	raw, err := rlp.EncodeToBytes(newBlock)
 	if err != nil {
 		return nil, err
@@ -229,10 +260,53 @@ func (c *Chain) AddBlock(newBlock *block.Block, receipts tx.Receipts) (*Fork, er
 	c.caches.rawBlocks.Add(newBlockID, newRawBlock(raw, newBlock))
 	c.caches.receipts.Add(newBlockID, receipts)
 
+	log.Debug("block added", "number", newBlock.Header().Number(), "id", newBlockID, "trunk", isTrunk)
+
 	c.tick.Broadcast()
+	if isTrunk && len(fork.Branch) > 0 {
+		log.Debug("chain reorganized", "ancestor", fork.Ancestor.Number(), "dropped", len(fork.Branch), "added", len(fork.Trunk))
+		// send out of lock, subscribers may access chain
+		c.queueReorg(fork)
+	}
 	return fork, nil
 }
 
+// queueReorg queues the fork to be sent, in the order forks happened.
+func (c *Chain) queueReorg(fork *Fork) {
+	q := &c.reorgs
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	q.pending = append(q.pending, fork)
+	if q.sending {
+		return
+	}
+	q.sending = true
+	go func() {
+		for {
+			q.lock.Lock()
+			if len(q.pending) == 0 {
+				q.sending = false
+				q.lock.Unlock()
+				return
+			}
+			fork := q.pending[0]
+			q.pending[0] = nil
+			q.pending = q.pending[1:]
+			q.lock.Unlock()
+
+			c.reorgFeed.Send(fork)
+		}
+	}()
+}
+
+// SubscribeReorg subscribes reorg events.
+// A fork is sent when trunk switched to a heavier branch, with Fork.Branch
+// the headers dropped from trunk, and Fork.Trunk the headers became trunk.
+func (c *Chain) SubscribeReorg(ch chan *Fork) event.Subscription {
+	return c.reorgFeed.Subscribe(ch)
+}
+
 // GetBlockHeader get block header by block id.
 func (c *Chain) GetBlockHeader(id thor.Bytes32) (*block.Header, error) {
 	c.rw.RLock()
@@ -280,6 +354,13 @@ func (c *Chain) GetAncestorBlockID(descendantID thor.Bytes32, ancestorNum uint32
 	return c.ancestorTrie.GetAncestor(descendantID, ancestorNum)
 }
 
+// GetAncestorBlockIDs batch version of GetAncestorBlockID.
+func (c *Chain) GetAncestorBlockIDs(descendantID thor.Bytes32, ancestorNums []uint32) ([]thor.Bytes32, error) {
+	c.rw.RLock()
+	defer c.rw.RUnlock()
+	return c.ancestorTrie.GetAncestors(descendantID, ancestorNums)
+}
+
 // GetTransactionMeta get transaction meta info, on the chain defined by head block ID.
 func (c *Chain) GetTransactionMeta(txID thor.Bytes32, headBlockID thor.Bytes32) (*TxMeta, error) {
 	c.rw.RLock()
@@ -352,6 +433,37 @@ func (c *Chain) GetTrunkBlockRaw(num uint32) (block.Raw, error) {
 	return raw.raw, nil
 }
 
+// FindTrunkBlockNumber returns the number of the first trunk block with timestamp not less than the given one.
+// Best block number plus one returned if no such block.
+func (c *Chain) FindTrunkBlockNumber(timestamp uint64) (uint32, error) {
+	c.rw.RLock()
+	defer c.rw.RUnlock()
+
+	best := c.bestBlock.Header()
+	if best.Timestamp() < timestamp {
+		return best.Number() + 1, nil
+	}
+	// binary search, timestamps of trunk blocks are strictly increasing
+	lo, hi := uint32(0), best.Number()
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		id, err := c.ancestorTrie.GetAncestor(best.ID(), mid)
+		if err != nil {
+			return 0, err
+		}
+		header, err := c.getBlockHeader(id)
+		if err != nil {
+			return 0, err
+		}
+		if header.Timestamp() < timestamp {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, nil
+}
+
 // GetTrunkTransactionMeta get transaction meta info on trunk by given tx id.
 func (c *Chain) GetTrunkTransactionMeta(txID thor.Bytes32) (*TxMeta, error) {
 	c.rw.RLock()
@@ -379,6 +491,9 @@ func (c *Chain) NewSeeker(headBlockID thor.Bytes32) *Seeker {
 	return newSeeker(c, headBlockID)
 }
 
+// isTrunk returns whether the header should be the new best.
+// Block with larger total score wins, and if total scores are equal, the smaller ID,
+// which is prefixed with block number, breaks the tie.
 func (c *Chain) isTrunk(header *block.Header) bool {
 	bestHeader := c.bestBlock.Header()
 
@@ -514,6 +629,30 @@ func (c *Chain) getTransactionMeta(txID thor.Bytes32, headBlockID thor.Bytes32)
 	return nil, errNotFound
 }
 
+// auditTxs checks that txs of the block are neither duplicated in the block, nor included
+// in ancestors of the block.
+func (c *Chain) auditTxs(blk *block.Block) error {
+	parentID := blk.Header().ParentID()
+	seen := make(map[thor.Bytes32]bool)
+	for _, tx := range blk.Transactions() {
+		txID := tx.ID()
+		if seen[txID] {
+			return errors.Errorf("tx audit: tx %v duplicated in block", txID)
+		}
+		seen[txID] = true
+
+		meta, err := c.getTransactionMeta(txID, parentID)
+		if err != nil {
+			if c.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		return errors.Errorf("tx audit: tx %v already included in block %v", txID, meta.BlockID)
+	}
+	return nil
+}
+
 func (c *Chain) getTransaction(blockID thor.Bytes32, index uint64) (*tx.Transaction, error) {
 	body, err := c.getBlockBody(blockID)
 	if err != nil {
@@ -7,6 +7,7 @@ package chain_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
@@ -15,6 +16,8 @@ import (
 	"github.com/vechain/thor/genesis"
 	"github.com/vechain/thor/lvldb"
 	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
 )
 
 func initChain() *chain.Chain {
@@ -74,3 +77,152 @@ func TestAdd(t *testing.T) {
 		}
 	}
 }
+
+func TestSubscribeReorg(t *testing.T) {
+	ch := initChain()
+	b0 := ch.GenesisBlock()
+	b1 := newBlock(b0, 1)
+	b2 := newBlock(b1, 1)
+	b2x := newBlock(b1, 2)
+
+	reorgCh := make(chan *chain.Fork, 1)
+	sub := ch.SubscribeReorg(reorgCh)
+	defer sub.Unsubscribe()
+
+	ch.AddBlock(b1, nil)
+	ch.AddBlock(b2, nil)
+	select {
+	case <-reorgCh:
+		t.Fatal("unexpected reorg")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	ch.AddBlock(b2x, nil)
+	select {
+	case fork := <-reorgCh:
+		assert.Equal(t, b1.Header().ID(), fork.Ancestor.ID())
+		assert.Equal(t, b2x.Header().ID(), fork.Trunk[0].ID())
+		assert.Equal(t, b2.Header().ID(), fork.Branch[0].ID())
+	case <-time.After(time.Second):
+		t.Fatal("reorg event expected")
+	}
+
+	// sent in order
+	b3 := newBlock(b2, 2)
+	b3x := newBlock(b2x, 3)
+	ch.AddBlock(b3, nil)
+	ch.AddBlock(b3x, nil)
+	for _, want := range []*block.Block{b3, b3x} {
+		select {
+		case fork := <-reorgCh:
+			assert.Equal(t, want.Header().ID(), fork.Trunk[len(fork.Trunk)-1].ID())
+		case <-time.After(time.Second):
+			t.Fatal("reorg event expected")
+		}
+	}
+}
+
+func TestSeeker(t *testing.T) {
+	ch := initChain()
+	blocks := []*block.Block{ch.GenesisBlock()}
+	for i := 0; i < 20; i++ {
+		b := newBlock(blocks[len(blocks)-1], 1)
+		if _, err := ch.AddBlock(b, nil); err != nil {
+			t.Fatal(err)
+		}
+		blocks = append(blocks, b)
+	}
+
+	head := blocks[len(blocks)-1].Header()
+	ids, err := ch.GetAncestorBlockIDs(head.ID(), []uint32{0, 10, 20})
+	assert.Nil(t, err)
+	assert.Equal(t, []thor.Bytes32{blocks[0].Header().ID(), blocks[10].Header().ID(), head.ID()}, ids)
+
+	_, err = ch.GetAncestorBlockIDs(blocks[10].Header().ID(), []uint32{11})
+	assert.True(t, ch.IsNotFound(err))
+
+	seeker := ch.NewSeeker(head.ID())
+	for i := len(blocks) - 1; i >= 0; i-- {
+		assert.Equal(t, blocks[i].Header().ID(), seeker.GetID(uint32(i)))
+	}
+	assert.Nil(t, seeker.Err())
+}
+
+func TestFindTrunkBlockNumber(t *testing.T) {
+	ch := initChain()
+	parent := ch.GenesisBlock()
+	ts0 := parent.Header().Timestamp()
+	for i := 0; i < 10; i++ {
+		b := new(block.Builder).
+			ParentID(parent.Header().ID()).
+			TotalScore(parent.Header().TotalScore() + 1).
+			Timestamp(parent.Header().Timestamp() + 10).
+			Build()
+		sig, _ := crypto.Sign(b.Header().SigningHash().Bytes(), privateKey)
+		b = b.WithSignature(sig)
+		if _, err := ch.AddBlock(b, nil); err != nil {
+			t.Fatal(err)
+		}
+		parent = b
+	}
+
+	tests := []struct {
+		timestamp uint64
+		want      uint32
+	}{
+		{0, 0},
+		{ts0, 0},
+		{ts0 + 1, 1},
+		{ts0 + 10, 1},
+		{ts0 + 55, 6},
+		{ts0 + 100, 10},
+		{ts0 + 101, 11},
+	}
+	for _, tt := range tests {
+		num, err := ch.FindTrunkBlockNumber(tt.timestamp)
+		assert.Nil(t, err)
+		assert.Equal(t, tt.want, num, "timestamp %v", tt.timestamp)
+	}
+}
+
+func TestTxAudit(t *testing.T) {
+	ch := initChain()
+	ch.SetTxAudit(true)
+	b0 := ch.GenesisBlock()
+
+	trx := new(tx.Builder).ChainTag(ch.Tag()).Build()
+	newBlockWithTxs := func(parent *block.Block, score uint64, txs ...*tx.Transaction) (*block.Block, tx.Receipts) {
+		builder := new(block.Builder).ParentID(parent.Header().ID()).TotalScore(parent.Header().TotalScore() + score)
+		receipts := make(tx.Receipts, 0, len(txs))
+		for _, trx := range txs {
+			builder.Transaction(trx)
+			receipts = append(receipts, &tx.Receipt{})
+		}
+		b := builder.Build()
+		sig, _ := crypto.Sign(b.Header().SigningHash().Bytes(), privateKey)
+		return b.WithSignature(sig), receipts
+	}
+
+	b1, r1 := newBlockWithTxs(b0, 1, trx)
+	_, err := ch.AddBlock(b1, r1)
+	assert.Nil(t, err)
+
+	// included by the parent
+	b2, r2 := newBlockWithTxs(b1, 1, trx)
+	_, err = ch.AddBlock(b2, r2)
+	assert.NotNil(t, err)
+
+	// duplicated in the block
+	b1x, r1x := newBlockWithTxs(b0, 2, trx, trx)
+	_, err = ch.AddBlock(b1x, r1x)
+	assert.NotNil(t, err)
+
+	// allowed on another branch
+	b1y, r1y := newBlockWithTxs(b0, 2, trx)
+	_, err = ch.AddBlock(b1y, r1y)
+	assert.Nil(t, err)
+
+	ch.SetTxAudit(false)
+	_, err = ch.AddBlock(b2, r2)
+	assert.Nil(t, err)
+}
@@ -65,7 +65,7 @@ func (rb *rawBlock) Block() (*block.Block, error) {
 		return nil, err
 	}
 
-	block := block.Compose(h, b.Txs)
+	block := block.Compose(h, b.Txs).WithEndorsements(b.Endorsements)
 
 	rb.block.Store(block)
 	return block, nil
@@ -10,10 +10,15 @@ import (
 	"github.com/vechain/thor/thor"
 )
 
+// block IDs are loaded in batch of this size, when seeker cache missed.
+const seekerBatchSize = 16
+
 // Seeker to seek block by given number on the chain defined by head block ID.
+// It caches seeked block IDs, and is not safe for concurrent use.
 type Seeker struct {
 	chain       *Chain
 	headBlockID thor.Bytes32
+	idCache     map[uint32]thor.Bytes32
 	err         error
 }
 
@@ -21,9 +26,16 @@ func newSeeker(chain *Chain, headBlockID thor.Bytes32) *Seeker {
 	return &Seeker{
 		chain:       chain,
 		headBlockID: headBlockID,
+		idCache:     make(map[uint32]thor.Bytes32),
 	}
 }
 
+// Copy returns a new seeker with the same head, which has its own cache and error, so that
+// it can be used in another goroutine.
+func (s *Seeker) Copy() *Seeker {
+	return newSeeker(s.chain, s.headBlockID)
+}
+
 func (s *Seeker) setError(err error) {
 	if s.err == nil {
 		s.err = err
@@ -37,12 +49,33 @@ func (s *Seeker) Err() error {
 
 // GetID returns block ID by the given number.
 func (s *Seeker) GetID(num uint32) thor.Bytes32 {
-	if num > block.Number(s.headBlockID) {
+	headNum := block.Number(s.headBlockID)
+	if num > headNum {
 		panic("num exceeds head block")
 	}
-	id, err := s.chain.GetAncestorBlockID(s.headBlockID, num)
-	s.setError(err)
-	return id
+	if id, ok := s.idCache[num]; ok {
+		return id
+	}
+
+	// load the aligned batch which contains num
+	start := num / seekerBatchSize * seekerBatchSize
+	end := start + seekerBatchSize - 1
+	if end > headNum {
+		end = headNum
+	}
+	nums := make([]uint32, 0, end-start+1)
+	for n := start; n <= end; n++ {
+		nums = append(nums, n)
+	}
+	ids, err := s.chain.GetAncestorBlockIDs(s.headBlockID, nums)
+	if err != nil {
+		s.setError(err)
+		return thor.Bytes32{}
+	}
+	for i, n := range nums {
+		s.idCache[n] = ids[i]
+	}
+	return s.idCache[num]
 }
 
 // GetHeader returns block header by the given number.
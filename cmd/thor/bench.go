@@ -0,0 +1,142 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/consensus"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+// replayStats accumulated stats of block replay.
+type replayStats struct {
+	blocks  int
+	txs     int
+	gasUsed uint64
+
+	load    time.Duration // load block and parent state
+	execute time.Duration // execute txs in VM
+	commit  time.Duration // compute state root
+}
+
+func (s *replayStats) total() time.Duration {
+	return s.load + s.execute + s.commit
+}
+
+func (s *replayStats) String() string {
+	secs := s.total().Seconds()
+	if secs == 0 {
+		secs = 1e-9
+	}
+	return fmt.Sprintf(`    Blocks:     %v
+    Txs:        %v
+    Gas:        %v
+    Elapsed:    %v
+    Gas/sec:    %.0f
+    Tx/sec:     %.2f
+    Load:       %v
+    Execute:    %v
+    Commit:     %v
+`,
+		s.blocks, s.txs, s.gasUsed, s.total(),
+		float64(s.gasUsed)/secs, float64(s.txs)/secs,
+		s.load, s.execute, s.commit)
+}
+
+func benchReplayAction(ctx *cli.Context) error {
+	initLogger(ctx)
+	gene := selectGenesis(ctx)
+	instanceDir := makeInstanceDir(ctx, gene)
+
+	mainDB := openMainDB(ctx, instanceDir)
+	defer mainDB.Close()
+
+	logDB := openMemLogDB()
+	defer logDB.Close()
+
+	chain := initChain(gene, mainDB, logDB)
+
+	from := uint32(ctx.Int(benchFromFlag.Name))
+	to := uint32(ctx.Int(benchToFlag.Name))
+	best := chain.BestBlock().Header().Number()
+	if to == 0 || to > best {
+		to = best
+	}
+	if from == 0 || from > to {
+		return fmt.Errorf("invalid block range [%v, %v]", from, to)
+	}
+
+	cons := consensus.New(chain, state.NewCreator(mainDB))
+	var stats replayStats
+	for num := from; num <= to; num++ {
+		if err := replayBlock(chain, cons, num, &stats); err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("replay block %v", num))
+		}
+	}
+	fmt.Printf("Replayed blocks [%v, %v]\n%v", from, to, &stats)
+	return nil
+}
+
+// replayBlock re-executes the trunk block of given number, and checks the outcome
+// against its header. Nothing is written back to the database.
+func replayBlock(chain *chain.Chain, cons *consensus.Consensus, num uint32, stats *replayStats) error {
+	startTime := time.Now()
+	blk, err := chain.GetTrunkBlock(num)
+	if err != nil {
+		return err
+	}
+	header := blk.Header()
+	rt, err := cons.NewRuntimeForReplay(header, true)
+	if err != nil {
+		return err
+	}
+	stats.load += time.Since(startTime)
+
+	startTime = time.Now()
+	receipts := make(tx.Receipts, 0, len(blk.Transactions()))
+	for _, tx := range blk.Transactions() {
+		receipt, err := rt.ExecuteTransaction(tx)
+		if err != nil {
+			return err
+		}
+		receipts = append(receipts, receipt)
+	}
+	stats.execute += time.Since(startTime)
+
+	startTime = time.Now()
+	stateRoot, err := rt.State().Stage().Hash()
+	if err != nil {
+		return err
+	}
+	stats.commit += time.Since(startTime)
+
+	if err := checkReplayed(header, stateRoot, receipts); err != nil {
+		return err
+	}
+
+	stats.blocks++
+	stats.txs += len(receipts)
+	stats.gasUsed += header.GasUsed()
+	return nil
+}
+
+func checkReplayed(header *block.Header, stateRoot thor.Bytes32, receipts tx.Receipts) error {
+	if header.StateRoot() != stateRoot {
+		return fmt.Errorf("state root mismatch: want %v, got %v", header.StateRoot(), stateRoot)
+	}
+	if receiptsRoot := receipts.RootHash(); header.ReceiptsRoot() != receiptsRoot {
+		return fmt.Errorf("receipts root mismatch: want %v, got %v", header.ReceiptsRoot(), receiptsRoot)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	cli "gopkg.in/urfave/cli.v1"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const envPrefix = "THOR_"
+
+// envName returns the env var name of the flag, e.g. 'THOR_DATA_DIR' for 'data-dir'.
+func envName(flagName string) string {
+	return envPrefix + strings.ToUpper(strings.Replace(flagName, "-", "_", -1))
+}
+
+func flagName(flag cli.Flag) string {
+	return strings.Split(flag.GetName(), ",")[0]
+}
+
+// contextFlags returns flags of the command, or of the app if it's the root context.
+func contextFlags(ctx *cli.Context) []cli.Flag {
+	if ctx.Command.Name == "" {
+		return ctx.App.Flags
+	}
+	return ctx.Command.Flags
+}
+
+// loadConfig applies values from env vars and the config file to flags not set in command line.
+// The precedence is command line > env var > config file > default value.
+func loadConfig(ctx *cli.Context) error {
+	flags := contextFlags(ctx)
+
+	for _, flag := range flags {
+		name := flagName(flag)
+		if ctx.IsSet(name) {
+			continue
+		}
+		if value, ok := os.LookupEnv(envName(name)); ok {
+			if err := ctx.Set(name, value); err != nil {
+				return errors.WithMessage(err, "env "+envName(name))
+			}
+		}
+	}
+
+	path := ctx.String(configFileFlag.Name)
+	if path == "" {
+		return nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return errors.WithMessage(err, "read config file")
+	}
+	var entries map[string]interface{}
+	if err := yaml.Unmarshal(data, &entries); err != nil {
+		return errors.WithMessage(err, "decode config file")
+	}
+
+	known := make(map[string]bool)
+	for _, flag := range flags {
+		known[flagName(flag)] = true
+	}
+	for name, value := range entries {
+		if !known[name] || name == configFileFlag.Name {
+			return fmt.Errorf("config file: unknown option '%v'", name)
+		}
+		if ctx.IsSet(name) {
+			continue
+		}
+		if err := ctx.Set(name, fmt.Sprint(value)); err != nil {
+			return errors.WithMessage(err, "config file: "+name)
+		}
+	}
+	return nil
+}
+
+// configDumpAction prints the effective node settings in config file form.
+func configDumpAction(ctx *cli.Context) error {
+	if err := loadConfig(ctx); err != nil {
+		return err
+	}
+	var entries yaml.MapSlice
+	for _, flag := range ctx.Command.Flags {
+		name := flagName(flag)
+		if name == configFileFlag.Name {
+			continue
+		}
+		var value interface{}
+		switch flag.(type) {
+		case cli.IntFlag:
+			value = ctx.Int(name)
+		case cli.BoolFlag:
+			value = ctx.Bool(name)
+		default:
+			value = ctx.String(name)
+		}
+		entries = append(entries, yaml.MapItem{Key: name, Value: value})
+	}
+	data, err := yaml.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	fmt.Print(string(data))
+	return nil
+}
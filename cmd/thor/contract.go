@@ -0,0 +1,215 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/abi"
+	"github.com/vechain/thor/api/accounts"
+	"github.com/vechain/thor/api/transactions"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/thorclient"
+	"github.com/vechain/thor/tx"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+// revert data is encoded as calling 'Error(string)'
+var errorMethod = func() *abi.Method {
+	a, err := abi.New([]byte(`[{"type":"function","name":"Error","inputs":[{"name":"reason","type":"string"}]}]`))
+	if err != nil {
+		panic(err)
+	}
+	m, _ := a.MethodByName("Error")
+	return m
+}()
+
+func contractDeployAction(ctx *cli.Context) error {
+	code, err := ioutil.ReadFile(ctx.String(contractBytecodeFlag.Name))
+	if err != nil {
+		return errors.WithMessage(err, "read bytecode")
+	}
+	str := strings.TrimSpace(string(code))
+	if !strings.HasPrefix(str, "0x") {
+		str = "0x" + str
+	}
+	data, err := hexutil.Decode(str)
+	if err != nil {
+		return errors.WithMessage(err, "decode bytecode")
+	}
+
+	if ctx.String(contractABIFlag.Name) != "" {
+		contractABI, err := loadABI(ctx)
+		if err != nil {
+			return err
+		}
+		if constructor := contractABI.Constructor(); constructor != nil {
+			args, err := parseArgs(ctx, constructor)
+			if err != nil {
+				return err
+			}
+			encodedArgs, err := constructor.EncodeArgs(args...)
+			if err != nil {
+				return errors.WithMessage(err, "encode constructor args")
+			}
+			data = append(data, encodedArgs...)
+		}
+	}
+
+	value, ok := math.ParseBig256(ctx.String(txValueFlag.Name))
+	if !ok {
+		return errors.New("invalid value")
+	}
+	clause := tx.NewClause(nil).WithValue(value).WithData(data)
+
+	client := thorclient.New(ctx.String(apiURLFlag.Name))
+	key, err := loadSigningKey(ctx)
+	if err != nil {
+		return err
+	}
+	receipt, err := sendTx(ctx, client, key, []*tx.Clause{clause}, true)
+	if err != nil {
+		return err
+	}
+	if receipt.Reverted {
+		return explainReverted(client, receipt, clause)
+	}
+	fmt.Println("Contract deployed:", receipt.Outputs[0].ContractAddress)
+	return nil
+}
+
+func contractCallAction(ctx *cli.Context) error {
+	contract, err := thor.ParseAddress(ctx.String(contractAddrFlag.Name))
+	if err != nil {
+		return errors.WithMessage(err, "contract")
+	}
+	contractABI, err := loadABI(ctx)
+	if err != nil {
+		return err
+	}
+	method, found := contractABI.MethodByName(ctx.String(contractMethodFlag.Name))
+	if !found {
+		return fmt.Errorf("method '%v' not found in ABI", ctx.String(contractMethodFlag.Name))
+	}
+	args, err := parseArgs(ctx, method)
+	if err != nil {
+		return err
+	}
+	data, err := method.EncodeInput(args...)
+	if err != nil {
+		return errors.WithMessage(err, "encode args")
+	}
+	value, ok := math.ParseBig256(ctx.String(txValueFlag.Name))
+	if !ok {
+		return errors.New("invalid value")
+	}
+
+	client := thorclient.New(ctx.String(apiURLFlag.Name))
+
+	// const methods are simulated, no tx sent
+	if method.Const() {
+		result, err := client.Call(&contract, &accounts.CallData{
+			Value: (*math.HexOrDecimal256)(value),
+			Data:  hexutil.Encode(data),
+		}, thorclient.RevisionBest)
+		if err != nil {
+			return err
+		}
+		output, err := hexutil.Decode(result.Data)
+		if err != nil {
+			return err
+		}
+		if result.Reverted {
+			return fmt.Errorf("reverted: %v", revertReason(output, result.VMError))
+		}
+		values, err := method.DecodeOutputValues(output)
+		if err != nil {
+			return errors.WithMessage(err, "decode output")
+		}
+		for _, v := range values {
+			fmt.Println(v)
+		}
+		return nil
+	}
+
+	clause := tx.NewClause(&contract).WithValue(value).WithData(data)
+	key, err := loadSigningKey(ctx)
+	if err != nil {
+		return err
+	}
+	receipt, err := sendTx(ctx, client, key, []*tx.Clause{clause}, true)
+	if err != nil {
+		return err
+	}
+	if receipt.Reverted {
+		return explainReverted(client, receipt, clause)
+	}
+	return printJSON(receipt)
+}
+
+// explainReverted re-executes the clause upon the parent of the block which includes the tx,
+// to figure out the revert reason.
+func explainReverted(client *thorclient.Client, receipt *transactions.Receipt, clause *tx.Clause) error {
+	origin := receipt.Meta.TxOrigin
+	result, err := client.Call(clause.To(), &accounts.CallData{
+		Value:  (*math.HexOrDecimal256)(clause.Value()),
+		Data:   hexutil.Encode(clause.Data()),
+		Caller: &origin,
+	}, thorclient.RevisionNumber(receipt.Meta.BlockNumber-1))
+	if err != nil {
+		return fmt.Errorf("tx %v reverted", receipt.Meta.TxID)
+	}
+	output, _ := hexutil.Decode(result.Data)
+	return fmt.Errorf("tx %v reverted: %v", receipt.Meta.TxID, revertReason(output, result.VMError))
+}
+
+// revertReason decodes reason from revert data, or falls back to vm error.
+func revertReason(data []byte, vmErr string) string {
+	id := errorMethod.ID()
+	if bytes.HasPrefix(data, id[:]) {
+		var reason string
+		if err := errorMethod.DecodeInput(data, &reason); err == nil {
+			return reason
+		}
+	}
+	if vmErr == "" {
+		return "unknown"
+	}
+	return vmErr
+}
+
+func loadABI(ctx *cli.Context) (*abi.ABI, error) {
+	path := ctx.String(contractABIFlag.Name)
+	if path == "" {
+		return nil, fmt.Errorf("flag %s required", contractABIFlag.Name)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithMessage(err, "read ABI")
+	}
+	contractABI, err := abi.New(data)
+	if err != nil {
+		return nil, errors.WithMessage(err, "decode ABI")
+	}
+	return contractABI, nil
+}
+
+func parseArgs(ctx *cli.Context, method *abi.Method) ([]interface{}, error) {
+	var args []string
+	if str := ctx.String(contractArgsFlag.Name); str != "" {
+		if err := json.Unmarshal([]byte(str), &args); err != nil {
+			return nil, errors.WithMessage(err, "decode args")
+		}
+	}
+	return method.ParseArgs(args)
+}
@@ -6,11 +6,18 @@
 package main
 
 import (
+	"strings"
+
 	"github.com/inconshreveable/log15"
+	"github.com/vechain/thor/api"
 	cli "gopkg.in/urfave/cli.v1"
 )
 
 var (
+	configFileFlag = cli.StringFlag{
+		Name:  "config",
+		Usage: "path to YAML config file, with keys named after flags (overridden by flags and THOR_* env vars)",
+	}
 	networkFlag = cli.StringFlag{
 		Name:  "network",
 		Usage: "the network to join (main|test) or path to genesis file",
@@ -30,6 +37,15 @@ var (
 		Name:  "beneficiary",
 		Usage: "address for block rewards",
 	}
+	beneficiariesFlag = cli.StringFlag{
+		Name:  "beneficiaries",
+		Usage: "comma separated addresses to rotate block rewards among, each optionally suffixed by ':weight' (overrides beneficiary)",
+	}
+	beneficiaryRotationFlag = cli.StringFlag{
+		Name:  "beneficiary-rotation",
+		Value: "block",
+		Usage: "unit of beneficiary rotation (block|epoch)",
+	}
 	apiAddrFlag = cli.StringFlag{
 		Name:  "api-addr",
 		Value: "localhost:8669",
@@ -55,11 +71,96 @@ var (
 		Value: 1000,
 		Usage: "limit the distance between 'position' and best block for subscriptions APIs",
 	}
+	apiSyncToleranceFlag = cli.IntFlag{
+		Name:  "api-sync-tolerance",
+		Value: 60,
+		Usage: "max age in seconds of the best block for /readyz to report ready (check disabled if set to 0)",
+	}
+	apiWSIdleTimeoutFlag = cli.IntFlag{
+		Name:  "api-ws-idle-timeout",
+		Value: 60,
+		Usage: "idle timeout in seconds of subscriptions websocket connections, kept alive by pings (disabled if set to 0)",
+	}
+	apiMaxSubscriptionsFlag = cli.IntFlag{
+		Name:  "api-max-subscriptions",
+		Usage: "max count of concurrent subscriptions websocket connections (unlimited if set to 0)",
+	}
+	apiMaxClientSubscriptionsFlag = cli.IntFlag{
+		Name:  "api-max-client-subscriptions",
+		Usage: "max count of concurrent subscriptions websocket connections from a remote IP (unlimited if set to 0)",
+	}
+	apiTLSCertFlag = cli.StringFlag{
+		Name:  "api-tls-cert",
+		Usage: "path to TLS certificate file, API served over HTTPS if set along with api-tls-key",
+	}
+	apiTLSKeyFlag = cli.StringFlag{
+		Name:  "api-tls-key",
+		Usage: "path to TLS private key file",
+	}
+	apiTokensFlag = cli.StringFlag{
+		Name:  "api-tokens",
+		Usage: "comma separated tokens to access public APIs (public APIs open if not set)",
+	}
+	apiAdminTokensFlag = cli.StringFlag{
+		Name:  "api-admin-tokens",
+		Usage: "comma separated tokens to access all APIs, including /debug, /node and contract verifying (admin APIs open only if no token set)",
+	}
+	apiModulesFlag = cli.StringFlag{
+		Name:  "api-modules",
+		Value: strings.Join(api.Modules, ","),
+		Usage: "comma separated API modules to enable",
+	}
+	apiUsageFlag = cli.BoolFlag{
+		Name:  "api-usage",
+		Usage: "account calls, gas simulated and bytes served per configured API token, reported at /usage and /node/usage (admin scope)",
+	}
+	apiSocketFlag = cli.StringFlag{
+		Name:  "api-socket",
+		Usage: "path to unix domain socket to serve API additionally, with no token required",
+	}
+	apiSocketPermFlag = cli.StringFlag{
+		Name:  "api-socket-perm",
+		Value: "0600",
+		Usage: "file permissions of API unix domain socket in octal",
+	}
+	pprofFlag = cli.BoolFlag{
+		Name:  "pprof",
+		Usage: "serve runtime and contract execution profiles at /debug/pprof/ (admin scope)",
+	}
+	logDBRetentionFlag = cli.IntFlag{
+		Name:  "logdb-retention",
+		Usage: "count of recent blocks to keep event and transfer logs of, older ones pruned in background (unlimited if set to 0)",
+	}
+	activityIndexFlag = cli.BoolFlag{
+		Name:  "activity-index",
+		Usage: "index txs touching each address, to serve account history at /logs/activities",
+	}
+	diagDirFlag = cli.StringFlag{
+		Name:  "diag-dir",
+		Usage: "directory to dump reports and execution traces of blocks with mismatched gas used or roots",
+	}
+	execWorkersFlag = cli.IntFlag{
+		Name:  "exec-workers",
+		Usage: "(experimental) count of workers to execute txs of a block in parallel when importing blocks, 0 to execute serially",
+	}
+	witnessDirFlag = cli.StringFlag{
+		Name:  "witness-dir",
+		Usage: "(experimental) directory to dump witnesses of blocks imported, which are trie nodes and codes read during execution",
+	}
 	verbosityFlag = cli.IntFlag{
 		Name:  "verbosity",
 		Value: int(log15.LvlInfo),
 		Usage: "log verbosity (0-9)",
 	}
+	logModulesFlag = cli.StringFlag{
+		Name:  "log-modules",
+		Usage: "comma separated per-module log verbosity, overrides verbosity, e.g. 'runtime=4,p2p=2' (modules: runtime|chain|txpool|p2p|api|node)",
+	}
+	logFormatFlag = cli.StringFlag{
+		Name:  "log-format",
+		Value: "terminal",
+		Usage: "log output format (terminal|json)",
+	}
 
 	maxPeersFlag = cli.IntFlag{
 		Name:  "max-peers",
@@ -76,6 +177,30 @@ var (
 		Value: "any",
 		Usage: "port mapping mechanism (any|none|upnp|pmp|extip:<IP>)",
 	}
+	p2pAddrFlag = cli.StringFlag{
+		Name:  "p2p-addr",
+		Usage: "P2P network listening IP, IPv4 or IPv6 (all interfaces if not set)",
+	}
+	bootnodesFlag = cli.StringFlag{
+		Name:  "bootnodes",
+		Usage: "comma separated enode URLs for P2P discovery bootstrap (builtin ones if not set)",
+	}
+	dnsSeedsFlag = cli.StringFlag{
+		Name:  "dns-seeds",
+		Usage: "comma separated domains whose TXT records are enode URLs of peers to dial",
+	}
+	attestContractsFlag = cli.StringFlag{
+		Name:  "attest-contracts",
+		Usage: "comma separated contract addresses whose events to attest (attestation disabled if not set)",
+	}
+	attestPeersFlag = cli.StringFlag{
+		Name:  "attest-peers",
+		Usage: "comma separated API URLs of other authority nodes to collect attestation signatures from",
+	}
+	p2pExtIPFlag = cli.StringFlag{
+		Name:  "p2p-ext-ip",
+		Usage: "external IP to advertise, overrides the one reported by NAT while ports still mapped",
+	}
 	onDemandFlag = cli.BoolFlag{
 		Name:  "on-demand",
 		Usage: "create new block when there is pending transaction",
@@ -97,9 +222,156 @@ var (
 		Name:  "export",
 		Usage: "export master key to keystore",
 	}
+	rotateMasterKeyFlag = cli.BoolFlag{
+		Name:  "rotate",
+		Usage: "generate the next master key, and send the intent tx signed by the endorsor (keystore) to rotate to it",
+	}
 	targetGasLimitFlag = cli.IntFlag{
 		Name:  "target-gas-limit",
 		Value: 0,
 		Usage: "target block gas limit (adaptive if set to 0)",
 	}
+	packBlockTimeFlag = cli.IntFlag{
+		Name:  "pack-block-time",
+		Value: 2000,
+		Usage: "budget in milliseconds of executing txs when packing a block (unlimited if set to 0)",
+	}
+	packTxTimeFlag = cli.IntFlag{
+		Name:  "pack-tx-time",
+		Value: 500,
+		Usage: "budget in milliseconds of executing a tx when packing, slower txs are skipped (unlimited if set to 0)",
+	}
+	packMinGasRateFlag = cli.IntFlag{
+		Name:  "pack-min-gas-rate",
+		Value: 0,
+		Usage: "min gas per second of executing a tx when packing, slower txs are skipped (disabled if set to 0)",
+	}
+	packRemoveSlowTxsFlag = cli.BoolFlag{
+		Name:  "pack-remove-slow-txs",
+		Usage: "remove skipped slow txs from tx pool, instead of retrying them in next blocks",
+	}
+	packPriorityOriginsFlag = cli.StringFlag{
+		Name:  "pack-priority-origins",
+		Usage: "comma separated origin addresses of txs in the priority lane",
+	}
+	packPriorityRecipientsFlag = cli.StringFlag{
+		Name:  "pack-priority-recipients",
+		Usage: "comma separated addresses, txs with all clauses to them are in the priority lane",
+	}
+	packPriorityGasFlag = cli.IntFlag{
+		Name:  "pack-priority-gas",
+		Value: 0,
+		Usage: "gas reserved in each block for txs in the priority lane",
+	}
+	txFilterFlag = cli.StringFlag{
+		Name:  "tx-filter",
+		Usage: "path to JSON file of tx denylist rules applied to tx pool and packing, reloaded on modified",
+	}
+	txPoolLimitFlag = cli.IntFlag{
+		Name:  "txpool-limit",
+		Value: defaultTxPoolOptions.Limit,
+		Usage: "maximum number of txs in tx pool",
+	}
+	txPoolLimitPerAccountFlag = cli.IntFlag{
+		Name:  "txpool-limit-per-account",
+		Value: defaultTxPoolOptions.LimitPerAccount,
+		Usage: "maximum number of pending txs per origin account",
+	}
+	benchFromFlag = cli.IntFlag{
+		Name:  "from",
+		Value: 1,
+		Usage: "number of the first block to replay",
+	}
+	benchToFlag = cli.IntFlag{
+		Name:  "to",
+		Usage: "number of the last block to replay (best block if set to 0)",
+	}
+	stateBlockFlag = cli.StringFlag{
+		Name:  "block",
+		Usage: "number of the block whose state to dump (best block if not set)",
+	}
+	stateAddrPrefixFlag = cli.StringFlag{
+		Name:  "addr-prefix",
+		Usage: "hex prefix of addresses of accounts to dump",
+	}
+	stateAddrFlag = cli.StringFlag{
+		Name:  "addr",
+		Usage: "address of the account to dump its storage, instead of accounts",
+	}
+	stateFormatFlag = cli.StringFlag{
+		Name:  "format",
+		Value: "json",
+		Usage: "output format (json|csv|snapshot), snapshot dumps full state to be imported",
+	}
+	stateSnapshotFlag = cli.StringFlag{
+		Name:  "snapshot",
+		Usage: "path of snapshot file dumped in snapshot format",
+	}
+	stateGenesisFlag = cli.StringFlag{
+		Name:  "genesis",
+		Usage: "path of custom genesis file, whose authority, params and accounts are applied on the snapshot",
+	}
+	apiURLFlag = cli.StringFlag{
+		Name:  "api-url",
+		Value: "http://localhost:8669",
+		Usage: "API URL of the node to interact with",
+	}
+	keystoreFlag = cli.StringFlag{
+		Name:  "keystore",
+		Usage: "path to JSON keystore of the signing account",
+	}
+	txToFlag = cli.StringFlag{
+		Name:  "to",
+		Usage: "recipient address of the clause",
+	}
+	txValueFlag = cli.StringFlag{
+		Name:  "value",
+		Value: "0",
+		Usage: "VET amount in wei of the clause (decimal or 0x prefixed hex)",
+	}
+	txDataFlag = cli.StringFlag{
+		Name:  "data",
+		Usage: "0x prefixed hex data of the clause",
+	}
+	txClausesFlag = cli.StringFlag{
+		Name:  "clauses",
+		Usage: "path to JSON file of clauses, in form of [{\"to\", \"value\", \"data\"}]",
+	}
+	txGasFlag = cli.IntFlag{
+		Name:  "gas",
+		Usage: "gas limit of tx (estimated if set to 0)",
+	}
+	txGasPriceCoefFlag = cli.IntFlag{
+		Name:  "gas-price-coef",
+		Usage: "gas price coefficient (0-255)",
+	}
+	txExpirationFlag = cli.IntFlag{
+		Name:  "expiration",
+		Value: 720,
+		Usage: "number of blocks before tx expires",
+	}
+	txWaitFlag = cli.BoolFlag{
+		Name:  "wait",
+		Usage: "wait for the receipt after tx sent",
+	}
+	contractBytecodeFlag = cli.StringFlag{
+		Name:  "bytecode",
+		Usage: "path to file of contract bytecode in hex",
+	}
+	contractABIFlag = cli.StringFlag{
+		Name:  "abi",
+		Usage: "path to JSON ABI file of contract",
+	}
+	contractAddrFlag = cli.StringFlag{
+		Name:  "contract",
+		Usage: "address of the contract",
+	}
+	contractMethodFlag = cli.StringFlag{
+		Name:  "method",
+		Usage: "name of the method to call",
+	}
+	contractArgsFlag = cli.StringFlag{
+		Name:  "args",
+		Usage: "JSON array of method or constructor arguments, e.g. [\"0x7567d83b7b8d80addcb281a71d54fc7b3364ffed\", \"100\"]",
+	}
 )
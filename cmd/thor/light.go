@@ -0,0 +1,93 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gorilla/handlers"
+	"github.com/gorilla/mux"
+	"github.com/vechain/thor/light"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/poa"
+	"github.com/vechain/thor/state"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+// lightAction runs a light client, which follows headers from the genesis block and serves
+// the REST API backed by proofs from full peers.
+// The genesis block is the checkpoint, so syncing stalls once the authority set changed on chain.
+func lightAction(ctx *cli.Context) error {
+	exitSignal := handleExitSignal()
+
+	defer func() { log.Info("exited") }()
+
+	if err := loadConfig(ctx); err != nil {
+		return err
+	}
+	initLogger(ctx)
+	gene := selectGenesis(ctx)
+	instanceDir := makeInstanceDir(ctx, gene)
+
+	// genesis state is built in memory, only to load initial proposers
+	kv, err := lvldb.NewMem()
+	if err != nil {
+		return err
+	}
+	genesisBlock, _, err := gene.Build(state.NewCreator(kv))
+	if err != nil {
+		return err
+	}
+	st, err := state.New(genesisBlock.Header().StateRoot(), kv)
+	if err != nil {
+		return err
+	}
+	proposers := poa.ToProposers(poa.LoadCandidates(st))
+	kv.Close()
+
+	client := light.NewClient(gene.ID(), light.NewHeaderChain(genesisBlock.Header(), proposers))
+	defer func() { log.Info("stopping light client..."); client.Stop() }()
+
+	router := mux.NewRouter()
+	light.NewAPI(client).Mount(router)
+	origins := strings.Split(strings.TrimSpace(ctx.String(apiCorsFlag.Name)), ",")
+	for i, o := range origins {
+		origins[i] = strings.ToLower(strings.TrimSpace(o))
+	}
+	apiHandler := handlers.CORS(
+		handlers.AllowedOrigins(origins),
+		handlers.AllowedHeaders([]string{"content-type", "authorization", "x-api-key"}))(handlers.CompressHandler(router))
+
+	apiURL, srvCloser := startAPIServer(ctx, apiHandler, gene.ID())
+	defer func() { log.Info("stopping API server..."); srvCloser() }()
+
+	p2pSrv, peersCachePath := newP2PServer(ctx, instanceDir)
+	log.Info("starting P2P networking")
+	if err := p2pSrv.Start(client.Protocols()); err != nil {
+		return err
+	}
+	defer func() {
+		log.Info("stopping P2P server...")
+		p2pSrv.Stop()
+		log.Info("saving peers cache...")
+		savePeersCache(p2pSrv, peersCachePath)
+	}()
+
+	fmt.Printf(`Starting %v in light mode
+    Network      [ %v %v ]
+    Instance dir [ %v ]
+    API portal   [ %v ]
+`,
+		common.MakeName("Thor", fullVersion()),
+		gene.ID(), gene.Name(),
+		instanceDir,
+		apiURL)
+
+	<-exitSignal.Done()
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/inconshreveable/log15"
+)
+
+// logModules maps module names to 'pkg' values of loggers.
+// Names not listed here are treated as 'pkg' values directly.
+var logModules = map[string][]string{
+	"p2p":  {"p2psrv", "comm", "rpc"},
+	"api":  {"api", "subscriptions"},
+	"node": {"node", "solo"},
+}
+
+// parseLogModules parses per-module levels in form of 'module=lvl,...'
+// into levels keyed by 'pkg'.
+func parseLogModules(str string) (map[string]log15.Lvl, error) {
+	lvls := make(map[string]log15.Lvl)
+	if str == "" {
+		return lvls, nil
+	}
+	for _, entry := range strings.Split(str, ",") {
+		parts := strings.Split(strings.TrimSpace(entry), "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid entry '%v'", entry)
+		}
+		lvl, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid level of module '%v'", parts[0])
+		}
+		pkgs, ok := logModules[parts[0]]
+		if !ok {
+			pkgs = []string{parts[0]}
+		}
+		for _, pkg := range pkgs {
+			lvls[pkg] = log15.Lvl(lvl)
+		}
+	}
+	return lvls, nil
+}
+
+// moduleLvlFilterHandler filters records by level of the module the record belongs to,
+// and falls back to defaultLvl.
+func moduleLvlFilterHandler(defaultLvl log15.Lvl, lvls map[string]log15.Lvl, h log15.Handler) log15.Handler {
+	return log15.FilterHandler(func(r *log15.Record) bool {
+		lvl := defaultLvl
+		for i := 0; i+1 < len(r.Ctx); i += 2 {
+			if r.Ctx[i] == "pkg" {
+				if pkg, ok := r.Ctx[i+1].(string); ok {
+					if l, ok := lvls[pkg]; ok {
+						lvl = l
+					}
+				}
+				break
+			}
+		}
+		return r.Lvl <= lvl
+	}, h)
+}
@@ -19,15 +19,20 @@ import (
 	isatty "github.com/mattn/go-isatty"
 	"github.com/pborman/uuid"
 	"github.com/pkg/errors"
+	"github.com/vechain/thor/analytics"
 	"github.com/vechain/thor/api"
 	"github.com/vechain/thor/cmd/thor/node"
 	"github.com/vechain/thor/cmd/thor/solo"
+	"github.com/vechain/thor/evidence"
 	"github.com/vechain/thor/genesis"
 	"github.com/vechain/thor/logdb"
 	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/packer"
+	"github.com/vechain/thor/runtime"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/txpool"
+	"github.com/vechain/thor/verification"
 	cli "gopkg.in/urfave/cli.v1"
 )
 
@@ -53,46 +58,136 @@ func fullVersion() string {
 }
 
 func main() {
+	nodeFlags := []cli.Flag{
+		configFileFlag,
+		networkFlag,
+		configDirFlag,
+		dataDirFlag,
+		beneficiaryFlag,
+		beneficiariesFlag,
+		beneficiaryRotationFlag,
+		targetGasLimitFlag,
+		packBlockTimeFlag,
+		packTxTimeFlag,
+		packMinGasRateFlag,
+		packRemoveSlowTxsFlag,
+		packPriorityOriginsFlag,
+		packPriorityRecipientsFlag,
+		packPriorityGasFlag,
+		txPoolLimitFlag,
+		txPoolLimitPerAccountFlag,
+		txFilterFlag,
+		apiAddrFlag,
+		apiCorsFlag,
+		apiTimeoutFlag,
+		apiCallGasLimitFlag,
+		apiBacktraceLimitFlag,
+		apiSyncToleranceFlag,
+		apiWSIdleTimeoutFlag,
+		apiMaxSubscriptionsFlag,
+		apiMaxClientSubscriptionsFlag,
+		apiTLSCertFlag,
+		apiTLSKeyFlag,
+		apiTokensFlag,
+		apiAdminTokensFlag,
+		apiModulesFlag,
+		apiUsageFlag,
+		apiSocketFlag,
+		apiSocketPermFlag,
+		pprofFlag,
+		logDBRetentionFlag,
+		activityIndexFlag,
+		diagDirFlag,
+		execWorkersFlag,
+		witnessDirFlag,
+		verbosityFlag,
+		logModulesFlag,
+		logFormatFlag,
+		maxPeersFlag,
+		p2pPortFlag,
+		p2pAddrFlag,
+		natFlag,
+		p2pExtIPFlag,
+		bootnodesFlag,
+		dnsSeedsFlag,
+		attestContractsFlag,
+		attestPeersFlag,
+	}
+
 	app := cli.App{
 		Version:   fullVersion(),
 		Name:      "Thor",
 		Usage:     "Node of VeChain Thor Network",
 		Copyright: "2018 VeChain Foundation <https://vechain.org/>",
-		Flags: []cli.Flag{
-			networkFlag,
-			configDirFlag,
-			dataDirFlag,
-			beneficiaryFlag,
-			targetGasLimitFlag,
-			apiAddrFlag,
-			apiCorsFlag,
-			apiTimeoutFlag,
-			apiCallGasLimitFlag,
-			apiBacktraceLimitFlag,
-			verbosityFlag,
-			maxPeersFlag,
-			p2pPortFlag,
-			natFlag,
-		},
-		Action: defaultAction,
+		Flags:     nodeFlags,
+		Action:    defaultAction,
 		Commands: []cli.Command{
 			{
 				Name:  "solo",
 				Usage: "client runs in solo mode for test & dev",
 				Flags: []cli.Flag{
+					configFileFlag,
 					dataDirFlag,
 					apiAddrFlag,
 					apiCorsFlag,
 					apiTimeoutFlag,
 					apiCallGasLimitFlag,
 					apiBacktraceLimitFlag,
+					apiWSIdleTimeoutFlag,
+					apiMaxSubscriptionsFlag,
+					apiMaxClientSubscriptionsFlag,
+					apiTLSCertFlag,
+					apiTLSKeyFlag,
+					apiTokensFlag,
+					apiAdminTokensFlag,
+					apiModulesFlag,
+					apiUsageFlag,
+					apiSocketFlag,
+					apiSocketPermFlag,
+					pprofFlag,
+					activityIndexFlag,
 					onDemandFlag,
 					persistFlag,
 					gasLimitFlag,
+					txPoolLimitFlag,
+					txPoolLimitPerAccountFlag,
 					verbosityFlag,
+					logModulesFlag,
+					logFormatFlag,
 				},
 				Action: soloAction,
 			},
+			{
+				Name:  "light",
+				Usage: "client follows headers only, and serves API backed by proofs from full nodes",
+				Flags: []cli.Flag{
+					configFileFlag,
+					networkFlag,
+					configDirFlag,
+					dataDirFlag,
+					apiAddrFlag,
+					apiCorsFlag,
+					apiTimeoutFlag,
+					apiTLSCertFlag,
+					apiTLSKeyFlag,
+					apiTokensFlag,
+					apiAdminTokensFlag,
+					apiUsageFlag,
+					apiSocketFlag,
+					apiSocketPermFlag,
+					verbosityFlag,
+					logModulesFlag,
+					logFormatFlag,
+					maxPeersFlag,
+					p2pPortFlag,
+					p2pAddrFlag,
+					natFlag,
+					p2pExtIPFlag,
+					bootnodesFlag,
+					dnsSeedsFlag,
+				},
+				Action: lightAction,
+			},
 			{
 				Name:  "master-key",
 				Usage: "master key management",
@@ -100,9 +195,138 @@ func main() {
 					configDirFlag,
 					importMasterKeyFlag,
 					exportMasterKeyFlag,
+					rotateMasterKeyFlag,
+					keystoreFlag,
+					apiURLFlag,
+					txGasFlag,
+					txGasPriceCoefFlag,
+					txExpirationFlag,
+					txWaitFlag,
 				},
 				Action: masterKeyAction,
 			},
+			{
+				Name:  "config",
+				Usage: "config file tools",
+				Subcommands: []cli.Command{
+					{
+						Name:   "dump",
+						Usage:  "print effective node settings merged from flags, env vars and config file",
+						Flags:  nodeFlags,
+						Action: configDumpAction,
+					},
+				},
+			},
+			{
+				Name:  "tx",
+				Usage: "transaction tools",
+				Subcommands: []cli.Command{
+					{
+						Name:  "send",
+						Usage: "sign and send a transaction to a node",
+						Flags: []cli.Flag{
+							apiURLFlag,
+							keystoreFlag,
+							txToFlag,
+							txValueFlag,
+							txDataFlag,
+							txClausesFlag,
+							txGasFlag,
+							txGasPriceCoefFlag,
+							txExpirationFlag,
+							txWaitFlag,
+						},
+						Action: txSendAction,
+					},
+				},
+			},
+			{
+				Name:  "contract",
+				Usage: "contract tools",
+				Subcommands: []cli.Command{
+					{
+						Name:  "deploy",
+						Usage: "deploy a contract, with constructor args encoded if ABI given",
+						Flags: []cli.Flag{
+							apiURLFlag,
+							keystoreFlag,
+							contractBytecodeFlag,
+							contractABIFlag,
+							contractArgsFlag,
+							txValueFlag,
+							txGasFlag,
+							txGasPriceCoefFlag,
+							txExpirationFlag,
+						},
+						Action: contractDeployAction,
+					},
+					{
+						Name:  "call",
+						Usage: "call a contract method, constant methods are simulated without sending tx",
+						Flags: []cli.Flag{
+							apiURLFlag,
+							keystoreFlag,
+							contractAddrFlag,
+							contractABIFlag,
+							contractMethodFlag,
+							contractArgsFlag,
+							txValueFlag,
+							txGasFlag,
+							txGasPriceCoefFlag,
+							txExpirationFlag,
+						},
+						Action: contractCallAction,
+					},
+				},
+			},
+			{
+				Name:  "bench",
+				Usage: "benchmark tools",
+				Subcommands: []cli.Command{
+					{
+						Name:  "replay",
+						Usage: "re-execute a range of blocks from local database",
+						Flags: []cli.Flag{
+							networkFlag,
+							dataDirFlag,
+							benchFromFlag,
+							benchToFlag,
+							verbosityFlag,
+							logModulesFlag,
+							logFormatFlag,
+						},
+						Action: benchReplayAction,
+					},
+				},
+			},
+			{
+				Name:  "state",
+				Usage: "state tools",
+				Subcommands: []cli.Command{
+					{
+						Name:  "dump",
+						Usage: "export accounts, or storage of an account, at a block from local database",
+						Flags: []cli.Flag{
+							networkFlag,
+							dataDirFlag,
+							stateBlockFlag,
+							stateAddrPrefixFlag,
+							stateAddrFlag,
+							stateFormatFlag,
+						},
+						Action: stateDumpAction,
+					},
+					{
+						Name:  "import",
+						Usage: "build genesis of a network forked from snapshot, after verifying its state root",
+						Flags: []cli.Flag{
+							stateSnapshotFlag,
+							stateGenesisFlag,
+						},
+						Action: stateImportAction,
+					},
+				},
+			},
 		},
 	}
 
@@ -117,6 +341,9 @@ func defaultAction(ctx *cli.Context) error {
 
 	defer func() { log.Info("exited") }()
 
+	if err := loadConfig(ctx); err != nil {
+		return err
+	}
 	initLogger(ctx)
 	gene := selectGenesis(ctx)
 	instanceDir := makeInstanceDir(ctx, gene)
@@ -130,22 +357,25 @@ func defaultAction(ctx *cli.Context) error {
 	chain := initChain(gene, mainDB, logDB)
 	master := loadNodeMaster(ctx)
 
-	txPool := txpool.New(chain, state.NewCreator(mainDB), defaultTxPoolOptions)
+	txFilter := newTxFilter(ctx, exitSignal)
+	txPool := txpool.New(chain, state.NewCreator(mainDB), txPoolOptions(ctx))
+	txPool.SetFilter(txFilter)
 	defer func() { log.Info("closing tx pool..."); txPool.Close() }()
 
-	p2pcom := newP2PComm(ctx, chain, txPool, instanceDir)
-	apiHandler, apiCloser := api.New(chain, state.NewCreator(mainDB), txPool, logDB, p2pcom.comm, ctx.String(apiCorsFlag.Name), uint32(ctx.Int(apiBacktraceLimitFlag.Name)), uint64(ctx.Int(apiCallGasLimitFlag.Name)))
-	defer func() { log.Info("closing API..."); apiCloser() }()
+	evidencePool := evidence.New(mainDB)
 
-	apiURL, srvCloser := startAPIServer(ctx, apiHandler, chain.GenesisBlock().Header().ID())
-	defer func() { log.Info("stopping API server..."); srvCloser() }()
-
-	printStartupMessage(gene, chain, master, instanceDir, apiURL)
+	attester := newAttester(ctx, chain, mainDB, master)
+	if attester != nil {
+		defer func() { log.Info("closing attester..."); attester.Close() }()
+	}
 
-	p2pcom.Start()
-	defer p2pcom.Stop()
+	// usage of contracts in blocks of about a day
+	contractsAnalytics := analytics.NewContracts(8640)
+	// block production of authorities in blocks of about a day
+	proposersAnalytics := analytics.NewProposers(chain, state.NewCreator(mainDB), mainDB, 8640)
 
-	return node.New(
+	p2pcom := newP2PComm(ctx, chain, state.NewCreator(mainDB), txPool, instanceDir)
+	n := node.New(
 		master,
 		chain,
 		state.NewCreator(mainDB),
@@ -153,13 +383,45 @@ func defaultAction(ctx *cli.Context) error {
 		txPool,
 		filepath.Join(instanceDir, "tx.stash"),
 		p2pcom.comm,
-		uint64(ctx.Int(targetGasLimitFlag.Name))).
-		Run(exitSignal)
+		evidencePool,
+		uint64(ctx.Int(targetGasLimitFlag.Name)),
+		packer.ExecBudget{
+			Block:      time.Duration(ctx.Int(packBlockTimeFlag.Name)) * time.Millisecond,
+			Tx:         time.Duration(ctx.Int(packTxTimeFlag.Name)) * time.Millisecond,
+			MinGasRate: uint64(ctx.Int(packMinGasRateFlag.Name)),
+		},
+		priorityLane(ctx),
+		ctx.Bool(packRemoveSlowTxsFlag.Name))
+	n.SetHook(runtime.Hooks{contractsAnalytics, proposersAnalytics})
+	n.SetBlockObserver(proposersAnalytics.OnBlockReceived)
+	n.SetTxFilter(txFilter)
+	n.SetDiagnosticsDir(ctx.String(diagDirFlag.Name))
+	n.SetParallelWorkers(ctx.Int(execWorkersFlag.Name))
+	n.SetWitnessDir(ctx.String(witnessDirFlag.Name))
+
+	apiHandler, apiCloser, err := api.New(chain, mainDB, state.NewCreator(mainDB), txPool, logDB, p2pcom.comm, evidencePool, verification.New(mainDB), attester, contractsAnalytics, proposersAnalytics, n, ctx.String(apiCorsFlag.Name), uint32(ctx.Int(apiBacktraceLimitFlag.Name)), uint64(ctx.Int(apiCallGasLimitFlag.Name)), time.Duration(ctx.Int(apiSyncToleranceFlag.Name))*time.Second, subscriptionOptions(ctx), splitTokens(ctx.String(apiModulesFlag.Name)))
+	if err != nil {
+		return errors.WithMessage(err, apiModulesFlag.Name)
+	}
+	defer func() { log.Info("closing API..."); apiCloser() }()
+
+	apiURL, srvCloser := startAPIServer(ctx, apiHandler, chain.GenesisBlock().Header().ID())
+	defer func() { log.Info("stopping API server..."); srvCloser() }()
+
+	printStartupMessage(gene, chain, master, instanceDir, apiURL)
+
+	p2pcom.Start()
+	defer p2pcom.Stop()
+
+	return n.Run(exitSignal)
 }
 
 func soloAction(ctx *cli.Context) error {
 	defer func() { log.Info("exited") }()
 
+	if err := loadConfig(ctx); err != nil {
+		return err
+	}
 	initLogger(ctx)
 	gene := genesis.NewDevnet()
 
@@ -175,17 +437,23 @@ func soloAction(ctx *cli.Context) error {
 		instanceDir = "Memory"
 		mainDB = openMemMainDB()
 		logDB = openMemLogDB()
+		logDB.SetActivityIndex(ctx.Bool(activityIndexFlag.Name))
 	}
 
 	defer func() { log.Info("closing main database..."); mainDB.Close() }()
 	defer func() { log.Info("closing log database..."); logDB.Close() }()
 
 	chain := initChain(gene, mainDB, logDB)
+	// solo is for dev, audit txs to assert no tx included twice
+	chain.SetTxAudit(true)
 
-	txPool := txpool.New(chain, state.NewCreator(mainDB), defaultTxPoolOptions)
+	txPool := txpool.New(chain, state.NewCreator(mainDB), txPoolOptions(ctx))
 	defer func() { log.Info("closing tx pool..."); txPool.Close() }()
 
-	apiHandler, apiCloser := api.New(chain, state.NewCreator(mainDB), txPool, logDB, solo.Communicator{}, ctx.String(apiCorsFlag.Name), uint32(ctx.Int(apiBacktraceLimitFlag.Name)), uint64(ctx.Int(apiCallGasLimitFlag.Name)))
+	apiHandler, apiCloser, err := api.New(chain, mainDB, state.NewCreator(mainDB), txPool, logDB, solo.Communicator{}, evidence.New(mainDB), verification.New(mainDB), nil, nil, nil, nil, ctx.String(apiCorsFlag.Name), uint32(ctx.Int(apiBacktraceLimitFlag.Name)), uint64(ctx.Int(apiCallGasLimitFlag.Name)), 0, subscriptionOptions(ctx), splitTokens(ctx.String(apiModulesFlag.Name)))
+	if err != nil {
+		return errors.WithMessage(err, apiModulesFlag.Name)
+	}
 	defer func() { log.Info("closing API..."); apiCloser() }()
 
 	apiURL, srvCloser := startAPIServer(ctx, apiHandler, chain.GenesisBlock().Header().ID())
@@ -208,6 +476,13 @@ func masterKeyAction(ctx *cli.Context) error {
 		return fmt.Errorf("flag %s and %s are exclusive", importMasterKeyFlag.Name, exportMasterKeyFlag.Name)
 	}
 
+	if ctx.Bool(rotateMasterKeyFlag.Name) {
+		if hasImportFlag || hasExportFlag {
+			return fmt.Errorf("flag %s is exclusive with %s and %s", rotateMasterKeyFlag.Name, importMasterKeyFlag.Name, exportMasterKeyFlag.Name)
+		}
+		return rotateMasterKey(ctx)
+	}
+
 	if !hasImportFlag && !hasExportFlag {
 		masterKey, err := loadOrGeneratePrivateKey(masterKeyPath(ctx))
 		if err != nil {
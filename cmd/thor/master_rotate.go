@@ -0,0 +1,81 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/builtin/authority"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/thorclient"
+	"github.com/vechain/thor/tx"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+// rotateMasterKey generates the next master key if not yet, and sends the rotation intent tx signed by
+// the endorsor of the current master. The node loads the next key on start, and switches to it once activated.
+func rotateMasterKey(ctx *cli.Context) error {
+	// never generated here, since the current master is the one listed
+	key, err := crypto.LoadECDSA(masterKeyPath(ctx))
+	if err != nil {
+		return errors.WithMessage(err, "load master key")
+	}
+	nextKey, err := loadOrGeneratePrivateKey(nextMasterKeyPath(ctx))
+	if err != nil {
+		return err
+	}
+	endorsorKey, err := loadSigningKey(ctx)
+	if err != nil {
+		return errors.WithMessage(err, "load endorsor key")
+	}
+
+	var (
+		master     = thor.Address(crypto.PubkeyToAddress(key.PublicKey))
+		nextMaster = thor.Address(crypto.PubkeyToAddress(nextKey.PublicKey))
+	)
+	client := thorclient.New(ctx.String(apiURLFlag.Name))
+	// the intent is signed for the chain only
+	genesisBlock, err := client.GetBlock(thorclient.RevisionNumber(0))
+	if err != nil {
+		return errors.WithMessage(err, "get genesis block")
+	}
+	data, err := authority.EncodeRotationIntent(genesisBlock.ID, master, nextKey)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Master:", master)
+	fmt.Println("Next master:", nextMaster)
+	fmt.Println("Endorsor:", thor.Address(crypto.PubkeyToAddress(endorsorKey.PublicKey)))
+
+	receipt, err := sendTx(ctx, client, endorsorKey, []*tx.Clause{tx.NewClause(&master).WithData(data)}, ctx.Bool(txWaitFlag.Name))
+	if err != nil {
+		return err
+	}
+	if receipt == nil {
+		fmt.Printf("Rotation activates %v blocks after the tx packed, if the endorsor matches\n", thor.MasterRotationDelay)
+	} else {
+		if receipt.Reverted {
+			return errors.New("intent tx reverted")
+		}
+		// the intent is ignored rather than reverted if not applicable
+		slot, err := client.GetSlot()
+		if err != nil {
+			return errors.WithMessage(err, "get pending rotation")
+		}
+		if slot.NodeMaster != master {
+			return fmt.Errorf("api-url should be of the node with master %v", master)
+		}
+		if slot.Rotation == nil || slot.Rotation.NewMaster != nextMaster {
+			return errors.New("rotation not scheduled, the master may be unlisted, or the tx not sent by its endorsor")
+		}
+		fmt.Printf("Rotation activates at block #%v\n", slot.Rotation.Activation)
+	}
+	fmt.Println("Keep the node running with both keys, and replace master.key with master.next.key after activated.")
+	return nil
+}
@@ -6,6 +6,8 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -14,15 +16,23 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/fdlimit"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/discover"
 	"github.com/ethereum/go-ethereum/p2p/nat"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/inconshreveable/log15"
+	"github.com/vechain/thor/api/batch"
+	"github.com/vechain/thor/api/subscriptions"
+	"github.com/vechain/thor/api/usage"
+	"github.com/vechain/thor/attest"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/cmd/thor/node"
 	"github.com/vechain/thor/co"
@@ -31,17 +41,41 @@ import (
 	"github.com/vechain/thor/logdb"
 	"github.com/vechain/thor/lvldb"
 	"github.com/vechain/thor/p2psrv"
+	"github.com/vechain/thor/packer"
+	"github.com/vechain/thor/runtime"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/thorclient"
+	"github.com/vechain/thor/txfilter"
 	"github.com/vechain/thor/txpool"
 	cli "gopkg.in/urfave/cli.v1"
 )
 
 func initLogger(ctx *cli.Context) {
 	logLevel := ctx.Int(verbosityFlag.Name)
-	log15.Root().SetHandler(log15.LvlFilterHandler(log15.Lvl(logLevel), log15.StderrHandler))
+	moduleLvls, err := parseLogModules(ctx.String(logModulesFlag.Name))
+	if err != nil {
+		fatal(fmt.Sprintf("parse %s: %v", logModulesFlag.Name, err))
+	}
+
+	var (
+		format    log15.Format
+		ethFormat ethlog.Format
+	)
+	switch ctx.String(logFormatFlag.Name) {
+	case "terminal":
+		format = log15.TerminalFormat()
+		ethFormat = ethlog.TerminalFormat(true)
+	case "json":
+		format = log15.JsonFormat()
+		ethFormat = ethlog.JSONFormat()
+	default:
+		fatal(fmt.Sprintf("unsupported log format: %v", ctx.String(logFormatFlag.Name)))
+	}
+
+	log15.Root().SetHandler(moduleLvlFilterHandler(log15.Lvl(logLevel), moduleLvls, log15.StreamHandler(os.Stderr, format)))
 	// set go-ethereum log lvl to Warn
-	ethLogHandler := ethlog.NewGlogHandler(ethlog.StreamHandler(os.Stderr, ethlog.TerminalFormat(true)))
+	ethLogHandler := ethlog.NewGlogHandler(ethlog.StreamHandler(os.Stderr, ethFormat))
 	ethLogHandler.Verbosity(ethlog.LvlWarn)
 	ethlog.Root().SetHandler(ethLogHandler)
 }
@@ -74,6 +108,16 @@ func selectGenesis(ctx *cli.Context) *genesis.Genesis {
 			if err != nil {
 				fatal(fmt.Sprintf("build genesis: %v", err))
 			}
+			if gen.Constants != nil {
+				thor.SetConstants(*gen.Constants)
+				log.Info("protocol constants overridden", "constants", thor.CurrentConstants())
+			}
+			if gen.GasOverrides != nil {
+				// validated by building genesis
+				overrides, _ := gen.GasOverrides.VMGasOverrides()
+				runtime.SetGasOverrides(overrides)
+				log.Info("gas costs overridden", "ops", len(overrides.Ops), "intrinsic", overrides.Intrinsic != nil)
+			}
 
 			return customGen
 		}
@@ -148,6 +192,8 @@ func openLogDB(ctx *cli.Context, dataDir string) *logdb.LogDB {
 	if err != nil {
 		fatal(fmt.Sprintf("open log database [%v]: %v", dir, err))
 	}
+	db.SetActivityIndex(ctx.Bool(activityIndexFlag.Name))
+	db.SetRetention(uint32(ctx.Int(logDBRetentionFlag.Name)))
 	return db
 }
 
@@ -170,11 +216,54 @@ func initChain(gene *genesis.Genesis, mainDB *lvldb.LevelDB, logDB *logdb.LogDB)
 	return chain
 }
 
+func subscriptionOptions(ctx *cli.Context) subscriptions.Options {
+	return subscriptions.Options{
+		IdleTimeout:               time.Duration(ctx.Int(apiWSIdleTimeoutFlag.Name)) * time.Second,
+		MaxSubscriptions:          ctx.Int(apiMaxSubscriptionsFlag.Name),
+		MaxSubscriptionsPerClient: ctx.Int(apiMaxClientSubscriptionsFlag.Name),
+	}
+}
+
+func txPoolOptions(ctx *cli.Context) txpool.Options {
+	options := defaultTxPoolOptions
+	options.Limit = ctx.Int(txPoolLimitFlag.Name)
+	options.LimitPerAccount = ctx.Int(txPoolLimitPerAccountFlag.Name)
+	if options.Limit <= 0 || options.LimitPerAccount <= 0 {
+		fatal("tx pool limits should be positive")
+	}
+	return options
+}
+
+func priorityLane(ctx *cli.Context) packer.PriorityLane {
+	parse := func(flag cli.StringFlag) []thor.Address {
+		var addrs []thor.Address
+		for _, str := range splitTokens(ctx.String(flag.Name)) {
+			addr, err := thor.ParseAddress(str)
+			if err != nil {
+				fatal(fmt.Sprintf("invalid address in %v: %v", flag.Name, str))
+			}
+			addrs = append(addrs, addr)
+		}
+		return addrs
+	}
+	return packer.PriorityLane{
+		Origins:     parse(packPriorityOriginsFlag),
+		Recipients:  parse(packPriorityRecipientsFlag),
+		ReservedGas: uint64(ctx.Int(packPriorityGasFlag.Name)),
+	}
+}
+
 func masterKeyPath(ctx *cli.Context) string {
 	configDir := makeConfigDir(ctx)
 	return filepath.Join(configDir, "master.key")
 }
 
+// nextMasterKeyPath path of the master key rotated to.
+func nextMasterKeyPath(ctx *cli.Context) string {
+	configDir := makeConfigDir(ctx)
+	return filepath.Join(configDir, "master.next.key")
+}
+
 func beneficiary(ctx *cli.Context) *thor.Address {
 	value := ctx.String(beneficiaryFlag.Name)
 	if value == "" {
@@ -187,13 +276,40 @@ func beneficiary(ctx *cli.Context) *thor.Address {
 	return &addr
 }
 
+func beneficiaries(ctx *cli.Context) packer.BeneficiaryRotation {
+	var rotation packer.BeneficiaryRotation
+	switch value := ctx.String(beneficiaryRotationFlag.Name); value {
+	case "block":
+	case "epoch":
+		rotation.PerEpoch = true
+	default:
+		fatal(fmt.Sprintf("invalid %v: %v", beneficiaryRotationFlag.Name, value))
+	}
+	for _, str := range splitTokens(ctx.String(beneficiariesFlag.Name)) {
+		parts := strings.SplitN(str, ":", 2)
+		addr, err := thor.ParseAddress(parts[0])
+		if err != nil {
+			fatal(fmt.Sprintf("invalid address in %v: %v", beneficiariesFlag.Name, str))
+		}
+		weight := uint64(1)
+		if len(parts) > 1 {
+			if weight, err = strconv.ParseUint(parts[1], 10, 64); err != nil || weight == 0 {
+				fatal(fmt.Sprintf("invalid weight in %v: %v", beneficiariesFlag.Name, str))
+			}
+		}
+		rotation.Beneficiaries = append(rotation.Beneficiaries, packer.WeightedBeneficiary{Address: addr, Weight: weight})
+	}
+	return rotation
+}
+
 func loadNodeMaster(ctx *cli.Context) *node.Master {
 	if ctx.String(networkFlag.Name) == "dev" {
 		i := rand.Intn(len(genesis.DevAccounts()))
 		acc := genesis.DevAccounts()[i]
 		return &node.Master{
-			PrivateKey:  acc.PrivateKey,
-			Beneficiary: beneficiary(ctx),
+			PrivateKey:    acc.PrivateKey,
+			Beneficiary:   beneficiary(ctx),
+			Beneficiaries: beneficiaries(ctx),
 		}
 	}
 	key, err := loadOrGeneratePrivateKey(masterKeyPath(ctx))
@@ -202,16 +318,120 @@ func loadNodeMaster(ctx *cli.Context) *node.Master {
 	}
 	master := &node.Master{PrivateKey: key}
 	master.Beneficiary = beneficiary(ctx)
+	master.Beneficiaries = beneficiaries(ctx)
+
+	// generated by master-key --rotate
+	nextKey, err := crypto.LoadECDSA(nextMasterKeyPath(ctx))
+	if err == nil {
+		master.NextKey = nextKey
+	} else if !os.IsNotExist(err) {
+		fatal("load next master key:", err)
+	}
 	return master
 }
 
+// newAttester creates an attester if contracts to attest are configured, otherwise nil returned.
+func newAttester(ctx *cli.Context, chain *chain.Chain, mainDB *lvldb.LevelDB, master *node.Master) *attest.Attester {
+	var contracts []thor.Address
+	for _, str := range splitTokens(ctx.String(attestContractsFlag.Name)) {
+		addr, err := thor.ParseAddress(str)
+		if err != nil {
+			fatal(fmt.Sprintf("parse %s: %v", attestContractsFlag.Name, err))
+		}
+		contracts = append(contracts, addr)
+	}
+	if len(contracts) == 0 {
+		return nil
+	}
+	var peers []attest.Peer
+	for _, url := range splitTokens(ctx.String(attestPeersFlag.Name)) {
+		peers = append(peers, attestPeer{thorclient.New(url)})
+	}
+	return attest.New(chain, state.NewCreator(mainDB), mainDB, master.KeyOf, contracts, peers)
+}
+
+const txFilterReloadInterval = 10 * time.Second
+
+// newTxFilter loads the tx filter if configured, and reloads it on file modified until exit.
+func newTxFilter(ctx *cli.Context, exitSignal context.Context) txfilter.Filter {
+	path := ctx.String(txFilterFlag.Name)
+	if path == "" {
+		return nil
+	}
+	filter, err := txfilter.NewFileFilter(path)
+	if err != nil {
+		fatal(fmt.Sprintf("load tx filter: %v", err))
+	}
+	go func() {
+		ticker := time.NewTicker(txFilterReloadInterval)
+		defer ticker.Stop()
+		var lastErr string
+		for {
+			select {
+			case <-exitSignal.Done():
+				return
+			case <-ticker.C:
+			}
+			reloaded, err := filter.Reload()
+			if err != nil {
+				// warn once for the same error
+				if err.Error() != lastErr {
+					log.Warn("failed to reload tx filter, previous rules kept", "err", err)
+				}
+				lastErr = err.Error()
+				continue
+			}
+			lastErr = ""
+			if reloaded {
+				log.Info("tx filter reloaded")
+			}
+		}
+	}()
+	return filter
+}
+
+// attestPeer collects attestation signatures via API of a remote node.
+type attestPeer struct {
+	client *thorclient.Client
+}
+
+func (p attestPeer) GetSignatures(hash thor.Bytes32) ([]*attest.Signature, error) {
+	at, err := p.client.GetAttestation(hash)
+	if err != nil || at == nil {
+		return nil, err
+	}
+	sigs := make([]*attest.Signature, 0, len(at.Signatures))
+	for _, s := range at.Signatures {
+		sig, err := hexutil.Decode(s.Signature)
+		if err != nil {
+			return nil, err
+		}
+		sigs = append(sigs, &attest.Signature{Signer: s.Signer, Signature: sig})
+	}
+	return sigs, nil
+}
+
 type p2pComm struct {
 	comm           *comm.Communicator
 	p2pSrv         *p2psrv.Server
 	peersCachePath string
+	goes           co.Goes
+	done           chan struct{}
 }
 
-func newP2PComm(ctx *cli.Context, chain *chain.Chain, txPool *txpool.TxPool, instanceDir string) *p2pComm {
+func newP2PComm(ctx *cli.Context, chain *chain.Chain, stateCreator *state.Creator, txPool *txpool.TxPool, instanceDir string) *p2pComm {
+	p2pSrv, peersCachePath := newP2PServer(ctx, instanceDir)
+	return &p2pComm{
+		comm:           comm.New(chain, stateCreator, txPool),
+		p2pSrv:         p2pSrv,
+		peersCachePath: peersCachePath,
+		done:           make(chan struct{}),
+	}
+}
+
+// newP2PServer creates the p2p server with known nodes loaded from peers cache, and returns
+// along with path of the cache.
+func newP2PServer(ctx *cli.Context, instanceDir string) (*p2psrv.Server, string) {
 	configDir := makeConfigDir(ctx)
 	key, err := loadOrGeneratePrivateKey(filepath.Join(configDir, "p2p.key"))
 	if err != nil {
@@ -224,13 +444,36 @@ func newP2PComm(ctx *cli.Context, chain *chain.Chain, txPool *txpool.TxPool, ins
 		fmt.Println("parse -nat flag:", err)
 		os.Exit(1)
 	}
+	var extIP net.IP
+	if str := ctx.String(p2pExtIPFlag.Name); str != "" {
+		if extIP = net.ParseIP(str); extIP == nil {
+			fatal(fmt.Sprintf("invalid external IP: %v", str))
+		}
+	}
+	// empty host to listen on all interfaces, both IPv4 and IPv6
+	listenAddr := net.JoinHostPort(ctx.String(p2pAddrFlag.Name), strconv.Itoa(ctx.Int(p2pPortFlag.Name)))
+
+	bootnodes := bootstrapNodes
+	if str := ctx.String(bootnodesFlag.Name); str != "" {
+		bootnodes = nil
+		for _, url := range strings.Split(str, ",") {
+			node, err := discover.ParseNode(strings.TrimSpace(url))
+			if err != nil {
+				fatal(fmt.Sprintf("parse bootnode [%v]: %v", url, err))
+			}
+			bootnodes = append(bootnodes, node)
+		}
+	}
+
 	opts := &p2psrv.Options{
 		Name:           common.MakeName("thor", fullVersion()),
 		PrivateKey:     key,
 		MaxPeers:       ctx.Int(maxPeersFlag.Name),
-		ListenAddr:     fmt.Sprintf(":%v", ctx.Int(p2pPortFlag.Name)),
-		BootstrapNodes: bootstrapNodes,
+		ListenAddr:     listenAddr,
+		BootstrapNodes: bootnodes,
+		DNSSeeds:       splitTokens(ctx.String(dnsSeedsFlag.Name)),
 		NAT:            nat,
+		ExternalIP:     extIP,
 	}
 
 	peersCachePath := filepath.Join(instanceDir, "peers.cache")
@@ -243,11 +486,7 @@ func newP2PComm(ctx *cli.Context, chain *chain.Chain, txPool *txpool.TxPool, ins
 		log.Warn("failed to load peers cache", "err", err)
 	}
 
-	return &p2pComm{
-		comm:           comm.New(chain, txPool),
-		p2pSrv:         p2psrv.New(opts),
-		peersCachePath: peersCachePath,
-	}
+	return p2psrv.New(opts), peersCachePath
 }
 
 func (p *p2pComm) Start() {
@@ -256,9 +495,26 @@ func (p *p2pComm) Start() {
 		fatal("start P2P server:", err)
 	}
 	p.comm.Start()
+
+	// save peers cache periodically, to survive unclean exit
+	p.goes.Go(func() {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				savePeersCache(p.p2pSrv, p.peersCachePath)
+			case <-p.done:
+				return
+			}
+		}
+	})
 }
 
 func (p *p2pComm) Stop() {
+	close(p.done)
+	p.goes.Wait()
+
 	log.Info("stopping communicator...")
 	p.comm.Stop()
 
@@ -266,13 +522,26 @@ func (p *p2pComm) Stop() {
 	p.p2pSrv.Stop()
 
 	log.Info("saving peers cache...")
-	nodes := p.p2pSrv.KnownNodes()
+	savePeersCache(p.p2pSrv, p.peersCachePath)
+}
+
+func savePeersCache(p2pSrv *p2psrv.Server, path string) {
+	nodes := p2pSrv.KnownNodes()
+	if len(nodes) == 0 {
+		return
+	}
 	data, err := rlp.EncodeToBytes(nodes)
 	if err != nil {
 		log.Warn("failed to encode cached peers", "err", err)
 		return
 	}
-	if err := ioutil.WriteFile(p.peersCachePath, data, 0600); err != nil {
+	// write to temp file then rename, to avoid corrupted cache
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		log.Warn("failed to write peers cache", "err", err)
+		return
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
 		log.Warn("failed to write peers cache", "err", err)
 	}
 }
@@ -290,17 +559,104 @@ func startAPIServer(ctx *cli.Context, handler http.Handler, genesisID thor.Bytes
 	handler = handleXGenesisID(handler, genesisID)
 	handler = handleXThorestVersion(handler)
 	handler = requestBodyLimit(handler)
+
+	publicTokens := splitTokens(ctx.String(apiTokensFlag.Name))
+	adminTokens := splitTokens(ctx.String(apiAdminTokensFlag.Name))
+	if ctx.Bool(apiUsageFlag.Name) {
+		if len(publicTokens) == 0 && len(adminTokens) == 0 {
+			log.Warn("API usage metered per token, but no token configured")
+		}
+		handler = handleAPIUsage(handler, usage.NewMeter(), append(append([]string(nil), publicTokens...), adminTokens...))
+	}
+
+	// unix socket is guarded by file permissions, so no token required
+	socketHandler := handler
+	if len(publicTokens) > 0 || len(adminTokens) > 0 {
+		handler = handleAPIAuth(handler, publicTokens, adminTokens)
+	}
+	// requests in a batch go through all middlewares above, with headers of the batch request
+	handler = requestBodyLimit(batch.Handler(handler))
+	socketHandler = requestBodyLimit(batch.Handler(socketHandler))
+	if ctx.Bool(pprofFlag.Name) {
+		handler = handlePprof(handler, publicTokens, adminTokens)
+		socketHandler = handlePprof(socketHandler, nil, nil)
+	}
+
+	certFile, keyFile := ctx.String(apiTLSCertFlag.Name), ctx.String(apiTLSKeyFlag.Name)
+	if (certFile == "") != (keyFile == "") {
+		fatal(fmt.Sprintf("flag %s and %s should be set together", apiTLSCertFlag.Name, apiTLSKeyFlag.Name))
+	}
+	scheme := "http"
+	if certFile != "" {
+		// load in advance to fail fast
+		if _, err := tls.LoadX509KeyPair(certFile, keyFile); err != nil {
+			fatal(fmt.Sprintf("load TLS key pair: %v", err))
+		}
+		scheme = "https"
+	}
+
 	srv := &http.Server{Handler: handler}
 	var goes co.Goes
 	goes.Go(func() {
-		srv.Serve(listener)
+		if certFile != "" {
+			srv.ServeTLS(listener, certFile, keyFile)
+		} else {
+			srv.Serve(listener)
+		}
 	})
-	return "http://" + listener.Addr().String() + "/", func() {
+
+	var socketSrv *http.Server
+	if socketPath := ctx.String(apiSocketFlag.Name); socketPath != "" {
+		socketListener := listenUnixSocket(socketPath, ctx.String(apiSocketPermFlag.Name))
+		socketSrv = &http.Server{Handler: socketHandler}
+		goes.Go(func() {
+			socketSrv.Serve(socketListener)
+		})
+	}
+
+	return scheme + "://" + listener.Addr().String() + "/", func() {
 		srv.Close()
+		if socketSrv != nil {
+			// the socket file is removed by unix listener on close
+			socketSrv.Close()
+		}
 		goes.Wait()
 	}
 }
 
+func listenUnixSocket(path string, perm string) net.Listener {
+	mode, err := strconv.ParseUint(perm, 8, 32)
+	if err != nil {
+		fatal(fmt.Sprintf("invalid API socket permissions: %v", perm))
+	}
+	// remove the stale socket file left by unclean exit
+	if info, err := os.Stat(path); err == nil && info.Mode()&os.ModeSocket != 0 {
+		if err := os.Remove(path); err != nil {
+			fatal(fmt.Sprintf("remove stale API socket [%v]: %v", path, err))
+		}
+	}
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		fatal(fmt.Sprintf("listen API socket [%v]: %v", path, err))
+	}
+	if err := os.Chmod(path, os.FileMode(mode)); err != nil {
+		listener.Close()
+		fatal(fmt.Sprintf("chmod API socket [%v]: %v", path, err))
+	}
+	return listener
+}
+
+// splitTokens splits comma separated tokens, with empty ones dropped.
+func splitTokens(str string) []string {
+	var tokens []string
+	for _, t := range strings.Split(str, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			tokens = append(tokens, t)
+		}
+	}
+	return tokens
+}
+
 func printStartupMessage(
 	gene *genesis.Genesis,
 	chain *chain.Chain,
@@ -323,8 +679,19 @@ func printStartupMessage(
 		gene.ID(), gene.Name(),
 		bestBlock.Header().ID(), bestBlock.Header().Number(), time.Unix(int64(bestBlock.Header().Timestamp()), 0),
 		thor.GetForkConfig(gene.ID()),
-		master.Address(),
 		func() string {
+			if master.NextKey == nil {
+				return master.Address().String()
+			}
+			return fmt.Sprintf("%v, rotating to %v", master.Address(), thor.Address(crypto.PubkeyToAddress(master.NextKey.PublicKey)))
+		}(),
+		func() string {
+			if n := len(master.Beneficiaries.Beneficiaries); n > 0 {
+				if master.Beneficiaries.PerEpoch {
+					return fmt.Sprintf("rotated among %v addresses per epoch", n)
+				}
+				return fmt.Sprintf("rotated among %v addresses per block", n)
+			}
 			if master.Beneficiary == nil {
 				return "not set, defaults to endorsor"
 			}
@@ -9,14 +9,25 @@ import (
 	"crypto/ecdsa"
 
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/vechain/thor/packer"
 	"github.com/vechain/thor/thor"
 )
 
 type Master struct {
-	PrivateKey  *ecdsa.PrivateKey
-	Beneficiary *thor.Address
+	PrivateKey    *ecdsa.PrivateKey
+	Beneficiary   *thor.Address
+	Beneficiaries packer.BeneficiaryRotation // overrides Beneficiary if not empty
+	NextKey       *ecdsa.PrivateKey          // rotated to, once activated
 }
 
 func (m *Master) Address() thor.Address {
 	return thor.Address(crypto.PubkeyToAddress(m.PrivateKey.PublicKey))
 }
+
+// KeyOf returns the private key of the address, either the current or the next one.
+func (m *Master) KeyOf(addr thor.Address) *ecdsa.PrivateKey {
+	if m.NextKey != nil && thor.Address(crypto.PubkeyToAddress(m.NextKey.PublicKey)) == addr {
+		return m.NextKey
+	}
+	return m.PrivateKey
+}
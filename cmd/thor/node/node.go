@@ -10,26 +10,30 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/beevik/ntp"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/mclock"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/inconshreveable/log15"
-	"github.com/pkg/errors"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/cache"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/co"
 	"github.com/vechain/thor/comm"
 	"github.com/vechain/thor/consensus"
+	"github.com/vechain/thor/evidence"
 	"github.com/vechain/thor/logdb"
 	"github.com/vechain/thor/lvldb"
 	"github.com/vechain/thor/packer"
+	"github.com/vechain/thor/runtime"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/txfilter"
 	"github.com/vechain/thor/txpool"
 )
 
@@ -46,8 +50,15 @@ type Node struct {
 	txPool         *txpool.TxPool
 	txStashPath    string
 	comm           *comm.Communicator
+	evidence       *evidence.Pool
 	commitLock     sync.Mutex
+	packLock       sync.Mutex // serializes packing and dry runs on the packer
 	targetGasLimit uint64
+	removeSlowTxs  bool
+	clockOffset    atomic.Value // time.Duration, measured by NTP
+	diagnosing     int32        // 1 while diagnosing a mismatched block
+	hook           runtime.Hook
+	blockObserver  func(blk *block.Block, receivedAt time.Time)
 }
 
 func New(
@@ -58,10 +69,21 @@ func New(
 	txPool *txpool.TxPool,
 	txStashPath string,
 	comm *comm.Communicator,
+	evidence *evidence.Pool,
 	targetGasLimit uint64,
+	execBudget packer.ExecBudget,
+	priorityLane packer.PriorityLane,
+	removeSlowTxs bool,
 ) *Node {
-	return &Node{
-		packer:         packer.New(chain, stateCreator, master.Address(), master.Beneficiary),
+	p := packer.New(chain, stateCreator, master.Address(), master.Beneficiary)
+	p.SetBeneficiaryRotation(master.Beneficiaries)
+	if master.NextKey != nil {
+		p.SetNextMaster(thor.Address(crypto.PubkeyToAddress(master.NextKey.PublicKey)))
+	}
+	p.SetExecBudget(execBudget)
+	p.SetPriorityLane(priorityLane)
+	n := &Node{
+		packer:         p,
 		cons:           consensus.New(chain, stateCreator),
 		master:         master,
 		chain:          chain,
@@ -69,15 +91,58 @@ func New(
 		txPool:         txPool,
 		txStashPath:    txStashPath,
 		comm:           comm,
+		evidence:       evidence,
 		targetGasLimit: targetGasLimit,
+		removeSlowTxs:  removeSlowTxs,
 	}
+	comm.SetEndorser(n.endorse)
+	return n
+}
+
+// SetHook set the hook to receive execution results of committed blocks.
+// It should be called before Run.
+func (n *Node) SetHook(hook runtime.Hook) {
+	n.hook = hook
+	n.packer.SetRecordDetail(hook != nil)
+}
+
+// SetBlockObserver set the observer of blocks received from peers, which is called before they're processed.
+// It should be called before Run.
+func (n *Node) SetBlockObserver(observer func(blk *block.Block, receivedAt time.Time)) {
+	n.blockObserver = observer
+}
+
+// SetTxFilter set the admission filter of txs to be packed.
+// It should be called before Run.
+func (n *Node) SetTxFilter(filter txfilter.Filter) {
+	n.packer.SetFilter(filter)
+}
+
+// SetDiagnosticsDir set the dir to dump reports and execution traces of blocks
+// failed with mismatched gas used or roots.
+func (n *Node) SetDiagnosticsDir(dir string) {
+	n.cons.SetDiagnosticsDir(dir)
+}
+
+// SetParallelWorkers set count of workers to execute txs of a block in parallel when
+// importing blocks. It's experimental.
+func (n *Node) SetParallelWorkers(workers int) {
+	n.cons.SetParallelWorkers(workers)
+}
+
+// SetWitnessDir set the dir to dump witnesses of blocks imported, for stateless verification.
+func (n *Node) SetWitnessDir(dir string) {
+	n.cons.SetWitnessDir(dir)
 }
 
 func (n *Node) Run(ctx context.Context) error {
 	n.comm.Sync(n.handleBlockStream)
 
+	n.goes.Go(n.checkClockOffset)
 	n.goes.Go(func() { n.houseKeeping(ctx) })
 	n.goes.Go(func() { n.txStashLoop(ctx) })
+	n.goes.Go(func() { n.logDBPruneLoop(ctx) })
+	n.goes.Go(func() { logdb.NewIndexer(n.logDB, n.chain).Run(ctx) })
 	n.goes.Go(func() { n.packerLoop(ctx) })
 
 	n.goes.Wait()
@@ -135,6 +200,9 @@ func (n *Node) houseKeeping(ctx context.Context) {
 	connectivityTicker := time.NewTicker(time.Second)
 	defer connectivityTicker.Stop()
 
+	clockTicker := time.NewTicker(10 * time.Minute)
+	defer clockTicker.Stop()
+
 	var noPeerTimes int
 
 	futureBlocks := cache.NewRandCache(32)
@@ -144,8 +212,14 @@ func (n *Node) houseKeeping(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case newBlock := <-newBlockCh:
+			if n.blockObserver != nil {
+				n.blockObserver(newBlock.Block, newBlock.ReceivedAt)
+			}
 			var stats blockStats
 			if isTrunk, err := n.processBlock(newBlock.Block, &stats); err != nil {
+				if consensus.IsCritical(err) {
+					n.comm.ReportInvalidBlock(newBlock)
+				}
 				if consensus.IsFutureBlock(err) ||
 					(consensus.IsParentMissing(err) && futureBlocks.Contains(newBlock.Header().ParentID())) {
 					log.Debug("future block added", "id", newBlock.Header().ID())
@@ -184,11 +258,33 @@ func (n *Node) houseKeeping(ctx context.Context) {
 				noPeerTimes++
 				if noPeerTimes > 30 {
 					noPeerTimes = 0
-					go checkClockOffset()
+					go n.checkClockOffset()
 				}
 			} else {
 				noPeerTimes = 0
 			}
+		case <-clockTicker.C:
+			go n.checkClockOffset()
+		}
+	}
+}
+
+// logDBPruneLoop prunes logs beyond retention periodically.
+func (n *Node) logDBPruneLoop(ctx context.Context) {
+	log.Debug("enter log db prune loop")
+	defer log.Debug("leave log db prune loop")
+
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := n.logDB.Prune(ctx, n.chain.BestBlock().Header().Number()); err != nil && ctx.Err() == nil {
+				log.Warn("failed to prune log db", "err", err)
+			}
 		}
 	}
 }
@@ -220,6 +316,15 @@ func (n *Node) txStashLoop(ctx context.Context) {
 	for {
 		select {
 		case <-ctx.Done():
+			// executable txs are not stashed while running, so persist them on exit.
+			// they will be revalidated by tx pool after reloaded.
+			txs := n.txPool.Dump()
+			for _, tx := range txs {
+				if err := stash.Save(tx); err != nil {
+					log.Warn("stash tx", "id", tx.ID(), "err", err)
+				}
+			}
+			log.Debug("stashed txs in pool", "count", len(txs))
 			return
 		case txEv := <-txCh:
 			// skip executables
@@ -236,10 +341,49 @@ func (n *Node) txStashLoop(ctx context.Context) {
 	}
 }
 
+// diagnoseTimeout the max time requesting receipts of a mismatched block from peers.
+const diagnoseTimeout = 30 * time.Second
+
+// diagnoseMismatch compares local receipts of the mismatched block against those proved by peers,
+// and reports the first differing tx.
+func (n *Node) diagnoseMismatch(blk *block.Block, report *consensus.MismatchReport) {
+	ctx, cancel := context.WithTimeout(context.Background(), diagnoseTimeout)
+	defer cancel()
+
+	header := blk.Header()
+	expected := n.comm.RequestReceipts(ctx, blk)
+	local := *report
+	if len(expected) < len(local.Receipts) {
+		// compare the proved ones only
+		local.Receipts = local.Receipts[:len(expected)]
+	}
+	if diff := local.Compare(expected); diff != nil {
+		log.Error("first differing tx of mismatched block", "number", header.Number(), "id", header.ID(),
+			"index", diff.Index, "txid", diff.TxID, "fields", diff.Fields)
+		return
+	}
+	if len(expected) < len(blk.Transactions()) {
+		log.Warn("no differing tx found in receipts proved by peers", "number", header.Number(), "id", header.ID(),
+			"proved", len(expected), "txs", len(blk.Transactions()))
+		return
+	}
+	log.Warn("receipts of mismatched block are identical to peers'", "number", header.Number(), "id", header.ID())
+}
+
 func (n *Node) processBlock(blk *block.Block, stats *blockStats) (bool, error) {
 	startTime := mclock.Now()
 	now := uint64(time.Now().Unix())
-	stage, receipts, err := n.cons.Process(blk, now)
+	var (
+		stage    *state.Stage
+		receipts tx.Receipts
+		executed []*runtime.ExecutedTx
+		err      error
+	)
+	if n.hook != nil {
+		stage, receipts, executed, err = n.cons.ProcessWithDetail(blk, now)
+	} else {
+		stage, receipts, err = n.cons.Process(blk, now)
+	}
 	if err != nil {
 		switch {
 		case consensus.IsKnownBlock(err):
@@ -249,9 +393,15 @@ func (n *Node) processBlock(blk *block.Block, stats *blockStats) (bool, error) {
 			stats.UpdateQueued(1)
 		case consensus.IsCritical(err):
 			msg := fmt.Sprintf(`failed to process block due to consensus failure \n%v\n`, blk.Header())
-			log.Error(msg, "err", err)
+			log.Error(msg, "number", blk.Header().Number(), "id", blk.Header().ID(), "err", err)
+			if report := consensus.MismatchReportOf(err); report != nil && atomic.CompareAndSwapInt32(&n.diagnosing, 0, 1) {
+				n.goes.Go(func() {
+					defer atomic.StoreInt32(&n.diagnosing, 0)
+					n.diagnoseMismatch(blk, report)
+				})
+			}
 		default:
-			log.Error("failed to process block", "err", err)
+			log.Error("failed to process block", "number", blk.Header().Number(), "id", blk.Header().ID(), "err", err)
 		}
 		return false, err
 	}
@@ -259,14 +409,14 @@ func (n *Node) processBlock(blk *block.Block, stats *blockStats) (bool, error) {
 	execElapsed := mclock.Now() - startTime
 
 	if _, err := stage.Commit(); err != nil {
-		log.Error("failed to commit state", "err", err)
+		log.Error("failed to commit state", "number", blk.Header().Number(), "id", blk.Header().ID(), "err", err)
 		return false, err
 	}
 
-	fork, err := n.commitBlock(blk, receipts)
+	fork, err := n.commitBlock(blk, receipts, executed)
 	if err != nil {
 		if !n.chain.IsBlockExist(err) {
-			log.Error("failed to commit block", "err", err)
+			log.Error("failed to commit block", "number", blk.Header().Number(), "id", blk.Header().ID(), "err", err)
 		}
 		return false, err
 	}
@@ -276,7 +426,9 @@ func (n *Node) processBlock(blk *block.Block, stats *blockStats) (bool, error) {
 	return len(fork.Trunk) > 0, nil
 }
 
-func (n *Node) commitBlock(newBlock *block.Block, receipts tx.Receipts) (*chain.Fork, error) {
+// commitBlock saves the block along with its receipts, then notifies the hook if any,
+// with executed txs. Logs are written by the indexer in background.
+func (n *Node) commitBlock(newBlock *block.Block, receipts tx.Receipts, executed []*runtime.ExecutedTx) (*chain.Fork, error) {
 	n.commitLock.Lock()
 	defer n.commitLock.Unlock()
 
@@ -285,22 +437,17 @@ func (n *Node) commitBlock(newBlock *block.Block, receipts tx.Receipts) (*chain.
 		return nil, err
 	}
 
-	forkIDs := make([]thor.Bytes32, 0, len(fork.Branch))
-	for _, header := range fork.Branch {
-		forkIDs = append(forkIDs, header.ID())
+	if ev, err := n.evidence.Observe(newBlock.Header()); err != nil {
+		log.Warn("failed to observe block for double signing", "err", err)
+	} else if ev != nil {
+		log.Warn("double signing detected", "signer", ev.Signer, "timestamp", ev.Timestamp, "id1", ev.Headers[0].ID(), "id2", ev.Headers[1].ID())
 	}
 
-	batch := n.logDB.Prepare(newBlock.Header())
-	for i, tx := range newBlock.Transactions() {
-		origin, _ := tx.Signer()
-		txBatch := batch.ForTransaction(tx.ID(), origin)
-		for _, output := range receipts[i].Outputs {
-			txBatch.Insert(output.Events, output.Transfers)
+	if n.hook != nil {
+		for _, e := range executed {
+			n.hook.OnTxExecuted(e)
 		}
-	}
-
-	if err := batch.Commit(forkIDs...); err != nil {
-		return nil, errors.Wrap(err, "commit logs")
+		n.hook.OnBlockCommitted(newBlock, fork)
 	}
 	return fork, nil
 }
@@ -317,27 +464,58 @@ branch:   %v  %v`, fork.Ancestor,
 			trunkLen, fork.Trunk[trunkLen-1],
 			branchLen, fork.Branch[branchLen-1]))
 	}
+	// txs of abandoned blocks, in order of the branch so that deps come first
+	var txs tx.Transactions
 	for _, header := range fork.Branch {
 		body, err := n.chain.GetBlockBody(header.ID())
 		if err != nil {
 			log.Warn("failed to get block body", "err", err, "blockid", header.ID())
 			continue
 		}
-		for _, tx := range body.Txs {
-			if err := n.txPool.Add(tx); err != nil {
-				log.Debug("failed to add tx to tx pool", "err", err, "id", tx.ID())
-			}
-		}
+		txs = append(txs, body.Txs...)
+	}
+	if len(txs) > 0 {
+		reinjected := n.txPool.Reinject(txs)
+		log.Debug("re-injected txs of abandoned blocks", "count", reinjected, "total", len(txs))
 	}
 }
 
-func checkClockOffset() {
+// maxClockOffset the tolerance of local clock offset.
+// Blocks packed by a drifting clock are likely to be rejected by others.
+func maxClockOffset() time.Duration {
+	return time.Duration(thor.BlockInterval) * time.Second / 2
+}
+
+func (n *Node) checkClockOffset() {
 	resp, err := ntp.Query("pool.ntp.org")
 	if err != nil {
 		log.Debug("failed to access NTP", "err", err)
 		return
 	}
-	if resp.ClockOffset > time.Duration(thor.BlockInterval)*time.Second/2 {
+	n.clockOffset.Store(resp.ClockOffset)
+	if resp.ClockOffset > maxClockOffset() || resp.ClockOffset < -maxClockOffset() {
 		log.Warn("clock offset detected", "offset", common.PrettyDuration(resp.ClockOffset))
 	}
 }
+
+// isClockDrifting returns whether local clock drifts beyond tolerance, according to the last NTP measurement.
+func (n *Node) isClockDrifting() (bool, time.Duration) {
+	offset, _ := n.clockOffset.Load().(time.Duration)
+	return offset > maxClockOffset() || offset < -maxClockOffset(), offset
+}
+
+// ClockOffset returns the local clock offset of the last NTP measurement, false if not measured yet.
+func (n *Node) ClockOffset() (time.Duration, bool) {
+	offset, ok := n.clockOffset.Load().(time.Duration)
+	return offset, ok
+}
+
+// NodeMaster returns address of the active node master, which is the next one once rotated to.
+func (n *Node) NodeMaster() thor.Address {
+	addr, err := n.packer.NodeMaster(n.chain.BestBlock().Header())
+	if err != nil {
+		log.Warn("failed to find active node master", "err", err)
+		return n.master.Address()
+	}
+	return thor.Address(crypto.PubkeyToAddress(n.master.KeyOf(addr).PublicKey))
+}
@@ -7,6 +7,7 @@ package node
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"fmt"
 	"math"
 	"time"
@@ -14,10 +15,15 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/mclock"
 	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/packer"
 	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
 )
 
+// endorsementTimeout the max time waiting for endorsements of a proposed block.
+const endorsementTimeout = time.Second
+
 func (n *Node) packerLoop(ctx context.Context) {
 	log.Debug("enter packer loop")
 	defer log.Debug("leave packer loop")
@@ -38,7 +44,9 @@ func (n *Node) packerLoop(ctx context.Context) {
 	)
 	defer ticker.Stop()
 
+	n.packLock.Lock()
 	n.packer.SetTargetGasLimit(n.targetGasLimit)
+	n.packLock.Unlock()
 
 	for {
 		select {
@@ -73,6 +81,11 @@ func (n *Node) packerLoop(ctx context.Context) {
 		}
 
 		if now+1 >= flow.When() {
+			if drifting, offset := n.isClockDrifting(); drifting {
+				log.Warn("skip packing due to clock offset, please sync the clock", "offset", common.PrettyDuration(offset))
+				flow = nil
+				continue
+			}
 			if err := n.pack(flow); err != nil {
 				log.Error("failed to pack block", "err", err)
 			}
@@ -81,29 +94,81 @@ func (n *Node) packerLoop(ctx context.Context) {
 	}
 }
 
-func (n *Node) pack(flow *packer.Flow) error {
-	txs := n.txPool.Executables()
-	var txsToRemove []thor.Bytes32
-	defer func() {
-		for _, id := range txsToRemove {
-			n.txPool.Remove(id)
+// adoptTxs adopts executable txs in the pool into the flow, and returns txs should be removed from the pool.
+func (n *Node) adoptTxs(flow *packer.Flow) (txsToRemove []thor.Bytes32) {
+	// two lanes, priority txs first to take the reserved gas
+	executables := n.txPool.Executables()
+	txs := make(tx.Transactions, 0, len(executables))
+	for _, tx := range executables {
+		if flow.IsPriority(tx) {
+			txs = append(txs, tx)
 		}
-	}()
+	}
+	for _, tx := range executables {
+		if !flow.IsPriority(tx) {
+			txs = append(txs, tx)
+		}
+	}
 
-	startTime := mclock.Now()
 	for _, tx := range txs {
 		if err := flow.Adopt(tx); err != nil {
 			if packer.IsGasLimitReached(err) {
 				break
 			}
+			if packer.IsExecTimeReached(err) {
+				log.Debug("stop adopting txs", "err", err)
+				break
+			}
 			if packer.IsTxNotAdoptableNow(err) {
 				continue
 			}
+			if packer.IsTxTooSlow(err) {
+				log.Debug("skip tx", "id", tx.ID(), "err", err)
+				if n.removeSlowTxs {
+					txsToRemove = append(txsToRemove, tx.ID())
+				}
+				continue
+			}
 			txsToRemove = append(txsToRemove, tx.ID())
 		}
 	}
+	return
+}
+
+// DryRun assembles a block from the pool upon the best block, as the packer would do, but
+// the block is neither signed nor broadcast, and the pool is left untouched.
+// It works even if the node is not an authority node.
+// It waits for the block being packed, if any, and packs upon the best block after it.
+func (n *Node) DryRun() (*packer.Flow, error) {
+	n.packLock.Lock()
+	defer n.packLock.Unlock()
 
-	newBlock, stage, receipts, err := flow.Pack(n.master.PrivateKey)
+	flow, err := n.packer.DryRun(n.chain.BestBlock().Header(), uint64(time.Now().Unix()))
+	if err != nil {
+		return nil, err
+	}
+	n.adoptTxs(flow)
+	return flow, nil
+}
+
+func (n *Node) pack(flow *packer.Flow) error {
+	n.packLock.Lock()
+	defer n.packLock.Unlock()
+
+	startTime := mclock.Now()
+	txsToRemove := n.adoptTxs(flow)
+	defer func() {
+		for _, id := range txsToRemove {
+			n.txPool.Remove(id)
+		}
+	}()
+
+	privateKey := n.master.KeyOf(flow.Signer())
+	if flow.Endorsable() {
+		n.collectEndorsements(flow, privateKey)
+	}
+
+	newBlock, stage, receipts, err := flow.Pack(privateKey)
 	if err != nil {
 		return err
 	}
@@ -113,7 +178,7 @@ func (n *Node) pack(flow *packer.Flow) error {
 		return errors.WithMessage(err, "commit state")
 	}
 
-	fork, err := n.commitBlock(newBlock, receipts)
+	fork, err := n.commitBlock(newBlock, receipts, flow.Executed())
 	if err != nil {
 		return errors.WithMessage(err, "commit block")
 	}
@@ -123,9 +188,11 @@ func (n *Node) pack(flow *packer.Flow) error {
 
 	if len(fork.Trunk) > 0 {
 		n.comm.BroadcastBlock(newBlock)
+		laneUsed, laneReserved := flow.LaneUtilization()
 		log.Info("📦 new block packed",
 			"txs", len(receipts),
 			"mgas", float64(newBlock.Header().GasUsed())/1000/1000,
+			"lane", fmt.Sprintf("%v/%v", laneUsed, laneReserved),
 			"et", fmt.Sprintf("%v|%v", common.PrettyDuration(execElapsed), common.PrettyDuration(commitElapsed)),
 			"id", shortID(newBlock.Header().ID()),
 		)
@@ -145,3 +212,40 @@ func (n *Node) pack(flow *packer.Flow) error {
 	}
 	return nil
 }
+
+// collectEndorsements proposes the block to peers, and adds endorsements of committee members
+// responded in time into the flow.
+func (n *Node) collectEndorsements(flow *packer.Flow, privateKey *ecdsa.PrivateKey) {
+	proposal, err := flow.Propose(privateKey)
+	if err != nil {
+		log.Warn("failed to propose block", "err", err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), endorsementTimeout)
+	defer cancel()
+
+	for _, e := range n.comm.RequestEndorsements(ctx, proposal) {
+		if err := flow.AddEndorsement(e); err != nil {
+			log.Debug("drop endorsement", "err", err)
+		}
+	}
+}
+
+// endorse endorses the header proposed by others, by the master key selected as committee member.
+func (n *Node) endorse(header *block.Header) (*block.Endorsement, error) {
+	keys := []*ecdsa.PrivateKey{n.master.PrivateKey}
+	if n.master.NextKey != nil {
+		keys = append(keys, n.master.NextKey)
+	}
+	for _, key := range keys {
+		endorsement, err := n.packer.Endorse(header, key)
+		if err != nil {
+			if packer.IsNotCommitteeMember(err) {
+				continue
+			}
+			return nil, err
+		}
+		return endorsement, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/pprof"
+	"strings"
+
+	"github.com/vechain/thor/runtime"
+)
+
+const pprofPathPrefix = "/debug/pprof/"
+
+// middleware to serve net/http/pprof, and contract execution profiles collected by the runtime
+// at /debug/pprof/contracts (DELETE to reset). Profiles collecting is turned on along.
+// It should wrap the batch handler, since profiling takes long and is not limited by the API timeout,
+// and is not allowed in batch. Tokens are checked the same way as other APIs.
+func handlePprof(h http.Handler, publicTokens, adminTokens []string) http.Handler {
+	runtime.EnableProfiling(true)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(pprofPathPrefix, pprof.Index)
+	mux.HandleFunc(pprofPathPrefix+"cmdline", pprof.Cmdline)
+	mux.HandleFunc(pprofPathPrefix+"profile", pprof.Profile)
+	mux.HandleFunc(pprofPathPrefix+"symbol", pprof.Symbol)
+	mux.HandleFunc(pprofPathPrefix+"trace", pprof.Trace)
+	mux.HandleFunc(pprofPathPrefix+"contracts", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			json.NewEncoder(w).Encode(runtime.Profiles())
+		case http.MethodDelete:
+			runtime.ResetProfiles()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	var pprofHandler http.Handler = mux
+	if len(publicTokens) > 0 || len(adminTokens) > 0 {
+		pprofHandler = handleAPIAuth(mux, publicTokens, adminTokens)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, pprofPathPrefix) {
+			pprofHandler.ServeHTTP(w, r)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
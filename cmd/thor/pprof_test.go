@@ -0,0 +1,42 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/runtime"
+)
+
+func TestHandlePprof(t *testing.T) {
+	defer runtime.EnableProfiling(false)
+
+	passed := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { passed = true })
+	h := handlePprof(next, []string{"pub"}, []string{"adm"})
+
+	serve := func(path, token string) int {
+		passed = false
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusOK, serve("/blocks/best", ""))
+	assert.True(t, passed)
+
+	assert.Equal(t, http.StatusUnauthorized, serve("/debug/pprof/contracts", ""))
+	assert.Equal(t, http.StatusForbidden, serve("/debug/pprof/contracts", "pub"))
+	assert.Equal(t, http.StatusOK, serve("/debug/pprof/contracts", "adm"))
+	assert.False(t, passed, "served by pprof")
+}
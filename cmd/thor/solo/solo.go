@@ -27,7 +27,7 @@ import (
 	"github.com/vechain/thor/txpool"
 )
 
-var log = log15.New()
+var log = log15.New("pkg", "solo")
 
 // Solo mode is the standalone client without p2p server
 type Solo struct {
@@ -134,7 +134,7 @@ func (s *Solo) packing(pendingTxs tx.Transactions) error {
 	for _, tx := range pendingTxs {
 		err := flow.Adopt(tx)
 		if err != nil {
-			log.Error("executing transaction", "error", fmt.Sprintf("%+v", err.Error()))
+			log.Error("executing transaction", "number", flow.ParentHeader().Number()+1, "txid", tx.ID(), "error", fmt.Sprintf("%+v", err.Error()))
 		}
 		switch {
 		case packer.IsGasLimitReached(err):
@@ -175,6 +175,7 @@ func (s *Solo) packing(pendingTxs tx.Transactions) error {
 		for _, output := range receipt.Outputs {
 			txBatch.Insert(output.Events, output.Transfers)
 		}
+		batch.TrackActivities(uint32(i), tx, origin, receipt)
 	}
 	if err := batch.Commit(); err != nil {
 		return errors.WithMessage(err, "commit log")
@@ -0,0 +1,238 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+// recordWriter streams records as a JSON array of objects, or CSV with header line.
+type recordWriter struct {
+	w      *bufio.Writer
+	csv    *csv.Writer // nil for json
+	fields []string
+	n      int
+}
+
+func newRecordWriter(w io.Writer, format string, fields []string) (*recordWriter, error) {
+	rw := &recordWriter{w: bufio.NewWriter(w), fields: fields}
+	switch format {
+	case "json":
+		rw.w.WriteString("[")
+	case "csv":
+		rw.csv = csv.NewWriter(rw.w)
+		if err := rw.csv.Write(fields); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported format '%v'", format)
+	}
+	return rw, nil
+}
+
+func (rw *recordWriter) Write(values []string) error {
+	rw.n++
+	if rw.csv != nil {
+		return rw.csv.Write(values)
+	}
+	if rw.n > 1 {
+		rw.w.WriteString(",")
+	}
+	rw.w.WriteString("\n  {")
+	for i, field := range rw.fields {
+		if i > 0 {
+			rw.w.WriteString(", ")
+		}
+		k, _ := json.Marshal(field)
+		v, _ := json.Marshal(values[i])
+		rw.w.Write(k)
+		rw.w.WriteString(": ")
+		rw.w.Write(v)
+	}
+	_, err := rw.w.WriteString("}")
+	return err
+}
+
+// Close ends the output and flushes.
+func (rw *recordWriter) Close() error {
+	if rw.csv != nil {
+		rw.csv.Flush()
+		if err := rw.csv.Error(); err != nil {
+			return err
+		}
+	} else {
+		rw.w.WriteString("\n]\n")
+	}
+	return rw.w.Flush()
+}
+
+func stateDumpAction(ctx *cli.Context) error {
+	gene := selectGenesis(ctx)
+	instanceDir := makeInstanceDir(ctx, gene)
+
+	mainDB := openMainDB(ctx, instanceDir)
+	defer mainDB.Close()
+
+	logDB := openMemLogDB()
+	defer logDB.Close()
+
+	chain := initChain(gene, mainDB, logDB)
+
+	header := chain.BestBlock().Header()
+	if str := ctx.String(stateBlockFlag.Name); str != "" {
+		num, err := strconv.ParseUint(str, 0, 32)
+		if err != nil {
+			return errors.WithMessage(err, "block")
+		}
+		if header, err = chain.GetTrunkBlockHeader(uint32(num)); err != nil {
+			return errors.WithMessage(err, "block")
+		}
+	}
+	st, err := state.NewCreator(mainDB).NewState(header.StateRoot())
+	if err != nil {
+		return err
+	}
+
+	if ctx.String(stateFormatFlag.Name) == "snapshot" {
+		if ctx.String(stateAddrFlag.Name) != "" || ctx.String(stateAddrPrefixFlag.Name) != "" {
+			return errors.New("snapshot covers full state, addr and addr-prefix not allowed")
+		}
+		return dumpSnapshot(st, header)
+	}
+	if str := ctx.String(stateAddrFlag.Name); str != "" {
+		addr, err := thor.ParseAddress(str)
+		if err != nil {
+			return errors.WithMessage(err, "addr")
+		}
+		return dumpStorage(ctx, st, addr)
+	}
+	return dumpAccounts(ctx, st, header)
+}
+
+func dumpAccounts(ctx *cli.Context, st *state.State, header *block.Header) error {
+	prefix := strings.TrimPrefix(strings.ToLower(ctx.String(stateAddrPrefixFlag.Name)), "0x")
+	w, err := newRecordWriter(os.Stdout, ctx.String(stateFormatFlag.Name),
+		[]string{"address", "balance", "energy", "master", "codeHash", "storageRoot"})
+	if err != nil {
+		return err
+	}
+	var writeErr error
+	if err := st.ForEachAccount(func(addr thor.Address, a *state.Account) bool {
+		if !strings.HasPrefix(addr.String()[2:], prefix) {
+			return true
+		}
+		writeErr = w.Write([]string{
+			addr.String(),
+			a.Balance.String(),
+			// energy grown up to the block
+			a.CalcEnergy(header.Timestamp()).String(),
+			hexutil.Encode(a.Master),
+			hexutil.Encode(a.CodeHash),
+			hexutil.Encode(a.StorageRoot),
+		})
+		return writeErr == nil
+	}); err != nil {
+		return err
+	}
+	if writeErr != nil {
+		return writeErr
+	}
+	return w.Close()
+}
+
+func dumpStorage(ctx *cli.Context, st *state.State, addr thor.Address) error {
+	w, err := newRecordWriter(os.Stdout, ctx.String(stateFormatFlag.Name), []string{"key", "value"})
+	if err != nil {
+		return err
+	}
+	var writeErr error
+	if err := st.ForEachStorage(addr, func(key thor.Bytes32, value rlp.RawValue) bool {
+		// raw value is rlp encoded
+		writeErr = w.Write([]string{key.String(), hexutil.Encode(value)})
+		return writeErr == nil
+	}); err != nil {
+		return err
+	}
+	if writeErr != nil {
+		return writeErr
+	}
+	return w.Close()
+}
+
+// dumpSnapshot writes full state as genesis.Snapshot in JSON, which can be imported to fork the network.
+func dumpSnapshot(st *state.State, header *block.Header) error {
+	w := bufio.NewWriter(os.Stdout)
+	fmt.Fprintf(w, "{\"number\": %v, \"stateRoot\": \"%v\", \"accounts\": [", header.Number(), header.StateRoot())
+
+	var (
+		n        int
+		writeErr error
+	)
+	if err := st.ForEachAccount(func(addr thor.Address, a *state.Account) bool {
+		sa := genesis.SnapshotAccount{
+			Address:   addr,
+			Balance:   a.Balance,
+			Energy:    a.Energy,
+			BlockTime: a.BlockTime,
+		}
+		if len(a.Master) > 0 {
+			master := thor.BytesToAddress(a.Master)
+			sa.Master = &master
+		}
+		if code := st.GetCode(addr); len(code) > 0 {
+			sa.Code = hexutil.Encode(code)
+		}
+		if writeErr = st.ForEachStorage(addr, func(key thor.Bytes32, value rlp.RawValue) bool {
+			if sa.Storage == nil {
+				sa.Storage = make(map[string]string)
+			}
+			sa.Storage[key.String()] = hexutil.Encode(value)
+			return true
+		}); writeErr != nil {
+			return false
+		}
+		if err := st.Err(); err != nil {
+			writeErr = err
+			return false
+		}
+
+		data, err := json.Marshal(&sa)
+		if err != nil {
+			writeErr = err
+			return false
+		}
+		if n > 0 {
+			w.WriteString(",")
+		}
+		n++
+		w.WriteString("\n  ")
+		_, writeErr = w.Write(data)
+		return writeErr == nil
+	}); err != nil {
+		return err
+	}
+	if writeErr != nil {
+		return writeErr
+	}
+	w.WriteString("\n]}\n")
+	return w.Flush()
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/genesis"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+func decodeJSONFile(path string, v interface{}) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	decoder := json.NewDecoder(bufio.NewReader(file))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
+// stateImportAction writes the custom genesis with the snapshot embedded, which can be passed to --network.
+func stateImportAction(ctx *cli.Context) error {
+	var gen genesis.CustomGenesis
+	if err := decodeJSONFile(ctx.String(stateGenesisFlag.Name), &gen); err != nil {
+		return errors.WithMessage(err, "genesis")
+	}
+	var snapshot genesis.Snapshot
+	if err := decodeJSONFile(ctx.String(stateSnapshotFlag.Name), &snapshot); err != nil {
+		return errors.WithMessage(err, "snapshot")
+	}
+	gen.Snapshot = &snapshot
+
+	gene, err := genesis.NewCustomNet(&gen)
+	if err != nil {
+		return errors.WithMessage(err, "build genesis")
+	}
+	fmt.Fprintf(os.Stderr, "snapshot of block %v imported, state root verified %v\n", snapshot.Number, snapshot.StateRoot)
+	fmt.Fprintf(os.Stderr, "genesis ID %v\n", gene.ID())
+
+	w := bufio.NewWriter(os.Stdout)
+	if err := json.NewEncoder(w).Encode(&gen); err != nil {
+		return err
+	}
+	return w.Flush()
+}
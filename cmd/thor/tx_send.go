@@ -0,0 +1,222 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/accounts"
+	"github.com/vechain/thor/api/transactions"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/thorclient"
+	"github.com/vechain/thor/tx"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+func txSendAction(ctx *cli.Context) error {
+	clauses, err := parseClauses(ctx)
+	if err != nil {
+		return err
+	}
+	client := thorclient.New(ctx.String(apiURLFlag.Name))
+	key, err := loadSigningKey(ctx)
+	if err != nil {
+		return err
+	}
+	receipt, err := sendTx(ctx, client, key, clauses, ctx.Bool(txWaitFlag.Name))
+	if err != nil {
+		return err
+	}
+	if receipt != nil {
+		return printJSON(receipt)
+	}
+	return nil
+}
+
+// sendTx signs and sends a tx consists of given clauses, and prints the tx ID.
+// If wait is true, it returns the receipt after the tx packed.
+func sendTx(ctx *cli.Context, client *thorclient.Client, key *ecdsa.PrivateKey, clauses []*tx.Clause, wait bool) (*transactions.Receipt, error) {
+	trx, err := buildTx(ctx, client, key, clauses)
+	if err != nil {
+		return nil, err
+	}
+	id, err := client.SendTransaction(trx)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Println("Tx sent:", id)
+
+	if !wait {
+		return nil, nil
+	}
+	timeout := time.Duration(uint64(trx.Expiration())*thor.BlockInterval) * time.Second
+	return waitForReceipt(client, id, timeout)
+}
+
+func buildTx(ctx *cli.Context, client *thorclient.Client, key *ecdsa.PrivateKey, clauses []*tx.Clause) (*tx.Transaction, error) {
+	genesisBlock, err := client.GetBlock(thorclient.RevisionNumber(0))
+	if err != nil {
+		return nil, errors.WithMessage(err, "get genesis block")
+	}
+	best, err := client.GetBlock(thorclient.RevisionBest)
+	if err != nil {
+		return nil, errors.WithMessage(err, "get best block")
+	}
+
+	gasPriceCoef := ctx.Int(txGasPriceCoefFlag.Name)
+	if gasPriceCoef < 0 || gasPriceCoef > 255 {
+		return nil, fmt.Errorf("invalid gas price coef: %v", gasPriceCoef)
+	}
+
+	gas := uint64(ctx.Int(txGasFlag.Name))
+	if gas == 0 {
+		caller := thor.Address(crypto.PubkeyToAddress(key.PublicKey))
+		if gas, err = estimateGas(client, caller, clauses); err != nil {
+			return nil, err
+		}
+	}
+
+	builder := new(tx.Builder).
+		ChainTag(genesisBlock.ID[31]).
+		BlockRef(tx.NewBlockRefFromID(best.ID)).
+		Expiration(uint32(ctx.Int(txExpirationFlag.Name))).
+		GasPriceCoef(uint8(gasPriceCoef)).
+		Gas(gas).
+		Nonce(uint64(time.Now().UnixNano()))
+	for _, c := range clauses {
+		builder.Clause(c)
+	}
+	trx := builder.Build()
+
+	sig, err := crypto.Sign(trx.SigningHash().Bytes(), key)
+	if err != nil {
+		return nil, err
+	}
+	return trx.WithSignature(sig), nil
+}
+
+// estimateGas simulates each clause, and sums up intrinsic gas and gas used.
+func estimateGas(client *thorclient.Client, caller thor.Address, clauses []*tx.Clause) (uint64, error) {
+	gas, err := tx.IntrinsicGas(clauses...)
+	if err != nil {
+		return 0, err
+	}
+	for i, c := range clauses {
+		result, err := client.Call(c.To(), &accounts.CallData{
+			Value:  (*math.HexOrDecimal256)(c.Value()),
+			Data:   hexutil.Encode(c.Data()),
+			Caller: &caller,
+		}, thorclient.RevisionBest)
+		if err != nil {
+			return 0, errors.WithMessage(err, "estimate gas")
+		}
+		if result.Reverted {
+			return 0, fmt.Errorf("clause #%v reverted in simulation: %v", i, result.VMError)
+		}
+		gas += result.GasUsed
+	}
+	return gas, nil
+}
+
+func waitForReceipt(client *thorclient.Client, id thor.Bytes32, timeout time.Duration) (*transactions.Receipt, error) {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		receipt, err := client.GetReceipt(id)
+		if err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			return receipt, nil
+		}
+		time.Sleep(time.Second)
+	}
+	return nil, errors.New("timeout waiting for receipt")
+}
+
+// parseClauses parses clauses from either the clauses file, or to/value/data flags.
+func parseClauses(ctx *cli.Context) ([]*tx.Clause, error) {
+	if path := ctx.String(txClausesFlag.Name); path != "" {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var jsonClauses transactions.Clauses
+		if err := json.Unmarshal(data, &jsonClauses); err != nil {
+			return nil, errors.WithMessage(err, "decode clauses")
+		}
+		clauses := make([]*tx.Clause, 0, len(jsonClauses))
+		for i, c := range jsonClauses {
+			data, err := hexutil.Decode(c.Data)
+			if err != nil && c.Data != "" {
+				return nil, errors.WithMessage(err, fmt.Sprintf("clause #%v data", i))
+			}
+			value := big.Int(c.Value)
+			clauses = append(clauses, tx.NewClause(c.To).WithValue(&value).WithData(data))
+		}
+		return clauses, nil
+	}
+
+	var to *thor.Address
+	if str := ctx.String(txToFlag.Name); str != "" {
+		addr, err := thor.ParseAddress(str)
+		if err != nil {
+			return nil, errors.WithMessage(err, "to")
+		}
+		to = &addr
+	}
+	value, ok := math.ParseBig256(ctx.String(txValueFlag.Name))
+	if !ok {
+		return nil, errors.New("invalid value")
+	}
+	var data []byte
+	if str := ctx.String(txDataFlag.Name); str != "" {
+		var err error
+		if data, err = hexutil.Decode(str); err != nil {
+			return nil, errors.WithMessage(err, "data")
+		}
+	}
+	return []*tx.Clause{tx.NewClause(to).WithValue(value).WithData(data)}, nil
+}
+
+// loadSigningKey decrypts the keystore with passphrase read from tty.
+func loadSigningKey(ctx *cli.Context) (*ecdsa.PrivateKey, error) {
+	path := ctx.String(keystoreFlag.Name)
+	if path == "" {
+		return nil, fmt.Errorf("flag %s required", keystoreFlag.Name)
+	}
+	keyjson, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	password, err := readPasswordFromNewTTY("Enter passphrase: ")
+	if err != nil {
+		return nil, err
+	}
+	key, err := keystore.DecryptKey(keyjson, password)
+	if err != nil {
+		return nil, errors.WithMessage(err, "decrypt")
+	}
+	return key.PrivateKey, nil
+}
+
+func printJSON(v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(data))
+	return nil
+}
@@ -8,6 +8,7 @@ package main
 import (
 	"context"
 	"crypto/ecdsa"
+	"crypto/subtle"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -17,12 +18,16 @@ import (
 	"os/user"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	tty "github.com/mattn/go-tty"
 	"github.com/vechain/thor/api/doc"
+	"github.com/vechain/thor/api/usage"
+	"github.com/vechain/thor/api/utils"
 	"github.com/vechain/thor/thor"
 )
 
@@ -151,9 +156,22 @@ func handleXThorestVersion(h http.Handler) http.Handler {
 	})
 }
 
+// exportPaths are paths of APIs able to export logs.
+var exportPaths = map[string]bool{"/logs/event": true, "/logs/transfer": true}
+
+// isExportRequest returns whether the request exports logs, which streams as long as the client reads.
+func isExportRequest(r *http.Request) bool {
+	return r.Method == http.MethodPost && exportPaths[r.URL.Path] && utils.ExportFormat(r) != ""
+}
+
 // middleware for http request timeout.
+// Exports are not limited, as they're paged and end once the client gone.
 func handleAPITimeout(h http.Handler, timeout time.Duration) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isExportRequest(r) {
+			h.ServeHTTP(w, r)
+			return
+		}
 		ctx, cancel := context.WithTimeout(r.Context(), timeout)
 		defer cancel()
 		r = r.WithContext(ctx)
@@ -161,6 +179,127 @@ func handleAPITimeout(h http.Handler, timeout time.Duration) http.Handler {
 	})
 }
 
+// adminPathPrefixes are paths of APIs in admin scope.
+var adminPathPrefixes = []string{"/debug/", "/node/"}
+
+// isAdminRequest returns whether the request is to APIs in admin scope.
+// Submitting contracts for verification is also in admin scope, since verified contracts are served to all.
+func isAdminRequest(r *http.Request) bool {
+	for _, prefix := range adminPathPrefixes {
+		if strings.HasPrefix(r.URL.Path, prefix) {
+			return true
+		}
+	}
+	return r.Method == http.MethodPost &&
+		strings.HasPrefix(r.URL.Path, "/contracts/") &&
+		strings.HasSuffix(r.URL.Path, "/verify")
+}
+
+// authExemptPaths are paths that never require tokens, e.g. probes for orchestration.
+var authExemptPaths = map[string]bool{"/healthz": true, "/readyz": true}
+
+// middleware to authenticate requests with tokens, passed by 'Authorization: Bearer <token>'
+// header, 'x-api-key' header or 'api-key' query (for websocket clients).
+// Admin tokens have access to all APIs, while public tokens have no access to APIs in admin scope.
+// Public APIs are open if no public token configured, while APIs in admin scope are open only if
+// no token configured at all, and are closed if only public tokens configured.
+// Cacheable responses are made private, since caches in between can't authenticate.
+// Requests with a token are identified as from the token's client.
+func handleAPIAuth(h http.Handler, publicTokens, adminTokens []string) http.Handler {
+	match := func(token string, tokens []string) bool {
+		found := false
+		for _, t := range tokens {
+			if subtle.ConstantTimeCompare([]byte(token), []byte(t)) == 1 {
+				found = true
+			}
+		}
+		return found
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodOptions || authExemptPaths[r.URL.Path] {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		var allowed bool
+		token := requestToken(r)
+		if isAdminRequest(r) {
+			allowed = (len(publicTokens) == 0 && len(adminTokens) == 0) || match(token, adminTokens)
+		} else {
+			allowed = len(publicTokens) == 0 || match(token, publicTokens) || match(token, adminTokens)
+		}
+
+		if !allowed {
+			io.Copy(ioutil.Discard, r.Body)
+			if token == "" {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				http.Error(w, "token required", http.StatusUnauthorized)
+			} else {
+				http.Error(w, "invalid token", http.StatusForbidden)
+			}
+			return
+		}
+		if token != "" {
+			r = utils.WithClient(r, "token:"+token)
+		}
+		h.ServeHTTP(w, utils.WithPrivateCache(r))
+	})
+}
+
+// middleware to account usage of APIs per token, served at '/usage' for usage of the token requesting,
+// and at '/node/usage' for all tokens, which are identified by hash prefixes.
+// Only configured tokens are accounted, so that made-up tokens can't pile up.
+func handleAPIUsage(h http.Handler, meter *usage.Meter, tokens []string) http.Handler {
+	keyID := func(key string) string {
+		return hexutil.Encode(thor.Blake2b([]byte(key)).Bytes()[:8])
+	}
+	configured := make(map[string]bool, len(tokens))
+	for _, token := range tokens {
+		configured[token] = true
+	}
+	keyOf := func(r *http.Request) string {
+		if token := requestToken(r); configured[token] {
+			return token
+		}
+		return ""
+	}
+	h = meter.Handler(h, keyOf)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/usage":
+			token := requestToken(r)
+			if token == "" {
+				http.Error(w, "token required", http.StatusUnauthorized)
+				return
+			}
+			if !configured[token] {
+				http.Error(w, "invalid token", http.StatusForbidden)
+				return
+			}
+			utils.WriteJSON(w, utils.M{"id": keyID(token), "usage": meter.Get(token)})
+		case "/node/usage":
+			all := make(map[string]usage.Usage)
+			for key, u := range meter.All() {
+				all[keyID(key)] = u
+			}
+			utils.WriteJSON(w, all)
+		default:
+			h.ServeHTTP(w, r)
+		}
+	})
+}
+
+func requestToken(r *http.Request) string {
+	const bearerPrefix = "Bearer "
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, bearerPrefix) {
+		return strings.TrimPrefix(auth, bearerPrefix)
+	}
+	if key := r.Header.Get("x-api-key"); key != "" {
+		return key
+	}
+	return r.URL.Query().Get("api-key")
+}
+
 func readPasswordFromNewTTY(prompt string) (string, error) {
 	t, err := tty.Open()
 	if err != nil {
@@ -0,0 +1,184 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/api/usage"
+	"github.com/vechain/thor/api/utils"
+)
+
+func TestHandleAPIAuth(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	tests := []struct {
+		name         string
+		publicTokens []string
+		adminTokens  []string
+		method       string
+		path         string
+		token        string
+		want         int
+	}{
+		{"open", nil, nil, "GET", "/blocks/best", "", 200},
+		{"open admin", nil, nil, "GET", "/node/network/peers", "", 200},
+
+		{"public no token", []string{"pub"}, []string{"adm"}, "GET", "/blocks/best", "", 401},
+		{"public bad token", []string{"pub"}, []string{"adm"}, "GET", "/blocks/best", "bad", 403},
+		{"public token", []string{"pub"}, []string{"adm"}, "GET", "/blocks/best", "pub", 200},
+		{"public by admin token", []string{"pub"}, []string{"adm"}, "GET", "/blocks/best", "adm", 200},
+
+		{"admin no token", []string{"pub"}, []string{"adm"}, "GET", "/node/network/peers", "", 401},
+		{"admin by public token", []string{"pub"}, []string{"adm"}, "GET", "/node/network/peers", "pub", 403},
+		{"debug by public token", []string{"pub"}, []string{"adm"}, "POST", "/debug/tracers", "pub", 403},
+		{"admin token", []string{"pub"}, []string{"adm"}, "GET", "/node/network/peers", "adm", 200},
+		{"verify by public token", []string{"pub"}, []string{"adm"}, "POST", "/contracts/0x0000000000000000000000000000456e65726779/verify", "pub", 403},
+		{"verify by admin token", []string{"pub"}, []string{"adm"}, "POST", "/contracts/0x0000000000000000000000000000456e65726779/verify", "adm", 200},
+		{"contract by public token", []string{"pub"}, []string{"adm"}, "GET", "/contracts/0x0000000000000000000000000000456e65726779", "pub", 200},
+		{"admin scope closed", []string{"pub"}, nil, "GET", "/node/network/peers", "", 401},
+		{"admin scope closed to public token", []string{"pub"}, nil, "GET", "/node/network/peers", "pub", 403},
+		{"public scope open", nil, []string{"adm"}, "GET", "/blocks/best", "", 200},
+
+		{"not admin prefix", nil, []string{"adm"}, "GET", "/nodes", "", 200},
+		{"admin prefix only", nil, []string{"adm"}, "GET", "/node/", "", 401},
+
+		{"exempt", []string{"pub"}, []string{"adm"}, "GET", "/healthz", "", 200},
+		{"preflight", []string{"pub"}, []string{"adm"}, "OPTIONS", "/node/network/peers", "", 200},
+	}
+
+	for _, tt := range tests {
+		h := handleAPIAuth(ok, tt.publicTokens, tt.adminTokens)
+		req := httptest.NewRequest(tt.method, tt.path, nil)
+		if tt.token != "" {
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		assert.Equal(t, tt.want, rec.Code, tt.name)
+	}
+}
+
+func TestHandleAPIAuthCache(t *testing.T) {
+	immutable := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		utils.WriteImmutableJSON(w, r, "final")
+	})
+
+	rec := httptest.NewRecorder()
+	immutable.ServeHTTP(rec, httptest.NewRequest("GET", "/blocks/1", nil))
+	assert.Equal(t, "public, max-age=31536000, immutable", rec.Header().Get("Cache-Control"))
+
+	req := httptest.NewRequest("GET", "/blocks/1", nil)
+	req.Header.Set("x-api-key", "pub")
+	rec = httptest.NewRecorder()
+	handleAPIAuth(immutable, []string{"pub"}, nil).ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "private, max-age=31536000, immutable", rec.Header().Get("Cache-Control"))
+}
+
+func TestHandleAPIAuthClient(t *testing.T) {
+	var client string
+	h := handleAPIAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client = utils.ClientOf(r)
+	}), nil, nil)
+
+	req := httptest.NewRequest("GET", "/blocks/1", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	h.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "10.0.0.1", client)
+
+	req.Header.Set("x-api-key", "pub")
+	h.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "token:pub", client)
+}
+
+func TestHandleAPITimeout(t *testing.T) {
+	var limited bool
+	h := handleAPITimeout(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, limited = r.Context().Deadline()
+	}), time.Second)
+
+	tests := []struct {
+		method string
+		path   string
+		accept string
+		want   bool
+	}{
+		{"POST", "/logs/event", "", true},
+		{"POST", "/logs/event", "application/json", true},
+		{"POST", "/logs/event", "text/csv", false},
+		{"POST", "/logs/transfer", "application/x-ndjson", false},
+		{"GET", "/blocks/best", "text/csv", true},
+		{"POST", "/logs/events", "text/csv", true},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, nil)
+		req.Header.Set("Accept", tt.accept)
+		h.ServeHTTP(httptest.NewRecorder(), req)
+		assert.Equal(t, tt.want, limited, "%v %v %v", tt.method, tt.path, tt.accept)
+	}
+}
+
+func TestHandleAPIUsage(t *testing.T) {
+	meter := usage.NewMeter()
+	h := handleAPIUsage(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		usage.AddGas(r.Context(), 100)
+		w.Write([]byte("hello"))
+	}), meter, []string{"pub", "adm"})
+
+	serve := func(path, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", path, nil)
+		if token != "" {
+			req.Header.Set("x-api-key", token)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for _, token := range []string{"pub", "pub", "adm", "made-up", ""} {
+		serve("/blocks/best", token)
+	}
+	assert.Equal(t, usage.Usage{Calls: 2, Gas: 200, Bytes: 10}, meter.Get("pub"))
+	assert.Equal(t, usage.Usage{Calls: 1, Gas: 100, Bytes: 5}, meter.Get("adm"))
+	assert.Equal(t, 2, len(meter.All()), "only configured tokens accounted")
+
+	rec := serve("/usage", "pub")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var own struct {
+		ID    string      `json:"id"`
+		Usage usage.Usage `json:"usage"`
+	}
+	assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), &own))
+	assert.Equal(t, usage.Usage{Calls: 2, Gas: 200, Bytes: 10}, own.Usage)
+	assert.Len(t, own.ID, 18)
+
+	assert.Equal(t, http.StatusUnauthorized, serve("/usage", "").Code)
+	assert.Equal(t, http.StatusForbidden, serve("/usage", "made-up").Code)
+
+	rec = serve("/node/usage", "adm")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var all map[string]usage.Usage
+	assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), &all))
+	assert.Equal(t, 2, len(all))
+	assert.Equal(t, usage.Usage{Calls: 2, Gas: 200, Bytes: 10}, all[own.ID])
+}
+
+func TestRequestToken(t *testing.T) {
+	req := httptest.NewRequest("GET", "/blocks/best?api-key=query", nil)
+	assert.Equal(t, "query", requestToken(req))
+
+	req.Header.Set("x-api-key", "header")
+	assert.Equal(t, "header", requestToken(req))
+
+	req.Header.Set("Authorization", "Bearer bearer")
+	assert.Equal(t, "bearer", requestToken(req))
+}
@@ -6,6 +6,9 @@
 package comm
 
 import (
+	"context"
+	"time"
+
 	"github.com/ethereum/go-ethereum/p2p/discover"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/vechain/thor/block"
@@ -71,20 +74,28 @@ func (c *Communicator) fetchBlockByID(peer *Peer, newBlockID thor.Bytes32) {
 	result, err := proto.GetBlockByID(c.ctx, peer, newBlockID)
 	if err != nil {
 		peer.logger.Debug("failed to get block by id", "err", err)
+		if err == context.DeadlineExceeded {
+			c.reportMisbehavior(peer, Timeout)
+		}
 		return
 	}
 	if len(result) == 0 {
 		peer.logger.Debug("get nil block by id")
+		// announced but not served
+		c.reportMisbehavior(peer, UselessAnnouncement)
 		return
 	}
 
 	var blk block.Block
 	if err := rlp.DecodeBytes(result, &blk); err != nil {
 		peer.logger.Debug("failed to decode block got by id", "err", err)
+		c.reportMisbehavior(peer, InvalidBlock)
 		return
 	}
 
 	c.newBlockFeed.Send(&NewBlockEvent{
-		Block: &blk,
+		Block:      &blk,
+		ReceivedAt: time.Now(),
+		peer:       peer,
 	})
 }
@@ -15,13 +15,17 @@ import (
 
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/inconshreveable/log15"
+	"github.com/pkg/errors"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/co"
 	"github.com/vechain/thor/comm/proto"
 	"github.com/vechain/thor/p2psrv"
+	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/trie"
 	"github.com/vechain/thor/tx"
 	"github.com/vechain/thor/txpool"
 )
@@ -31,29 +35,40 @@ var log = log15.New("pkg", "comm")
 // Communicator communicates with remote p2p peers to exchange blocks and txs, etc.
 type Communicator struct {
 	chain          *chain.Chain
+	stateCreator   *state.Creator
 	txPool         *txpool.TxPool
 	ctx            context.Context
 	cancel         context.CancelFunc
 	peerSet        *PeerSet
+	banList        *banList
 	syncedCh       chan struct{}
 	newBlockFeed   event.Feed
 	announcementCh chan *announcement
+	fetchingTxs    *txIDSet
 	feedScope      event.SubscriptionScope
 	goes           co.Goes
 	onceSynced     sync.Once
+	endorser       Endorser
 }
 
+// Endorser endorses the header proposed by others.
+// It returns nil endorsement if not selected as committee member.
+type Endorser func(header *block.Header) (*block.Endorsement, error)
+
 // New create a new Communicator instance.
-func New(chain *chain.Chain, txPool *txpool.TxPool) *Communicator {
+func New(chain *chain.Chain, stateCreator *state.Creator, txPool *txpool.TxPool) *Communicator {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &Communicator{
 		chain:          chain,
+		stateCreator:   stateCreator,
 		txPool:         txPool,
 		ctx:            ctx,
 		cancel:         cancel,
 		peerSet:        newPeerSet(),
+		banList:        newBanList(),
 		syncedCh:       make(chan struct{}),
 		announcementCh: make(chan *announcement),
+		fetchingTxs:    newTxIDSet(),
 	}
 }
 
@@ -110,6 +125,9 @@ func (c *Communicator) Sync(handler HandleBlockStream) {
 				} else {
 					if err := c.sync(peer, best.Number(), handler); err != nil {
 						peer.logger.Debug("synchronization failed", "err", err)
+						if err == context.DeadlineExceeded {
+							c.reportMisbehavior(peer, Timeout)
+						}
 						break
 					}
 					peer.logger.Debug("synchronization done")
@@ -128,20 +146,56 @@ func (c *Communicator) Sync(handler HandleBlockStream) {
 }
 
 // Protocols returns all supported protocols.
+// Legacy versions are kept for compatibility, and the highest version shared with peer is chosen.
 func (c *Communicator) Protocols() []*p2psrv.Protocol {
 	genesisID := c.chain.GenesisBlock().Header().ID()
+	// topic unchanged across versions, to discover peers of any version
+	discTopic := fmt.Sprintf("%v%v@%x", proto.Name, proto.Version1, genesisID[24:])
 	return []*p2psrv.Protocol{
 		&p2psrv.Protocol{
 			Protocol: p2p.Protocol{
 				Name:    proto.Name,
 				Version: proto.Version,
 				Length:  proto.Length,
-				Run:     c.servePeer,
+				Run:     c.servePeerFunc(proto.Version),
+			},
+			DiscTopic: discTopic,
+		},
+		&p2psrv.Protocol{
+			Protocol: p2p.Protocol{
+				Name:    proto.Name,
+				Version: proto.Version3,
+				Length:  proto.Version3Length,
+				Run:     c.servePeerFunc(proto.Version3),
+			},
+			DiscTopic: discTopic,
+		},
+		&p2psrv.Protocol{
+			Protocol: p2p.Protocol{
+				Name:    proto.Name,
+				Version: proto.Version2,
+				Length:  proto.Version2Length,
+				Run:     c.servePeerFunc(proto.Version2),
+			},
+			DiscTopic: discTopic,
+		},
+		&p2psrv.Protocol{
+			Protocol: p2p.Protocol{
+				Name:    proto.Name,
+				Version: proto.Version1,
+				Length:  proto.Version1Length,
+				Run:     c.servePeerFunc(proto.Version1),
 			},
-			DiscTopic: fmt.Sprintf("%v%v@%x", proto.Name, proto.Version, genesisID[24:]),
+			DiscTopic: discTopic,
 		}}
 }
 
+// SetEndorser set the endorser to serve endorsement requests of peers.
+// Requests are ignored if not set.
+func (c *Communicator) SetEndorser(endorser Endorser) {
+	c.endorser = endorser
+}
+
 // Start start the communicator.
 func (c *Communicator) Start() {
 	c.goes.Go(c.txsLoop)
@@ -160,8 +214,17 @@ type txsToSync struct {
 	synced bool
 }
 
-func (c *Communicator) servePeer(p *p2p.Peer, rw p2p.MsgReadWriter) error {
-	peer := newPeer(p, rw)
+func (c *Communicator) servePeerFunc(version uint) func(p *p2p.Peer, rw p2p.MsgReadWriter) error {
+	return func(p *p2p.Peer, rw p2p.MsgReadWriter) error {
+		return c.servePeer(p, rw, version)
+	}
+}
+
+func (c *Communicator) servePeer(p *p2p.Peer, rw p2p.MsgReadWriter, version uint) error {
+	if c.banList.IsBanned(p.ID(), time.Now()) {
+		return errors.New("peer banned")
+	}
+	peer := newPeer(p, rw, version)
 	c.goes.Go(func() {
 		c.runPeer(peer)
 	})
@@ -259,6 +322,84 @@ func (c *Communicator) BroadcastBlock(blk *block.Block) {
 	}
 }
 
+// RequestEndorsements requests endorsements on the proposed header from peers, until all of them
+// responded or ctx done. Only committee members directly connected are able to endorse, and
+// endorsements returned are not verified.
+func (c *Communicator) RequestEndorsements(ctx context.Context, header *block.Header) block.Endorsements {
+	peers := c.peerSet.Slice().Filter(func(p *Peer) bool {
+		return p.SupportsEndorsement()
+	})
+
+	resultCh := make(chan block.Endorsements, len(peers))
+	for _, peer := range peers {
+		peer := peer
+		c.goes.Go(func() {
+			endorsements, err := proto.RequestEndorsement(ctx, peer, header)
+			if err != nil {
+				peer.logger.Debug("failed to request endorsement", "err", err)
+			}
+			// one endorsement at most from a peer
+			if len(endorsements) > 1 {
+				endorsements = endorsements[:1]
+			}
+			resultCh <- endorsements
+		})
+	}
+
+	var all block.Endorsements
+	for range peers {
+		select {
+		case <-ctx.Done():
+			return all
+		case endorsements := <-resultCh:
+			all = append(all, endorsements...)
+		}
+	}
+	return all
+}
+
+// RequestReceipts requests receipts of txs in the block from peers which have the block on trunk.
+// Receipts are verified against the receipts root of the block, and the result is cut at the first
+// receipt no peer proved.
+func (c *Communicator) RequestReceipts(ctx context.Context, blk *block.Block) tx.Receipts {
+	peers := c.peerSet.Slice().Filter(func(p *Peer) bool {
+		return p.SupportsTxProof()
+	})
+
+	header := blk.Header()
+	var receipts tx.Receipts
+	for i, trx := range blk.Transactions() {
+		var receipt *tx.Receipt
+		for _, peer := range peers {
+			proof, err := proto.GetTxProof(ctx, peer, trx.ID())
+			if err != nil {
+				peer.logger.Debug("failed to request tx proof", "err", err)
+				continue
+			}
+			if proof.BlockID != header.ID() || proof.Index != uint64(i) {
+				continue
+			}
+			data, err := trie.VerifyDerivedProof(header.ReceiptsRoot(), i, proof.Receipt)
+			if err != nil {
+				peer.logger.Debug("bad receipt proof", "err", err)
+				continue
+			}
+			var r tx.Receipt
+			if err := rlp.DecodeBytes(data, &r); err != nil {
+				peer.logger.Debug("bad receipt", "err", err)
+				continue
+			}
+			receipt = &r
+			break
+		}
+		if receipt == nil {
+			break
+		}
+		receipts = append(receipts, receipt)
+	}
+	return receipts
+}
+
 // PeerCount returns count of peers.
 func (c *Communicator) PeerCount() int {
 	return c.peerSet.Len()
@@ -269,14 +410,23 @@ func (c *Communicator) PeersStats() []*PeerStats {
 	var stats []*PeerStats
 	for _, peer := range c.peerSet.Slice() {
 		bestID, totalScore := peer.Head()
+		counts, penalty := peer.score.snapshot(time.Now())
+		misbehaviors := make(map[string]uint64)
+		for m, n := range counts {
+			if n > 0 {
+				misbehaviors[Misbehavior(m).String()] = n
+			}
+		}
 		stats = append(stats, &PeerStats{
-			Name:        peer.Name(),
-			BestBlockID: bestID,
-			TotalScore:  totalScore,
-			PeerID:      peer.ID().String(),
-			NetAddr:     peer.RemoteAddr().String(),
-			Inbound:     peer.Inbound(),
-			Duration:    uint64(time.Duration(peer.Duration()) / time.Second),
+			Name:         peer.Name(),
+			BestBlockID:  bestID,
+			TotalScore:   totalScore,
+			PeerID:       peer.ID().String(),
+			NetAddr:      peer.RemoteAddr().String(),
+			Inbound:      peer.Inbound(),
+			Duration:     uint64(time.Duration(peer.Duration()) / time.Second),
+			Penalty:      penalty,
+			Misbehaviors: misbehaviors,
 		})
 	}
 	sort.Slice(stats, func(i, j int) bool {
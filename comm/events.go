@@ -7,6 +7,7 @@ package comm
 
 import (
 	"context"
+	"time"
 
 	"github.com/vechain/thor/block"
 )
@@ -14,6 +15,8 @@ import (
 // NewBlockEvent event emitted when received block announcement.
 type NewBlockEvent struct {
 	*block.Block
+	ReceivedAt time.Time
+	peer       *Peer // the peer who sent the block
 }
 
 // HandleBlockStream to handle the stream of downloaded blocks in sync process.
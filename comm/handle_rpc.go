@@ -11,12 +11,15 @@ import (
 
 	"github.com/ethereum/go-ethereum/p2p"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/inconshreveable/log15"
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/comm/proto"
 	"github.com/vechain/thor/metric"
+	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/txpool"
 )
 
 // peer will be disconnected if error returned
@@ -44,14 +47,24 @@ func (c *Communicator) handleRPC(peer *Peer, msg *p2p.Msg, write func(interface{
 			BestBlockID:    best.ID(),
 		})
 	case proto.MsgNewBlock:
+		var raw rlp.RawValue
+		if err := msg.Decode(&raw); err != nil {
+			return errors.WithMessage(err, "decode msg")
+		}
 		var newBlock *block.Block
-		if err := msg.Decode(&newBlock); err != nil {
+		if err := rlp.DecodeBytes(raw, &newBlock); err != nil {
+			if block.IsUnsupportedVersion(err) {
+				// the peer is not misbehaving, but running a newer protocol
+				log.Warn("ignored new block", "err", err)
+				write(&struct{}{})
+				break
+			}
 			return errors.WithMessage(err, "decode msg")
 		}
 
 		peer.MarkBlock(newBlock.Header().ID())
 		peer.UpdateHead(newBlock.Header().ID(), newBlock.Header().TotalScore())
-		c.newBlockFeed.Send(&NewBlockEvent{Block: newBlock})
+		c.newBlockFeed.Send(&NewBlockEvent{Block: newBlock, ReceivedAt: time.Now(), peer: peer})
 		write(&struct{}{})
 	case proto.MsgNewBlockID:
 		var newBlockID thor.Bytes32
@@ -70,8 +83,44 @@ func (c *Communicator) handleRPC(peer *Peer, msg *p2p.Msg, write func(interface{
 			return errors.WithMessage(err, "decode msg")
 		}
 		peer.MarkTransaction(newTx.ID())
-		c.txPool.StrictlyAdd(newTx)
+		if err := c.txPool.StrictlyAdd(newTx); txpool.IsBadTx(err) {
+			c.reportMisbehavior(peer, BadTx)
+		}
+		write(&struct{}{})
+	case proto.MsgNewTxIDs:
+		var ids []thor.Bytes32
+		if err := msg.Decode(&ids); err != nil {
+			return errors.WithMessage(err, "decode msg")
+		}
+		if len(ids) > maxTxAnnouncements {
+			return errors.New("too many tx IDs")
+		}
+		var toFetch []thor.Bytes32
+		for _, id := range ids {
+			peer.MarkTransaction(id)
+			if c.txPool.Get(id) == nil && c.fetchingTxs.Add(id) {
+				toFetch = append(toFetch, id)
+			}
+		}
+		if len(toFetch) > 0 {
+			c.goes.Go(func() { c.fetchTxs(peer, toFetch) })
+		}
 		write(&struct{}{})
+	case proto.MsgGetTxsByID:
+		var ids []thor.Bytes32
+		if err := msg.Decode(&ids); err != nil {
+			return errors.WithMessage(err, "decode msg")
+		}
+		if len(ids) > maxTxAnnouncements {
+			return errors.New("too many tx IDs")
+		}
+		var txs tx.Transactions
+		for _, id := range ids {
+			if tx := c.txPool.Get(id); tx != nil {
+				txs = append(txs, tx)
+			}
+		}
+		write(txs)
 	case proto.MsgGetBlockByID:
 		var blockID thor.Bytes32
 		if err := msg.Decode(&blockID); err != nil {
@@ -164,8 +213,137 @@ func (c *Communicator) handleRPC(peer *Peer, msg *p2p.Msg, write func(interface{
 			}
 			write(toSend)
 		}
+	case proto.MsgGetHeadersFromNumber:
+		var num uint32
+		if err := msg.Decode(&num); err != nil {
+			return errors.WithMessage(err, "decode msg")
+		}
+
+		const maxHeaders = 1024
+		result := make([]*block.Header, 0, maxHeaders)
+		for len(result) < maxHeaders {
+			header, err := c.chain.GetTrunkBlockHeader(num)
+			if err != nil {
+				if !c.chain.IsNotFound(err) {
+					log.Error("failed to get block header by number", "err", err)
+				}
+				break
+			}
+			result = append(result, header)
+			num++
+		}
+		write(result)
+	case proto.MsgGetAccountProof:
+		var req proto.AccountProofRequest
+		if err := msg.Decode(&req); err != nil {
+			return errors.WithMessage(err, "decode msg")
+		}
+		var proof [][]byte
+		if st := c.stateAt(req.BlockID, log); st != nil {
+			accountProof, err := st.ProveAccount(req.Address)
+			if err != nil {
+				log.Error("failed to prove account", "err", err)
+			} else {
+				proof = accountProof
+			}
+		}
+		write(proof)
+	case proto.MsgGetStorageProof:
+		var req proto.StorageProofRequest
+		if err := msg.Decode(&req); err != nil {
+			return errors.WithMessage(err, "decode msg")
+		}
+		var proof proto.StorageProof
+		if st := c.stateAt(req.BlockID, log); st != nil {
+			accountProof, err := st.ProveAccount(req.Address)
+			if err != nil {
+				log.Error("failed to prove account", "err", err)
+			} else if storageProof, err := st.ProveStorage(req.Address, req.Key); err != nil {
+				log.Error("failed to prove storage", "err", err)
+			} else {
+				proof = proto.StorageProof{Account: accountProof, Storage: storageProof}
+			}
+		}
+		write(&proof)
+	case proto.MsgRequestEndorsement:
+		var header *block.Header
+		if err := msg.Decode(&header); err != nil {
+			return errors.WithMessage(err, "decode msg")
+		}
+		var result block.Endorsements
+		// only proposals upon the best block are endorsed
+		if c.endorser != nil && header.ParentID() == c.chain.BestBlock().Header().ID() {
+			endorsement, err := c.endorser(header)
+			if err != nil {
+				log.Debug("failed to endorse", "err", err)
+			} else if endorsement != nil {
+				result = append(result, endorsement)
+			}
+		}
+		write(result)
+	case proto.MsgGetTxProof:
+		var txID thor.Bytes32
+		if err := msg.Decode(&txID); err != nil {
+			return errors.WithMessage(err, "decode msg")
+		}
+		var proof proto.TxProof
+		if p, err := c.proveTx(txID); err != nil {
+			if !c.chain.IsNotFound(err) {
+				log.Error("failed to prove tx", "err", err)
+			}
+		} else {
+			proof = *p
+		}
+		write(&proof)
 	default:
 		return fmt.Errorf("unknown message (%v)", msg.Code)
 	}
 	return nil
 }
+
+// stateAt returns state of the given block, or nil if the block not found.
+func (c *Communicator) stateAt(blockID thor.Bytes32, log log15.Logger) *state.State {
+	header, err := c.chain.GetBlockHeader(blockID)
+	if err != nil {
+		if !c.chain.IsNotFound(err) {
+			log.Error("failed to get block header", "err", err)
+		}
+		return nil
+	}
+	st, err := c.stateCreator.NewState(header.StateRoot())
+	if err != nil {
+		log.Error("failed to create state", "err", err)
+		return nil
+	}
+	return st
+}
+
+// proveTx returns proofs of the tx and its receipt on trunk.
+func (c *Communicator) proveTx(txID thor.Bytes32) (*proto.TxProof, error) {
+	meta, err := c.chain.GetTransactionMeta(txID, c.chain.BestBlock().Header().ID())
+	if err != nil {
+		return nil, err
+	}
+	blk, err := c.chain.GetBlock(meta.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := c.chain.GetBlockReceipts(meta.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	txProof, err := blk.Transactions().Proof(int(meta.Index))
+	if err != nil {
+		return nil, err
+	}
+	receiptProof, err := receipts.Proof(int(meta.Index))
+	if err != nil {
+		return nil, err
+	}
+	return &proto.TxProof{
+		BlockID: meta.BlockID,
+		Index:   meta.Index,
+		Tx:      txProof,
+		Receipt: receiptProof,
+	}, nil
+}
@@ -34,9 +34,11 @@ type Peer struct {
 	*rpc.RPC
 	logger log15.Logger
 
+	version     uint // negotiated protocol version
 	createdTime mclock.AbsTime
 	knownTxs    *lru.Cache
 	knownBlocks *lru.Cache
+	score       peerScore
 	head        struct {
 		sync.Mutex
 		id         thor.Bytes32
@@ -44,7 +46,7 @@ type Peer struct {
 	}
 }
 
-func newPeer(peer *p2p.Peer, rw p2p.MsgReadWriter) *Peer {
+func newPeer(peer *p2p.Peer, rw p2p.MsgReadWriter, version uint) *Peer {
 	dir := "outbound"
 	if peer.Inbound() {
 		dir = "inbound"
@@ -52,6 +54,7 @@ func newPeer(peer *p2p.Peer, rw p2p.MsgReadWriter) *Peer {
 	ctx := []interface{}{
 		"peer", peer,
 		"dir", dir,
+		"ver", version,
 	}
 	knownTxs, _ := lru.New(maxKnownTxs)
 	knownBlocks, _ := lru.New(maxKnownBlocks)
@@ -59,6 +62,7 @@ func newPeer(peer *p2p.Peer, rw p2p.MsgReadWriter) *Peer {
 		Peer:        peer,
 		RPC:         rpc.New(peer, rw),
 		logger:      log.New(ctx...),
+		version:     version,
 		createdTime: mclock.Now(),
 		knownTxs:    knownTxs,
 		knownBlocks: knownBlocks,
@@ -101,6 +105,21 @@ func (p *Peer) IsBlockKnown(id thor.Bytes32) bool {
 	return p.knownBlocks.Contains(id)
 }
 
+// SupportsTxAnnouncement returns whether the peer accepts txs announced by ID.
+func (p *Peer) SupportsTxAnnouncement() bool {
+	return p.version >= 2
+}
+
+// SupportsEndorsement returns whether the peer exchanges endorsements.
+func (p *Peer) SupportsEndorsement() bool {
+	return p.version >= 4
+}
+
+// SupportsTxProof returns whether the peer proves txs and receipts.
+func (p *Peer) SupportsTxProof() bool {
+	return p.version >= 4
+}
+
 // Duration returns duration of connection.
 func (p *Peer) Duration() mclock.AbsTime {
 	return mclock.Now() - p.createdTime
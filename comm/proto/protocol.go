@@ -10,13 +10,30 @@ import (
 )
 
 // Constants
+//
+// Message payloads are not compressed at this level, since the underlying RLPx transport
+// (devp2p version 5, EIP-706) snappy-compresses every message once both sides agree in the
+// hello handshake. Compressing again here would only cost CPU. MaxMsgSize limits the
+// decompressed size.
 const (
 	Name              = "thor"
-	Version    uint   = 1
-	Length     uint64 = 8
+	Version    uint   = 4
+	Length     uint64 = 15
 	MaxMsgSize        = 10 * 1024 * 1024
 )
 
+// Legacy protocol versions and their lengths.
+// Version 1 lacks announcing txs by ID, version 2 lacks serving light clients, and version 3
+// lacks exchanging endorsements and proving txs.
+const (
+	Version1       uint   = 1
+	Version1Length uint64 = 8
+	Version2       uint   = 2
+	Version2Length uint64 = 10
+	Version3       uint   = 3
+	Version3Length uint64 = 13
+)
+
 // Protocol messages of thor
 const (
 	MsgGetStatus = iota
@@ -27,6 +44,13 @@ const (
 	MsgGetBlockIDByNumber
 	MsgGetBlocksFromNumber // fetch blocks from given number (including given number)
 	MsgGetTxs
+	MsgNewTxIDs             // since version 2
+	MsgGetTxsByID           // since version 2
+	MsgGetHeadersFromNumber // since version 3
+	MsgGetAccountProof      // since version 3
+	MsgGetStorageProof      // since version 3
+	MsgRequestEndorsement   // since version 4
+	MsgGetTxProof           // since version 4
 )
 
 // MsgName convert msg code to string.
@@ -48,6 +72,20 @@ func MsgName(msgCode uint64) string {
 		return "MsgGetBlocksFromNumber"
 	case MsgGetTxs:
 		return "MsgGetTxs"
+	case MsgNewTxIDs:
+		return "MsgNewTxIDs"
+	case MsgGetTxsByID:
+		return "MsgGetTxsByID"
+	case MsgGetHeadersFromNumber:
+		return "MsgGetHeadersFromNumber"
+	case MsgGetAccountProof:
+		return "MsgGetAccountProof"
+	case MsgGetStorageProof:
+		return "MsgGetStorageProof"
+	case MsgRequestEndorsement:
+		return "MsgRequestEndorsement"
+	case MsgGetTxProof:
+		return "MsgGetTxProof"
 	default:
 		return fmt.Sprintf("unknown msg code(%v)", msgCode)
 	}
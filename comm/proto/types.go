@@ -23,6 +23,35 @@ type (
 		BestBlockID    thor.Bytes32
 		TotalScore     uint64
 	}
+
+	// AccountProofRequest arg of MsgGetAccountProof.
+	AccountProofRequest struct {
+		BlockID thor.Bytes32
+		Address thor.Address
+	}
+
+	// StorageProofRequest arg of MsgGetStorageProof.
+	StorageProofRequest struct {
+		BlockID thor.Bytes32
+		Address thor.Address
+		Key     thor.Bytes32
+	}
+
+	// StorageProof result of MsgGetStorageProof.
+	// The account proof is included to prove the storage root.
+	StorageProof struct {
+		Account [][]byte
+		Storage [][]byte
+	}
+
+	// TxProof result of MsgGetTxProof.
+	// Proofs are against txs root and receipts root of the block, keyed by rlp encoded index.
+	TxProof struct {
+		BlockID thor.Bytes32
+		Index   uint64
+		Tx      [][]byte
+		Receipt [][]byte
+	}
 )
 
 // RPC defines RPC interface.
@@ -55,6 +84,20 @@ func NotifyNewTx(ctx context.Context, rpc RPC, tx *tx.Transaction) error {
 	return rpc.Notify(ctx, MsgNewTx, tx)
 }
 
+// NotifyNewTxIDs announces IDs of new txs to remote peer.
+func NotifyNewTxIDs(ctx context.Context, rpc RPC, ids []thor.Bytes32) error {
+	return rpc.Notify(ctx, MsgNewTxIDs, ids)
+}
+
+// GetTxsByID get txs from remote peer by IDs. Txs not found are absent in result.
+func GetTxsByID(ctx context.Context, rpc RPC, ids []thor.Bytes32) (tx.Transactions, error) {
+	var txs tx.Transactions
+	if err := rpc.Call(ctx, MsgGetTxsByID, ids, &txs); err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
+
 // GetBlockByID query block from remote peer by given block ID.
 // It may return nil block even no error.
 func GetBlockByID(ctx context.Context, rpc RPC, id thor.Bytes32) (rlp.RawValue, error) {
@@ -94,3 +137,51 @@ func GetTxs(ctx context.Context, rpc RPC) (tx.Transactions, error) {
 	}
 	return txs, nil
 }
+
+// GetHeadersFromNumber get a batch of block headers starts with num from remote peer.
+func GetHeadersFromNumber(ctx context.Context, rpc RPC, num uint32) ([]*block.Header, error) {
+	var headers []*block.Header
+	if err := rpc.Call(ctx, MsgGetHeadersFromNumber, num, &headers); err != nil {
+		return nil, err
+	}
+	return headers, nil
+}
+
+// GetAccountProof get merkle proof of the account in state of the given block from remote peer.
+// Empty proof is returned if the block is unknown to remote peer.
+func GetAccountProof(ctx context.Context, rpc RPC, blockID thor.Bytes32, addr thor.Address) ([][]byte, error) {
+	var proof [][]byte
+	if err := rpc.Call(ctx, MsgGetAccountProof, &AccountProofRequest{blockID, addr}, &proof); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// GetStorageProof get merkle proof of the storage value in state of the given block from remote peer.
+func GetStorageProof(ctx context.Context, rpc RPC, blockID thor.Bytes32, addr thor.Address, key thor.Bytes32) (*StorageProof, error) {
+	var proof StorageProof
+	if err := rpc.Call(ctx, MsgGetStorageProof, &StorageProofRequest{blockID, addr, key}, &proof); err != nil {
+		return nil, err
+	}
+	return &proof, nil
+}
+
+// RequestEndorsement request endorsement on the proposed header from remote peer.
+// Empty result is returned if remote peer is not selected as committee member.
+func RequestEndorsement(ctx context.Context, rpc RPC, header *block.Header) (block.Endorsements, error) {
+	var endorsements block.Endorsements
+	if err := rpc.Call(ctx, MsgRequestEndorsement, header, &endorsements); err != nil {
+		return nil, err
+	}
+	return endorsements, nil
+}
+
+// GetTxProof get merkle proofs of the tx and its receipt on trunk from remote peer.
+// Empty proofs are returned if the tx is unknown to remote peer.
+func GetTxProof(ctx context.Context, rpc RPC, txID thor.Bytes32) (*TxProof, error) {
+	var proof TxProof
+	if err := rpc.Call(ctx, MsgGetTxProof, txID, &proof); err != nil {
+		return nil, err
+	}
+	return &proof, nil
+}
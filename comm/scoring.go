@@ -0,0 +1,166 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package comm
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/discover"
+)
+
+// Misbehavior kinds of peer misbehavior.
+type Misbehavior int
+
+// misbehaviors
+const (
+	InvalidBlock Misbehavior = iota
+	BadTx
+	Timeout
+	UselessAnnouncement
+	numMisbehaviors
+)
+
+const (
+	banThreshold  = 100
+	banDuration   = 30 * time.Minute
+	penaltyDecay  = 5 // points per minute
+	maxBannedKeep = 1024
+)
+
+// penalty points of each kind of misbehavior.
+var penalties = [numMisbehaviors]float64{
+	InvalidBlock:        50,
+	BadTx:               5,
+	Timeout:             10,
+	UselessAnnouncement: 2,
+}
+
+func (m Misbehavior) String() string {
+	switch m {
+	case InvalidBlock:
+		return "invalid block"
+	case BadTx:
+		return "bad tx"
+	case Timeout:
+		return "timeout"
+	case UselessAnnouncement:
+		return "useless announcement"
+	}
+	return "unknown"
+}
+
+// peerScore records misbehaviors of a peer.
+// Penalty points decay over time, so that occasional faults of good peers are tolerated.
+type peerScore struct {
+	lock       sync.Mutex
+	counts     [numMisbehaviors]uint64
+	penalty    float64
+	lastUpdate time.Time
+}
+
+func (s *peerScore) decay(now time.Time) {
+	if !s.lastUpdate.IsZero() {
+		s.penalty -= now.Sub(s.lastUpdate).Minutes() * penaltyDecay
+		if s.penalty < 0 {
+			s.penalty = 0
+		}
+	}
+	s.lastUpdate = now
+}
+
+// add adds a misbehavior and returns the current penalty.
+func (s *peerScore) add(m Misbehavior, now time.Time) float64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.decay(now)
+	s.counts[m]++
+	s.penalty += penalties[m]
+	return s.penalty
+}
+
+// snapshot returns counts of each misbehavior and the current penalty.
+func (s *peerScore) snapshot(now time.Time) (counts [numMisbehaviors]uint64, penalty float64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.decay(now)
+	return s.counts, s.penalty
+}
+
+// banList records temporarily banned nodes.
+type banList struct {
+	lock sync.Mutex
+	m    map[discover.NodeID]time.Time // node id => ban expiry
+}
+
+func newBanList() *banList {
+	return &banList{m: make(map[discover.NodeID]time.Time)}
+}
+
+func (b *banList) Ban(id discover.NodeID, until time.Time) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if len(b.m) >= maxBannedKeep {
+		b.purge(time.Now())
+	}
+	b.m[id] = until
+}
+
+func (b *banList) IsBanned(id discover.NodeID, now time.Time) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	until, ok := b.m[id]
+	if !ok {
+		return false
+	}
+	if now.After(until) {
+		delete(b.m, id)
+		return false
+	}
+	return true
+}
+
+// Len returns count of nodes still banned.
+func (b *banList) Len() int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.purge(time.Now())
+	return len(b.m)
+}
+
+func (b *banList) purge(now time.Time) {
+	for id, until := range b.m {
+		if now.After(until) {
+			delete(b.m, id)
+		}
+	}
+}
+
+// reportMisbehavior records the misbehavior of the peer, and disconnects
+// and bans the peer if its penalty exceeds the threshold.
+func (c *Communicator) reportMisbehavior(peer *Peer, m Misbehavior) {
+	now := time.Now()
+	penalty := peer.score.add(m, now)
+	peer.logger.Debug("peer misbehaved", "kind", m, "penalty", penalty)
+	if penalty >= banThreshold {
+		peer.logger.Debug("peer banned", "until", now.Add(banDuration))
+		c.banList.Ban(peer.ID(), now.Add(banDuration))
+		peer.Disconnect(p2p.DiscUselessPeer)
+	}
+}
+
+// ReportInvalidBlock reports that the block of the event is invalid, and penalizes the peer who sent it.
+func (c *Communicator) ReportInvalidBlock(ev *NewBlockEvent) {
+	if ev.peer != nil {
+		c.reportMisbehavior(ev.peer, InvalidBlock)
+	}
+}
+
+// BannedCount returns count of nodes currently banned.
+func (c *Communicator) BannedCount() int {
+	return c.banList.Len()
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package comm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/discover"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeerScore(t *testing.T) {
+	var s peerScore
+	now := time.Now()
+
+	assert.Equal(t, float64(50), s.add(InvalidBlock, now))
+	assert.Equal(t, float64(55), s.add(BadTx, now))
+
+	// decays over time
+	counts, penalty := s.snapshot(now.Add(time.Minute))
+	assert.Equal(t, float64(50), penalty)
+	assert.Equal(t, uint64(1), counts[InvalidBlock])
+	assert.Equal(t, uint64(1), counts[BadTx])
+
+	_, penalty = s.snapshot(now.Add(time.Hour))
+	assert.Equal(t, float64(0), penalty)
+}
+
+func TestBanList(t *testing.T) {
+	b := newBanList()
+	id := discover.NodeID{1}
+	now := time.Now()
+
+	assert.False(t, b.IsBanned(id, now))
+	b.Ban(id, now.Add(time.Minute))
+	assert.True(t, b.IsBanned(id, now))
+	assert.Equal(t, 1, b.Len())
+	assert.False(t, b.IsBanned(id, now.Add(2*time.Minute)))
+}
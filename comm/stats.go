@@ -17,11 +17,13 @@ import (
 
 // PeerStats records stats of a peer.
 type PeerStats struct {
-	Name        string
-	BestBlockID thor.Bytes32
-	TotalScore  uint64
-	PeerID      string
-	NetAddr     string
-	Inbound     bool
-	Duration    uint64 // in seconds
+	Name         string
+	BestBlockID  thor.Bytes32
+	TotalScore   uint64
+	PeerID       string
+	NetAddr      string
+	Inbound      bool
+	Duration     uint64 // in seconds
+	Penalty      float64
+	Misbehaviors map[string]uint64 // kind => count
 }
@@ -14,6 +14,8 @@ import (
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/co"
 	"github.com/vechain/thor/comm/proto"
+	"github.com/vechain/thor/consensus"
+	"github.com/vechain/thor/txpool"
 )
 
 func (c *Communicator) sync(peer *Peer, headNum uint32, handler HandleBlockStream) error {
@@ -36,6 +38,9 @@ func (c *Communicator) download(peer *Peer, fromNum uint32, handler HandleBlockS
 	goes.Go(func() {
 		defer cancel()
 		if err := handler(ctx, blockCh); err != nil {
+			if consensus.IsCritical(err) {
+				c.reportMisbehavior(peer, InvalidBlock)
+			}
 			errCh <- err
 		}
 	})
@@ -56,10 +61,12 @@ func (c *Communicator) download(peer *Peer, fromNum uint32, handler HandleBlockS
 			for _, raw := range result {
 				var blk block.Block
 				if err := rlp.DecodeBytes(raw, &blk); err != nil {
+					c.reportMisbehavior(peer, InvalidBlock)
 					errCh <- errors.Wrap(err, "invalid block")
 					return
 				}
 				if blk.Header().Number() != fromNum {
+					c.reportMisbehavior(peer, InvalidBlock)
 					errCh <- errors.New("broken sequence")
 					return
 				}
@@ -184,6 +191,9 @@ func (c *Communicator) syncTxs(peer *Peer) {
 		result, err := proto.GetTxs(c.ctx, peer)
 		if err != nil {
 			peer.logger.Debug("failed to request txs", "err", err)
+			if err == context.DeadlineExceeded {
+				c.reportMisbehavior(peer, Timeout)
+			}
 			return
 		}
 
@@ -194,7 +204,9 @@ func (c *Communicator) syncTxs(peer *Peer) {
 
 		for _, tx := range result {
 			peer.MarkTransaction(tx.ID())
-			c.txPool.StrictlyAdd(tx)
+			if err := c.txPool.StrictlyAdd(tx); txpool.IsBadTx(err) {
+				c.reportMisbehavior(peer, BadTx)
+			}
 			select {
 			case <-c.ctx.Done():
 				return
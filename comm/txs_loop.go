@@ -6,37 +6,150 @@
 package comm
 
 import (
+	"context"
+	"sync"
+	"time"
+
 	"github.com/vechain/thor/comm/proto"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
 	"github.com/vechain/thor/txpool"
 )
 
+const (
+	maxTxAnnouncements   = 256                    // max tx IDs in one announcement or request
+	txAnnouncementPeriod = 100 * time.Millisecond // interval to flush batched tx announcements
+)
+
 func (c *Communicator) txsLoop() {
 
 	txEvCh := make(chan *txpool.TxEvent, 10)
 	sub := c.txPool.SubscribeTxEvent(txEvCh)
 	defer sub.Unsubscribe()
 
+	ticker := time.NewTicker(txAnnouncementPeriod)
+	defer ticker.Stop()
+
+	// txs to be announced by ID, batched to save messages
+	var pending tx.Transactions
+
 	for {
 		select {
 		case <-c.ctx.Done():
 			return
 		case txEv := <-txEvCh:
 			if txEv.Executable != nil && *txEv.Executable {
-				tx := txEv.Tx
-				peers := c.peerSet.Slice().Filter(func(p *Peer) bool {
-					return !p.IsTransactionKnown(tx.ID())
-				})
-
-				for _, peer := range peers {
-					peer := peer
-					peer.MarkTransaction(tx.ID())
-					c.goes.Go(func() {
-						if err := proto.NotifyNewTx(c.ctx, peer, tx); err != nil {
-							peer.logger.Debug("failed to broadcast tx", "err", err)
-						}
-					})
+				pending = append(pending, txEv.Tx)
+				if len(pending) >= maxTxAnnouncements {
+					c.broadcastTxs(pending)
+					pending = nil
 				}
 			}
+		case <-ticker.C:
+			if len(pending) > 0 {
+				c.broadcastTxs(pending)
+				pending = nil
+			}
+		}
+	}
+}
+
+// broadcastTxs announces IDs of txs to peers which support it, and sends full txs to legacy peers.
+func (c *Communicator) broadcastTxs(txs tx.Transactions) {
+	for _, peer := range c.peerSet.Slice() {
+		peer := peer
+		var toSend tx.Transactions
+		for _, tx := range txs {
+			if !peer.IsTransactionKnown(tx.ID()) {
+				peer.MarkTransaction(tx.ID())
+				toSend = append(toSend, tx)
+			}
+		}
+		if len(toSend) == 0 {
+			continue
+		}
+
+		if peer.SupportsTxAnnouncement() {
+			ids := make([]thor.Bytes32, 0, len(toSend))
+			for _, tx := range toSend {
+				ids = append(ids, tx.ID())
+			}
+			c.goes.Go(func() {
+				if err := proto.NotifyNewTxIDs(c.ctx, peer, ids); err != nil {
+					peer.logger.Debug("failed to announce txs", "err", err)
+				}
+			})
+			continue
+		}
+
+		for _, tx := range toSend {
+			tx := tx
+			c.goes.Go(func() {
+				if err := proto.NotifyNewTx(c.ctx, peer, tx); err != nil {
+					peer.logger.Debug("failed to broadcast tx", "err", err)
+				}
+			})
+		}
+	}
+}
+
+// fetchTxs requests announced txs from the peer.
+// IDs should be added to fetchingTxs before, and will be removed after.
+func (c *Communicator) fetchTxs(peer *Peer, ids []thor.Bytes32) {
+	defer func() {
+		for _, id := range ids {
+			c.fetchingTxs.Remove(id)
+		}
+	}()
+
+	txs, err := proto.GetTxsByID(c.ctx, peer, ids)
+	if err != nil {
+		peer.logger.Debug("failed to fetch txs", "err", err)
+		if err == context.DeadlineExceeded {
+			c.reportMisbehavior(peer, Timeout)
+		}
+		return
+	}
+
+	requested := make(map[thor.Bytes32]bool, len(ids))
+	for _, id := range ids {
+		requested[id] = true
+	}
+	for _, tx := range txs {
+		if !requested[tx.ID()] {
+			c.reportMisbehavior(peer, BadTx)
+			return
 		}
+		peer.MarkTransaction(tx.ID())
+		if err := c.txPool.StrictlyAdd(tx); txpool.IsBadTx(err) {
+			c.reportMisbehavior(peer, BadTx)
+		}
+	}
+}
+
+// txIDSet thread-safe set of tx IDs.
+type txIDSet struct {
+	lock sync.Mutex
+	m    map[thor.Bytes32]struct{}
+}
+
+func newTxIDSet() *txIDSet {
+	return &txIDSet{m: make(map[thor.Bytes32]struct{})}
+}
+
+// Add adds the id and returns true if not contained before.
+func (s *txIDSet) Add(id thor.Bytes32) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.m[id]; ok {
+		return false
 	}
+	s.m[id] = struct{}{}
+	return true
+}
+
+func (s *txIDSet) Remove(id thor.Bytes32) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.m, id)
 }
@@ -0,0 +1,23 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package comm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/thor"
+)
+
+func TestTxIDSet(t *testing.T) {
+	s := newTxIDSet()
+	id := thor.BytesToBytes32([]byte("tx"))
+
+	assert.True(t, s.Add(id))
+	assert.False(t, s.Add(id), "should not add twice")
+	s.Remove(id)
+	assert.True(t, s.Add(id))
+}
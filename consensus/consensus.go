@@ -8,8 +8,10 @@ package consensus
 import (
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/poa"
 	"github.com/vechain/thor/runtime"
 	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
 	"github.com/vechain/thor/xenv"
 )
@@ -17,19 +19,58 @@ import (
 // Consensus check whether the block is verified,
 // and predicate which trunk it belong to.
 type Consensus struct {
-	chain        *chain.Chain
-	stateCreator *state.Creator
+	chain           *chain.Chain
+	stateCreator    *state.Creator
+	forkConfig      thor.ForkConfig
+	diagnosticsDir  string
+	parallelWorkers int
+	witnessDir      string
 }
 
 // New create a Consensus instance.
 func New(chain *chain.Chain, stateCreator *state.Creator) *Consensus {
 	return &Consensus{
 		chain:        chain,
-		stateCreator: stateCreator}
+		stateCreator: stateCreator,
+		forkConfig:   thor.GetForkConfig(chain.GenesisBlock().Header().ID())}
+}
+
+// SetDiagnosticsDir set the dir to dump reports and execution traces of blocks
+// with mismatched gas used or roots. Empty dir to disable dumping.
+func (c *Consensus) SetDiagnosticsDir(dir string) {
+	c.diagnosticsDir = dir
+}
+
+// SetParallelWorkers set count of workers to execute txs of a block in parallel.
+// Txs are executed serially if less than 2, or details of execution are collected.
+// It's experimental.
+func (c *Consensus) SetParallelWorkers(n int) {
+	c.parallelWorkers = n
+}
+
+// SetWitnessDir set the dir to dump witnesses of blocks processed, which are trie nodes and
+// codes read during execution, for stateless verification. Empty dir to disable recording.
+func (c *Consensus) SetWitnessDir(dir string) {
+	c.witnessDir = dir
 }
 
 // Process process a block.
 func (c *Consensus) Process(blk *block.Block, nowTimestamp uint64) (*state.Stage, tx.Receipts, error) {
+	return c.process(blk, nowTimestamp, nil)
+}
+
+// ProcessWithDetail is like Process, but also returns execution results of txs in detail.
+func (c *Consensus) ProcessWithDetail(blk *block.Block, nowTimestamp uint64) (*state.Stage, tx.Receipts, []*runtime.ExecutedTx, error) {
+	executed := []*runtime.ExecutedTx{}
+	stage, receipts, err := c.process(blk, nowTimestamp, &executed)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return stage, receipts, executed, nil
+}
+
+// process processes the block, and collects execution results into executed if it's not nil.
+func (c *Consensus) process(blk *block.Block, nowTimestamp uint64, executed *[]*runtime.ExecutedTx) (*state.Stage, tx.Receipts, error) {
 	header := blk.Header()
 
 	if _, err := c.chain.GetBlockHeader(header.ID()); err != nil {
@@ -48,16 +89,32 @@ func (c *Consensus) Process(blk *block.Block, nowTimestamp uint64) (*state.Stage
 		return nil, nil, errParentMissing
 	}
 
-	state, err := c.stateCreator.NewState(parentHeader.StateRoot())
+	var (
+		st       *state.State
+		recorder *state.WitnessRecorder
+	)
+	if c.witnessDir != "" {
+		st, recorder, err = c.stateCreator.NewWitnessedState(parentHeader.StateRoot())
+	} else {
+		st, err = c.stateCreator.NewState(parentHeader.StateRoot())
+	}
 	if err != nil {
 		return nil, nil, err
 	}
 
-	stage, receipts, err := c.validate(state, blk, parentHeader, nowTimestamp)
+	stage, receipts, err := c.validate(st, blk, parentHeader, nowTimestamp, executed)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	if recorder != nil {
+		// failure of dumping witness doesn't affect the block
+		if path, err := dumpWitness(c.witnessDir, header, recorder.Witness()); err != nil {
+			log.Warn("failed to dump witness", "number", header.Number(), "id", header.ID(), "err", err)
+		} else {
+			log.Debug("witness dumped", "number", header.Number(), "path", path)
+		}
+	}
 	return stage, receipts, nil
 }
 
@@ -77,6 +134,7 @@ func (c *Consensus) NewRuntimeForReplay(header *block.Header, skipPoA bool) (*ru
 	if err != nil {
 		return nil, err
 	}
+	poa.ActivateRotations(state, header.Number())
 	if !skipPoA {
 		if err := c.validateProposer(header, parentHeader, state); err != nil {
 			return nil, err
@@ -8,13 +8,16 @@ package consensus
 import (
 	"crypto/ecdsa"
 	"fmt"
+	"io/ioutil"
 	"math/big"
+	"os"
 	"reflect"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/assert"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/builtin"
@@ -25,6 +28,7 @@ import (
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/vrf"
 )
 
 func TestConsensus(t *testing.T) {
@@ -78,7 +82,9 @@ func newTestConsensus(t *testing.T) *testConsensus {
 		State(func(state *state.State) error {
 			bal, _ := new(big.Int).SetString("1000000000000000000000000000", 10)
 			state.SetCode(builtin.Authority.Address, builtin.Authority.RuntimeBytecodes())
+			state.SetCode(builtin.Params.Address, builtin.Params.RuntimeBytecodes())
 			builtin.Params.Native(state).Set(thor.KeyExecutorAddress, new(big.Int).SetBytes(genesis.DevAccounts()[0].Address[:]))
+			builtin.Params.Native(state).Set(thor.KeyMaxTxClauses, big.NewInt(1))
 			for _, acc := range genesis.DevAccounts() {
 				state.SetBalance(acc.Address, bal)
 				state.SetEnergy(acc.Address, bal, launchTime)
@@ -238,6 +244,16 @@ func (tc *testConsensus) TestValidateBlockHeader() {
 		)
 		tc.assert.Equal(err, expect)
 	}
+	triggers["triggerErrExtensionNotActivated"] = func() {
+		forkConfig := tc.con.forkConfig
+		defer func() { tc.con.forkConfig = forkConfig }()
+		tc.con.forkConfig.VRF = 2
+
+		blk := tc.sign(tc.originalBuilder().Extension(block.Extension{Alpha: []byte("alpha")}).Build())
+		err := tc.consent(blk)
+		expect := consensusError("block header extension not activated: current 1, fork 2")
+		tc.assert.Equal(err, expect)
+	}
 
 	for _, trigger := range triggers {
 		trigger()
@@ -328,6 +344,104 @@ func (tc *testConsensus) TestValidateBlockBody() {
 		expect := consensusError("tx ref future block: ref 100, current 1")
 		tc.assert.Equal(err, expect)
 	}
+	triggers["triggerErrTxLimitExceeded"] = func() {
+		address := thor.BytesToAddress([]byte("addr"))
+		blk := tc.sign(
+			tc.originalBuilder().Transaction(
+				txSign(txBuilder(tc.tag).Clause(tx.NewClause(&address))),
+			).Build(),
+		)
+		expect := consensusError("tx clause count exceeds limit: limit 1, have 2")
+		tc.assert.Equal(expect, tc.consent(blk))
+
+		forkConfig := tc.con.forkConfig
+		defer func() { tc.con.forkConfig = forkConfig }()
+		tc.con.forkConfig.TxLimits = 2
+		tc.assert.NotEqual(expect, tc.consent(blk), "not checked before fork")
+	}
+
+	for _, trigger := range triggers {
+		trigger()
+	}
+}
+
+func (tc *testConsensus) TestValidateEndorsements() {
+	proposal := tc.sign(tc.originalBuilder().Build()).Header()
+	endorse := func(key *ecdsa.PrivateKey) *block.Endorsement {
+		_, proof, err := vrf.Prove(key, proposal.ParentID().Bytes())
+		if err != nil {
+			tc.t.Fatal(err)
+		}
+		sig, err := crypto.Sign(block.EndorsementSigningHash(proposal, proof).Bytes(), key)
+		if err != nil {
+			tc.t.Fatal(err)
+		}
+		return &block.Endorsement{Proof: proof, Signature: sig}
+	}
+	endorser := genesis.DevAccounts()[1]
+	endorsed := func(endorsements ...*block.Endorsement) *block.Block {
+		return tc.sign(tc.originalBuilder().
+			Extension(block.Extension{Alpha: proposal.ParentID().Bytes(), COM: true}).
+			Endorsements(endorsements).
+			Build())
+	}
+
+	triggers := make(map[string]func())
+	triggers["triggerEndorsed"] = func() {
+		tc.assert.Nil(tc.consent(endorsed(endorse(endorser.PrivateKey))))
+	}
+	triggers["triggerErrEndorsementsRootMismatch"] = func() {
+		blk := tc.sign(tc.originalBuilder().Build()).WithEndorsements(block.Endorsements{endorse(endorser.PrivateKey)})
+		err := tc.consent(blk)
+		expect := consensusError(fmt.Sprintf("block endorsements root mismatch: want %v, have %v",
+			thor.Bytes32{}, blk.Endorsements().RootHash()))
+		tc.assert.Equal(err, expect)
+	}
+	triggers["triggerErrCOMInvalid"] = func() {
+		blk := tc.sign(tc.originalBuilder().Endorsements(block.Endorsements{endorse(endorser.PrivateKey)}).Build())
+		tc.assert.Equal(consensusError("block COM invalid: want true, have false"), tc.consent(blk))
+
+		blk = tc.sign(tc.originalBuilder().Extension(block.Extension{COM: true}).Build())
+		tc.assert.Equal(consensusError("block COM invalid: want false, have true"), tc.consent(blk))
+	}
+	triggers["triggerErrAlphaInvalid"] = func() {
+		blk := tc.sign(tc.originalBuilder().
+			Extension(block.Extension{Alpha: []byte("alpha"), COM: true}).
+			Endorsements(block.Endorsements{endorse(endorser.PrivateKey)}).
+			Build())
+		expect := consensusError(fmt.Sprintf("block alpha invalid: want 0x%x, have 0x%x", proposal.ParentID().Bytes(), []byte("alpha")))
+		tc.assert.Equal(expect, tc.consent(blk))
+
+		blk = tc.sign(tc.originalBuilder().Extension(block.Extension{Alpha: []byte("alpha")}).Build())
+		expect = consensusError(fmt.Sprintf("block alpha invalid: want 0x, have 0x%x", []byte("alpha")))
+		tc.assert.Equal(expect, tc.consent(blk))
+	}
+	triggers["triggerErrEndorsedBySigner"] = func() {
+		err := tc.consent(endorsed(endorse(tc.pk)))
+		expect := consensusError(fmt.Sprintf("block endorsed by signer: %v", genesis.DevAccounts()[0].Address))
+		tc.assert.Equal(err, expect)
+	}
+	triggers["triggerErrEndorsedRepeatedly"] = func() {
+		e := endorse(endorser.PrivateKey)
+		err := tc.consent(endorsed(e, e))
+		expect := consensusError(fmt.Sprintf("block endorsed repeatedly: %v", endorser.Address))
+		tc.assert.Equal(err, expect)
+	}
+	triggers["triggerErrEndorserUnauthorized"] = func() {
+		key, _ := crypto.GenerateKey()
+		err := tc.consent(endorsed(endorse(key)))
+		expect := consensusError(fmt.Sprintf("block endorser invalid: %v unauthorized", thor.Address(crypto.PubkeyToAddress(key.PublicKey))))
+		tc.assert.Equal(err, expect)
+	}
+	triggers["triggerErrEndorsementsNotActivated"] = func() {
+		forkConfig := tc.con.forkConfig
+		defer func() { tc.con.forkConfig = forkConfig }()
+		tc.con.forkConfig.VRF = 2
+
+		err := tc.consent(endorsed(endorse(endorser.PrivateKey)))
+		expect := consensusError("block header extension not activated: current 1, fork 2")
+		tc.assert.Equal(err, expect)
+	}
 
 	for _, trigger := range triggers {
 		trigger()
@@ -382,3 +496,39 @@ func (tc *testConsensus) TestValidateProposer() {
 		trigger()
 	}
 }
+
+func (tc *testConsensus) TestMismatchReport() {
+	dir, _ := ioutil.TempDir("", "diag")
+	defer os.RemoveAll(dir)
+	tc.con.SetDiagnosticsDir(dir)
+	defer tc.con.SetDiagnosticsDir("")
+
+	trx := txSign(txBuilder(tc.tag))
+	err := tc.consent(tc.sign(tc.originalBuilder().Transaction(trx).Build()))
+	tc.assert.True(IsCritical(err))
+
+	report := MismatchReportOf(err)
+	if !tc.assert.NotNil(report) {
+		return
+	}
+	tc.assert.Equal("gas used", report.Field)
+	tc.assert.Equal(1, len(report.Receipts))
+	tc.assert.Equal(trx.ID(), report.Receipts[0].TxID)
+	_, statErr := os.Stat(report.TraceFile)
+	tc.assert.Nil(statErr)
+
+	local := report.Receipts[0]
+	var expected tx.Receipt
+	tc.assert.Nil(rlp.DecodeBytes(local.ReceiptRLP, &expected))
+	tc.assert.Nil(report.Compare(tx.Receipts{&expected}))
+
+	expected.GasUsed++
+	diff := report.Compare(tx.Receipts{&expected})
+	if tc.assert.NotNil(diff) {
+		tc.assert.Equal(0, diff.Index)
+		tc.assert.Equal(map[string][2]string{
+			"gasUsed": {fmt.Sprint(local.GasUsed), fmt.Sprint(local.GasUsed + 1)},
+		}, diff.Fields)
+	}
+	tc.assert.Equal(map[string][2]string{"existence": {"true", "false"}}, report.Compare(nil).Fields)
+}
@@ -0,0 +1,244 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package consensus
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/runtime"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+// MismatchReport details the divergence of local execution result from the block header,
+// to help tracking down the cause.
+type MismatchReport struct {
+	BlockID     thor.Bytes32
+	BlockNumber uint32
+	Field       string // 'gas used', 'receipts root' or 'state root'
+	Want        string
+	Have        string
+	Receipts    []*ReceiptSummary // of local execution
+	TraceFile   string            // path of the trace dumped, or empty
+}
+
+// ReceiptSummary fields of a receipt to be compared.
+type ReceiptSummary struct {
+	Index      int
+	TxID       thor.Bytes32
+	GasUsed    uint64
+	GasPayer   thor.Address
+	Paid       *big.Int
+	Reward     *big.Int
+	Reverted   bool
+	Outputs    int
+	Events     int
+	Transfers  int
+	ReceiptRLP hexutil.Bytes
+}
+
+func newReceiptSummary(index int, txID thor.Bytes32, r *tx.Receipt) *ReceiptSummary {
+	s := &ReceiptSummary{
+		Index:    index,
+		TxID:     txID,
+		GasUsed:  r.GasUsed,
+		GasPayer: r.GasPayer,
+		Paid:     r.Paid,
+		Reward:   r.Reward,
+		Reverted: r.Reverted,
+		Outputs:  len(r.Outputs),
+	}
+	for _, o := range r.Outputs {
+		s.Events += len(o.Events)
+		s.Transfers += len(o.Transfers)
+	}
+	s.ReceiptRLP, _ = rlp.EncodeToBytes(r)
+	return s
+}
+
+// ReceiptDiff differences between local and expected receipt of a tx.
+type ReceiptDiff struct {
+	Index  int
+	TxID   thor.Bytes32
+	Fields map[string][2]string // field name => [local, expected]
+}
+
+// Compare compares local receipts against expected ones, e.g. those produced by
+// another implementation, and returns the diff of the first differing tx.
+// Nil returned if no difference found.
+func (r *MismatchReport) Compare(expected tx.Receipts) *ReceiptDiff {
+	for i, local := range r.Receipts {
+		if i >= len(expected) {
+			return &ReceiptDiff{
+				Index:  i,
+				TxID:   local.TxID,
+				Fields: map[string][2]string{"existence": {"true", "false"}},
+			}
+		}
+		want := newReceiptSummary(i, local.TxID, expected[i])
+		fields := make(map[string][2]string)
+		check := func(name string, l, e interface{}) {
+			if ls, es := fmt.Sprint(l), fmt.Sprint(e); ls != es {
+				fields[name] = [2]string{ls, es}
+			}
+		}
+		check("gasUsed", local.GasUsed, want.GasUsed)
+		check("gasPayer", local.GasPayer, want.GasPayer)
+		check("paid", local.Paid, want.Paid)
+		check("reward", local.Reward, want.Reward)
+		check("reverted", local.Reverted, want.Reverted)
+		check("outputs", local.Outputs, want.Outputs)
+		check("events", local.Events, want.Events)
+		check("transfers", local.Transfers, want.Transfers)
+		if len(fields) == 0 && string(local.ReceiptRLP) != string(want.ReceiptRLP) {
+			// same counts, but events or transfers differ in content
+			fields["rlp"] = [2]string{local.ReceiptRLP.String(), want.ReceiptRLP.String()}
+		}
+		if len(fields) > 0 {
+			return &ReceiptDiff{Index: i, TxID: local.TxID, Fields: fields}
+		}
+	}
+	if len(expected) > len(r.Receipts) {
+		return &ReceiptDiff{
+			Index:  len(r.Receipts),
+			Fields: map[string][2]string{"existence": {"false", "true"}},
+		}
+	}
+	return nil
+}
+
+type mismatchError struct {
+	msg    string
+	report *MismatchReport
+}
+
+func (err *mismatchError) Error() string {
+	return err.msg
+}
+
+// MismatchReportOf returns the report if the error is caused by mismatch of block gas used or roots.
+func MismatchReportOf(err error) *MismatchReport {
+	if e, ok := err.(*mismatchError); ok {
+		return e.report
+	}
+	return nil
+}
+
+// traceTx execution trace of a tx.
+type traceTx struct {
+	TxID    thor.Bytes32
+	Receipt *tx.Receipt
+	Outputs []*traceOutput
+	Diff    map[string]*traceAccount
+}
+
+type traceOutput struct {
+	LeftOverGas     uint64
+	RefundGas       uint64
+	VMErr           string
+	ContractAddress *thor.Address
+}
+
+type traceAccount struct {
+	Account *state.Account
+	Code    hexutil.Bytes
+	Storage map[string]hexutil.Bytes
+}
+
+func newTraceTx(executed *runtime.ExecutedTx) *traceTx {
+	t := &traceTx{
+		TxID:    executed.Tx.ID(),
+		Receipt: executed.Receipt,
+		Diff:    make(map[string]*traceAccount),
+	}
+	for _, o := range executed.Outputs {
+		to := &traceOutput{
+			LeftOverGas:     o.LeftOverGas,
+			RefundGas:       o.RefundGas,
+			ContractAddress: o.ContractAddress,
+		}
+		if o.VMErr != nil {
+			to.VMErr = o.VMErr.Error()
+		}
+		t.Outputs = append(t.Outputs, to)
+	}
+	for addr, d := range executed.Diff {
+		ta := &traceAccount{Account: d.Account, Code: d.Code}
+		if d.Storage != nil {
+			ta.Storage = make(map[string]hexutil.Bytes)
+			for k, v := range d.Storage {
+				ta.Storage[k.String()] = hexutil.Bytes(v)
+			}
+		}
+		t.Diff[addr.String()] = ta
+	}
+	return t
+}
+
+// newMismatchError builds the report, and dumps it together with the trace of execution
+// if diagnostics dir set.
+func (c *Consensus) newMismatchError(
+	header *block.Header,
+	field string,
+	want, have interface{},
+	txs tx.Transactions,
+	receipts tx.Receipts,
+	executed []*runtime.ExecutedTx,
+) error {
+	report := &MismatchReport{
+		BlockID:     header.ID(),
+		BlockNumber: header.Number(),
+		Field:       field,
+		Want:        fmt.Sprint(want),
+		Have:        fmt.Sprint(have),
+	}
+	for i, r := range receipts {
+		report.Receipts = append(report.Receipts, newReceiptSummary(i, txs[i].ID(), r))
+	}
+
+	msg := fmt.Sprintf("block %v mismatch: want %v, have %v", field, want, have)
+	if c.diagnosticsDir != "" {
+		path, err := dumpTrace(c.diagnosticsDir, report, executed)
+		if err != nil {
+			msg += fmt.Sprintf(" (failed to dump trace: %v)", err)
+		} else {
+			report.TraceFile = path
+			msg += fmt.Sprintf(" (trace dumped to %v)", path)
+		}
+	}
+	return &mismatchError{msg, report}
+}
+
+func dumpTrace(dir string, report *MismatchReport, executed []*runtime.ExecutedTx) (string, error) {
+	trace := struct {
+		Report *MismatchReport
+		Txs    []*traceTx
+	}{Report: report}
+	for _, e := range executed {
+		trace.Txs = append(trace.Txs, newTraceTx(e))
+	}
+	data, err := json.MarshalIndent(&trace, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("mismatch-%v-%x.json", report.BlockNumber, report.BlockID[:8]))
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		return "", err
+	}
+	return path, nil
+}
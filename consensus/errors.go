@@ -39,6 +39,9 @@ func IsKnownBlock(err error) bool {
 
 // IsCritical returns if the error is consensus related.
 func IsCritical(err error) bool {
-	_, ok := err.(consensusError)
-	return ok
+	switch err.(type) {
+	case consensusError, *mismatchError:
+		return true
+	}
+	return false
 }
@@ -6,11 +6,12 @@
 package consensus
 
 import (
+	"bytes"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/block"
-	"github.com/vechain/thor/builtin"
 	"github.com/vechain/thor/poa"
 	"github.com/vechain/thor/runtime"
 	"github.com/vechain/thor/state"
@@ -24,6 +25,7 @@ func (c *Consensus) validate(
 	block *block.Block,
 	parentHeader *block.Header,
 	nowTimestamp uint64,
+	executed *[]*runtime.ExecutedTx,
 ) (*state.Stage, tx.Receipts, error) {
 	header := block.Header()
 
@@ -31,6 +33,13 @@ func (c *Consensus) validate(
 		return nil, nil, err
 	}
 
+	// candidates rotated before validating signers, as the packer does
+	poa.ActivateRotations(state, header.Number())
+
+	if err := c.validateEndorsements(block, state); err != nil {
+		return nil, nil, err
+	}
+
 	if err := c.validateProposer(header, parentHeader, state); err != nil {
 		return nil, nil, err
 	}
@@ -39,7 +48,7 @@ func (c *Consensus) validate(
 		return nil, nil, err
 	}
 
-	stage, receipts, err := c.verifyBlock(block, state)
+	stage, receipts, err := c.verifyBlock(block, state, executed)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -72,6 +81,27 @@ func (c *Consensus) validateBlockHeader(header *block.Header, parent *block.Head
 		return consensusError(fmt.Sprintf("block total score invalid: parent %v, current %v", parent.TotalScore(), header.TotalScore()))
 	}
 
+	ext := header.Extension()
+	if header.Number() < c.forkConfig.VRF {
+		if !ext.IsEmpty() {
+			return consensusError(fmt.Sprintf("block header extension not activated: current %v, fork %v", header.Number(), c.forkConfig.VRF))
+		}
+		return nil
+	}
+
+	// committee data is present only if endorsed
+	endorsed := !ext.Endorsements.IsZero()
+	if ext.COM != endorsed {
+		return consensusError(fmt.Sprintf("block COM invalid: want %v, have %v", endorsed, ext.COM))
+	}
+	var alpha []byte
+	if endorsed {
+		alpha = header.ParentID().Bytes()
+	}
+	if !bytes.Equal(ext.Alpha, alpha) {
+		return consensusError(fmt.Sprintf("block alpha invalid: want 0x%x, have 0x%x", alpha, ext.Alpha))
+	}
+
 	return nil
 }
 
@@ -81,17 +111,7 @@ func (c *Consensus) validateProposer(header *block.Header, parent *block.Header,
 		return consensusError(fmt.Sprintf("block signer unavailable: %v", err))
 	}
 
-	authority := builtin.Authority.Native(st)
-	endorsement := builtin.Params.Native(st).Get(thor.KeyProposerEndorsement)
-
-	candidates := authority.Candidates(endorsement, thor.MaxBlockProposers)
-	proposers := make([]poa.Proposer, 0, len(candidates))
-	for _, c := range candidates {
-		proposers = append(proposers, poa.Proposer{
-			Address: c.NodeMaster,
-			Active:  c.Active,
-		})
-	}
+	proposers := poa.ToProposers(poa.LoadCandidates(st))
 
 	sched, err := poa.NewScheduler(signer, proposers, parent.Number(), parent.Timestamp())
 	if err != nil {
@@ -107,10 +127,46 @@ func (c *Consensus) validateProposer(header *block.Header, parent *block.Header,
 		return consensusError(fmt.Sprintf("block total score invalid: want %v, have %v", parent.TotalScore()+score, header.TotalScore()))
 	}
 
-	for _, proposer := range updates {
-		authority.Update(proposer.Address, proposer.Active)
+	poa.ApplyUpdates(st, updates)
+
+	return nil
+}
+
+// validateEndorsements validates optional endorsements of committee members, which are
+// committed by header since the VRF fork.
+func (c *Consensus) validateEndorsements(blk *block.Block, st *state.State) error {
+	header := blk.Header()
+	endorsements := blk.Endorsements()
+	if root := endorsements.RootHash(); header.EndorsementsRoot() != root {
+		return consensusError(fmt.Sprintf("block endorsements root mismatch: want %v, have %v", header.EndorsementsRoot(), root))
+	}
+	if len(endorsements) == 0 {
+		return nil
+	}
+	signer, err := header.Signer()
+	if err != nil {
+		return consensusError(fmt.Sprintf("block signer unavailable: %v", err))
 	}
 
+	candidates := poa.LoadCandidates(st)
+	endorsed := make(map[thor.Address]bool, len(endorsements))
+	for _, e := range endorsements {
+		pub, err := e.Endorser(header)
+		if err != nil {
+			return consensusError(fmt.Sprintf("block endorser unavailable: %v", err))
+		}
+		endorser := thor.Address(crypto.PubkeyToAddress(*pub))
+		switch {
+		case endorser == signer:
+			return consensusError(fmt.Sprintf("block endorsed by signer: %v", endorser))
+		case endorsed[endorser]:
+			return consensusError(fmt.Sprintf("block endorsed repeatedly: %v", endorser))
+		}
+		if err := poa.VerifyEndorser(pub, e.Proof, header.ParentID(), candidates); err != nil {
+			return consensusError(fmt.Sprintf("block endorser invalid: %v %v", endorser, err))
+		}
+		endorsed[endorser] = true
+	}
 	return nil
 }
 
@@ -141,7 +197,19 @@ func (c *Consensus) validateBlockBody(blk *block.Block) error {
 	return nil
 }
 
-func (c *Consensus) verifyBlock(blk *block.Block, state *state.State) (*state.Stage, tx.Receipts, error) {
+func (c *Consensus) verifyBlock(blk *block.Block, state *state.State, executed *[]*runtime.ExecutedTx) (*state.Stage, tx.Receipts, error) {
+	if executed == nil && c.diagnosticsDir != "" {
+		// collect details for the trace, in case of mismatch
+		executed = &[]*runtime.ExecutedTx{}
+	}
+	mismatch := func(field string, want, have interface{}, receipts tx.Receipts) error {
+		var details []*runtime.ExecutedTx
+		if executed != nil {
+			details = *executed
+		}
+		return c.newMismatchError(blk.Header(), field, want, have, blk.Transactions(), receipts, details)
+	}
+
 	var totalGasUsed uint64
 	txs := blk.Transactions()
 	receipts := make(tx.Receipts, 0, len(txs))
@@ -158,7 +226,7 @@ func (c *Consensus) verifyBlock(blk *block.Block, state *state.State) (*state.St
 			Time:        header.Timestamp(),
 			GasLimit:    header.GasLimit(),
 			TotalScore:  header.TotalScore(),
-		})
+		}).SetProfiled(true)
 
 	findTx := func(txID thor.Bytes32) (found bool, reverted bool, err error) {
 		if reverted, ok := processedTxs[txID]; ok {
@@ -174,7 +242,29 @@ func (c *Consensus) verifyBlock(blk *block.Block, state *state.State) (*state.St
 		return true, meta.Reverted, nil
 	}
 
+	execute := func(trx *tx.Transaction) (*tx.Receipt, error) {
+		return executeTransaction(rt, trx, executed)
+	}
+	if executed == nil && c.parallelWorkers > 1 && len(txs) > 1 {
+		parallel := rt.NewParallelExecutor(txs, c.parallelWorkers)
+		// txs are committed in order
+		execute = func(*tx.Transaction) (*tx.Receipt, error) {
+			return parallel.Next()
+		}
+	}
+
+	var txLimits *runtime.TxLimits
+	if header.Number() >= c.forkConfig.TxLimits {
+		limits := runtime.LoadTxLimits(state)
+		txLimits = &limits
+	}
 	for _, tx := range txs {
+		if txLimits != nil {
+			if err := txLimits.Check(tx); err != nil {
+				return nil, nil, consensusError(err.Error())
+			}
+		}
+
 		// check if tx existed
 		if found, _, err := findTx(tx.ID()); err != nil {
 			return nil, nil, err
@@ -197,7 +287,7 @@ func (c *Consensus) verifyBlock(blk *block.Block, state *state.State) (*state.St
 			}
 		}
 
-		receipt, err := rt.ExecuteTransaction(tx)
+		receipt, err := execute(tx)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -208,12 +298,12 @@ func (c *Consensus) verifyBlock(blk *block.Block, state *state.State) (*state.St
 	}
 
 	if header.GasUsed() != totalGasUsed {
-		return nil, nil, consensusError(fmt.Sprintf("block gas used mismatch: want %v, have %v", header.GasUsed(), totalGasUsed))
+		return nil, nil, mismatch("gas used", header.GasUsed(), totalGasUsed, receipts)
 	}
 
 	receiptsRoot := receipts.RootHash()
 	if header.ReceiptsRoot() != receiptsRoot {
-		return nil, nil, consensusError(fmt.Sprintf("block receipts root mismatch: want %v, have %v", header.ReceiptsRoot(), receiptsRoot))
+		return nil, nil, mismatch("receipts root", header.ReceiptsRoot(), receiptsRoot, receipts)
 	}
 
 	if err := rt.Seeker().Err(); err != nil {
@@ -227,8 +317,21 @@ func (c *Consensus) verifyBlock(blk *block.Block, state *state.State) (*state.St
 	}
 
 	if blk.Header().StateRoot() != stateRoot {
-		return nil, nil, consensusError(fmt.Sprintf("block state root mismatch: want %v, have %v", header.StateRoot(), stateRoot))
+		return nil, nil, mismatch("state root", header.StateRoot(), stateRoot, receipts)
 	}
 
 	return stage, receipts, nil
 }
+
+// executeTransaction executes the tx, and collects the result in detail if executed is not nil.
+func executeTransaction(rt *runtime.Runtime, trx *tx.Transaction, executed *[]*runtime.ExecutedTx) (*tx.Receipt, error) {
+	if executed == nil {
+		return rt.ExecuteTransaction(trx)
+	}
+	detail, err := rt.ExecuteTransactionWithDetail(trx)
+	if err != nil {
+		return nil, err
+	}
+	*executed = append(*executed, detail)
+	return detail.Receipt, nil
+}
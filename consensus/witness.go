@@ -0,0 +1,37 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package consensus
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/inconshreveable/log15"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/state"
+)
+
+var log = log15.New("pkg", "consensus")
+
+// dumpWitness writes the RLP encoded witness of the block into dir.
+func dumpWitness(dir string, header *block.Header, witness *state.Witness) (string, error) {
+	data, err := rlp.EncodeToBytes(witness)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	id := header.ID()
+	path := filepath.Join(dir, fmt.Sprintf("witness-%v-%x.rlp", header.Number(), id[:8]))
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		return "", err
+	}
+	return path, nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package evidence detects and records double signing of block proposers.
+package evidence
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/cache"
+	"github.com/vechain/thor/kv"
+	"github.com/vechain/thor/thor"
+)
+
+var evidencePrefix = []byte("evidence") // (prefix, signer, timestamp) -> evidence
+
+// Evidence proves that a proposer signed two different blocks for the same time slot.
+type Evidence struct {
+	Signer    thor.Address
+	Timestamp uint64
+	Headers   [2]*block.Header
+}
+
+type slot struct {
+	signer    thor.Address
+	timestamp uint64
+}
+
+// Pool detects double signing among observed block headers, and persists evidences.
+// It's thread-safe.
+type Pool struct {
+	kv     kv.GetPutter
+	recent *cache.RandCache
+	lock   sync.Mutex
+}
+
+// New create a evidence pool.
+func New(kv kv.GetPutter) *Pool {
+	return &Pool{
+		kv:     kv,
+		recent: cache.NewRandCache(int(thor.MaxBlockProposers) * 16),
+	}
+}
+
+// Observe checks the header against previously observed ones.
+// An evidence is recorded and returned, if the signer has signed another block for the same slot.
+// The header should have passed consensus validation.
+func (p *Pool) Observe(header *block.Header) (*Evidence, error) {
+	if header.Number() == 0 {
+		return nil, nil
+	}
+	signer, err := header.Signer()
+	if err != nil {
+		return nil, err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	key := slot{signer, header.Timestamp()}
+	cached, ok := p.recent.Get(key)
+	if !ok {
+		p.recent.Set(key, header)
+		return nil, nil
+	}
+	prev := cached.(*block.Header)
+	if prev.ID() == header.ID() {
+		return nil, nil
+	}
+
+	ev := &Evidence{
+		Signer:    signer,
+		Timestamp: header.Timestamp(),
+		Headers:   [2]*block.Header{prev, header},
+	}
+	data, err := rlp.EncodeToBytes(ev)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.kv.Put(evidenceKey(signer, header.Timestamp()), data); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
+
+// All returns all recorded evidences.
+func (p *Pool) All() ([]*Evidence, error) {
+	var evs []*Evidence
+	iter := p.kv.NewIterator(*kv.NewRangeWithBytesPrefix(evidencePrefix))
+	defer iter.Release()
+	for iter.Next() {
+		if len(iter.Key()) != len(evidencePrefix)+20+8 {
+			continue
+		}
+		var ev Evidence
+		if err := rlp.DecodeBytes(iter.Value(), &ev); err != nil {
+			return nil, err
+		}
+		evs = append(evs, &ev)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	return evs, nil
+}
+
+func evidenceKey(signer thor.Address, timestamp uint64) []byte {
+	key := make([]byte, 0, len(evidencePrefix)+len(signer)+8)
+	key = append(key, evidencePrefix...)
+	key = append(key, signer.Bytes()...)
+	var b8 [8]byte
+	binary.BigEndian.PutUint64(b8[:], timestamp)
+	return append(key, b8[:]...)
+}
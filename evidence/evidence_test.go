@@ -0,0 +1,57 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package evidence_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/evidence"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/thor"
+)
+
+func TestObserve(t *testing.T) {
+	db, _ := lvldb.NewMem()
+	pool := evidence.New(db)
+	key, _ := crypto.GenerateKey()
+
+	newHeader := func(parentID thor.Bytes32, timestamp uint64) *block.Header {
+		b := new(block.Builder).ParentID(parentID).Timestamp(timestamp).Build()
+		sig, _ := crypto.Sign(b.Header().SigningHash().Bytes(), key)
+		return b.WithSignature(sig).Header()
+	}
+
+	h1 := newHeader(thor.Bytes32{1}, 10)
+	h2 := newHeader(thor.Bytes32{2}, 10)
+	h3 := newHeader(thor.Bytes32{1}, 20)
+
+	ev, err := pool.Observe(h1)
+	assert.Nil(t, err)
+	assert.Nil(t, ev)
+
+	ev, err = pool.Observe(h1)
+	assert.Nil(t, err)
+	assert.Nil(t, ev, "same block is not evidence")
+
+	ev, err = pool.Observe(h3)
+	assert.Nil(t, err)
+	assert.Nil(t, ev, "different slot is not evidence")
+
+	ev, err = pool.Observe(h2)
+	assert.Nil(t, err)
+	assert.NotNil(t, ev)
+	assert.Equal(t, thor.Address(crypto.PubkeyToAddress(key.PublicKey)), ev.Signer)
+	assert.Equal(t, uint64(10), ev.Timestamp)
+
+	all, err := pool.All()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(all))
+	assert.Equal(t, h1.ID(), all[0].Headers[0].ID())
+	assert.Equal(t, h2.ID(), all[0].Headers[1].ID())
+}
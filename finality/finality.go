@@ -0,0 +1,130 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package finality tracks the committed block of trunk.
+//
+// A block is regarded as finalized, once blocks built on it are signed by
+// a supermajority (more than 2/3) of block proposers. It can't be reverted
+// unless more than 1/3 proposers misbehave.
+package finality
+
+import (
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/poa"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+// Finality computes finalized block of trunk.
+// It's thread-safe.
+type Finality struct {
+	chain        *chain.Chain
+	stateCreator *state.Creator
+	cache        *lru.Cache // head id -> finalized header
+
+	lock      sync.Mutex
+	bestID    thor.Bytes32
+	finalized *block.Header
+}
+
+// New create a Finality instance.
+func New(chain *chain.Chain, stateCreator *state.Creator) *Finality {
+	cache, _ := lru.New(256)
+	return &Finality{
+		chain:        chain,
+		stateCreator: stateCreator,
+		cache:        cache,
+		finalized:    chain.GenesisBlock().Header(),
+	}
+}
+
+// Finalized returns header of the latest finalized block on trunk.
+func (f *Finality) Finalized() (*block.Header, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	best := f.chain.BestBlock().Header()
+	if best.ID() == f.bestID {
+		return f.finalized, nil
+	}
+
+	// keep the previous one if it's still on trunk, to make it monotonic
+	floor := f.chain.GenesisBlock().Header()
+	ancestorID, err := f.chain.GetAncestorBlockID(best.ID(), f.finalized.Number())
+	if err != nil {
+		return nil, err
+	}
+	if ancestorID == f.finalized.ID() {
+		floor = f.finalized
+	}
+
+	finalized, err := f.compute(best, floor)
+	if err != nil {
+		return nil, err
+	}
+
+	f.bestID = best.ID()
+	f.finalized = finalized
+	return finalized, nil
+}
+
+// compute walks back from head, until distinct proposers signed walked blocks reach quorum.
+// The walk stops at floor, which is known finalized on the chain of head.
+func (f *Finality) compute(head *block.Header, floor *block.Header) (*block.Header, error) {
+	if cached, ok := f.cache.Get(head.ID()); ok {
+		if finalized := cached.(*block.Header); finalized.Number() >= floor.Number() {
+			return finalized, nil
+		}
+	}
+
+	st, err := f.stateCreator.NewState(head.StateRoot())
+	if err != nil {
+		return nil, err
+	}
+	candidates := poa.LoadCandidates(st)
+	if err := st.Err(); err != nil {
+		return nil, err
+	}
+	quorum := Quorum(len(candidates))
+	isCandidate := make(map[thor.Address]bool, len(candidates))
+	for _, c := range candidates {
+		isCandidate[c.NodeMaster] = true
+	}
+
+	finalized := floor
+	signers := make(map[thor.Address]bool)
+	h := head
+	for i := uint32(0); i < thor.EpochLength && h.Number() > floor.Number(); i++ {
+		signer, err := h.Signer()
+		if err != nil {
+			return nil, err
+		}
+		// only signatures of proposers count
+		if isCandidate[signer] {
+			signers[signer] = true
+		}
+
+		parent, err := f.chain.GetBlockHeader(h.ParentID())
+		if err != nil {
+			return nil, err
+		}
+		if len(signers) >= quorum {
+			finalized = parent
+			break
+		}
+		h = parent
+	}
+	f.cache.Add(head.ID(), finalized)
+	return finalized, nil
+}
+
+// Quorum returns count of distinct signers required to finalize a block, among n proposers.
+func Quorum(n int) int {
+	return n*2/3 + 1
+}
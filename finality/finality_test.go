@@ -0,0 +1,93 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package finality_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/finality"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+func TestQuorum(t *testing.T) {
+	tests := []struct {
+		n    int
+		want int
+	}{
+		{1, 1},
+		{3, 3},
+		{4, 3},
+		{7, 5},
+		{101, 68},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, finality.Quorum(tt.n))
+	}
+}
+
+func TestFinalized(t *testing.T) {
+	db, _ := lvldb.NewMem()
+	stateC := state.NewCreator(db)
+	b0, _, _ := genesis.NewDevnet().Build(stateC)
+	c, _ := chain.New(db, b0)
+
+	f := finality.New(c, stateC)
+	h, err := f.Finalized()
+	assert.Nil(t, err)
+	assert.Equal(t, b0.Header().ID(), h.ID())
+}
+
+func TestFinalizedOnTrunk(t *testing.T) {
+	db, _ := lvldb.NewMem()
+	stateC := state.NewCreator(db)
+	b0, _, _ := genesis.NewDevnet().Build(stateC)
+	c, _ := chain.New(db, b0)
+
+	accs := genesis.DevAccounts()
+	newBlock := func(parent *block.Block, score uint64, acc genesis.DevAccount) *block.Block {
+		b := new(block.Builder).
+			ParentID(parent.Header().ID()).
+			Timestamp(parent.Header().Timestamp() + thor.BlockInterval).
+			TotalScore(parent.Header().TotalScore() + score).
+			StateRoot(parent.Header().StateRoot()).
+			Build()
+		sig, _ := crypto.Sign(b.Header().SigningHash().Bytes(), acc.PrivateKey)
+		b = b.WithSignature(sig)
+		if _, err := c.AddBlock(b, nil); err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	f := finality.New(c, stateC)
+	finalized := func() thor.Bytes32 {
+		h, err := f.Finalized()
+		assert.Nil(t, err)
+		return h.ID()
+	}
+
+	// the only proposer of devnet is accs[0]
+	b1 := newBlock(b0, 1, accs[0])
+	b2 := newBlock(b1, 1, accs[0])
+	assert.Equal(t, b1.Header().ID(), finalized())
+
+	// signers not proposer don't count
+	newBlock(b2, 1, accs[1])
+	assert.Equal(t, b1.Header().ID(), finalized())
+
+	// monotonic on trunk
+	b3x := newBlock(b2, 2, accs[0])
+	assert.Equal(t, b2.Header().ID(), finalized())
+	newBlock(b3x, 1, accs[1])
+	assert.Equal(t, b2.Header().ID(), finalized())
+}
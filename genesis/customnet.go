@@ -9,10 +9,13 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rlp"
 
 	"github.com/vechain/thor/builtin"
+	builtingen "github.com/vechain/thor/builtin/gen"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
@@ -28,6 +31,40 @@ type CustomGenesis struct {
 	Authority  []Authority `json:"authority"`
 	Params     Params      `json:"params"`
 	Executor   Executor    `json:"executor"`
+
+	// Constants overrides protocol constants, zero fields fall back to defaults.
+	Constants *thor.Constants `json:"constants,omitempty"`
+
+	// GasOverrides overrides gas costs of opcodes and txs.
+	GasOverrides *GasOverrides `json:"gasOverrides,omitempty"`
+
+	// Snapshot if set, the genesis state is imported from it, to fork an existing network.
+	Snapshot *Snapshot `json:"snapshot,omitempty"`
+}
+
+// GasOverrides customized gas costs, with opcodes keyed by name, e.g. "SLOAD".
+type GasOverrides struct {
+	Ops       map[string]uint64        `json:"ops,omitempty"`
+	Intrinsic *tx.IntrinsicGasSchedule `json:"intrinsic,omitempty"`
+}
+
+// VMGasOverrides converts into validated gas overrides of VM.
+func (o *GasOverrides) VMGasOverrides() (*vm.GasOverrides, error) {
+	overrides := &vm.GasOverrides{
+		Ops:       make(map[vm.OpCode]uint64, len(o.Ops)),
+		Intrinsic: o.Intrinsic,
+	}
+	for name, gas := range o.Ops {
+		op := vm.StringToOp(name)
+		if op.String() != name {
+			return nil, fmt.Errorf("gas overrides: unknown opcode %v", name)
+		}
+		overrides.Ops[op] = gas
+	}
+	if err := overrides.Validate(); err != nil {
+		return nil, err
+	}
+	return overrides, nil
 }
 
 // NewCustomNet create custom network genesis.
@@ -37,6 +74,19 @@ func NewCustomNet(gen *CustomGenesis) (*Genesis, error) {
 	if gen.GasLimit < 0 {
 		return nil, errors.New("gasLimit must not be 0")
 	}
+	if gen.Constants != nil {
+		if err := gen.Constants.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if gen.GasOverrides != nil {
+		if _, err := gen.GasOverrides.VMGasOverrides(); err != nil {
+			return nil, err
+		}
+	}
+	if gen.Snapshot != nil {
+		return newForkNet(gen)
+	}
 	var executor thor.Address
 	if gen.Params.ExecutorAddress != nil {
 		executor = *gen.Params.ExecutorAddress
@@ -73,15 +123,15 @@ func NewCustomNet(gen *CustomGenesis) (*Genesis, error) {
 				if a.Balance.Sign() < 1 {
 					return fmt.Errorf("%s: balance must be a non-zero integer", a.Address)
 				}
-				if a.Balance.Sign() < 1 {
-					return fmt.Errorf("%s: balance must be a non-zero integer", a.Address)
+				if !thor.IsUint256(a.Balance) {
+					return fmt.Errorf("%s: balance exceeds 256 bits", a.Address)
 				}
 
 				tokenSupply.Add(tokenSupply, a.Balance)
 				state.SetBalance(a.Address, a.Balance)
 				if a.Energy != nil {
-					if a.Energy.Sign() < 0 {
-						return fmt.Errorf("%s: energy must be a non-negative integer", a.Address)
+					if !thor.IsUint256(a.Energy) {
+						return fmt.Errorf("%s: energy must be a non-negative integer within 256 bits", a.Address)
 					}
 					energySupply.Add(energySupply, a.Energy)
 					state.SetEnergy(a.Address, a.Energy, launchTime)
@@ -101,7 +151,7 @@ func NewCustomNet(gen *CustomGenesis) (*Genesis, error) {
 			}
 
 			builtin.Energy.Native(state, launchTime).SetInitialSupply(tokenSupply, energySupply)
-			return nil
+			return commitRules(state, gen)
 		})
 
 	///// initialize builtin contracts
@@ -117,31 +167,40 @@ func NewCustomNet(gen *CustomGenesis) (*Genesis, error) {
 		return nil, errors.New("proposerEndorsement must be a non-zero integer")
 	}
 
-	data := mustEncodeInput(builtin.Params.ABI, "set", thor.KeyExecutorAddress, new(big.Int).SetBytes(executor[:]))
+	data := mustPack(builtingen.ParamsABI.PackSet(thor.KeyExecutorAddress, new(big.Int).SetBytes(executor[:])))
 	builder.Call(tx.NewClause(&builtin.Params.Address).WithData(data), thor.Address{})
 
-	data = mustEncodeInput(builtin.Params.ABI, "set", thor.KeyRewardRatio, gen.Params.RewardRatio)
+	data = mustPack(builtingen.ParamsABI.PackSet(thor.KeyRewardRatio, gen.Params.RewardRatio))
 	builder.Call(tx.NewClause(&builtin.Params.Address).WithData(data), executor)
 
-	data = mustEncodeInput(builtin.Params.ABI, "set", thor.KeyBaseGasPrice, gen.Params.BaseGasPrice)
+	data = mustPack(builtingen.ParamsABI.PackSet(thor.KeyBaseGasPrice, gen.Params.BaseGasPrice))
 	builder.Call(tx.NewClause(&builtin.Params.Address).WithData(data), executor)
 
-	data = mustEncodeInput(builtin.Params.ABI, "set", thor.KeyProposerEndorsement, gen.Params.ProposerEndorsement)
+	data = mustPack(builtingen.ParamsABI.PackSet(thor.KeyProposerEndorsement, gen.Params.ProposerEndorsement))
 	builder.Call(tx.NewClause(&builtin.Params.Address).WithData(data), executor)
 
+	if gen.Params.MaxTxClauses > 0 {
+		data = mustPack(builtingen.ParamsABI.PackSet(thor.KeyMaxTxClauses, new(big.Int).SetUint64(gen.Params.MaxTxClauses)))
+		builder.Call(tx.NewClause(&builtin.Params.Address).WithData(data), executor)
+	}
+	if gen.Params.MaxTxSize > 0 {
+		data = mustPack(builtingen.ParamsABI.PackSet(thor.KeyMaxTxSize, new(big.Int).SetUint64(gen.Params.MaxTxSize)))
+		builder.Call(tx.NewClause(&builtin.Params.Address).WithData(data), executor)
+	}
+
 	if len(gen.Authority) == 0 {
 		return nil, errors.New("at least one authority node")
 	}
 	// add initial authority nodes
 	for _, anode := range gen.Authority {
-		data := mustEncodeInput(builtin.Authority.ABI, "add", anode.MasterAddress, anode.EndorsorAddress, anode.Identity)
+		data := mustPack(builtingen.AuthorityABI.PackAdd(anode.MasterAddress, anode.EndorsorAddress, anode.Identity))
 		builder.Call(tx.NewClause(&builtin.Authority.Address).WithData(data), executor)
 	}
 
 	if len(gen.Executor.Approvers) > 0 {
 		// add initial approvers
 		for _, approver := range gen.Executor.Approvers {
-			data := mustEncodeInput(builtin.Executor.ABI, "addApprover", approver.Address, approver.Identity)
+			data := mustPack(builtingen.ExecutorABI.PackAddApprover(approver.Address, approver.Identity))
 			builder.Call(tx.NewClause(&builtin.Executor.Address).WithData(data), executor)
 		}
 	}
@@ -159,6 +218,50 @@ func NewCustomNet(gen *CustomGenesis) (*Genesis, error) {
 	return &Genesis{builder, id, "customnet"}, nil
 }
 
+// commitRules commits hashes of protocol rules overridden by the custom genesis into params,
+// so that networks of different rules have different genesis IDs.
+func commitRules(state *state.State, gen *CustomGenesis) error {
+	params := builtin.Params.Native(state)
+	if gen.Constants != nil {
+		if constants := gen.Constants.WithDefaults(); constants != thor.DefaultConstants {
+			data, err := rlp.EncodeToBytes(&constants)
+			if err != nil {
+				return err
+			}
+			params.Set(thor.KeyConstantsHash, new(big.Int).SetBytes(thor.Blake2b(data).Bytes()))
+		}
+	}
+	if gen.GasOverrides != nil {
+		overrides, err := gen.GasOverrides.VMGasOverrides()
+		if err != nil {
+			return err
+		}
+		if len(overrides.Ops) > 0 || overrides.Intrinsic != nil {
+			data, err := encodeGasOverrides(overrides)
+			if err != nil {
+				return err
+			}
+			params.Set(thor.KeyGasOverridesHash, new(big.Int).SetBytes(thor.Blake2b(data).Bytes()))
+		}
+	}
+	return state.Err()
+}
+
+// encodeGasOverrides encodes gas overrides canonically, with opcodes in order.
+func encodeGasOverrides(overrides *vm.GasOverrides) ([]byte, error) {
+	ops := make([][2]uint64, 0, len(overrides.Ops))
+	for op, gas := range overrides.Ops {
+		ops = append(ops, [2]uint64{uint64(op), gas})
+	}
+	sort.Slice(ops, func(i, j int) bool { return ops[i][0] < ops[j][0] })
+
+	intrinsic := tx.DefaultIntrinsicGasSchedule
+	if overrides.Intrinsic != nil {
+		intrinsic = *overrides.Intrinsic
+	}
+	return rlp.EncodeToBytes([]interface{}{ops, &intrinsic})
+}
+
 // Account is the account will set to the genesis block
 type Account struct {
 	Address thor.Address            `json:"address"`
@@ -191,5 +294,7 @@ type Params struct {
 	RewardRatio         *big.Int      `json:"rewardRatio"`
 	BaseGasPrice        *big.Int      `json:"baseGasPrice"`
 	ProposerEndorsement *big.Int      `json:"proposerEndorsement"`
+	MaxTxClauses        uint64        `json:"maxTxClauses,omitempty"` // unlimited if not set
+	MaxTxSize           uint64        `json:"maxTxSize,omitempty"`    // unlimited if not set
 	ExecutorAddress     *thor.Address `json:"executorAddress"`
 }
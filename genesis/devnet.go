@@ -12,6 +12,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/builtin/gen"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
@@ -93,19 +94,19 @@ func NewDevnet() *Genesis {
 			return nil
 		}).
 		Call(
-			tx.NewClause(&builtin.Params.Address).WithData(mustEncodeInput(builtin.Params.ABI, "set", thor.KeyExecutorAddress, new(big.Int).SetBytes(executor[:]))),
+			tx.NewClause(&builtin.Params.Address).WithData(mustPack(gen.ParamsABI.PackSet(thor.KeyExecutorAddress, new(big.Int).SetBytes(executor[:])))),
 			thor.Address{}).
 		Call(
-			tx.NewClause(&builtin.Params.Address).WithData(mustEncodeInput(builtin.Params.ABI, "set", thor.KeyRewardRatio, thor.InitialRewardRatio)),
+			tx.NewClause(&builtin.Params.Address).WithData(mustPack(gen.ParamsABI.PackSet(thor.KeyRewardRatio, thor.InitialRewardRatio))),
 			executor).
 		Call(
-			tx.NewClause(&builtin.Params.Address).WithData(mustEncodeInput(builtin.Params.ABI, "set", thor.KeyBaseGasPrice, thor.InitialBaseGasPrice)),
+			tx.NewClause(&builtin.Params.Address).WithData(mustPack(gen.ParamsABI.PackSet(thor.KeyBaseGasPrice, thor.InitialBaseGasPrice))),
 			executor).
 		Call(
-			tx.NewClause(&builtin.Params.Address).WithData(mustEncodeInput(builtin.Params.ABI, "set", thor.KeyProposerEndorsement, thor.InitialProposerEndorsement)),
+			tx.NewClause(&builtin.Params.Address).WithData(mustPack(gen.ParamsABI.PackSet(thor.KeyProposerEndorsement, thor.InitialProposerEndorsement))),
 			executor).
 		Call(
-			tx.NewClause(&builtin.Authority.Address).WithData(mustEncodeInput(builtin.Authority.ABI, "add", soloBlockSigner.Address, soloBlockSigner.Address, thor.BytesToBytes32([]byte("Solo Block Signer")))),
+			tx.NewClause(&builtin.Authority.Address).WithData(mustPack(gen.AuthorityABI.PackAdd(soloBlockSigner.Address, soloBlockSigner.Address, thor.BytesToBytes32([]byte("Solo Block Signer"))))),
 			executor)
 
 	id, err := builder.ComputeID()
@@ -8,7 +8,6 @@ package genesis
 import (
 	"encoding/hex"
 
-	"github.com/vechain/thor/abi"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
@@ -44,12 +43,7 @@ func (g *Genesis) Name() string {
 	return g.name
 }
 
-func mustEncodeInput(abi *abi.ABI, name string, args ...interface{}) []byte {
-	m, found := abi.MethodByName(name)
-	if !found {
-		panic("method not found")
-	}
-	data, err := m.EncodeInput(args...)
+func mustPack(data []byte, err error) []byte {
 	if err != nil {
 		panic(err)
 	}
@@ -6,12 +6,19 @@
 package genesis_test
 
 import (
+	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/builtin"
 	"github.com/vechain/thor/genesis"
 	"github.com/vechain/thor/lvldb"
 	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/vm"
 )
 
 func TestTestnetGenesis(t *testing.T) {
@@ -24,3 +31,124 @@ func TestTestnetGenesis(t *testing.T) {
 	_, err = state.New(b0.Header().StateRoot(), kv)
 	assert.Nil(t, err)
 }
+
+func TestForkNet(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	b0, _, _ := genesis.NewDevnet().Build(state.NewCreator(kv))
+	st, _ := state.New(b0.Header().StateRoot(), kv)
+
+	snapshot := &genesis.Snapshot{StateRoot: b0.Header().StateRoot()}
+	assert.Nil(t, st.ForEachAccount(func(addr thor.Address, a *state.Account) bool {
+		sa := genesis.SnapshotAccount{Address: addr, Balance: a.Balance, Energy: a.Energy, BlockTime: a.BlockTime}
+		if len(a.Master) > 0 {
+			master := thor.BytesToAddress(a.Master)
+			sa.Master = &master
+		}
+		snapshot.Accounts = append(snapshot.Accounts, sa)
+		return true
+	}))
+	for i, sa := range snapshot.Accounts {
+		if code := st.GetCode(sa.Address); len(code) > 0 {
+			snapshot.Accounts[i].Code = hexutil.Encode(code)
+		}
+		storage := make(map[string]string)
+		assert.Nil(t, st.ForEachStorage(sa.Address, func(key thor.Bytes32, value rlp.RawValue) bool {
+			storage[key.String()] = hexutil.Encode(value)
+			return true
+		}))
+		snapshot.Accounts[i].Storage = storage
+	}
+
+	master := thor.BytesToAddress([]byte("master"))
+	gen := &genesis.CustomGenesis{
+		LaunchTime: b0.Header().Timestamp() + 100,
+		GasLimit:   thor.InitialGasLimit,
+		Authority:  []genesis.Authority{{MasterAddress: master, EndorsorAddress: genesis.DevAccounts()[0].Address}},
+		Snapshot:   snapshot,
+	}
+	gene, err := genesis.NewCustomNet(gen)
+	assert.Nil(t, err)
+
+	forkKV, _ := lvldb.NewMem()
+	b, _, err := gene.Build(state.NewCreator(forkKV))
+	assert.Nil(t, err)
+	forkSt, _ := state.New(b.Header().StateRoot(), forkKV)
+
+	candidates := builtin.Authority.Native(forkSt).Candidates(&big.Int{}, 100)
+	assert.Equal(t, 1, len(candidates))
+	assert.Equal(t, master, candidates[0].NodeMaster)
+
+	addr := genesis.DevAccounts()[1].Address
+	assert.Equal(t, st.GetBalance(addr), forkSt.GetBalance(addr))
+
+	snapshot.StateRoot = thor.Bytes32{}
+	_, err = genesis.NewCustomNet(gen)
+	assert.NotNil(t, err, "state root should mismatch")
+}
+
+func TestCustomNetGasOverrides(t *testing.T) {
+	o := &genesis.GasOverrides{Ops: map[string]uint64{"ADD": 1}}
+	overrides, err := o.VMGasOverrides()
+	assert.Nil(t, err)
+	assert.Equal(t, map[vm.OpCode]uint64{vm.ADD: 1}, overrides.Ops)
+
+	for _, ops := range []map[string]uint64{
+		{"ADDX": 1},
+		{"ADD": 0},
+		{"SLOAD": 1},
+	} {
+		o.Ops = ops
+		_, err := o.VMGasOverrides()
+		assert.NotNil(t, err, "%v", ops)
+	}
+
+	// rejected before building genesis
+	_, err = genesis.NewCustomNet(&genesis.CustomGenesis{GasOverrides: o})
+	assert.Equal(t, "gas overrides: gas of SLOAD not overridable", err.Error())
+}
+
+func TestCustomNetRules(t *testing.T) {
+	newGen := func() *genesis.CustomGenesis {
+		return &genesis.CustomGenesis{
+			GasLimit: thor.InitialGasLimit,
+			Authority: []genesis.Authority{{
+				MasterAddress:   genesis.DevAccounts()[0].Address,
+				EndorsorAddress: genesis.DevAccounts()[0].Address,
+				Identity:        thor.BytesToBytes32([]byte("master")),
+			}},
+			Params: genesis.Params{
+				RewardRatio:         thor.InitialRewardRatio,
+				BaseGasPrice:        thor.InitialBaseGasPrice,
+				ProposerEndorsement: thor.InitialProposerEndorsement,
+			},
+		}
+	}
+	idOf := func(gen *genesis.CustomGenesis) thor.Bytes32 {
+		gene, err := genesis.NewCustomNet(gen)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return gene.ID()
+	}
+
+	gen := newGen()
+	id := idOf(gen)
+
+	// same rules as default
+	gen.Constants = &thor.Constants{BlockInterval: thor.DefaultConstants.BlockInterval}
+	assert.Equal(t, id, idOf(gen))
+
+	gen.Constants = &thor.Constants{BlockInterval: 5}
+	constantsID := idOf(gen)
+	assert.NotEqual(t, id, constantsID, "constants committed")
+
+	gen.GasOverrides = &genesis.GasOverrides{Ops: map[string]uint64{"ADD": 1}}
+	opsID := idOf(gen)
+	assert.NotEqual(t, constantsID, opsID, "gas overrides committed")
+
+	gen.GasOverrides.Ops["ADD"] = 2
+	assert.NotEqual(t, opsID, idOf(gen))
+
+	gen.GasOverrides = &genesis.GasOverrides{Intrinsic: &tx.IntrinsicGasSchedule{TxGas: 1, ClauseGas: 1, ClauseGasContractCreation: 1}}
+	assert.NotEqual(t, constantsID, idOf(gen), "intrinsic gas overrides committed")
+}
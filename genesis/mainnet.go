@@ -9,6 +9,7 @@ import (
 	"math/big"
 
 	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/builtin/gen"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
@@ -74,27 +75,27 @@ func NewMainnet() *Genesis {
 	///// initialize builtin contracts
 
 	// initialize params
-	data := mustEncodeInput(builtin.Params.ABI, "set", thor.KeyExecutorAddress, new(big.Int).SetBytes(builtin.Executor.Address[:]))
+	data := mustPack(gen.ParamsABI.PackSet(thor.KeyExecutorAddress, new(big.Int).SetBytes(builtin.Executor.Address[:])))
 	builder.Call(tx.NewClause(&builtin.Params.Address).WithData(data), thor.Address{})
 
-	data = mustEncodeInput(builtin.Params.ABI, "set", thor.KeyRewardRatio, thor.InitialRewardRatio)
+	data = mustPack(gen.ParamsABI.PackSet(thor.KeyRewardRatio, thor.InitialRewardRatio))
 	builder.Call(tx.NewClause(&builtin.Params.Address).WithData(data), builtin.Executor.Address)
 
-	data = mustEncodeInput(builtin.Params.ABI, "set", thor.KeyBaseGasPrice, thor.InitialBaseGasPrice)
+	data = mustPack(gen.ParamsABI.PackSet(thor.KeyBaseGasPrice, thor.InitialBaseGasPrice))
 	builder.Call(tx.NewClause(&builtin.Params.Address).WithData(data), builtin.Executor.Address)
 
-	data = mustEncodeInput(builtin.Params.ABI, "set", thor.KeyProposerEndorsement, thor.InitialProposerEndorsement)
+	data = mustPack(gen.ParamsABI.PackSet(thor.KeyProposerEndorsement, thor.InitialProposerEndorsement))
 	builder.Call(tx.NewClause(&builtin.Params.Address).WithData(data), builtin.Executor.Address)
 
 	// add initial authority nodes
 	for _, anode := range initialAuthorityNodes {
-		data := mustEncodeInput(builtin.Authority.ABI, "add", anode.masterAddress, anode.endorsorAddress, anode.identity)
+		data := mustPack(gen.AuthorityABI.PackAdd(anode.masterAddress, anode.endorsorAddress, anode.identity))
 		builder.Call(tx.NewClause(&builtin.Authority.Address).WithData(data), builtin.Executor.Address)
 	}
 
 	// add initial approvers (steering committee)
 	for _, approver := range loadApprovers() {
-		data := mustEncodeInput(builtin.Executor.ABI, "addApprover", approver.address, thor.BytesToBytes32([]byte(approver.identity)))
+		data := mustPack(gen.ExecutorABI.PackAddApprover(approver.address, thor.BytesToBytes32([]byte(approver.identity))))
 		builder.Call(tx.NewClause(&builtin.Executor.Address).WithData(data), builtin.Executor.Address)
 	}
 
@@ -0,0 +1,152 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package genesis
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+// Snapshot is the full state of a network exported at a block.
+type Snapshot struct {
+	Number    uint32            `json:"number"`
+	StateRoot thor.Bytes32      `json:"stateRoot"`
+	Accounts  []SnapshotAccount `json:"accounts"`
+}
+
+// SnapshotAccount is the raw account in snapshot.
+// Energy is the stored value at BlockTime, rather than the grown one.
+type SnapshotAccount struct {
+	Address   thor.Address      `json:"address"`
+	Balance   *big.Int          `json:"balance"`
+	Energy    *big.Int          `json:"energy"`
+	BlockTime uint64            `json:"blockTime"`
+	Master    *thor.Address     `json:"master,omitempty"`
+	Code      string            `json:"code,omitempty"`
+	Storage   map[string]string `json:"storage,omitempty"` // key -> rlp encoded value
+}
+
+// Import sets accounts of the snapshot into the state.
+func (s *Snapshot) Import(state *state.State) error {
+	for _, a := range s.Accounts {
+		if a.Balance == nil || a.Energy == nil {
+			return fmt.Errorf("%v: balance and energy must be set", a.Address)
+		}
+		state.SetBalance(a.Address, a.Balance)
+		state.SetEnergy(a.Address, a.Energy, a.BlockTime)
+		if a.Master != nil {
+			state.SetMaster(a.Address, *a.Master)
+		}
+		if len(a.Code) > 0 {
+			code, err := hexutil.Decode(a.Code)
+			if err != nil {
+				return fmt.Errorf("%v: invalid code", a.Address)
+			}
+			state.SetCode(a.Address, code)
+		}
+		for k, v := range a.Storage {
+			key, err := thor.ParseBytes32(k)
+			if err != nil {
+				return fmt.Errorf("%v: invalid storage key %v", a.Address, k)
+			}
+			raw, err := hexutil.Decode(v)
+			if err != nil {
+				return fmt.Errorf("%v: invalid storage value of key %v", a.Address, k)
+			}
+			state.SetRawStorage(a.Address, key, raw)
+		}
+	}
+	return state.Err()
+}
+
+// newForkNet creates genesis of a network forked from the snapshot.
+// The state root formed by imported accounts is verified against the snapshot.
+// Authority nodes of the snapshot are replaced by those in gen, and other fields of gen,
+// if set, override the imported state. Energy supply is kept as in the snapshot.
+func newForkNet(gen *CustomGenesis) (*Genesis, error) {
+	snapshot := gen.Snapshot
+	if len(gen.Authority) == 0 {
+		return nil, errors.New("at least one authority node")
+	}
+	if len(gen.Executor.Approvers) > 0 {
+		return nil, errors.New("executor approvers can not be set on snapshot")
+	}
+
+	builder := new(Builder).
+		Timestamp(gen.LaunchTime).
+		GasLimit(gen.GasLimit).
+		State(func(state *state.State) error {
+			if err := snapshot.Import(state); err != nil {
+				return err
+			}
+			root, err := state.Stage().Hash()
+			if err != nil {
+				return err
+			}
+			if root != snapshot.StateRoot {
+				return fmt.Errorf("snapshot state root mismatch: want %v, got %v", snapshot.StateRoot, root)
+			}
+
+			// the fork is run by its own authority nodes
+			aut := builtin.Authority.Native(state)
+			var revoking []thor.Address
+			for ptr := aut.First(); ptr != nil; ptr = aut.Next(*ptr) {
+				revoking = append(revoking, *ptr)
+			}
+			// add before revoking, since a sole entry is not linked and can't be revoked
+			for _, anode := range gen.Authority {
+				if !aut.Add(anode.MasterAddress, anode.EndorsorAddress, anode.Identity) {
+					return fmt.Errorf("%v: authority node already exists", anode.MasterAddress)
+				}
+			}
+			for _, master := range revoking {
+				aut.Revoke(master)
+			}
+
+			params := builtin.Params.Native(state)
+			if addr := gen.Params.ExecutorAddress; addr != nil {
+				params.Set(thor.KeyExecutorAddress, new(big.Int).SetBytes(addr[:]))
+			}
+			for key, value := range map[thor.Bytes32]*big.Int{
+				thor.KeyRewardRatio:         gen.Params.RewardRatio,
+				thor.KeyBaseGasPrice:        gen.Params.BaseGasPrice,
+				thor.KeyProposerEndorsement: gen.Params.ProposerEndorsement,
+			} {
+				if value != nil {
+					params.Set(key, value)
+				}
+			}
+
+			// e.g. to fund endorsors and test accounts
+			for _, a := range gen.Accounts {
+				if a.Balance != nil {
+					state.SetBalance(a.Address, a.Balance)
+				}
+				if a.Energy != nil {
+					state.SetEnergy(a.Address, a.Energy, gen.LaunchTime)
+				}
+			}
+			return commitRules(state, gen)
+		})
+
+	if len(gen.ExtraData) > 0 {
+		var extra [28]byte
+		copy(extra[:], gen.ExtraData)
+		builder.ExtraData(extra)
+	}
+
+	id, err := builder.ComputeID()
+	if err != nil {
+		return nil, err
+	}
+	return &Genesis{builder, id, "forknet"}, nil
+}
@@ -9,6 +9,7 @@ import (
 	"math/big"
 
 	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/builtin/gen"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
@@ -62,19 +63,19 @@ func NewTestnet() *Genesis {
 		// set initial params
 		// use an external account as executor to manage testnet easily
 		Call(
-			tx.NewClause(&builtin.Params.Address).WithData(mustEncodeInput(builtin.Params.ABI, "set", thor.KeyExecutorAddress, new(big.Int).SetBytes(executor[:]))),
+			tx.NewClause(&builtin.Params.Address).WithData(mustPack(gen.ParamsABI.PackSet(thor.KeyExecutorAddress, new(big.Int).SetBytes(executor[:])))),
 			thor.Address{}).
 		Call(
-			tx.NewClause(&builtin.Params.Address).WithData(mustEncodeInput(builtin.Params.ABI, "set", thor.KeyRewardRatio, thor.InitialRewardRatio)),
+			tx.NewClause(&builtin.Params.Address).WithData(mustPack(gen.ParamsABI.PackSet(thor.KeyRewardRatio, thor.InitialRewardRatio))),
 			executor).
 		Call(
-			tx.NewClause(&builtin.Params.Address).WithData(mustEncodeInput(builtin.Params.ABI, "set", thor.KeyBaseGasPrice, thor.InitialBaseGasPrice)),
+			tx.NewClause(&builtin.Params.Address).WithData(mustPack(gen.ParamsABI.PackSet(thor.KeyBaseGasPrice, thor.InitialBaseGasPrice))),
 			executor).
 		Call(
-			tx.NewClause(&builtin.Params.Address).WithData(mustEncodeInput(builtin.Params.ABI, "set", thor.KeyProposerEndorsement, thor.InitialProposerEndorsement)),
+			tx.NewClause(&builtin.Params.Address).WithData(mustPack(gen.ParamsABI.PackSet(thor.KeyProposerEndorsement, thor.InitialProposerEndorsement))),
 			executor).
 		// add master0 as the initial block proposer
-		Call(tx.NewClause(&builtin.Authority.Address).WithData(mustEncodeInput(builtin.Authority.ABI, "add", master0, endorser0, thor.BytesToBytes32([]byte("master0")))),
+		Call(tx.NewClause(&builtin.Authority.Address).WithData(mustPack(gen.AuthorityABI.PackAdd(master0, endorser0, thor.BytesToBytes32([]byte("master0"))))),
 			executor)
 
 	id, err := builder.ComputeID()
@@ -0,0 +1,171 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package light
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/accounts"
+	"github.com/vechain/thor/api/blocks"
+	"github.com/vechain/thor/api/transactions"
+	"github.com/vechain/thor/api/utils"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/thor"
+)
+
+// API serves the subset of REST API, which is backed by headers and proofs.
+// Blocks are presented without txs, and the size of them is unknown.
+type API struct {
+	client *Client
+}
+
+// NewAPI creates the API upon the client.
+func NewAPI(client *Client) *API {
+	return &API{client}
+}
+
+// handleRevision resolves revision to header on trunk, which is 'best', block number or block ID.
+func (a *API) handleRevision(revision string) (*block.Header, error) {
+	chain := a.client.Chain()
+	if revision == "" || revision == "best" {
+		return chain.BestHeader(), nil
+	}
+	if len(revision) == 66 || len(revision) == 64 {
+		id, err := thor.ParseBytes32(revision)
+		if err != nil {
+			return nil, utils.BadRequest(errors.WithMessage(err, "revision"))
+		}
+		header := chain.GetHeader(id)
+		if header == nil {
+			return nil, utils.HTTPError(errors.New("revision: not found"), http.StatusNotFound)
+		}
+		return header, nil
+	}
+	n, err := strconv.ParseUint(revision, 0, 0)
+	if err != nil {
+		return nil, utils.BadRequest(errors.WithMessage(err, "revision"))
+	}
+	if n > math.MaxUint32 {
+		return nil, utils.BadRequest(errors.New("revision: out of range"))
+	}
+	header := chain.GetTrunkHeader(uint32(n))
+	if header == nil {
+		return nil, utils.HTTPError(errors.New("revision: not found"), http.StatusNotFound)
+	}
+	return header, nil
+}
+
+func (a *API) handleGetBlock(w http.ResponseWriter, req *http.Request) error {
+	header, err := a.handleRevision(mux.Vars(req)["revision"])
+	if err != nil {
+		return err
+	}
+	signer, err := header.Signer()
+	if err != nil {
+		return err
+	}
+	trunk := a.client.Chain().GetTrunkHeader(header.Number())
+	return utils.WriteJSON(w, &blocks.Block{
+		Number:       header.Number(),
+		ID:           header.ID(),
+		ParentID:     header.ParentID(),
+		Timestamp:    header.Timestamp(),
+		GasLimit:     header.GasLimit(),
+		Beneficiary:  header.Beneficiary(),
+		GasUsed:      header.GasUsed(),
+		TotalScore:   header.TotalScore(),
+		TxsRoot:      header.TxsRoot(),
+		StateRoot:    header.StateRoot(),
+		ReceiptsRoot: header.ReceiptsRoot(),
+		Signer:       signer,
+		IsTrunk:      trunk != nil && trunk.ID() == header.ID(),
+	})
+}
+
+func (a *API) handleGetAccount(w http.ResponseWriter, req *http.Request) error {
+	addr, err := thor.ParseAddress(mux.Vars(req)["address"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "address"))
+	}
+	header, err := a.handleRevision(req.URL.Query().Get("revision"))
+	if err != nil {
+		return err
+	}
+	account, err := a.client.GetAccount(req.Context(), header.ID(), addr)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, &accounts.Account{
+		Balance: math.HexOrDecimal256(*account.Balance),
+		Energy:  math.HexOrDecimal256(*account.CalcEnergy(header.Timestamp())),
+		HasCode: len(account.CodeHash) > 0,
+	})
+}
+
+func (a *API) handleGetStorage(w http.ResponseWriter, req *http.Request) error {
+	addr, err := thor.ParseAddress(mux.Vars(req)["address"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "address"))
+	}
+	key, err := thor.ParseBytes32(mux.Vars(req)["key"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "key"))
+	}
+	header, err := a.handleRevision(req.URL.Query().Get("revision"))
+	if err != nil {
+		return err
+	}
+	raw, err := a.client.GetRawStorage(req.Context(), header.ID(), addr, key)
+	if err != nil {
+		return err
+	}
+	// decoded the same way as state.GetStorage
+	var value thor.Bytes32
+	if len(raw) > 0 {
+		kind, content, _, err := rlp.Split(raw)
+		if err != nil {
+			return err
+		}
+		if kind == rlp.List {
+			value = thor.Blake2b(raw)
+		} else {
+			value = thor.BytesToBytes32(content)
+		}
+	}
+	return utils.WriteJSON(w, map[string]string{"value": value.String()})
+}
+
+func (a *API) handleGetTransactionReceipt(w http.ResponseWriter, req *http.Request) error {
+	id, err := thor.ParseBytes32(mux.Vars(req)["id"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "id"))
+	}
+	trx, receipt, header, err := a.client.GetTx(req.Context(), id)
+	if err != nil {
+		return err
+	}
+	if trx == nil {
+		return utils.WriteJSON(w, nil)
+	}
+	r, err := transactions.ConvertReceipt(receipt, header, trx)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, r)
+}
+
+// Mount mounts handlers at the same paths as the full API.
+func (a *API) Mount(root *mux.Router) {
+	root.Path("/blocks/{revision}").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(a.handleGetBlock))
+	root.Path("/accounts/{address}").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(a.handleGetAccount))
+	root.Path("/accounts/{address}/storage/{key}").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(a.handleGetStorage))
+	root.Path("/transactions/{id}/receipt").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(a.handleGetTransactionReceipt))
+}
@@ -0,0 +1,339 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package light
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/discover"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/inconshreveable/log15"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/co"
+	"github.com/vechain/thor/comm/proto"
+	"github.com/vechain/thor/p2psrv"
+	"github.com/vechain/thor/p2psrv/rpc"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+var log = log15.New("pkg", "light")
+
+// maxTries max count of peers tried for a request.
+const maxTries = 3
+
+type peer struct {
+	*p2p.Peer
+	*rpc.RPC
+	logger    log15.Logger
+	announced chan struct{}
+}
+
+// Client follows headers and retrieves verified state from full peers.
+// Peers should speak protocol version 4 or higher.
+type Client struct {
+	genesisID thor.Bytes32
+	chain     *HeaderChain
+	ctx       context.Context
+	cancel    context.CancelFunc
+	goes      co.Goes
+
+	lock  sync.Mutex
+	peers map[discover.NodeID]*peer
+}
+
+// NewClient create a light client upon the header chain.
+func NewClient(genesisID thor.Bytes32, chain *HeaderChain) *Client {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Client{
+		genesisID: genesisID,
+		chain:     chain,
+		ctx:       ctx,
+		cancel:    cancel,
+		peers:     make(map[discover.NodeID]*peer),
+	}
+}
+
+// Chain returns the header chain.
+func (c *Client) Chain() *HeaderChain {
+	return c.chain
+}
+
+// Stop stops syncing with peers.
+func (c *Client) Stop() {
+	c.cancel()
+	c.goes.Wait()
+}
+
+// PeerCount returns count of connected peers.
+func (c *Client) PeerCount() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return len(c.peers)
+}
+
+// Protocols returns the protocol to be run by p2p server.
+func (c *Client) Protocols() []*p2psrv.Protocol {
+	return []*p2psrv.Protocol{{
+		Protocol: p2p.Protocol{
+			Name:    proto.Name,
+			Version: proto.Version,
+			Length:  proto.Length,
+			Run:     c.servePeer,
+		},
+		// same as topic of full nodes
+		DiscTopic: fmt.Sprintf("%v%v@%x", proto.Name, proto.Version1, c.genesisID[24:]),
+	}}
+}
+
+func (c *Client) servePeer(p *p2p.Peer, rw p2p.MsgReadWriter) error {
+	peer := &peer{
+		Peer:      p,
+		RPC:       rpc.New(p, rw),
+		logger:    log.New("peer", p),
+		announced: make(chan struct{}, 1),
+	}
+	c.goes.Go(func() {
+		c.runPeer(peer)
+	})
+	return peer.Serve(func(msg *p2p.Msg, write func(interface{})) error {
+		switch msg.Code {
+		case proto.MsgGetStatus:
+			// light clients serve no blocks, and zero total score keeps full peers from syncing from them
+			write(&proto.Status{
+				GenesisBlockID: c.genesisID,
+				SysTimestamp:   uint64(time.Now().Unix()),
+				BestBlockID:    c.chain.BestHeader().ID(),
+			})
+		case proto.MsgNewBlockID, proto.MsgNewBlock:
+			select {
+			case peer.announced <- struct{}{}:
+			default:
+			}
+			write(&struct{}{})
+		default:
+			// nothing else to serve, an empty result for calls, and ignored for notifications
+			write(&struct{}{})
+		}
+		return nil
+	}, proto.MaxMsgSize)
+}
+
+func (c *Client) runPeer(peer *peer) {
+	defer peer.Disconnect(p2p.DiscRequested)
+
+	ctx, cancel := context.WithTimeout(c.ctx, 5*time.Second)
+	status, err := proto.GetStatus(ctx, peer)
+	cancel()
+	if err != nil {
+		peer.logger.Debug("failed to get status", "err", err)
+		return
+	}
+	if status.GenesisBlockID != c.genesisID {
+		peer.logger.Debug("failed to handshake", "err", "genesis id mismatch")
+		return
+	}
+
+	c.lock.Lock()
+	c.peers[peer.ID()] = peer
+	c.lock.Unlock()
+	defer func() {
+		c.lock.Lock()
+		delete(c.peers, peer.ID())
+		c.lock.Unlock()
+	}()
+
+	ticker := time.NewTicker(time.Duration(thor.BlockInterval) * time.Second)
+	defer ticker.Stop()
+	for {
+		if err := c.SyncHeaders(c.ctx, peer); err != nil {
+			peer.logger.Debug("failed to sync headers", "err", err)
+			if IsInvalid(err) {
+				return
+			}
+		}
+		select {
+		case <-peer.Done():
+			return
+		case <-c.ctx.Done():
+			return
+		case <-peer.announced:
+		case <-ticker.C:
+		}
+	}
+}
+
+// SyncHeaders fetches headers following the best header from the peer, until
+// no more headers returned. If the peer is on another branch, headers are fetched
+// from lower numbers, down to the base of the header chain.
+func (c *Client) SyncHeaders(ctx context.Context, rpc proto.RPC) error {
+	num := c.chain.BestHeader().Number() + 1
+	back := uint32(1)
+	for {
+		headers, err := proto.GetHeadersFromNumber(ctx, rpc, num)
+		if err != nil {
+			return err
+		}
+		if len(headers) == 0 {
+			return nil
+		}
+		if c.chain.GetHeader(headers[0].ParentID()) == nil {
+			base := c.chain.BaseNumber()
+			if num <= base+1 {
+				return errParentMissing
+			}
+			if num-base-1 > back {
+				num -= back
+			} else {
+				num = base + 1
+			}
+			back *= 2
+			continue
+		}
+		now := uint64(time.Now().Unix())
+		for _, header := range headers {
+			if _, err := c.chain.AddHeader(header, now); err != nil {
+				if IsKnownHeader(err) {
+					continue
+				}
+				return err
+			}
+		}
+		num = headers[len(headers)-1].Number() + 1
+	}
+}
+
+// request calls f with randomly picked peers, until succeeded or tried maxTries peers.
+// Peers responding invalid data are disconnected.
+func (c *Client) request(f func(rpc proto.RPC) error) error {
+	c.lock.Lock()
+	peers := make([]*peer, 0, len(c.peers))
+	for _, p := range c.peers {
+		peers = append(peers, p)
+	}
+	c.lock.Unlock()
+
+	if len(peers) == 0 {
+		return errNoPeer
+	}
+	rand.Shuffle(len(peers), func(i, j int) { peers[i], peers[j] = peers[j], peers[i] })
+
+	var err error
+	for i, p := range peers {
+		if i == maxTries {
+			break
+		}
+		if err = f(p); err == nil {
+			return nil
+		}
+		p.logger.Debug("failed to request", "err", err)
+		if IsInvalid(err) {
+			p.Disconnect(p2p.DiscUselessPeer)
+		}
+	}
+	return err
+}
+
+// stateRoot returns state root of the block.
+func (c *Client) stateRoot(blockID thor.Bytes32) (thor.Bytes32, error) {
+	header := c.chain.GetHeader(blockID)
+	if header == nil {
+		return thor.Bytes32{}, fmt.Errorf("header not found: %v", blockID)
+	}
+	return header.StateRoot(), nil
+}
+
+// GetAccount fetches the account in state of the given block from peers, and verifies it.
+func (c *Client) GetAccount(ctx context.Context, blockID thor.Bytes32, addr thor.Address) (*state.Account, error) {
+	root, err := c.stateRoot(blockID)
+	if err != nil {
+		return nil, err
+	}
+	var account *state.Account
+	if err := c.request(func(rpc proto.RPC) error {
+		proof, err := proto.GetAccountProof(ctx, rpc, blockID, addr)
+		if err != nil {
+			return err
+		}
+		if len(proof) == 0 {
+			return errUnknownBlock
+		}
+		account, err = VerifyAccount(root, addr, proof)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// GetRawStorage fetches the raw storage value in state of the given block from peers, and verifies it.
+func (c *Client) GetRawStorage(ctx context.Context, blockID thor.Bytes32, addr thor.Address, key thor.Bytes32) (rlp.RawValue, error) {
+	root, err := c.stateRoot(blockID)
+	if err != nil {
+		return nil, err
+	}
+	var value rlp.RawValue
+	if err := c.request(func(rpc proto.RPC) error {
+		proof, err := proto.GetStorageProof(ctx, rpc, blockID, addr, key)
+		if err != nil {
+			return err
+		}
+		if len(proof.Account) == 0 {
+			return errUnknownBlock
+		}
+		account, err := VerifyAccount(root, addr, proof.Account)
+		if err != nil {
+			return err
+		}
+		value, err = VerifyStorage(thor.BytesToBytes32(account.StorageRoot), key, proof.Storage)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// GetTx fetches the tx along with its receipt and including block header from peers, and verifies them.
+// Nil is returned if the tx is not found in blocks on trunk of the header chain.
+func (c *Client) GetTx(ctx context.Context, txID thor.Bytes32) (*tx.Transaction, *tx.Receipt, *block.Header, error) {
+	var (
+		trx     *tx.Transaction
+		receipt *tx.Receipt
+		header  *block.Header
+	)
+	err := c.request(func(rpc proto.RPC) error {
+		proof, err := proto.GetTxProof(ctx, rpc, txID)
+		if err != nil {
+			return err
+		}
+		if len(proof.Tx) == 0 {
+			return nil
+		}
+		h := c.chain.GetHeader(proof.BlockID)
+		if h == nil {
+			// the peer is ahead, or on another branch
+			return nil
+		}
+		if trunk := c.chain.GetTrunkHeader(h.Number()); trunk == nil || trunk.ID() != h.ID() {
+			return nil
+		}
+		if trx, receipt, err = VerifyTx(h, txID, proof.Index, proof.Tx, proof.Receipt); err != nil {
+			return err
+		}
+		header = h
+		return nil
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return trx, receipt, header, nil
+}
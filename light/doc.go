@@ -0,0 +1,14 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package light implements the client side of the light protocol.
+// A light client follows block headers only, starting from a trusted checkpoint,
+// and validates the proposer of each header against the schedule of PoA.
+// Account and storage data are fetched on demand from full peers, and verified
+// by merkle proofs against the state root of headers.
+//
+// Changes of the authority set made by governance are not observable from headers,
+// so the checkpoint should be renewed once the set changed.
+package light
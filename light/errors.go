@@ -0,0 +1,47 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package light
+
+import (
+	"errors"
+)
+
+var (
+	errFutureHeader  = errors.New("header in the future")
+	errParentMissing = errors.New("parent header is missing")
+	errKnownHeader   = errors.New("header already in the chain")
+	errUnknownBlock  = errors.New("block unknown to peer")
+	errNoPeer        = errors.New("no peer available")
+)
+
+type invalidError string
+
+func (err invalidError) Error() string {
+	return string(err)
+}
+
+// IsFutureHeader returns if the error indicates that the header should be
+// processed later.
+func IsFutureHeader(err error) bool {
+	return err == errFutureHeader
+}
+
+// IsParentMissing returns if the parent of the header is not in the chain.
+func IsParentMissing(err error) bool {
+	return err == errParentMissing
+}
+
+// IsKnownHeader returns if the error means the header was already in the chain.
+func IsKnownHeader(err error) bool {
+	return err == errKnownHeader
+}
+
+// IsInvalid returns if the error means the header or proof is invalid, which
+// indicates the remote peer is misbehaving.
+func IsInvalid(err error) bool {
+	_, ok := err.(invalidError)
+	return ok
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package light
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/poa"
+	"github.com/vechain/thor/thor"
+)
+
+// maxHeaders limits count of headers kept in memory. Once exceeded, headers far behind
+// the best one are evicted.
+const maxHeaders = 8192
+
+type headerEntry struct {
+	header    *block.Header
+	proposers []poa.Proposer // proposers status after the block
+}
+
+// HeaderChain keeps validated block headers in memory.
+type HeaderChain struct {
+	lock    sync.RWMutex
+	entries map[thor.Bytes32]*headerEntry
+	best    *block.Header
+	base    uint32 // number of the lowest header kept
+}
+
+// NewHeaderChain create a header chain starts from the trusted checkpoint header,
+// along with proposers status after the checkpoint block.
+func NewHeaderChain(checkpoint *block.Header, proposers []poa.Proposer) *HeaderChain {
+	return &HeaderChain{
+		entries: map[thor.Bytes32]*headerEntry{
+			checkpoint.ID(): {checkpoint, append([]poa.Proposer(nil), proposers...)},
+		},
+		best: checkpoint,
+		base: checkpoint.Number(),
+	}
+}
+
+// BestHeader returns the header with the highest total score.
+func (hc *HeaderChain) BestHeader() *block.Header {
+	hc.lock.RLock()
+	defer hc.lock.RUnlock()
+	return hc.best
+}
+
+// GetHeader returns header by ID, or nil if not in the chain.
+func (hc *HeaderChain) GetHeader(id thor.Bytes32) *block.Header {
+	hc.lock.RLock()
+	defer hc.lock.RUnlock()
+	if entry, ok := hc.entries[id]; ok {
+		return entry.header
+	}
+	return nil
+}
+
+// BaseNumber returns number of the lowest header kept, below which headers are evicted.
+func (hc *HeaderChain) BaseNumber() uint32 {
+	hc.lock.RLock()
+	defer hc.lock.RUnlock()
+	return hc.base
+}
+
+// GetTrunkHeader returns header on trunk by number, or nil if not in the chain.
+func (hc *HeaderChain) GetTrunkHeader(num uint32) *block.Header {
+	hc.lock.RLock()
+	defer hc.lock.RUnlock()
+	header := hc.best
+	for header.Number() > num {
+		entry, ok := hc.entries[header.ParentID()]
+		if !ok {
+			return nil
+		}
+		header = entry.header
+	}
+	if header.Number() != num {
+		return nil
+	}
+	return header
+}
+
+// AddHeader validates the header and adds it into the chain.
+// It returns true if the header becomes the best one.
+func (hc *HeaderChain) AddHeader(header *block.Header, nowTimestamp uint64) (bool, error) {
+	hc.lock.Lock()
+	defer hc.lock.Unlock()
+
+	id := header.ID()
+	if _, ok := hc.entries[id]; ok {
+		return false, errKnownHeader
+	}
+	parent, ok := hc.entries[header.ParentID()]
+	if !ok {
+		return false, errParentMissing
+	}
+	if err := validateHeader(header, parent.header, nowTimestamp); err != nil {
+		return false, err
+	}
+	proposers, err := validateProposer(header, parent.header, parent.proposers)
+	if err != nil {
+		return false, err
+	}
+
+	hc.entries[id] = &headerEntry{header, proposers}
+	if header.TotalScore() > hc.best.TotalScore() {
+		hc.best = header
+		if len(hc.entries) > maxHeaders {
+			hc.evict()
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// evict removes headers far behind the best one, keeping half of maxHeaders at most.
+func (hc *HeaderChain) evict() {
+	if hc.best.Number() < maxHeaders/2 {
+		return
+	}
+	base := hc.best.Number() - maxHeaders/2 + 1
+	for id, entry := range hc.entries {
+		if entry.header.Number() < base {
+			delete(hc.entries, id)
+		}
+	}
+	if base > hc.base {
+		hc.base = base
+	}
+}
+
+func validateHeader(header *block.Header, parent *block.Header, nowTimestamp uint64) error {
+	if header.Timestamp() <= parent.Timestamp() {
+		return invalidError(fmt.Sprintf("header timestamp behind parents: parent %v, current %v", parent.Timestamp(), header.Timestamp()))
+	}
+	if (header.Timestamp()-parent.Timestamp())%thor.BlockInterval != 0 {
+		return invalidError(fmt.Sprintf("header interval not rounded: parent %v, current %v", parent.Timestamp(), header.Timestamp()))
+	}
+	if header.Timestamp() > nowTimestamp+thor.BlockInterval {
+		return errFutureHeader
+	}
+	if !block.GasLimit(header.GasLimit()).IsValid(parent.GasLimit()) {
+		return invalidError(fmt.Sprintf("header gas limit invalid: parent %v, current %v", parent.GasLimit(), header.GasLimit()))
+	}
+	if header.GasUsed() > header.GasLimit() {
+		return invalidError(fmt.Sprintf("header gas used exceeds limit: limit %v, used %v", header.GasLimit(), header.GasUsed()))
+	}
+	return nil
+}
+
+// validateProposer checks the signer is scheduled at the header timestamp, and returns
+// proposers status after the block.
+func validateProposer(header *block.Header, parent *block.Header, proposers []poa.Proposer) ([]poa.Proposer, error) {
+	signer, err := header.Signer()
+	if err != nil {
+		return nil, invalidError(fmt.Sprintf("header signer unavailable: %v", err))
+	}
+	sched, err := poa.NewScheduler(signer, proposers, parent.Number(), parent.Timestamp())
+	if err != nil {
+		return nil, invalidError(fmt.Sprintf("header signer invalid: %v %v", signer, err))
+	}
+	if !sched.IsTheTime(header.Timestamp()) {
+		return nil, invalidError(fmt.Sprintf("header timestamp unscheduled: t %v, s %v", header.Timestamp(), signer))
+	}
+	updates, score := sched.Updates(header.Timestamp())
+	if parent.TotalScore()+score != header.TotalScore() {
+		return nil, invalidError(fmt.Sprintf("header total score invalid: want %v, have %v", parent.TotalScore()+score, header.TotalScore()))
+	}
+
+	status := make(map[thor.Address]bool, len(updates))
+	for _, u := range updates {
+		status[u.Address] = u.Active
+	}
+	result := make([]poa.Proposer, 0, len(proposers))
+	for _, p := range proposers {
+		if active, ok := status[p.Address]; ok {
+			p.Active = active
+		}
+		result = append(result, p)
+	}
+	return result, nil
+}
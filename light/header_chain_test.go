@@ -0,0 +1,179 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package light
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/poa"
+	"github.com/vechain/thor/thor"
+)
+
+const launchTime = uint64(1526400000)
+
+func newCheckpoint() *block.Header {
+	return new(block.Builder).
+		ParentID(thor.Bytes32{0xff, 0xff, 0xff, 0xff}).
+		Timestamp(launchTime).
+		GasLimit(thor.InitialGasLimit).
+		TotalScore(0).
+		Build().Header()
+}
+
+func newProposers() []poa.Proposer {
+	var proposers []poa.Proposer
+	for _, acc := range genesis.DevAccounts()[:3] {
+		proposers = append(proposers, poa.Proposer{Address: acc.Address, Active: true})
+	}
+	return proposers
+}
+
+// signHeader builds a header upon the parent, signed by the dev account at the given time.
+func signHeader(parent *block.Header, acc genesis.DevAccount, timestamp uint64, score uint64) *block.Header {
+	b := new(block.Builder).
+		ParentID(parent.ID()).
+		Timestamp(timestamp).
+		GasLimit(parent.GasLimit()).
+		TotalScore(parent.TotalScore() + score).
+		Build()
+	sig, _ := crypto.Sign(b.Header().SigningHash().Bytes(), acc.PrivateKey)
+	return b.WithSignature(sig).Header()
+}
+
+// nextHeader builds a valid header upon the parent, by the proposer who is scheduled first.
+func nextHeader(parent *block.Header, proposers []poa.Proposer) *block.Header {
+	var (
+		best      genesis.DevAccount
+		bestTime  uint64
+		bestScore uint64
+	)
+	for _, acc := range genesis.DevAccounts()[:3] {
+		sched, err := poa.NewScheduler(acc.Address, proposers, parent.Number(), parent.Timestamp())
+		if err != nil {
+			continue
+		}
+		t := sched.Schedule(parent.Timestamp() + thor.BlockInterval)
+		if bestTime == 0 || t < bestTime {
+			_, score := sched.Updates(t)
+			best, bestTime, bestScore = acc, t, score
+		}
+	}
+	return signHeader(parent, best, bestTime, bestScore)
+}
+
+func TestValidateProposer(t *testing.T) {
+	checkpoint := newCheckpoint()
+	proposers := newProposers()
+
+	header := nextHeader(checkpoint, proposers)
+	result, err := validateProposer(header, checkpoint, proposers)
+	assert.Nil(t, err)
+	assert.Equal(t, len(proposers), len(result))
+
+	signer, _ := header.Signer()
+	var (
+		acc, other genesis.DevAccount
+		stranger   = genesis.DevAccounts()[5]
+	)
+	for _, a := range genesis.DevAccounts()[:3] {
+		if a.Address == signer {
+			acc = a
+		} else {
+			other = a
+		}
+	}
+
+	// not in proposers
+	_, err = validateProposer(signHeader(checkpoint, stranger, header.Timestamp(), header.TotalScore()), checkpoint, proposers)
+	assert.True(t, IsInvalid(err))
+
+	// not the time of the signer
+	_, err = validateProposer(signHeader(checkpoint, other, header.Timestamp(), header.TotalScore()), checkpoint, proposers)
+	assert.True(t, IsInvalid(err))
+
+	// bad total score
+	_, err = validateProposer(signHeader(checkpoint, acc, header.Timestamp(), header.TotalScore()+1), checkpoint, proposers)
+	assert.True(t, IsInvalid(err))
+
+	// proposers skipped are deactivated
+	sched, _ := poa.NewScheduler(signer, proposers, checkpoint.Number(), checkpoint.Timestamp())
+	late := sched.Schedule(header.Timestamp() + thor.BlockInterval*10)
+	updates, score := sched.Updates(late)
+	result, err = validateProposer(signHeader(checkpoint, acc, late, score), checkpoint, proposers)
+	assert.Nil(t, err)
+	inactive := 0
+	for _, p := range result {
+		if !p.Active {
+			inactive++
+		}
+	}
+	assert.Equal(t, len(updates), inactive)
+}
+
+func TestHeaderChain(t *testing.T) {
+	checkpoint := newCheckpoint()
+	proposers := newProposers()
+	hc := NewHeaderChain(checkpoint, proposers)
+	now := launchTime + 1000*thor.BlockInterval
+
+	_, err := hc.AddHeader(checkpoint, now)
+	assert.True(t, IsKnownHeader(err))
+
+	h1 := nextHeader(checkpoint, proposers)
+	isBest, err := hc.AddHeader(h1, now)
+	assert.Nil(t, err)
+	assert.True(t, isBest)
+	assert.Equal(t, h1.ID(), hc.BestHeader().ID())
+
+	// proposers status after h1 are used for h2
+	p1, _ := validateProposer(h1, checkpoint, proposers)
+	h2 := nextHeader(h1, p1)
+	_, err = hc.AddHeader(h2, now)
+	assert.Nil(t, err)
+	assert.Equal(t, h2, hc.GetTrunkHeader(2))
+	assert.Equal(t, h1, hc.GetTrunkHeader(1))
+	assert.Equal(t, checkpoint, hc.GetTrunkHeader(0))
+	assert.Nil(t, hc.GetTrunkHeader(3))
+
+	p2, _ := validateProposer(h2, h1, p1)
+	h3 := nextHeader(h2, p2)
+	_, err = hc.AddHeader(h3, h3.Timestamp()-thor.BlockInterval*2)
+	assert.True(t, IsFutureHeader(err))
+
+	h4 := nextHeader(h3, p2)
+	_, err = hc.AddHeader(h4, now)
+	assert.True(t, IsParentMissing(err))
+
+	// a block from the stranger
+	_, err = hc.AddHeader(signHeader(h2, genesis.DevAccounts()[5], h3.Timestamp(), h3.TotalScore()-h2.TotalScore()), now)
+	assert.True(t, IsInvalid(err))
+}
+
+func TestHeaderChainEvict(t *testing.T) {
+	checkpoint := newCheckpoint()
+	hc := NewHeaderChain(checkpoint, nil)
+
+	parent := checkpoint
+	for i := 0; i < maxHeaders; i++ {
+		header := new(block.Builder).ParentID(parent.ID()).TotalScore(parent.TotalScore() + 1).Build().Header()
+		hc.entries[header.ID()] = &headerEntry{header: header}
+		parent = header
+	}
+	hc.best = parent
+	assert.Equal(t, maxHeaders+1, len(hc.entries))
+
+	hc.evict()
+	assert.Equal(t, maxHeaders/2, len(hc.entries))
+	assert.Equal(t, uint32(maxHeaders/2+1), hc.BaseNumber())
+	assert.Nil(t, hc.GetHeader(checkpoint.ID()))
+	assert.Equal(t, parent, hc.GetTrunkHeader(parent.Number()))
+	assert.Nil(t, hc.GetTrunkHeader(hc.BaseNumber()-1))
+	assert.NotNil(t, hc.GetTrunkHeader(hc.BaseNumber()))
+}
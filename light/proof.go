@@ -0,0 +1,88 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package light
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/trie"
+	"github.com/vechain/thor/tx"
+)
+
+// emptyRoot is the root of an empty trie.
+var emptyRoot = thor.Blake2b(rlp.EmptyString)
+
+// verify verifies the proof of key in a secure trie with the given root.
+func verify(root thor.Bytes32, key []byte, proof [][]byte) ([]byte, error) {
+	if root == (thor.Bytes32{}) || root == emptyRoot {
+		return nil, nil
+	}
+	value, err := trie.VerifyProofList(root, thor.Blake2b(key).Bytes(), proof)
+	if err != nil {
+		return nil, invalidError(fmt.Sprintf("bad proof: %v", err))
+	}
+	return value, nil
+}
+
+// VerifyAccount verifies the proof of account at addr against the state root.
+// An empty account is returned for absent account.
+func VerifyAccount(stateRoot thor.Bytes32, addr thor.Address, proof [][]byte) (*state.Account, error) {
+	data, err := verify(stateRoot, addr[:], proof)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return &state.Account{Balance: &big.Int{}, Energy: &big.Int{}}, nil
+	}
+	var a state.Account
+	if err := rlp.DecodeBytes(data, &a); err != nil {
+		return nil, invalidError(fmt.Sprintf("bad account: %v", err))
+	}
+	return &a, nil
+}
+
+// VerifyAccountByWitness verifies the account at addr against the state root, using trie nodes of the
+// execution witness as the proof. A witness of a block proves accounts read by executing the block, as
+// of the parent state, so they can be verified without querying peers.
+func VerifyAccountByWitness(stateRoot thor.Bytes32, addr thor.Address, witness *state.Witness) (*state.Account, error) {
+	return VerifyAccount(stateRoot, addr, witness.Nodes)
+}
+
+// VerifyStorage verifies the proof of raw storage value for given key against the storage root.
+func VerifyStorage(storageRoot thor.Bytes32, key thor.Bytes32, proof [][]byte) (rlp.RawValue, error) {
+	return verify(storageRoot, key[:], proof)
+}
+
+// VerifyTx verifies proofs of the tx with given ID and its receipt, at index of txs in the block,
+// against txs root and receipts root of the block header.
+func VerifyTx(header *block.Header, txID thor.Bytes32, index uint64, txProof, receiptProof [][]byte) (*tx.Transaction, *tx.Receipt, error) {
+	data, err := trie.VerifyDerivedProof(header.TxsRoot(), int(index), txProof)
+	if err != nil {
+		return nil, nil, invalidError(fmt.Sprintf("bad tx proof: %v", err))
+	}
+	var trx *tx.Transaction
+	if err := rlp.DecodeBytes(data, &trx); err != nil {
+		return nil, nil, invalidError(fmt.Sprintf("bad tx: %v", err))
+	}
+	if trx.ID() != txID {
+		return nil, nil, invalidError(fmt.Sprintf("tx id mismatch: want %v, have %v", txID, trx.ID()))
+	}
+
+	data, err = trie.VerifyDerivedProof(header.ReceiptsRoot(), int(index), receiptProof)
+	if err != nil {
+		return nil, nil, invalidError(fmt.Sprintf("bad receipt proof: %v", err))
+	}
+	var receipt tx.Receipt
+	if err := rlp.DecodeBytes(data, &receipt); err != nil {
+		return nil, nil, invalidError(fmt.Sprintf("bad receipt: %v", err))
+	}
+	return trx, &receipt, nil
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package light
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestVerifyProof(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	st, _ := state.New(thor.Bytes32{}, kv)
+
+	addr := thor.BytesToAddress([]byte("account1"))
+	absent := thor.BytesToAddress([]byte("account2"))
+	key := thor.BytesToBytes32([]byte("key"))
+	for i := 0; i < 100; i++ {
+		st.SetBalance(thor.BytesToAddress([]byte{byte(i)}), big.NewInt(int64(i)+1))
+	}
+	st.SetBalance(addr, big.NewInt(100))
+	st.SetStorage(addr, key, thor.BytesToBytes32([]byte("value")))
+	root, err := st.Stage().Commit()
+	assert.Nil(t, err)
+
+	st, _ = state.New(root, kv)
+	proof, err := st.ProveAccount(addr)
+	assert.Nil(t, err)
+	account, err := VerifyAccount(root, addr, proof)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), account.Balance)
+
+	storageProof, err := st.ProveStorage(addr, key)
+	assert.Nil(t, err)
+	raw, err := VerifyStorage(thor.BytesToBytes32(account.StorageRoot), key, storageProof)
+	assert.Nil(t, err)
+	assert.Equal(t, st.GetRawStorage(addr, key), raw)
+
+	proof, err = st.ProveAccount(absent)
+	assert.Nil(t, err)
+	account, err = VerifyAccount(root, absent, proof)
+	assert.Nil(t, err)
+	assert.True(t, account.IsEmpty())
+
+	_, err = VerifyAccount(thor.BytesToBytes32([]byte("root")), addr, proof)
+	assert.True(t, IsInvalid(err))
+}
+
+func TestVerifyAccountByWitness(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	st, _ := state.New(thor.Bytes32{}, kv)
+
+	addr := thor.BytesToAddress([]byte("account1"))
+	for i := 0; i < 100; i++ {
+		st.SetBalance(thor.BytesToAddress([]byte{byte(i)}), big.NewInt(int64(i)+1))
+	}
+	st.SetBalance(addr, big.NewInt(100))
+	root, err := st.Stage().Commit()
+	assert.Nil(t, err)
+
+	st, recorder, err := state.NewCreator(kv).NewWitnessedState(root)
+	assert.Nil(t, err)
+	st.GetBalance(addr)
+	witness := recorder.Witness()
+
+	account, err := VerifyAccountByWitness(root, addr, witness)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), account.Balance)
+
+	// not read, so not proved
+	_, err = VerifyAccountByWitness(root, thor.BytesToAddress([]byte{1}), witness)
+	assert.True(t, IsInvalid(err))
+}
+
+func TestVerifyTx(t *testing.T) {
+	var (
+		txs      tx.Transactions
+		receipts tx.Receipts
+	)
+	builder := new(block.Builder)
+	for i := 0; i < 10; i++ {
+		trx := new(tx.Builder).Nonce(uint64(i)).Build()
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+		trx = trx.WithSignature(sig)
+		txs = append(txs, trx)
+		builder.Transaction(trx)
+		receipts = append(receipts, &tx.Receipt{GasUsed: uint64(i), Paid: &big.Int{}, Reward: &big.Int{}})
+	}
+	header := builder.ReceiptsRoot(receipts.RootHash()).Build().Header()
+
+	txProof, _ := txs.Proof(3)
+	receiptProof, _ := receipts.Proof(3)
+	trx, receipt, err := VerifyTx(header, txs[3].ID(), 3, txProof, receiptProof)
+	assert.Nil(t, err)
+	assert.Equal(t, txs[3].ID(), trx.ID())
+	assert.Equal(t, uint64(3), receipt.GasUsed)
+
+	// proof of another index
+	_, _, err = VerifyTx(header, txs[3].ID(), 4, txProof, receiptProof)
+	assert.True(t, IsInvalid(err))
+
+	// tx not matched
+	_, _, err = VerifyTx(header, txs[4].ID(), 3, txProof, receiptProof)
+	assert.True(t, IsInvalid(err))
+
+	// receipt proof of another block
+	other := append(tx.Receipts(nil), receipts...)
+	other[3] = &tx.Receipt{GasUsed: 100, Paid: &big.Int{}, Reward: &big.Int{}}
+	otherProof, _ := other.Proof(3)
+	_, _, err = VerifyTx(header, txs[3].ID(), 3, txProof, otherProof)
+	assert.True(t, IsInvalid(err))
+}
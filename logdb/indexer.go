@@ -0,0 +1,186 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package logdb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/inconshreveable/log15"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+var log = log15.New("pkg", "logdb")
+
+// indexBatchSize max count of blocks indexed in a db transaction.
+const indexBatchSize = 256
+
+// Indexer writes logs of trunk blocks into log db in background, decoupled from committing blocks.
+//
+// Progress is saved together with logs in a db transaction, so that indexing resumes from where
+// it stopped after restart or crash. Logs of blocks no longer on trunk are rolled back on fork.
+type Indexer struct {
+	db    *LogDB
+	chain *chain.Chain
+}
+
+// NewIndexer creates an indexer.
+func NewIndexer(db *LogDB, chain *chain.Chain) *Indexer {
+	return &Indexer{db, chain}
+}
+
+// Run indexes new trunk blocks until ctx done.
+func (ix *Indexer) Run(ctx context.Context) {
+	log.Debug("enter indexer loop")
+	defer log.Debug("leave indexer loop")
+
+	for {
+		ticker := ix.chain.NewTicker()
+		more, err := ix.Step(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Warn("failed to index logs", "err", err)
+		}
+		if more && err == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+		case <-time.After(10 * time.Second):
+		}
+	}
+}
+
+// Step indexes a batch of trunk blocks following the indexed head.
+// It returns whether there are more blocks to be indexed.
+func (ix *Indexer) Step(ctx context.Context) (bool, error) {
+	headNum, headID, err := ix.loadHead()
+	if err != nil {
+		return false, err
+	}
+	best := ix.chain.BestBlock().Header()
+	seeker := ix.chain.NewSeeker(best.ID())
+
+	// roll back to the common ancestor, if indexed head no longer on trunk
+	rollback := false
+	if headNum > best.Number() || seeker.GetID(headNum) != headID {
+		rollback = true
+		for {
+			header, err := ix.chain.GetBlockHeader(headID)
+			if err != nil {
+				return false, err
+			}
+			if header.Number() <= best.Number() && seeker.GetID(header.Number()) == headID {
+				break
+			}
+			if err := seeker.Err(); err != nil {
+				return false, err
+			}
+			headNum, headID = header.Number()-1, header.ParentID()
+		}
+	}
+
+	var batches []*BlockBatch
+	for num := headNum + 1; num <= best.Number() && len(batches) < indexBatchSize; num++ {
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		default:
+		}
+		blk, err := ix.chain.GetBlock(seeker.GetID(num))
+		if err != nil {
+			return false, err
+		}
+		receipts, err := ix.chain.GetBlockReceipts(blk.Header().ID())
+		if err != nil {
+			return false, err
+		}
+		batches = append(batches, ix.db.newBlockBatch(blk, receipts))
+	}
+	if err := seeker.Err(); err != nil {
+		return false, err
+	}
+	if !rollback && len(batches) == 0 {
+		return false, nil
+	}
+	// logs above the common ancestor are rolled back
+	ancestorNum := headNum
+	if len(batches) > 0 {
+		last := batches[len(batches)-1].header
+		headNum, headID = last.Number(), last.ID()
+	}
+
+	if err := execInTx(ix.db.db, func(tx *sql.Tx) error {
+		if rollback {
+			for _, table := range []string{"event", "transfer", "activity"} {
+				if _, err := tx.Exec("DELETE FROM "+table+" WHERE blockNumber > ?;", ancestorNum); err != nil {
+					return err
+				}
+			}
+		}
+		for _, bb := range batches {
+			if err := bb.insert(tx); err != nil {
+				return err
+			}
+		}
+		if _, err := tx.Exec("INSERT OR REPLACE INTO meta(name, value) VALUES ('headNumber', ?), ('headID', ?);", headNum, headID.Bytes()); err != nil {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return false, err
+	}
+	return headNum < best.Number(), nil
+}
+
+// loadHead loads number and id of the indexed head.
+func (ix *Indexer) loadHead() (uint32, thor.Bytes32, error) {
+	var (
+		num sql.NullInt64
+		id  []byte
+	)
+	if err := ix.db.db.QueryRow("SELECT (SELECT value FROM meta WHERE name = 'headNumber'), (SELECT value FROM meta WHERE name = 'headID');").
+		Scan(&num, &id); err != nil {
+		return 0, thor.Bytes32{}, err
+	}
+	if num.Valid && len(id) > 0 {
+		return uint32(num.Int64), thor.BytesToBytes32(id), nil
+	}
+
+	// not saved yet, as db written before indexer introduced, so the head is derived from logs
+	var max uint32
+	if err := ix.db.db.QueryRow("SELECT MAX(IFNULL((SELECT MAX(blockNumber) FROM event), 0), IFNULL((SELECT MAX(blockNumber) FROM transfer), 0));").
+		Scan(&max); err != nil {
+		return 0, thor.Bytes32{}, err
+	}
+	headID, err := ix.chain.GetTrunkBlockID(max)
+	if err != nil {
+		return 0, thor.Bytes32{}, err
+	}
+	return max, headID, nil
+}
+
+// newBlockBatch prepares the batch with logs of the block.
+func (db *LogDB) newBlockBatch(blk *block.Block, receipts tx.Receipts) *BlockBatch {
+	batch := db.Prepare(blk.Header())
+	for i, trx := range blk.Transactions() {
+		origin, _ := trx.Signer()
+		txBatch := batch.ForTransaction(trx.ID(), origin)
+		for _, output := range receipts[i].Outputs {
+			txBatch.Insert(output.Events, output.Transfers)
+		}
+		batch.TrackActivities(uint32(i), trx, origin, receipts[i])
+	}
+	return batch
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package logdb_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/logdb"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestIndexer(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	b0, _, _ := genesis.NewDevnet().Build(state.NewCreator(kv))
+	ch, err := chain.New(kv, b0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := logdb.NewMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	acc := genesis.DevAccounts()[0]
+	to := thor.BytesToAddress([]byte("to"))
+	newBlock := func(parent *block.Block, score uint64, withTx bool) *block.Block {
+		builder := new(block.Builder).ParentID(parent.Header().ID()).TotalScore(parent.Header().TotalScore() + score)
+		var receipts tx.Receipts
+		if withTx {
+			trx := new(tx.Builder).ChainTag(ch.Tag()).Clause(tx.NewClause(&to)).Nonce(uint64(parent.Header().Number())).Build()
+			sig, _ := crypto.Sign(trx.SigningHash().Bytes(), acc.PrivateKey)
+			builder.Transaction(trx.WithSignature(sig))
+			receipts = tx.Receipts{&tx.Receipt{Outputs: []*tx.Output{{
+				Transfers: tx.Transfers{&tx.Transfer{Sender: acc.Address, Recipient: to, Amount: big.NewInt(1)}},
+			}}}}
+		}
+		b := builder.Build()
+		sig, _ := crypto.Sign(b.Header().SigningHash().Bytes(), acc.PrivateKey)
+		b = b.WithSignature(sig)
+		if _, err := ch.AddBlock(b, receipts); err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	countTransfers := func() int {
+		transfers, err := db.FilterTransfers(context.Background(), &logdb.TransferFilter{})
+		assert.Nil(t, err)
+		return len(transfers)
+	}
+
+	ix := logdb.NewIndexer(db, ch)
+	b1 := newBlock(b0, 1, true)
+	newBlock(b1, 1, true)
+	more, err := ix.Step(context.Background())
+	assert.Nil(t, err)
+	assert.False(t, more)
+	assert.Equal(t, 2, countTransfers())
+
+	// resumes from saved progress
+	b3 := newBlock(ch.BestBlock(), 1, true)
+	_, err = logdb.NewIndexer(db, ch).Step(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 3, countTransfers())
+
+	// rolls back logs of blocks no longer on trunk
+	b2x := newBlock(b1, 3, false)
+	_, err = ix.Step(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, b2x.Header().ID(), ch.BestBlock().Header().ID())
+	assert.Equal(t, 1, countTransfers())
+
+	transfers, _ := db.FilterTransfers(context.Background(), &logdb.TransferFilter{})
+	assert.NotEqual(t, b3.Header().ID(), transfers[0].BlockID)
+	assert.Equal(t, b1.Header().ID(), transfers[0].BlockID)
+}
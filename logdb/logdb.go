@@ -8,8 +8,10 @@ package logdb
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"math/big"
+	"sync/atomic"
 
 	sqlite3 "github.com/mattn/go-sqlite3"
 	"github.com/vechain/thor/block"
@@ -17,10 +19,33 @@ import (
 	"github.com/vechain/thor/tx"
 )
 
+// ErrActivityIndexDisabled returned when querying activities with activity index disabled.
+var ErrActivityIndexDisabled = errors.New("activity index disabled")
+
+// pruneBatchSize max count of rows deleted in a statement when pruning, to not hold the db for long.
+const pruneBatchSize = 10000
+
+type prunedError struct {
+	prunedBelow uint32
+}
+
+func (e *prunedError) Error() string {
+	return fmt.Sprintf("range: logs before block %v pruned", e.prunedBelow)
+}
+
+// IsPruned returns whether the error is caused by querying logs pruned.
+func IsPruned(err error) bool {
+	_, ok := err.(*prunedError)
+	return ok
+}
+
 type LogDB struct {
 	path          string
 	db            *sql.DB
 	driverVersion string
+	activityIndex bool
+	retention     uint32
+	prunedBelow   uint32 // accessed atomically
 }
 
 // New create or open log db at given path.
@@ -38,7 +63,12 @@ func New(path string) (logDB *LogDB, err error) {
 	// to avoid 'database is locked' error
 	db.SetMaxOpenConns(1)
 
-	if _, err := db.Exec(eventTableSchema + transferTableSchema); err != nil {
+	if _, err := db.Exec(eventTableSchema + transferTableSchema + activityTableSchema + metaTableSchema); err != nil {
+		return nil, err
+	}
+
+	var prunedBelow uint32
+	if err := db.QueryRow("SELECT value FROM meta WHERE name = 'prunedBelow'").Scan(&prunedBelow); err != nil && err != sql.ErrNoRows {
 		return nil, err
 	}
 
@@ -47,6 +77,9 @@ func New(path string) (logDB *LogDB, err error) {
 		path,
 		db,
 		driverVer,
+		false,
+		0,
+		prunedBelow,
 	}, nil
 }
 
@@ -64,10 +97,81 @@ func (db *LogDB) Path() string {
 	return db.path
 }
 
+// SetActivityIndex sets whether to index activities of addresses, i.e. txs touching them.
+// Activities of blocks committed while disabled are not indexed.
+func (db *LogDB) SetActivityIndex(enabled bool) {
+	db.activityIndex = enabled
+}
+
+// SetRetention sets count of recent blocks to keep logs of, 0 to keep all.
+// Older logs are removed by Prune.
+func (db *LogDB) SetRetention(blocks uint32) {
+	db.retention = blocks
+}
+
+// Prune removes logs older than retention, relative to the best block number.
+// Logs are deleted in batches, so that it can run in background with block commits.
+func (db *LogDB) Prune(ctx context.Context, bestNum uint32) error {
+	if db.retention == 0 || bestNum < db.retention {
+		return nil
+	}
+	below := bestNum - db.retention + 1
+	if below <= atomic.LoadUint32(&db.prunedBelow) {
+		return nil
+	}
+	for _, table := range []string{"event", "transfer", "activity"} {
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+			res, err := db.db.ExecContext(ctx,
+				"DELETE FROM "+table+" WHERE rowid IN (SELECT rowid FROM "+table+" WHERE blockNumber < ? LIMIT ?);",
+				below, pruneBatchSize)
+			if err != nil {
+				return err
+			}
+			n, err := res.RowsAffected()
+			if err != nil {
+				return err
+			}
+			if n < pruneBatchSize {
+				break
+			}
+		}
+	}
+	if _, err := db.db.ExecContext(ctx, "INSERT OR REPLACE INTO meta(name, value) VALUES ('prunedBelow', ?);", below); err != nil {
+		return err
+	}
+	atomic.StoreUint32(&db.prunedBelow, below)
+	return nil
+}
+
+// afterCondition returns the condition of logs after the cursor in the order, which takes
+// block number twice and the log index as args.
+func afterCondition(indexColumn string, order Order) string {
+	cmp := ">"
+	if order == DESC {
+		cmp = "<"
+	}
+	return " AND (blockNumber " + cmp + " ? OR (blockNumber = ? AND " + indexColumn + " " + cmp + " ?)) "
+}
+
+// checkRange returns error if the block range covers pruned logs.
+func (db *LogDB) checkRange(r *Range) error {
+	prunedBelow := atomic.LoadUint32(&db.prunedBelow)
+	if prunedBelow > 0 && r != nil && r.Unit != Time && r.From < uint64(prunedBelow) {
+		return &prunedError{prunedBelow}
+	}
+	return nil
+}
+
 func (db *LogDB) Prepare(header *block.Header) *BlockBatch {
 	return &BlockBatch{
-		db:     db.db,
-		header: header,
+		db:            db.db,
+		header:        header,
+		activityIndex: db.activityIndex,
 	}
 }
 
@@ -75,6 +179,9 @@ func (db *LogDB) FilterEvents(ctx context.Context, filter *EventFilter) ([]*Even
 	if filter == nil {
 		return db.queryEvents(ctx, "SELECT * FROM event")
 	}
+	if err := db.checkRange(filter.Range); err != nil {
+		return nil, err
+	}
 	var args []interface{}
 	stmt := "SELECT * FROM event WHERE 1"
 	condition := "blockNumber"
@@ -89,9 +196,14 @@ func (db *LogDB) FilterEvents(ctx context.Context, filter *EventFilter) ([]*Even
 			stmt += " AND " + condition + " <= ? "
 		}
 	}
+	if filter.After != nil {
+		stmt += afterCondition("eventIndex", filter.Order)
+		args = append(args, filter.After.BlockNumber, filter.After.BlockNumber, filter.After.Index)
+	}
+	length := len(filter.CriteriaSet)
 	for i, criteria := range filter.CriteriaSet {
 		if i == 0 {
-			stmt += " AND ( 1"
+			stmt += " AND (( 1"
 		} else {
 			stmt += " OR ( 1"
 		}
@@ -105,7 +217,11 @@ func (db *LogDB) FilterEvents(ctx context.Context, filter *EventFilter) ([]*Even
 				stmt += fmt.Sprintf(" AND topic%v = ?", j)
 			}
 		}
-		stmt += ")"
+		if i == length-1 {
+			stmt += "))"
+		} else {
+			stmt += ")"
+		}
 	}
 
 	if filter.Order == DESC {
@@ -125,6 +241,9 @@ func (db *LogDB) FilterTransfers(ctx context.Context, filter *TransferFilter) ([
 	if filter == nil {
 		return db.queryTransfers(ctx, "SELECT * FROM transfer")
 	}
+	if err := db.checkRange(filter.Range); err != nil {
+		return nil, err
+	}
 	var args []interface{}
 	stmt := "SELECT * FROM transfer WHERE 1"
 	condition := "blockNumber"
@@ -143,6 +262,10 @@ func (db *LogDB) FilterTransfers(ctx context.Context, filter *TransferFilter) ([
 		args = append(args, filter.TxID.Bytes())
 		stmt += " AND txID = ? "
 	}
+	if filter.After != nil {
+		stmt += afterCondition("transferIndex", filter.Order)
+		args = append(args, filter.After.BlockNumber, filter.After.BlockNumber, filter.After.Index)
+	}
 	length := len(filter.CriteriaSet)
 	if length > 0 {
 		for i, criteria := range filter.CriteriaSet {
@@ -182,6 +305,40 @@ func (db *LogDB) FilterTransfers(ctx context.Context, filter *TransferFilter) ([
 	return db.queryTransfers(ctx, stmt, args...)
 }
 
+// FilterActivities returns activities of the address, i.e. txs touching it, in order of block number and tx index.
+func (db *LogDB) FilterActivities(ctx context.Context, filter *ActivityFilter) ([]*Activity, error) {
+	if !db.activityIndex {
+		return nil, ErrActivityIndexDisabled
+	}
+	if err := db.checkRange(filter.Range); err != nil {
+		return nil, err
+	}
+	args := []interface{}{filter.Address.Bytes()}
+	stmt := "SELECT * FROM activity WHERE address = ? "
+	if filter.Range != nil {
+		condition := "blockNumber"
+		if filter.Range.Unit == Time {
+			condition = "blockTime"
+		}
+		args = append(args, filter.Range.From)
+		stmt += " AND " + condition + " >= ? "
+		if filter.Range.To >= filter.Range.From {
+			args = append(args, filter.Range.To)
+			stmt += " AND " + condition + " <= ? "
+		}
+	}
+	if filter.Order == DESC {
+		stmt += " ORDER BY blockNumber DESC,txIndex DESC "
+	} else {
+		stmt += " ORDER BY blockNumber ASC,txIndex ASC "
+	}
+	if filter.Options != nil {
+		stmt += " limit ?, ? "
+		args = append(args, filter.Options.Offset, filter.Options.Limit)
+	}
+	return db.queryActivities(ctx, stmt, args...)
+}
+
 func (db *LogDB) queryEvents(ctx context.Context, stmt string, args ...interface{}) ([]*Event, error) {
 	rows, err := db.db.QueryContext(ctx, stmt, args...)
 	if err != nil {
@@ -304,6 +461,52 @@ func (db *LogDB) queryTransfers(ctx context.Context, stmt string, args ...interf
 	return transfers, nil
 }
 
+func (db *LogDB) queryActivities(ctx context.Context, stmt string, args ...interface{}) ([]*Activity, error) {
+	rows, err := db.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var activities []*Activity
+	for rows.Next() {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+		var (
+			address     []byte
+			blockID     []byte
+			blockNumber uint32
+			blockTime   uint64
+			txIndex     uint32
+			txID        []byte
+		)
+		if err := rows.Scan(
+			&address,
+			&blockID,
+			&blockNumber,
+			&blockTime,
+			&txIndex,
+			&txID,
+		); err != nil {
+			return nil, err
+		}
+		activities = append(activities, &Activity{
+			Address:     thor.BytesToAddress(address),
+			BlockID:     thor.BytesToBytes32(blockID),
+			BlockNumber: blockNumber,
+			BlockTime:   blockTime,
+			TxIndex:     txIndex,
+			TxID:        thor.BytesToBytes32(txID),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return activities, nil
+}
+
 func topicValue(topic *thor.Bytes32) []byte {
 	if topic == nil {
 		return nil
@@ -312,14 +515,16 @@ func topicValue(topic *thor.Bytes32) []byte {
 }
 
 type BlockBatch struct {
-	db        *sql.DB
-	header    *block.Header
-	events    []*Event
-	transfers []*Transfer
+	db            *sql.DB
+	header        *block.Header
+	events        []*Event
+	transfers     []*Transfer
+	activityIndex bool
+	activities    []*Activity
 }
 
-func (bb *BlockBatch) execInTx(proc func(*sql.Tx) error) (err error) {
-	tx, err := bb.db.Begin()
+func execInTx(db *sql.DB, proc func(*sql.Tx) error) (err error) {
+	tx, err := db.Begin()
 	if err != nil {
 		return err
 	}
@@ -331,41 +536,9 @@ func (bb *BlockBatch) execInTx(proc func(*sql.Tx) error) (err error) {
 }
 
 func (bb *BlockBatch) Commit(abandonedBlocks ...thor.Bytes32) error {
-	return bb.execInTx(func(tx *sql.Tx) error {
-		for _, event := range bb.events {
-			if _, err := tx.Exec("INSERT OR REPLACE INTO event(blockID ,eventIndex, blockNumber ,blockTime ,txID ,txOrigin ,address ,topic0 ,topic1 ,topic2 ,topic3 ,topic4, data) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);",
-				event.BlockID.Bytes(),
-				event.Index,
-				event.BlockNumber,
-				event.BlockTime,
-				event.TxID.Bytes(),
-				event.TxOrigin.Bytes(),
-				event.Address.Bytes(),
-				topicValue(event.Topics[0]),
-				topicValue(event.Topics[1]),
-				topicValue(event.Topics[2]),
-				topicValue(event.Topics[3]),
-				topicValue(event.Topics[4]),
-				event.Data,
-			); err != nil {
-				return err
-			}
-		}
-
-		for _, transfer := range bb.transfers {
-			if _, err := tx.Exec("INSERT OR REPLACE INTO transfer(blockID ,transferIndex, blockNumber ,blockTime ,txID ,txOrigin ,sender ,recipient ,amount) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?);",
-				transfer.BlockID.Bytes(),
-				transfer.Index,
-				transfer.BlockNumber,
-				transfer.BlockTime,
-				transfer.TxID.Bytes(),
-				transfer.TxOrigin.Bytes(),
-				transfer.Sender.Bytes(),
-				transfer.Recipient.Bytes(),
-				transfer.Amount.Bytes(),
-			); err != nil {
-				return err
-			}
+	return execInTx(bb.db, func(tx *sql.Tx) error {
+		if err := bb.insert(tx); err != nil {
+			return err
 		}
 		for _, id := range abandonedBlocks {
 			if _, err := tx.Exec("DELETE FROM event WHERE blockID = ?;", id.Bytes()); err != nil {
@@ -374,11 +547,66 @@ func (bb *BlockBatch) Commit(abandonedBlocks ...thor.Bytes32) error {
 			if _, err := tx.Exec("DELETE FROM transfer WHERE blockID = ?;", id.Bytes()); err != nil {
 				return err
 			}
+			if _, err := tx.Exec("DELETE FROM activity WHERE blockID = ?;", id.Bytes()); err != nil {
+				return err
+			}
 		}
 		return nil
 	})
 }
 
+// insert writes logs in the batch.
+func (bb *BlockBatch) insert(tx *sql.Tx) error {
+	for _, event := range bb.events {
+		if _, err := tx.Exec("INSERT OR REPLACE INTO event(blockID ,eventIndex, blockNumber ,blockTime ,txID ,txOrigin ,address ,topic0 ,topic1 ,topic2 ,topic3 ,topic4, data) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);",
+			event.BlockID.Bytes(),
+			event.Index,
+			event.BlockNumber,
+			event.BlockTime,
+			event.TxID.Bytes(),
+			event.TxOrigin.Bytes(),
+			event.Address.Bytes(),
+			topicValue(event.Topics[0]),
+			topicValue(event.Topics[1]),
+			topicValue(event.Topics[2]),
+			topicValue(event.Topics[3]),
+			topicValue(event.Topics[4]),
+			event.Data,
+		); err != nil {
+			return err
+		}
+	}
+
+	for _, transfer := range bb.transfers {
+		if _, err := tx.Exec("INSERT OR REPLACE INTO transfer(blockID ,transferIndex, blockNumber ,blockTime ,txID ,txOrigin ,sender ,recipient ,amount) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?);",
+			transfer.BlockID.Bytes(),
+			transfer.Index,
+			transfer.BlockNumber,
+			transfer.BlockTime,
+			transfer.TxID.Bytes(),
+			transfer.TxOrigin.Bytes(),
+			transfer.Sender.Bytes(),
+			transfer.Recipient.Bytes(),
+			transfer.Amount.Bytes(),
+		); err != nil {
+			return err
+		}
+	}
+	for _, activity := range bb.activities {
+		if _, err := tx.Exec("INSERT OR REPLACE INTO activity(address ,blockID ,blockNumber ,blockTime ,txIndex ,txID) VALUES ( ?, ?, ?, ?, ?, ?);",
+			activity.Address.Bytes(),
+			activity.BlockID.Bytes(),
+			activity.BlockNumber,
+			activity.BlockTime,
+			activity.TxIndex,
+			activity.TxID.Bytes(),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (bb *BlockBatch) ForTransaction(txID thor.Bytes32, txOrigin thor.Address) struct {
 	Insert func(tx.Events, tx.Transfers) *BlockBatch
 } {
@@ -396,3 +624,46 @@ func (bb *BlockBatch) ForTransaction(txID thor.Bytes32, txOrigin thor.Address) s
 		},
 	}
 }
+
+// TrackActivities records the tx at txIndex of the block as activity of addresses it touches, which are
+// the origin, clause recipients, event emitters and transfer participants.
+// The origin is passed in, as it's already recovered by the caller.
+// It's no-op if activity index disabled.
+func (bb *BlockBatch) TrackActivities(txIndex uint32, trx *tx.Transaction, origin thor.Address, receipt *tx.Receipt) *BlockBatch {
+	if !bb.activityIndex {
+		return bb
+	}
+	txID := trx.ID()
+	seen := make(map[thor.Address]bool)
+	track := func(addr thor.Address) {
+		if seen[addr] {
+			return
+		}
+		seen[addr] = true
+		bb.activities = append(bb.activities, &Activity{
+			Address:     addr,
+			BlockID:     bb.header.ID(),
+			BlockNumber: bb.header.Number(),
+			BlockTime:   bb.header.Timestamp(),
+			TxIndex:     txIndex,
+			TxID:        txID,
+		})
+	}
+
+	track(origin)
+	for _, clause := range trx.Clauses() {
+		if to := clause.To(); to != nil {
+			track(*to)
+		}
+	}
+	for _, output := range receipt.Outputs {
+		for _, event := range output.Events {
+			track(event.Address)
+		}
+		for _, transfer := range output.Transfers {
+			track(transfer.Sender)
+			track(transfer.Recipient)
+		}
+	}
+	return bb
+}
@@ -12,8 +12,10 @@ import (
 	"os/user"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/genesis"
 	"github.com/vechain/thor/logdb"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
@@ -132,6 +134,132 @@ func TestTransfers(t *testing.T) {
 		t.Fatal(err)
 	}
 	assert.Equal(t, len(ts), count, "transfers searched")
+
+	// paged by keyset
+	tf.After = &logdb.Cursor{BlockNumber: ts[9].BlockNumber, Index: ts[9].Index}
+	tf.Options = &logdb.Options{Offset: 0, Limit: 10}
+	paged, err := db.FilterTransfers(context.Background(), tf)
+	assert.Nil(t, err)
+	assert.Equal(t, ts[10:20], paged)
+
+	tf.Order = logdb.ASC
+	paged, err = db.FilterTransfers(context.Background(), tf)
+	assert.Nil(t, err)
+	assert.Equal(t, 9, len(paged))
+	assert.Equal(t, ts[8].BlockNumber, paged[0].BlockNumber)
+}
+
+func TestActivities(t *testing.T) {
+	db, err := logdb.NewMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	acc := genesis.DevAccounts()[0]
+	to := thor.BytesToAddress([]byte("to"))
+	contract := thor.BytesToAddress([]byte("contract"))
+	receiver := thor.BytesToAddress([]byte("receiver"))
+
+	trx := new(tx.Builder).Clause(tx.NewClause(&to)).Clause(tx.NewClause(&contract)).Build()
+	sig, _ := crypto.Sign(trx.SigningHash().Bytes(), acc.PrivateKey)
+	trx = trx.WithSignature(sig)
+	receipt := &tx.Receipt{Outputs: []*tx.Output{
+		{},
+		{
+			Events:    tx.Events{&tx.Event{Address: contract}},
+			Transfers: tx.Transfers{&tx.Transfer{Sender: contract, Recipient: receiver, Amount: big.NewInt(1)}},
+		},
+	}}
+
+	header := new(block.Builder).Build().Header()
+	// not indexed while disabled
+	assert.Nil(t, db.Prepare(header).TrackActivities(0, trx, acc.Address, receipt).Commit())
+	_, err = db.FilterActivities(context.Background(), &logdb.ActivityFilter{Address: to})
+	assert.Equal(t, logdb.ErrActivityIndexDisabled, err)
+
+	db.SetActivityIndex(true)
+	var headers []*block.Header
+	for i := 0; i < 5; i++ {
+		header = new(block.Builder).ParentID(header.ID()).Build().Header()
+		headers = append(headers, header)
+		assert.Nil(t, db.Prepare(header).TrackActivities(0, trx, acc.Address, receipt).Commit())
+	}
+
+	for _, addr := range []thor.Address{acc.Address, to, contract, receiver} {
+		activities, err := db.FilterActivities(context.Background(), &logdb.ActivityFilter{Address: addr})
+		assert.Nil(t, err)
+		assert.Equal(t, len(headers), len(activities), "one activity per tx")
+	}
+	activities, err := db.FilterActivities(context.Background(), &logdb.ActivityFilter{Address: thor.BytesToAddress([]byte("other"))})
+	assert.Nil(t, err)
+	assert.Empty(t, activities)
+
+	activities, err = db.FilterActivities(context.Background(), &logdb.ActivityFilter{
+		Address: receiver,
+		Options: &logdb.Options{Offset: 1, Limit: 2},
+		Order:   logdb.DESC,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(activities))
+	assert.Equal(t, headers[3].ID(), activities[0].BlockID)
+	assert.Equal(t, headers[2].ID(), activities[1].BlockID)
+	assert.Equal(t, trx.ID(), activities[0].TxID)
+
+	// activities of abandoned blocks removed
+	header = new(block.Builder).ParentID(headers[3].ID()).Timestamp(1).Build().Header()
+	assert.Nil(t, db.Prepare(header).Commit(headers[4].ID()))
+	activities, err = db.FilterActivities(context.Background(), &logdb.ActivityFilter{Address: to})
+	assert.Nil(t, err)
+	assert.Equal(t, len(headers)-1, len(activities))
+}
+
+func TestPrune(t *testing.T) {
+	db, err := logdb.NewMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	from := thor.BytesToAddress([]byte("from"))
+	// blocks 1 to 10
+	var parentID thor.Bytes32
+	for i := 0; i < 10; i++ {
+		header := new(block.Builder).ParentID(parentID).Build().Header()
+		parentID = header.ID()
+		if err := db.Prepare(header).ForTransaction(thor.Bytes32{}, from).
+			Insert(nil, tx.Transfers{&tx.Transfer{Sender: from, Recipient: from, Amount: big.NewInt(1)}}).
+			Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// no-op without retention
+	assert.Nil(t, db.Prune(context.Background(), 10))
+	ts, err := db.FilterTransfers(context.Background(), &logdb.TransferFilter{})
+	assert.Nil(t, err)
+	assert.Equal(t, 10, len(ts))
+
+	db.SetRetention(4)
+	assert.Nil(t, db.Prune(context.Background(), 10))
+	ts, err = db.FilterTransfers(context.Background(), &logdb.TransferFilter{})
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(ts))
+	assert.Equal(t, uint32(7), ts[0].BlockNumber)
+
+	_, err = db.FilterTransfers(context.Background(), &logdb.TransferFilter{Range: &logdb.Range{Unit: logdb.Block, From: 6, To: 10}})
+	assert.True(t, logdb.IsPruned(err))
+	_, err = db.FilterEvents(context.Background(), &logdb.EventFilter{Range: &logdb.Range{Unit: logdb.Block, From: 0, To: 10}})
+	assert.True(t, logdb.IsPruned(err))
+	ts, err = db.FilterTransfers(context.Background(), &logdb.TransferFilter{Range: &logdb.Range{Unit: logdb.Block, From: 7, To: 10}})
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(ts))
+
+	db.SetActivityIndex(true)
+	_, err = db.FilterActivities(context.Background(), &logdb.ActivityFilter{Address: from, Range: &logdb.Range{Unit: logdb.Block, From: 6, To: 10}})
+	assert.True(t, logdb.IsPruned(err))
+	_, err = db.FilterActivities(context.Background(), &logdb.ActivityFilter{Address: from, Range: &logdb.Range{Unit: logdb.Block, From: 7, To: 10}})
+	assert.Nil(t, err)
 }
 
 func home() (string, error) {
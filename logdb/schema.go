@@ -53,4 +53,25 @@ CREATE INDEX IF NOT EXISTS blockNumberIndex ON transfer(blockNumber);
 CREATE INDEX IF NOT EXISTS blockTimeIndex ON transfer(blockTime);
 CREATE INDEX IF NOT EXISTS senderIndex ON transfer(sender);
 CREATE INDEX IF NOT EXISTS recipientIndex ON transfer(recipient);`
+
+	// create a table for activities, the txs touching addresses
+	activityTableSchema = `CREATE TABLE IF NOT EXISTS activity (
+	address BLOB(20),
+	blockID	BLOB(32),
+	blockNumber INTEGER,
+	blockTime INTEGER,
+	txIndex INTEGER,
+	txID BLOB(32)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS activityPrim ON activity(blockID, txIndex, address);
+
+CREATE INDEX IF NOT EXISTS activityAddressIndex ON activity(address, blockNumber, txIndex);
+CREATE INDEX IF NOT EXISTS activityBlockNumberIndex ON activity(blockNumber);`
+
+	// create a table for meta info, e.g. progress of pruning
+	metaTableSchema = `CREATE TABLE IF NOT EXISTS meta (
+	name TEXT PRIMARY KEY,
+	value INTEGER
+);`
 )
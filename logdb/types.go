@@ -72,6 +72,17 @@ func newTransfer(header *block.Header, index uint32, txID thor.Bytes32, txOrigin
 	}
 }
 
+//Activity represents a tx touching an address, as the origin, a clause recipient,
+//an event emitter or a transfer participant.
+type Activity struct {
+	Address     thor.Address
+	BlockID     thor.Bytes32
+	BlockNumber uint32
+	BlockTime   uint64
+	TxIndex     uint32
+	TxID        thor.Bytes32
+}
+
 type RangeType string
 
 const (
@@ -97,6 +108,12 @@ type Options struct {
 	Limit  uint64
 }
 
+// Cursor the position of a log, i.e. block number and index of the log in the block.
+type Cursor struct {
+	BlockNumber uint32
+	Index       uint32
+}
+
 type EventCriteria struct {
 	Address *thor.Address // always a contract address
 	Topics  [5]*thor.Bytes32
@@ -107,7 +124,8 @@ type EventFilter struct {
 	CriteriaSet []*EventCriteria
 	Range       *Range
 	Options     *Options
-	Order       Order //default asc
+	Order       Order   //default asc
+	After       *Cursor `json:"-"` // only logs after it in the order, to page by keyset
 }
 
 type TransferCriteria struct {
@@ -121,5 +139,13 @@ type TransferFilter struct {
 	CriteriaSet []*TransferCriteria
 	Range       *Range
 	Options     *Options
-	Order       Order //default asc
+	Order       Order   //default asc
+	After       *Cursor `json:"-"` // only logs after it in the order, to page by keyset
+}
+
+type ActivityFilter struct {
+	Address thor.Address
+	Range   *Range
+	Options *Options
+	Order   Order //default asc
 }
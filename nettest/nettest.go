@@ -0,0 +1,389 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package nettest provides an in-process network of thor nodes for integration tests.
+// Nodes use dev accounts as authorities, and are connected by in-memory message pipes
+// instead of real p2p connections.
+package nettest
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/discover"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/co"
+	"github.com/vechain/thor/comm"
+	"github.com/vechain/thor/consensus"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/packer"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/txpool"
+)
+
+// Node an in-process node.
+type Node struct {
+	Key     *ecdsa.PrivateKey
+	Address thor.Address
+	Chain   *chain.Chain
+	TxPool  *txpool.TxPool
+	Comm    *comm.Communicator
+
+	db         *lvldb.LevelDB
+	packer     *packer.Packer
+	cons       *consensus.Consensus
+	commitLock sync.Mutex
+	ctx        context.Context
+	cancel     context.CancelFunc
+	goes       co.Goes
+}
+
+// Network a set of in-process nodes.
+type Network struct {
+	Nodes []*Node
+
+	lock  sync.Mutex
+	pipes []*p2p.MsgPipeRW
+	goes  co.Goes
+}
+
+// New create a network of n nodes, each of which is an authority.
+// At most len(genesis.DevAccounts()) nodes can be created.
+func New(n int) (*Network, error) {
+	accs := genesis.DevAccounts()
+	if n < 1 || n > len(accs) {
+		return nil, fmt.Errorf("node count should be in [1, %v]", len(accs))
+	}
+
+	gene, err := newGenesis(accs[:n])
+	if err != nil {
+		return nil, err
+	}
+
+	net := &Network{}
+	for _, acc := range accs[:n] {
+		node, err := newNode(gene, acc)
+		if err != nil {
+			net.Close()
+			return nil, err
+		}
+		net.Nodes = append(net.Nodes, node)
+	}
+	return net, nil
+}
+
+func newGenesis(authorities []genesis.DevAccount) (*genesis.Genesis, error) {
+	bal, _ := new(big.Int).SetString("1000000000000000000000000000", 10)
+
+	gen := &genesis.CustomGenesis{
+		// launched a round of proposers ago, so that the first blocks are not proposals in the future
+		LaunchTime: uint64(time.Now().Unix()) - thor.BlockInterval*uint64(len(authorities)),
+		GasLimit:   thor.InitialGasLimit,
+		Params: genesis.Params{
+			RewardRatio:         big.NewInt(3e17),
+			BaseGasPrice:        big.NewInt(1e15),
+			ProposerEndorsement: big.NewInt(1),
+		},
+	}
+	for _, acc := range genesis.DevAccounts() {
+		gen.Accounts = append(gen.Accounts, genesis.Account{
+			Address: acc.Address,
+			Balance: bal,
+			Energy:  bal,
+		})
+	}
+	for _, acc := range authorities {
+		gen.Authority = append(gen.Authority, genesis.Authority{
+			MasterAddress:   acc.Address,
+			EndorsorAddress: acc.Address,
+			Identity:        thor.BytesToBytes32(acc.Address.Bytes()),
+		})
+	}
+	return genesis.NewCustomNet(gen)
+}
+
+func newNode(gene *genesis.Genesis, acc genesis.DevAccount) (*Node, error) {
+	db, err := lvldb.NewMem()
+	if err != nil {
+		return nil, err
+	}
+	stateCreator := state.NewCreator(db)
+	genesisBlock, _, err := gene.Build(stateCreator)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	chain, err := chain.New(db, genesisBlock)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	txPool := txpool.New(chain, stateCreator, txpool.Options{
+		Limit:           10000,
+		LimitPerAccount: 64,
+		MaxLifetime:     10 * time.Minute,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	node := &Node{
+		Key:     acc.PrivateKey,
+		Address: acc.Address,
+		Chain:   chain,
+		TxPool:  txPool,
+		Comm:    comm.New(chain, stateCreator, txPool),
+		db:      db,
+		packer:  packer.New(chain, stateCreator, acc.Address, &acc.Address),
+		cons:    consensus.New(chain, stateCreator),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+	node.Comm.SetEndorser(func(header *block.Header) (*block.Endorsement, error) {
+		endorsement, err := node.packer.Endorse(header, node.Key)
+		if packer.IsNotCommitteeMember(err) {
+			return nil, nil
+		}
+		return endorsement, err
+	})
+	node.Comm.Start()
+	node.goes.Go(node.blockLoop)
+	return node, nil
+}
+
+func (n *Node) close() {
+	n.cancel()
+	n.goes.Wait()
+	n.Comm.Stop()
+	n.TxPool.Close()
+	n.db.Close()
+}
+
+// blockLoop imports blocks received from peers.
+func (n *Node) blockLoop() {
+	var scope event.SubscriptionScope
+	defer scope.Close()
+
+	newBlockCh := make(chan *comm.NewBlockEvent)
+	scope.Track(n.Comm.SubscribeBlock(newBlockCh))
+
+	for {
+		select {
+		case <-n.ctx.Done():
+			return
+		case ev := <-newBlockCh:
+			if isTrunk, err := n.importBlock(ev.Block); err == nil && isTrunk {
+				n.Comm.BroadcastBlock(ev.Block)
+			}
+		}
+	}
+}
+
+// importBlock verifies and commits a block. The block time is taken as current time,
+// so that nodes are not bound to wall clock.
+func (n *Node) importBlock(blk *block.Block) (bool, error) {
+	n.commitLock.Lock()
+	defer n.commitLock.Unlock()
+
+	stage, receipts, err := n.cons.Process(blk, blk.Header().Timestamp())
+	if err != nil {
+		return false, err
+	}
+	if _, err := stage.Commit(); err != nil {
+		return false, err
+	}
+	return n.commitBlock(blk, receipts)
+}
+
+func (n *Node) commitBlock(blk *block.Block, receipts tx.Receipts) (bool, error) {
+	fork, err := n.Chain.AddBlock(blk, receipts)
+	if err != nil {
+		return false, err
+	}
+	// txs in branch are returned to tx pool, as what a real node does
+	for _, header := range fork.Branch {
+		body, err := n.Chain.GetBlockBody(header.ID())
+		if err != nil {
+			return false, err
+		}
+		for _, tx := range body.Txs {
+			n.TxPool.Add(tx)
+		}
+	}
+	return len(fork.Trunk) > 0, nil
+}
+
+// Pack packs a block upon the best block at the node's next turn, with executable txs in
+// its tx pool, and endorsements of connected peers. The new block is broadcast if it becomes the best.
+func (n *Node) Pack() (*block.Block, error) {
+	n.commitLock.Lock()
+	defer n.commitLock.Unlock()
+
+	best := n.Chain.BestBlock().Header()
+	flow, err := n.packer.Schedule(best, best.Timestamp()+thor.BlockInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range n.TxPool.Executables() {
+		if err := flow.Adopt(tx); err != nil {
+			if packer.IsGasLimitReached(err) {
+				break
+			}
+			if !packer.IsTxNotAdoptableNow(err) {
+				n.TxPool.Remove(tx.ID())
+			}
+		}
+	}
+
+	if flow.Endorsable() {
+		proposal, err := flow.Propose(n.Key)
+		if err != nil {
+			return nil, err
+		}
+		ctx, cancel := context.WithTimeout(n.ctx, time.Second)
+		for _, e := range n.Comm.RequestEndorsements(ctx, proposal) {
+			flow.AddEndorsement(e)
+		}
+		cancel()
+	}
+
+	blk, stage, receipts, err := flow.Pack(n.Key)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := stage.Commit(); err != nil {
+		return nil, err
+	}
+	isTrunk, err := n.commitBlock(blk, receipts)
+	if err != nil {
+		return nil, err
+	}
+	if isTrunk {
+		n.Comm.BroadcastBlock(blk)
+	}
+	return blk, nil
+}
+
+// Connect connects two nodes with an in-memory message pipe.
+func (net *Network) Connect(a, b *Node) {
+	rwA, rwB := p2p.MsgPipe()
+
+	net.lock.Lock()
+	net.pipes = append(net.pipes, rwA, rwB)
+	net.lock.Unlock()
+
+	serve := func(local, remote *Node, rw p2p.MsgReadWriter) {
+		protocol := local.Comm.Protocols()[0]
+		peer := p2p.NewPeer(
+			discover.PubkeyID(&remote.Key.PublicKey),
+			remote.Address.String(),
+			[]p2p.Cap{{Name: protocol.Name, Version: protocol.Version}})
+		net.goes.Go(func() {
+			protocol.Run(peer, rw)
+		})
+	}
+	serve(a, b, &pipeRW{rwA})
+	serve(b, a, &pipeRW{rwB})
+}
+
+// pipeRW reads payloads of piped messages entirely, since the rpc layer relies on byte-reader
+// payloads, as what RLPx delivers.
+type pipeRW struct {
+	p2p.MsgReadWriter
+}
+
+func (rw *pipeRW) ReadMsg() (p2p.Msg, error) {
+	msg, err := rw.MsgReadWriter.ReadMsg()
+	if err != nil {
+		return msg, err
+	}
+	data, err := ioutil.ReadAll(msg.Payload)
+	if err != nil {
+		return msg, err
+	}
+	msg.Payload = bytes.NewReader(data)
+	return msg, nil
+}
+
+// ConnectAll connects every pair of nodes.
+func (net *Network) ConnectAll() {
+	for i, a := range net.Nodes {
+		for _, b := range net.Nodes[i+1:] {
+			net.Connect(a, b)
+		}
+	}
+}
+
+// Disconnect closes all connections.
+func (net *Network) Disconnect() {
+	net.lock.Lock()
+	pipes := net.pipes
+	net.pipes = nil
+	net.lock.Unlock()
+
+	for _, pipe := range pipes {
+		pipe.Close()
+	}
+	net.goes.Wait()
+}
+
+// Close disconnects and stops all nodes.
+func (net *Network) Close() {
+	net.Disconnect()
+	for _, node := range net.Nodes {
+		node.close()
+	}
+}
+
+// ErrTimeout returned by Wait when condition not satisfied in time.
+var ErrTimeout = errors.New("timeout")
+
+// Wait polls the condition until it's satisfied or timeout.
+func Wait(timeout time.Duration, cond func() bool) error {
+	deadline := time.Now().Add(timeout)
+	for !cond() {
+		if time.Now().After(deadline) {
+			return ErrTimeout
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return nil
+}
+
+// WaitBestBlock waits until all nodes have the given best block.
+func (net *Network) WaitBestBlock(id thor.Bytes32, timeout time.Duration) error {
+	return Wait(timeout, func() bool {
+		for _, node := range net.Nodes {
+			if node.Chain.BestBlock().Header().ID() != id {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// WaitPeers waits until each node has at least n peers.
+func (net *Network) WaitPeers(n int, timeout time.Duration) error {
+	return Wait(timeout, func() bool {
+		for _, node := range net.Nodes {
+			if node.Comm.PeerCount() < n {
+				return false
+			}
+		}
+		return true
+	})
+}
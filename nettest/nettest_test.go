@@ -0,0 +1,131 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package nettest_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/nettest"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+const timeout = 5 * time.Second
+
+func newNetwork(t *testing.T, n int) *nettest.Network {
+	net, err := nettest.New(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return net
+}
+
+func newTx(chainTag byte, nonce uint64) *tx.Transaction {
+	to := thor.BytesToAddress([]byte("to"))
+	trx := new(tx.Builder).
+		ChainTag(chainTag).
+		Expiration(100).
+		Gas(21000).
+		Nonce(nonce).
+		Clause(tx.NewClause(&to).WithValue(big.NewInt(1))).
+		Build()
+	sig, _ := crypto.Sign(trx.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+	return trx.WithSignature(sig)
+}
+
+func TestBlockPropagation(t *testing.T) {
+	net := newNetwork(t, 3)
+	defer net.Close()
+
+	net.ConnectAll()
+	assert.Nil(t, net.WaitPeers(2, timeout))
+
+	for _, node := range net.Nodes {
+		blk, err := node.Pack()
+		assert.Nil(t, err)
+		assert.Nil(t, net.WaitBestBlock(blk.Header().ID(), timeout))
+	}
+}
+
+func TestEndorsement(t *testing.T) {
+	net := newNetwork(t, 3)
+	defer net.Close()
+
+	net.ConnectAll()
+	assert.Nil(t, net.WaitPeers(2, timeout))
+
+	// committee members are all of the few authorities, except the proposer
+	blk, err := net.Nodes[0].Pack()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(blk.Endorsements()))
+	assert.Equal(t, blk.Endorsements().RootHash(), blk.Header().EndorsementsRoot())
+	assert.Nil(t, net.WaitBestBlock(blk.Header().ID(), timeout))
+
+	for _, node := range net.Nodes {
+		stored, err := node.Chain.GetBlock(blk.Header().ID())
+		assert.Nil(t, err)
+		assert.Equal(t, blk.Endorsements(), stored.Endorsements())
+	}
+}
+
+func TestTxPoolConvergence(t *testing.T) {
+	net := newNetwork(t, 3)
+	defer net.Close()
+
+	net.ConnectAll()
+	assert.Nil(t, net.WaitPeers(2, timeout))
+
+	trx := newTx(net.Nodes[0].Chain.Tag(), 1)
+	assert.Nil(t, net.Nodes[0].TxPool.Add(trx))
+
+	// propagated to all nodes, and becomes executable
+	assert.Nil(t, nettest.Wait(timeout, func() bool {
+		for _, node := range net.Nodes {
+			if len(node.TxPool.Executables()) != 1 {
+				return false
+			}
+		}
+		return true
+	}))
+
+	// packed by another node
+	blk, err := net.Nodes[1].Pack()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(blk.Transactions()))
+	assert.Nil(t, net.WaitBestBlock(blk.Header().ID(), timeout))
+}
+
+func TestReorg(t *testing.T) {
+	net := newNetwork(t, 3)
+	defer net.Close()
+
+	// partitioned, both nodes produce competing blocks
+	a, b := net.Nodes[0], net.Nodes[1]
+	blkA, err := a.Pack()
+	assert.Nil(t, err)
+	blkB, err := b.Pack()
+	assert.Nil(t, err)
+	assert.NotEqual(t, blkA.Header().ID(), blkB.Header().ID())
+
+	net.ConnectAll()
+	assert.Nil(t, net.WaitPeers(2, timeout))
+
+	// the heavier chain, extended by the third node, wins on all nodes
+	a.Comm.BroadcastBlock(blkA)
+	b.Comm.BroadcastBlock(blkB)
+	assert.Nil(t, nettest.Wait(timeout, func() bool {
+		return net.Nodes[2].Chain.BestBlock().Header().Number() == 1
+	}))
+
+	blk, err := net.Nodes[2].Pack()
+	assert.Nil(t, err)
+	assert.Nil(t, net.WaitBestBlock(blk.Header().ID(), timeout))
+}
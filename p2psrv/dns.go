@@ -0,0 +1,69 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package p2psrv
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/discover"
+)
+
+const dnsSeedsRefreshInterval = 30 * time.Minute
+
+// lookupTXT is replaceable for test.
+var lookupTXT = net.LookupTXT
+
+// resolveDNSSeed resolves nodes from TXT records of the domain.
+// Each TXT record is expected to be an enode URL, and invalid ones are skipped.
+func resolveDNSSeed(domain string) (Nodes, error) {
+	records, err := lookupTXT(domain)
+	if err != nil {
+		return nil, err
+	}
+	var nodes Nodes
+	for _, record := range records {
+		record = strings.TrimSpace(record)
+		if !strings.HasPrefix(record, "enode://") {
+			continue
+		}
+		node, err := discover.ParseNode(record)
+		if err != nil {
+			log.Debug("invalid node in DNS seed", "domain", domain, "record", record, "err", err)
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// dnsSeedsLoop periodically resolves DNS seeds, and feeds nodes to be dialed.
+func (s *Server) dnsSeedsLoop() {
+	ticker := time.NewTicker(dnsSeedsRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		for _, domain := range s.opts.DNSSeeds {
+			nodes, err := resolveDNSSeed(domain)
+			if err != nil {
+				log.Debug("failed to resolve DNS seed", "domain", domain, "err", err)
+				continue
+			}
+			log.Debug("resolved DNS seed", "domain", domain, "count", len(nodes))
+			for _, node := range nodes {
+				if _, found := s.discoveredNodes.Get(node.ID); !found {
+					s.discoveredNodes.Set(node.ID, node)
+				}
+			}
+		}
+		select {
+		case <-ticker.C:
+		case <-s.done:
+			return
+		}
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package p2psrv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveDNSSeed(t *testing.T) {
+	defer func(f func(string) ([]string, error)) { lookupTXT = f }(lookupTXT)
+
+	const enode = "enode://6865f570268591be82d5ec3dbdea1a1833bd3fedfec21812c71f743a9521577af134de5e3ef913264321de9c084726c393e2f057b0408fdcd1d2ff144585bbad@119.28.214.38:55555"
+	lookupTXT = func(domain string) ([]string, error) {
+		return []string{
+			enode,
+			"v=spf1 -all",
+			"enode://invalid",
+		}, nil
+	}
+
+	nodes, err := resolveDNSSeed("seeds.example.org")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(nodes))
+	assert.Equal(t, enode, nodes[0].String())
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package p2psrv
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/ethereum/go-ethereum/p2p/nat"
+)
+
+// extIPNAT maps ports via the underlying NAT, but reports the given external IP.
+// It's useful when the IP reported by router is not the public one, e.g. behind CGNAT.
+type extIPNAT struct {
+	nat.Interface
+	ip net.IP
+}
+
+func (n *extIPNAT) ExternalIP() (net.IP, error) {
+	return n.ip, nil
+}
+
+func (n *extIPNAT) String() string {
+	return fmt.Sprintf("%v(extip:%v)", n.Interface, n.ip)
+}
+
+// withExternalIP overrides external IP of the NAT interface.
+func withExternalIP(m nat.Interface, ip net.IP) nat.Interface {
+	if m == nil {
+		return nat.ExtIP(ip)
+	}
+	return &extIPNAT{m, ip}
+}
@@ -0,0 +1,24 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package p2psrv
+
+import (
+	"net"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/nat"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithExternalIP(t *testing.T) {
+	for _, ip := range []net.IP{net.ParseIP("1.2.3.4"), net.ParseIP("2001:db8::1")} {
+		for _, m := range []nat.Interface{nil, nat.ExtIP(net.ParseIP("10.0.0.1"))} {
+			ext, err := withExternalIP(m, ip).ExternalIP()
+			assert.Nil(t, err)
+			assert.Equal(t, ip, ext)
+		}
+	}
+}
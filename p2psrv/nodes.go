@@ -69,6 +69,16 @@ func (nm *nodeMap) Contains(id discover.NodeID) bool {
 	return nm.m[id] != nil
 }
 
+func (nm *nodeMap) Nodes() []*discover.Node {
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+	nodes := make([]*discover.Node, 0, len(nm.m))
+	for _, node := range nm.m {
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
 func (nm *nodeMap) Len() int {
 	nm.lock.Lock()
 	defer nm.lock.Unlock()
@@ -7,6 +7,7 @@ package p2psrv
 
 import (
 	"crypto/ecdsa"
+	"net"
 
 	"github.com/ethereum/go-ethereum/p2p/nat"
 	"github.com/ethereum/go-ethereum/p2p/netutil"
@@ -44,6 +45,10 @@ type Options struct {
 	// protocol.
 	BootstrapNodes Nodes
 
+	// DNSSeeds are domains whose TXT records are enode URLs.
+	// Nodes resolved are dialed in addition to discovered ones.
+	DNSSeeds []string
+
 	// Connectivity can be restricted to certain IP networks.
 	// If this option is set to a non-nil value, only hosts which match one of the
 	// IP networks contained in the list are considered.
@@ -54,6 +59,10 @@ type Options struct {
 	// Internet.
 	NAT nat.Interface
 
+	// If set, it's advertised as the external IP of this node, instead of
+	// the one reported by NAT. Port mapping still works if NAT is set.
+	ExternalIP net.IP
+
 	// If NoDial is true, the server will not dial any peers.
 	NoDial bool
 }
@@ -36,13 +36,19 @@ type Server struct {
 
 // New create a p2p server.
 func New(opts *Options) *Server {
-	knownNodes := cache.NewPrioCache(5)
+	knownNodes := cache.NewPrioCache(16)
 	discoveredNodes := cache.NewRandCache(128)
 	for _, node := range opts.KnownNodes {
 		knownNodes.Set(node.ID, node, 0)
 		discoveredNodes.Set(node.ID, node)
 	}
 
+	if opts.ExternalIP != nil {
+		cpy := *opts
+		cpy.NAT = withExternalIP(opts.NAT, opts.ExternalIP)
+		opts = &cpy
+	}
+
 	return &Server{
 		opts: *opts,
 		srv: &p2p.Server{
@@ -105,7 +111,13 @@ func (s *Server) Start(protocols []*Protocol) error {
 		if err := s.listenDiscV5(); err != nil {
 			return err
 		}
+		registered := make(map[string]bool)
 		for _, proto := range protocols {
+			// protocols of different versions may share the topic
+			if registered[proto.DiscTopic] {
+				continue
+			}
+			registered[proto.DiscTopic] = true
 			topicToRegister := discv5.Topic(proto.DiscTopic)
 			log.Debug("registering topic", "topic", topicToRegister)
 			s.goes.Go(func() {
@@ -119,6 +131,10 @@ func (s *Server) Start(protocols []*Protocol) error {
 		}
 	}
 
+	if len(s.opts.DNSSeeds) > 0 {
+		s.goes.Go(s.dnsSeedsLoop)
+	}
+
 	log.Debug("start up", "self", s.Self())
 
 	s.goes.Go(s.dialLoop)
@@ -136,12 +152,25 @@ func (s *Server) Stop() {
 }
 
 // KnownNodes returns known nodes that can be saved for fast connecting next time.
+// Nodes of connected outbound peers are included.
 func (s *Server) KnownNodes() Nodes {
 	nodes := make([]*discover.Node, 0, s.knownNodes.Len())
+	included := make(map[discover.NodeID]bool)
 	s.knownNodes.ForEach(func(ent *cache.PrioEntry) bool {
-		nodes = append(nodes, ent.Value.(*discover.Node))
+		node := ent.Value.(*discover.Node)
+		nodes = append(nodes, node)
+		included[node.ID] = true
 		return true
 	})
+	connected := make(map[discover.NodeID]bool)
+	for _, peer := range s.srv.Peers() {
+		connected[peer.ID()] = true
+	}
+	for _, node := range s.dialingNodes.Nodes() {
+		if connected[node.ID] && !included[node.ID] {
+			nodes = append(nodes, node)
+		}
+	}
 	return nodes
 }
 
@@ -0,0 +1,62 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package packer
+
+import (
+	"github.com/vechain/thor/thor"
+)
+
+// BeneficiaryRotation rotates block rewards among beneficiaries, to split rewards across entities.
+// Blocks, or epochs if PerEpoch, are assigned to beneficiaries in turn, each taking as many consecutive
+// ones as its weight, so that rewards are split in proportion to weights in the long run.
+type BeneficiaryRotation struct {
+	Beneficiaries []WeightedBeneficiary
+	PerEpoch      bool
+}
+
+// WeightedBeneficiary a beneficiary with its weight in rotation.
+type WeightedBeneficiary struct {
+	Address thor.Address
+	Weight  uint64
+}
+
+type beneficiaryRotation struct {
+	beneficiaries []WeightedBeneficiary // with positive weights
+	totalWeight   uint64
+	perEpoch      bool
+}
+
+// newBeneficiaryRotation returns nil if no beneficiary with positive weight.
+func newBeneficiaryRotation(rotation BeneficiaryRotation) *beneficiaryRotation {
+	br := &beneficiaryRotation{perEpoch: rotation.PerEpoch}
+	for _, b := range rotation.Beneficiaries {
+		if b.Weight > 0 {
+			br.beneficiaries = append(br.beneficiaries, b)
+			br.totalWeight += b.Weight
+		}
+	}
+	if len(br.beneficiaries) == 0 {
+		return nil
+	}
+	return br
+}
+
+// beneficiaryOf returns the beneficiary of the block with given number.
+func (br *beneficiaryRotation) beneficiaryOf(blockNumber uint32) thor.Address {
+	turn := uint64(blockNumber)
+	if br.perEpoch {
+		turn /= uint64(thor.EpochLength)
+	}
+	pos := turn % br.totalWeight
+	for _, b := range br.beneficiaries {
+		if pos < b.Weight {
+			return b.Address
+		}
+		pos -= b.Weight
+	}
+	// unreachable
+	return br.beneficiaries[0].Address
+}
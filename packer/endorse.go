@@ -0,0 +1,153 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package packer
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/builtin/authority"
+	"github.com/vechain/thor/cache"
+	"github.com/vechain/thor/poa"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/vrf"
+)
+
+// count of recent parents, upon which endorsed proposals are remembered
+const maxEndorsedParents = 64
+
+var errNotCommitteeMember = errors.New("not committee member")
+
+// endorsedProposals remembers the proposal endorsed upon each parent, to never endorse
+// conflicting proposals upon the same parent.
+type endorsedProposals struct {
+	lock  sync.Mutex
+	cache *cache.PrioCache // parent ID -> proposal hash, prioritized by parent number
+}
+
+func newEndorsedProposals() *endorsedProposals {
+	return &endorsedProposals{cache: cache.NewPrioCache(maxEndorsedParents)}
+}
+
+// mark marks the proposal upon the parent as endorsed. It returns false if another proposal upon
+// the parent already endorsed.
+func (e *endorsedProposals) mark(parent *block.Header, proposalHash thor.Bytes32) bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if endorsed, _, ok := e.cache.Get(parent.ID()); ok {
+		return endorsed.(thor.Bytes32) == proposalHash
+	}
+	e.cache.Set(parent.ID(), proposalHash, float64(parent.Number()))
+	return true
+}
+
+// IsNotCommitteeMember returns whether the error is caused by the endorser not selected as committee member.
+func IsNotCommitteeMember(err error) bool {
+	return err == errNotCommitteeMember
+}
+
+// Endorse produces endorsement on the header proposed by others, if the node master is selected
+// as committee member for the header. The header is validated against the parent and the schedule of
+// proposers, and at most one proposal upon a parent is endorsed.
+func (p *Packer) Endorse(header *block.Header, privateKey *ecdsa.PrivateKey) (*block.Endorsement, error) {
+	if header.Number() < p.forkConfig.VRF {
+		return nil, errors.New("endorsements not activated")
+	}
+	if ext := header.Extension(); !ext.IsEmpty() {
+		return nil, errors.New("header already endorsed")
+	}
+	if header.Timestamp() > uint64(time.Now().Unix())+thor.BlockInterval {
+		return nil, errors.New("proposal in the future")
+	}
+	endorser := thor.Address(crypto.PubkeyToAddress(privateKey.PublicKey))
+	if endorser != p.nodeMaster && (p.nextMaster == nil || endorser != *p.nextMaster) {
+		return nil, errors.New("private key mismatch")
+	}
+	signer, err := header.Signer()
+	if err != nil {
+		return nil, err
+	}
+	if signer == endorser {
+		return nil, errors.New("can not endorse block proposed by self")
+	}
+
+	parent, err := p.chain.GetBlockHeader(header.ParentID())
+	if err != nil {
+		return nil, err
+	}
+	state, err := p.stateCreator.NewState(parent.StateRoot())
+	if err != nil {
+		return nil, errors.Wrap(err, "state")
+	}
+	poa.ActivateRotations(state, header.Number())
+	candidates := poa.LoadCandidates(state)
+	if err := state.Err(); err != nil {
+		return nil, errors.Wrap(err, "state")
+	}
+
+	listed := false
+	for _, c := range candidates {
+		if c.NodeMaster == endorser {
+			listed = true
+			break
+		}
+	}
+	if !listed {
+		return nil, errNotCommitteeMember
+	}
+
+	beta, proof, err := vrf.Prove(privateKey, parent.ID().Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if !poa.IsCommitteeMember(beta, len(candidates)) {
+		return nil, errNotCommitteeMember
+	}
+
+	if err := validateProposal(header, parent, signer, candidates); err != nil {
+		return nil, err
+	}
+	if !p.endorsed.mark(parent, block.EndorsementSigningHash(header, nil)) {
+		return nil, errors.New("another proposal upon the parent already endorsed")
+	}
+
+	sig, err := crypto.Sign(block.EndorsementSigningHash(header, proof).Bytes(), privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &block.Endorsement{Proof: proof, Signature: sig}, nil
+}
+
+// validateProposal validates the proposed header against the parent, and the schedule of proposers.
+func validateProposal(header *block.Header, parent *block.Header, signer thor.Address, candidates []*authority.Candidate) error {
+	if header.Timestamp() <= parent.Timestamp() || (header.Timestamp()-parent.Timestamp())%thor.BlockInterval != 0 {
+		return fmt.Errorf("proposal timestamp invalid: parent %v, current %v", parent.Timestamp(), header.Timestamp())
+	}
+	if !block.GasLimit(header.GasLimit()).IsValid(parent.GasLimit()) {
+		return fmt.Errorf("proposal gas limit invalid: parent %v, current %v", parent.GasLimit(), header.GasLimit())
+	}
+	if header.GasUsed() > header.GasLimit() {
+		return fmt.Errorf("proposal gas used exceeds limit: limit %v, used %v", header.GasLimit(), header.GasUsed())
+	}
+
+	sched, err := poa.NewScheduler(signer, poa.ToProposers(candidates), parent.Number(), parent.Timestamp())
+	if err != nil {
+		return fmt.Errorf("proposer invalid: %v %v", signer, err)
+	}
+	if !sched.IsTheTime(header.Timestamp()) {
+		return fmt.Errorf("proposal timestamp unscheduled: t %v, s %v", header.Timestamp(), signer)
+	}
+	if _, score := sched.Updates(header.Timestamp()); parent.TotalScore()+score != header.TotalScore() {
+		return fmt.Errorf("proposal total score invalid: want %v, have %v", parent.TotalScore()+score, header.TotalScore())
+	}
+	return nil
+}
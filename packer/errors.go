@@ -12,6 +12,7 @@ var (
 	errTxNotAdoptableNow     = errors.New("tx not adoptable now")
 	errTxNotAdoptableForever = errors.New("tx not adoptable forever")
 	errKnownTx               = errors.New("known tx")
+	errExecTimeReached       = errors.New("execution time budget reached")
 )
 
 // IsGasLimitReached block if full of txs.
@@ -35,6 +36,17 @@ func IsKnownTx(err error) bool {
 	return errors.Cause(err) == errKnownTx
 }
 
+// IsExecTimeReached time budget of executing txs in a block used up.
+func IsExecTimeReached(err error) bool {
+	return errors.Cause(err) == errExecTimeReached
+}
+
+// IsTxTooSlow tx executed too slow, which is valid but skipped.
+func IsTxTooSlow(err error) bool {
+	_, ok := errors.Cause(err).(txTooSlowError)
+	return ok
+}
+
 type badTxError struct {
 	msg string
 }
@@ -42,3 +54,11 @@ type badTxError struct {
 func (e badTxError) Error() string {
 	return "bad tx: " + e.msg
 }
+
+type txTooSlowError struct {
+	msg string
+}
+
+func (e txTooSlowError) Error() string {
+	return "tx too slow: " + e.msg
+}
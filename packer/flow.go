@@ -7,14 +7,20 @@ package packer
 
 import (
 	"crypto/ecdsa"
+	"fmt"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common/mclock"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/builtin/authority"
+	"github.com/vechain/thor/poa"
 	"github.com/vechain/thor/runtime"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/txfilter"
 )
 
 // Flow the flow of packing a new block.
@@ -26,19 +32,40 @@ type Flow struct {
 	gasUsed      uint64
 	txs          tx.Transactions
 	receipts     tx.Receipts
+	budget       ExecBudget
+	execElapsed  time.Duration         // total time spent on executing txs
+	executed     []*runtime.ExecutedTx // nil if not recording detail
+	lane         *priorityLane
+	laneGasUsed  uint64 // gas used by priority txs
+	filter       txfilter.Filter
+	txLimits     *runtime.TxLimits      // loaded on first adopting
+	candidates   []*authority.Candidate // to verify endorsements, nil if not scheduled
+	proposal     *block.Header
+	endorsements block.Endorsements
 }
 
+// txs executed shorter than this are not checked against min gas rate, since the
+// measurement is inaccurate
+const minGasRateCheckTime = 50 * time.Millisecond
+
 func newFlow(
 	packer *Packer,
 	parentHeader *block.Header,
-	runtime *runtime.Runtime,
+	rt *runtime.Runtime,
 ) *Flow {
-	return &Flow{
+	flow := &Flow{
 		packer:       packer,
 		parentHeader: parentHeader,
-		runtime:      runtime,
+		runtime:      rt,
 		processedTxs: make(map[thor.Bytes32]bool),
+		budget:       packer.execBudget,
+		lane:         packer.priorityLane,
+		filter:       packer.filter,
+	}
+	if packer.recordDetail {
+		flow.executed = []*runtime.ExecutedTx{}
 	}
+	return flow
 }
 
 // ParentHeader returns parent block header.
@@ -78,13 +105,29 @@ func (f *Flow) Adopt(tx *tx.Transaction) error {
 		return errTxNotAdoptableNow
 	case tx.IsExpired(f.runtime.Context().Number):
 		return badTxError{"expired"}
-	case f.gasUsed+tx.Gas() > f.runtime.Context().GasLimit:
+	case f.gasUsed+tx.Gas() > f.gasLimitFor(tx):
 		// gasUsed < 90% gas limit
-		if float64(f.gasUsed)/float64(f.runtime.Context().GasLimit) < 0.9 {
+		if float64(f.gasUsed)/float64(f.gasLimitFor(tx)) < 0.9 {
 			// try to find a lower gas tx
 			return errTxNotAdoptableNow
 		}
 		return errGasLimitReached
+	case f.budget.Block > 0 && f.execElapsed >= f.budget.Block:
+		return errExecTimeReached
+	}
+
+	if f.filter != nil {
+		if err := f.filter.Check(tx); err != nil {
+			return err
+		}
+	}
+
+	if f.txLimits == nil {
+		limits := runtime.LoadTxLimits(f.runtime.State())
+		f.txLimits = &limits
+	}
+	if err := f.txLimits.Check(tx); err != nil {
+		return badTxError{err.Error()}
 	}
 
 	// check if tx already there
@@ -108,34 +151,216 @@ func (f *Flow) Adopt(tx *tx.Transaction) error {
 		}
 	}
 
+	// fail fast, rather than packing a tx to be reverted
+	if resolved, err := runtime.ResolveTransaction(tx); err == nil {
+		if err := resolved.CheckBalance(f.runtime.State()); err != nil {
+			return errors.WithMessage(errTxNotAdoptableNow, err.Error())
+		}
+	}
+
 	checkpoint := f.runtime.State().NewCheckpoint()
-	receipt, err := f.runtime.ExecuteTransaction(tx)
+	startTime := mclock.Now()
+	receipt, detail, err := f.execute(tx)
+	elapsed := time.Duration(mclock.Now() - startTime)
+	f.execElapsed += elapsed
 	if err != nil {
 		// skip and revert state
 		f.runtime.State().RevertTo(checkpoint)
+		if runtime.IsTemporary(err) {
+			return errors.WithMessage(errTxNotAdoptableNow, err.Error())
+		}
 		return badTxError{err.Error()}
 	}
+	if err := f.checkExecTime(receipt.GasUsed, elapsed); err != nil {
+		f.runtime.State().RevertTo(checkpoint)
+		return err
+	}
 	f.processedTxs[tx.ID()] = receipt.Reverted
 	f.gasUsed += receipt.GasUsed
+	if f.lane.contains(tx) {
+		f.laneGasUsed += receipt.GasUsed
+	}
 	f.receipts = append(f.receipts, receipt)
 	f.txs = append(f.txs, tx)
+	if detail != nil {
+		f.executed = append(f.executed, detail)
+	}
+	return nil
+}
+
+// IsPriority returns whether the tx is in the priority lane.
+func (f *Flow) IsPriority(tx *tx.Transaction) bool {
+	return f.lane.contains(tx)
+}
+
+// LaneUtilization returns gas used by priority txs, and the gas reserved for them.
+func (f *Flow) LaneUtilization() (used uint64, reserved uint64) {
+	if f.lane == nil {
+		return 0, 0
+	}
+	return f.laneGasUsed, f.lane.reservedGas
+}
+
+// gasLimitFor returns gas limit available for the tx, which excludes unused reserved gas for non-priority txs.
+func (f *Flow) gasLimitFor(tx *tx.Transaction) uint64 {
+	limit := f.runtime.Context().GasLimit
+	if f.lane == nil || f.lane.contains(tx) || f.laneGasUsed >= f.lane.reservedGas {
+		return limit
+	}
+	reserved := f.lane.reservedGas - f.laneGasUsed
+	if reserved > limit {
+		return 0
+	}
+	return limit - reserved
+}
+
+// Txs returns txs adopted so far.
+func (f *Flow) Txs() tx.Transactions {
+	return f.txs
+}
+
+// Receipts returns receipts of txs adopted so far.
+func (f *Flow) Receipts() tx.Receipts {
+	return f.receipts
+}
+
+// GasUsed returns gas used by txs adopted so far.
+func (f *Flow) GasUsed() uint64 {
+	return f.gasUsed
+}
+
+// Signer returns the node master to sign the block.
+func (f *Flow) Signer() thor.Address {
+	return f.runtime.Context().Signer
+}
+
+// Beneficiary returns the beneficiary of the block being packed.
+func (f *Flow) Beneficiary() thor.Address {
+	return f.runtime.Context().Beneficiary
+}
+
+// GasLimit returns gas limit of the block being packed.
+func (f *Flow) GasLimit() uint64 {
+	return f.runtime.Context().GasLimit
+}
+
+// Executed returns execution results of adopted txs in detail, or nil if not recorded.
+func (f *Flow) Executed() []*runtime.ExecutedTx {
+	return f.executed
+}
+
+// execute executes the tx, and also collects the result in detail if recording.
+func (f *Flow) execute(trx *tx.Transaction) (*tx.Receipt, *runtime.ExecutedTx, error) {
+	if f.executed == nil {
+		receipt, err := f.runtime.ExecuteTransaction(trx)
+		return receipt, nil, err
+	}
+	detail, err := f.runtime.ExecuteTransactionWithDetail(trx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return detail.Receipt, detail, nil
+}
+
+// checkExecTime checks whether the tx executed too slow, against the budget.
+func (f *Flow) checkExecTime(gasUsed uint64, elapsed time.Duration) error {
+	if f.budget.Tx > 0 && elapsed > f.budget.Tx {
+		return txTooSlowError{fmt.Sprintf("executed %v, exceeds %v", elapsed, f.budget.Tx)}
+	}
+	if f.budget.MinGasRate > 0 && elapsed > minGasRateCheckTime {
+		if rate := uint64(float64(gasUsed) / elapsed.Seconds()); rate < f.budget.MinGasRate {
+			return txTooSlowError{fmt.Sprintf("gas rate %v/s, below %v/s", rate, f.budget.MinGasRate)}
+		}
+	}
+	return nil
+}
+
+// Endorsable returns whether the block being packed can carry endorsements, which requires
+// the VRF fork activated, and the flow to be scheduled.
+func (f *Flow) Endorsable() bool {
+	return f.candidates != nil && f.runtime.Context().Number >= f.packer.forkConfig.VRF
+}
+
+// Propose builds and signs the block as proposed to committee members, which carries no
+// endorsements. Txs should not be adopted afterwards, or endorsements collected are discarded.
+func (f *Flow) Propose(privateKey *ecdsa.PrivateKey) (*block.Header, error) {
+	if !f.Endorsable() {
+		return nil, errors.New("not endorsable")
+	}
+	_, stateRoot, err := f.stage()
+	if err != nil {
+		return nil, err
+	}
+	proposal, err := f.build(privateKey, stateRoot, nil)
+	if err != nil {
+		return nil, err
+	}
+	f.proposal = proposal.Header()
+	f.endorsements = nil
+	return f.proposal, nil
+}
+
+// AddEndorsement verifies and adds the endorsement on the proposal.
+func (f *Flow) AddEndorsement(e *block.Endorsement) error {
+	if f.proposal == nil {
+		return errors.New("not proposed")
+	}
+	pub, err := e.Endorser(f.proposal)
+	if err != nil {
+		return err
+	}
+	endorser := thor.Address(crypto.PubkeyToAddress(*pub))
+	if endorser == f.Signer() {
+		return errors.New("endorsed by signer")
+	}
+	for _, added := range f.endorsements {
+		if pub, err := added.Endorser(f.proposal); err == nil && thor.Address(crypto.PubkeyToAddress(*pub)) == endorser {
+			return errors.New("endorsed repeatedly")
+		}
+	}
+	if err := poa.VerifyEndorser(pub, e.Proof, f.parentHeader.ID(), f.candidates); err != nil {
+		return err
+	}
+	f.endorsements = append(f.endorsements, e)
 	return nil
 }
 
 // Pack build and sign the new block.
+// Endorsements added are carried, if the block is unchanged since proposed.
 func (f *Flow) Pack(privateKey *ecdsa.PrivateKey) (*block.Block, *state.Stage, tx.Receipts, error) {
-	if f.packer.nodeMaster != thor.Address(crypto.PubkeyToAddress(privateKey.PublicKey)) {
-		return nil, nil, nil, errors.New("private key mismatch")
+	stage, stateRoot, err := f.stage()
+	if err != nil {
+		return nil, nil, nil, err
 	}
-
-	if err := f.runtime.Seeker().Err(); err != nil {
+	newBlock, err := f.build(privateKey, stateRoot, nil)
+	if err != nil {
 		return nil, nil, nil, err
 	}
+	if len(f.endorsements) > 0 &&
+		block.EndorsementSigningHash(newBlock.Header(), nil) == block.EndorsementSigningHash(f.proposal, nil) {
+		if newBlock, err = f.build(privateKey, stateRoot, f.endorsements); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	return newBlock, stage, f.receipts, nil
+}
 
+func (f *Flow) stage() (*state.Stage, thor.Bytes32, error) {
+	if err := f.runtime.Seeker().Err(); err != nil {
+		return nil, thor.Bytes32{}, err
+	}
 	stage := f.runtime.State().Stage()
 	stateRoot, err := stage.Hash()
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, thor.Bytes32{}, err
+	}
+	return stage, stateRoot, nil
+}
+
+// build builds and signs the block with given endorsements.
+func (f *Flow) build(privateKey *ecdsa.PrivateKey, stateRoot thor.Bytes32, endorsements block.Endorsements) (*block.Block, error) {
+	if f.Signer() != thor.Address(crypto.PubkeyToAddress(privateKey.PublicKey)) {
+		return nil, errors.New("private key mismatch")
 	}
 
 	builder := new(block.Builder).
@@ -146,7 +371,11 @@ func (f *Flow) Pack(privateKey *ecdsa.PrivateKey) (*block.Block, *state.Stage, t
 		TotalScore(f.runtime.Context().TotalScore).
 		GasUsed(f.gasUsed).
 		ReceiptsRoot(f.receipts.RootHash()).
-		StateRoot(stateRoot)
+		StateRoot(stateRoot).
+		Endorsements(endorsements)
+	if len(endorsements) > 0 {
+		builder.Extension(block.Extension{Alpha: f.parentHeader.ID().Bytes(), COM: true})
+	}
 	for _, tx := range f.txs {
 		builder.Transaction(tx)
 	}
@@ -154,7 +383,7 @@ func (f *Flow) Pack(privateKey *ecdsa.PrivateKey) (*block.Block, *state.Stage, t
 
 	sig, err := crypto.Sign(newBlock.Header().SigningHash().Bytes(), privateKey)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, err
 	}
-	return newBlock.WithSignature(sig), stage, f.receipts, nil
+	return newBlock.WithSignature(sig), nil
 }
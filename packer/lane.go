@@ -0,0 +1,61 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package packer
+
+import (
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+// PriorityLane reserves gas space of each block for txs from whitelisted origins, or
+// txs with all clauses to whitelisted recipients, e.g. oracle updates.
+// Other txs can't use the reserved space, while priority txs can use the whole block.
+type PriorityLane struct {
+	Origins     []thor.Address
+	Recipients  []thor.Address
+	ReservedGas uint64
+}
+
+type priorityLane struct {
+	origins     map[thor.Address]bool
+	recipients  map[thor.Address]bool
+	reservedGas uint64
+}
+
+func newPriorityLane(lane PriorityLane) *priorityLane {
+	pl := &priorityLane{
+		origins:     make(map[thor.Address]bool),
+		recipients:  make(map[thor.Address]bool),
+		reservedGas: lane.ReservedGas,
+	}
+	for _, addr := range lane.Origins {
+		pl.origins[addr] = true
+	}
+	for _, addr := range lane.Recipients {
+		pl.recipients[addr] = true
+	}
+	return pl
+}
+
+func (pl *priorityLane) contains(trx *tx.Transaction) bool {
+	if pl == nil {
+		return false
+	}
+	if len(pl.origins) > 0 {
+		if origin, err := trx.Signer(); err == nil && pl.origins[origin] {
+			return true
+		}
+	}
+	if len(pl.recipients) == 0 || len(trx.Clauses()) == 0 {
+		return false
+	}
+	for _, c := range trx.Clauses() {
+		if c.To() == nil || !pl.recipients[*c.To()] {
+			return false
+		}
+	}
+	return true
+}
@@ -6,14 +6,17 @@
 package packer
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/block"
-	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/builtin/authority"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/poa"
 	"github.com/vechain/thor/runtime"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/txfilter"
 	"github.com/vechain/thor/xenv"
 )
 
@@ -22,12 +25,28 @@ type Packer struct {
 	chain          *chain.Chain
 	stateCreator   *state.Creator
 	nodeMaster     thor.Address
+	nextMaster     *thor.Address
 	beneficiary    *thor.Address
+	rotation       *beneficiaryRotation
 	targetGasLimit uint64
+	execBudget     ExecBudget
+	recordDetail   bool
+	priorityLane   *priorityLane
+	filter         txfilter.Filter
+	forkConfig     thor.ForkConfig
+	endorsed       *endorsedProposals
+}
+
+// ExecBudget limits wall-clock time spent on executing txs, to keep block production
+// inside the slot interval. Zero fields mean unlimited.
+type ExecBudget struct {
+	Block      time.Duration // for all txs of a block
+	Tx         time.Duration // for a single tx
+	MinGasRate uint64        // gas per second, checked only for txs executed longer than minGasRateCheckTime
 }
 
 // New create a new Packer instance.
-// The beneficiary is optional, it defaults to endorsor if not set.
+// The beneficiary is optional, it defaults to endorsor if not set, and is overridden by beneficiary rotation.
 func New(
 	chain *chain.Chain,
 	stateCreator *state.Creator,
@@ -38,8 +57,16 @@ func New(
 		chain,
 		stateCreator,
 		nodeMaster,
+		nil,
 		beneficiary,
+		nil,
 		0,
+		ExecBudget{},
+		false,
+		nil,
+		nil,
+		thor.GetForkConfig(chain.GenesisBlock().Header().ID()),
+		newEndorsedProposals(),
 	}
 }
 
@@ -50,30 +77,16 @@ func (p *Packer) Schedule(parent *block.Header, nowTimestamp uint64) (flow *Flow
 		return nil, errors.Wrap(err, "state")
 	}
 
+	poa.ActivateRotations(state, parent.Number()+1)
+
 	var (
-		endorsement = builtin.Params.Native(state).Get(thor.KeyProposerEndorsement)
-		authority   = builtin.Authority.Native(state)
-		candidates  = authority.Candidates(endorsement, thor.MaxBlockProposers)
-		proposers   = make([]poa.Proposer, 0, len(candidates))
-		beneficiary thor.Address
+		candidates  = poa.LoadCandidates(state)
+		nodeMaster  = p.signerOf(candidates)
+		beneficiary = p.beneficiaryOf(nodeMaster, parent.Number()+1, candidates)
 	)
-	if p.beneficiary != nil {
-		beneficiary = *p.beneficiary
-	}
-
-	for _, c := range candidates {
-		if p.beneficiary == nil && c.NodeMaster == p.nodeMaster {
-			// not beneficiary not set, set it to endorsor
-			beneficiary = c.Endorsor
-		}
-		proposers = append(proposers, poa.Proposer{
-			Address: c.NodeMaster,
-			Active:  c.Active,
-		})
-	}
 
 	// calc the time when it's turn to produce block
-	sched, err := poa.NewScheduler(p.nodeMaster, proposers, parent.Number(), parent.Timestamp())
+	sched, err := poa.NewScheduler(nodeMaster, poa.ToProposers(candidates), parent.Number(), parent.Timestamp())
 	if err != nil {
 		return nil, err
 	}
@@ -81,23 +94,23 @@ func (p *Packer) Schedule(parent *block.Header, nowTimestamp uint64) (flow *Flow
 	newBlockTime := sched.Schedule(nowTimestamp)
 	updates, score := sched.Updates(newBlockTime)
 
-	for _, u := range updates {
-		authority.Update(u.Address, u.Active)
-	}
+	poa.ApplyUpdates(state, updates)
 
 	rt := runtime.New(
 		p.chain.NewSeeker(parent.ID()),
 		state,
 		&xenv.BlockContext{
 			Beneficiary: beneficiary,
-			Signer:      p.nodeMaster,
+			Signer:      nodeMaster,
 			Number:      parent.Number() + 1,
 			Time:        newBlockTime,
 			GasLimit:    p.gasLimit(parent.GasLimit()),
 			TotalScore:  parent.TotalScore() + score,
-		})
+		}).SetProfiled(true)
 
-	return newFlow(p, parent, rt), nil
+	flow = newFlow(p, parent, rt)
+	flow.candidates = candidates
+	return flow, nil
 }
 
 // Mock create a packing flow upon given parent, but with a designated timestamp.
@@ -124,6 +137,92 @@ func (p *Packer) Mock(parent *block.Header, targetTime uint64, gasLimit uint64)
 	return newFlow(p, parent, rt), nil
 }
 
+// DryRun create a packing flow upon given parent, as the node would schedule at the nearest
+// time after nowTimestamp, but with PoA scheduling skipped. It's to inspect which txs would
+// be packed, and the block produced by the returned flow is not in consensus.
+func (p *Packer) DryRun(parent *block.Header, nowTimestamp uint64) (*Flow, error) {
+	state, err := p.stateCreator.NewState(parent.StateRoot())
+	if err != nil {
+		return nil, errors.Wrap(err, "state")
+	}
+
+	poa.ActivateRotations(state, parent.Number()+1)
+	var (
+		candidates  = poa.LoadCandidates(state)
+		nodeMaster  = p.signerOf(candidates)
+		beneficiary = p.beneficiaryOf(nodeMaster, parent.Number()+1, candidates)
+	)
+
+	newBlockTime := parent.Timestamp() + thor.BlockInterval
+	if nowTimestamp > newBlockTime {
+		// align to the slot
+		newBlockTime += (nowTimestamp - newBlockTime + thor.BlockInterval - 1) / thor.BlockInterval * thor.BlockInterval
+	}
+
+	rt := runtime.New(
+		p.chain.NewSeeker(parent.ID()),
+		state,
+		&xenv.BlockContext{
+			Beneficiary: beneficiary,
+			Signer:      nodeMaster,
+			Number:      parent.Number() + 1,
+			Time:        newBlockTime,
+			GasLimit:    p.gasLimit(parent.GasLimit()),
+			TotalScore:  parent.TotalScore() + 1,
+		})
+
+	return newFlow(p, parent, rt), nil
+}
+
+// NodeMaster returns the node master to sign the block upon given parent, which is the next master once
+// it takes over the candidate by rotation.
+func (p *Packer) NodeMaster(parent *block.Header) (thor.Address, error) {
+	if p.nextMaster == nil {
+		return p.nodeMaster, nil
+	}
+	state, err := p.stateCreator.NewState(parent.StateRoot())
+	if err != nil {
+		return thor.Address{}, errors.Wrap(err, "state")
+	}
+	poa.ActivateRotations(state, parent.Number()+1)
+	candidates := poa.LoadCandidates(state)
+	if err := state.Err(); err != nil {
+		return thor.Address{}, errors.Wrap(err, "state")
+	}
+	return p.signerOf(candidates), nil
+}
+
+// signerOf returns the node master to sign the new block, which is the next master once it takes
+// over the candidate by rotation.
+func (p *Packer) signerOf(candidates []*authority.Candidate) thor.Address {
+	if p.nextMaster == nil {
+		return p.nodeMaster
+	}
+	for _, c := range candidates {
+		if c.NodeMaster == *p.nextMaster {
+			return *p.nextMaster
+		}
+	}
+	return p.nodeMaster
+}
+
+// beneficiaryOf returns the beneficiary of the new block, by rotation if set, otherwise the
+// configured one, and falls back to the endorsor of the node master.
+func (p *Packer) beneficiaryOf(nodeMaster thor.Address, blockNumber uint32, candidates []*authority.Candidate) (beneficiary thor.Address) {
+	if p.rotation != nil {
+		return p.rotation.beneficiaryOf(blockNumber)
+	}
+	if p.beneficiary != nil {
+		return *p.beneficiary
+	}
+	for _, c := range candidates {
+		if c.NodeMaster == nodeMaster {
+			beneficiary = c.Endorsor
+		}
+	}
+	return
+}
+
 func (p *Packer) gasLimit(parentGasLimit uint64) uint64 {
 	if p.targetGasLimit != 0 {
 		return block.GasLimit(p.targetGasLimit).Qualify(parentGasLimit)
@@ -136,3 +235,37 @@ func (p *Packer) gasLimit(parentGasLimit uint64) uint64 {
 func (p *Packer) SetTargetGasLimit(gl uint64) {
 	p.targetGasLimit = gl
 }
+
+// SetExecBudget set the budget of tx execution time for flows created afterwards.
+func (p *Packer) SetExecBudget(budget ExecBudget) {
+	p.execBudget = budget
+}
+
+// SetPriorityLane set the priority lane for flows created afterwards.
+func (p *Packer) SetPriorityLane(lane PriorityLane) {
+	p.priorityLane = newPriorityLane(lane)
+}
+
+// SetBeneficiaryRotation set the rotation of beneficiaries for flows created afterwards.
+// It takes no effect if no beneficiary with positive weight.
+func (p *Packer) SetBeneficiaryRotation(rotation BeneficiaryRotation) {
+	p.rotation = newBeneficiaryRotation(rotation)
+}
+
+// SetNextMaster set the node master rotated to, so that flows created afterwards are signed by it,
+// once it takes over the candidate of the current node master.
+func (p *Packer) SetNextMaster(nextMaster thor.Address) {
+	p.nextMaster = &nextMaster
+}
+
+// SetFilter set the admission filter of txs for flows created afterwards.
+// Txs denied are not adopted, while it never applies to validating blocks.
+func (p *Packer) SetFilter(filter txfilter.Filter) {
+	p.filter = filter
+}
+
+// SetRecordDetail set whether flows created afterwards collect execution results in detail,
+// which can be retrieved by Flow.Executed.
+func (p *Packer) SetRecordDetail(b bool) {
+	p.recordDetail = b
+}
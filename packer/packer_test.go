@@ -6,6 +6,7 @@
 package packer_test
 
 import (
+	"crypto/ecdsa"
 	"fmt"
 	"math"
 	"math/big"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/builtin"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/consensus"
@@ -23,6 +25,7 @@ import (
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/txfilter"
 )
 
 type txIterator struct {
@@ -112,3 +115,392 @@ func TestP(t *testing.T) {
 	fmt.Println(best.Header().Number(), best.Header().GasUsed())
 	//	fmt.Println(best)
 }
+
+func TestTargetGasLimit(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	defer kv.Close()
+
+	b0, _, _ := genesis.NewDevnet().Build(state.NewCreator(kv))
+	c, _ := chain.New(kv, b0)
+	stateCreator := state.NewCreator(kv)
+
+	a0 := genesis.DevAccounts()[0]
+	p := packer.New(c, stateCreator, a0.Address, &a0.Address)
+
+	target := b0.Header().GasLimit() * 2
+	p.SetTargetGasLimit(target)
+
+	parent := b0.Header()
+	for i := 0; i < 3; i++ {
+		flow, err := p.Schedule(parent, parent.Timestamp()+thor.BlockInterval)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blk, stage, receipts, err := flow.Pack(a0.PrivateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gl := blk.Header().GasLimit()
+		assert.True(t, gl > parent.GasLimit(), "gas limit should move toward target")
+		assert.True(t, gl <= target)
+		assert.True(t, block.GasLimit(gl).IsValid(parent.GasLimit()))
+
+		_, _, err = consensus.New(c, stateCreator).Process(blk, blk.Header().Timestamp())
+		assert.Nil(t, err)
+
+		if _, err := stage.Commit(); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.AddBlock(blk, receipts); err != nil {
+			t.Fatal(err)
+		}
+		parent = blk.Header()
+	}
+}
+
+func TestExecBudget(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	defer kv.Close()
+
+	b0, _, _ := genesis.NewDevnet().Build(state.NewCreator(kv))
+	c, _ := chain.New(kv, b0)
+
+	a0 := genesis.DevAccounts()[0]
+	p := packer.New(c, state.NewCreator(kv), a0.Address, &a0.Address)
+	iter := &txIterator{chainTag: b0.Header().ID()[31]}
+
+	p.SetExecBudget(packer.ExecBudget{Tx: time.Nanosecond})
+	flow, _ := p.Mock(b0.Header(), b0.Header().Timestamp()+thor.BlockInterval, b0.Header().GasLimit())
+	assert.True(t, packer.IsTxTooSlow(flow.Adopt(iter.Next())))
+
+	p.SetExecBudget(packer.ExecBudget{Block: time.Nanosecond})
+	flow, _ = p.Mock(b0.Header(), b0.Header().Timestamp()+thor.BlockInterval, b0.Header().GasLimit())
+	assert.Nil(t, flow.Adopt(iter.Next()), "the first tx always executed")
+	assert.True(t, packer.IsExecTimeReached(flow.Adopt(iter.Next())))
+
+	blk, _, _, err := flow.Pack(a0.PrivateKey)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(blk.Transactions()))
+}
+
+func TestDryRun(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	defer kv.Close()
+
+	b0, _, _ := genesis.NewDevnet().Build(state.NewCreator(kv))
+	c, _ := chain.New(kv, b0)
+
+	// not an authority node
+	master := thor.BytesToAddress([]byte("master"))
+	beneficiary := thor.BytesToAddress([]byte("beneficiary"))
+	p := packer.New(c, state.NewCreator(kv), master, &beneficiary)
+	_, err := p.Schedule(b0.Header(), b0.Header().Timestamp())
+	assert.NotNil(t, err)
+
+	now := b0.Header().Timestamp() + thor.BlockInterval*3 + 1
+	flow, err := p.DryRun(b0.Header(), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, b0.Header().Timestamp()+thor.BlockInterval*4, flow.When())
+	assert.Equal(t, beneficiary, flow.Beneficiary())
+	assert.Equal(t, b0.Header().GasLimit(), flow.GasLimit())
+
+	iter := &txIterator{chainTag: b0.Header().ID()[31]}
+	assert.Nil(t, flow.Adopt(iter.Next()))
+	assert.Equal(t, 1, len(flow.Txs()))
+	assert.Equal(t, 1, len(flow.Receipts()))
+	assert.Equal(t, flow.Receipts()[0].GasUsed, flow.GasUsed())
+}
+
+func TestBeneficiaryRotation(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	defer kv.Close()
+
+	b0, _, _ := genesis.NewDevnet().Build(state.NewCreator(kv))
+	c, _ := chain.New(kv, b0)
+
+	var (
+		a           = thor.BytesToAddress([]byte("a"))
+		b           = thor.BytesToAddress([]byte("b"))
+		beneficiary = thor.BytesToAddress([]byte("beneficiary"))
+	)
+	p := packer.New(c, state.NewCreator(kv), genesis.DevAccounts()[0].Address, &beneficiary)
+
+	tests := []struct {
+		rotation packer.BeneficiaryRotation
+		expected thor.Address
+	}{
+		{packer.BeneficiaryRotation{}, beneficiary},
+		{packer.BeneficiaryRotation{Beneficiaries: []packer.WeightedBeneficiary{{Address: a, Weight: 0}}}, beneficiary},
+		// the new block is #1
+		{packer.BeneficiaryRotation{Beneficiaries: []packer.WeightedBeneficiary{{Address: a, Weight: 1}, {Address: b, Weight: 2}}}, b},
+		{packer.BeneficiaryRotation{Beneficiaries: []packer.WeightedBeneficiary{{Address: a, Weight: 2}, {Address: b, Weight: 1}}}, a},
+		{packer.BeneficiaryRotation{Beneficiaries: []packer.WeightedBeneficiary{{Address: a, Weight: 0}, {Address: b, Weight: 1}}}, b},
+		{packer.BeneficiaryRotation{Beneficiaries: []packer.WeightedBeneficiary{{Address: a, Weight: 1}, {Address: b, Weight: 2}}, PerEpoch: true}, a},
+	}
+	for _, tt := range tests {
+		p.SetBeneficiaryRotation(tt.rotation)
+		flow, err := p.Schedule(b0.Header(), b0.Header().Timestamp())
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tt.expected, flow.Beneficiary())
+	}
+}
+
+func TestPriorityLane(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	defer kv.Close()
+
+	b0, _, _ := genesis.NewDevnet().Build(state.NewCreator(kv))
+	c, _ := chain.New(kv, b0)
+
+	a0, a1 := genesis.DevAccounts()[0], genesis.DevAccounts()[1]
+	newTx := func(acc genesis.DevAccount, to thor.Address) *tx.Transaction {
+		trx := new(tx.Builder).
+			ChainTag(c.Tag()).
+			Clause(tx.NewClause(&to).WithValue(big.NewInt(1))).
+			Gas(21000).Nonce(nonce).Expiration(math.MaxUint32).Build()
+		nonce++
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), acc.PrivateKey)
+		return trx.WithSignature(sig)
+	}
+	oracle := thor.BytesToAddress([]byte("oracle"))
+	other := thor.BytesToAddress([]byte("other"))
+
+	p := packer.New(c, state.NewCreator(kv), a0.Address, &a0.Address)
+	p.SetPriorityLane(packer.PriorityLane{
+		Origins:     []thor.Address{a1.Address},
+		Recipients:  []thor.Address{oracle},
+		ReservedGas: 42000,
+	})
+	flow, _ := p.Mock(b0.Header(), b0.Header().Timestamp()+thor.BlockInterval, 63000)
+
+	fromA1, toOracle, normal := newTx(a1, other), newTx(a0, oracle), newTx(a0, other)
+	assert.True(t, flow.IsPriority(fromA1))
+	assert.True(t, flow.IsPriority(toOracle))
+	assert.False(t, flow.IsPriority(normal))
+
+	assert.Nil(t, flow.Adopt(normal))
+	assert.True(t, packer.IsGasLimitReached(flow.Adopt(newTx(a0, other))), "reserved gas not available for normal txs")
+	assert.Nil(t, flow.Adopt(fromA1))
+	assert.Nil(t, flow.Adopt(toOracle))
+
+	used, reserved := flow.LaneUtilization()
+	assert.Equal(t, uint64(42000), used)
+	assert.Equal(t, uint64(42000), reserved)
+}
+
+func TestFilter(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	defer kv.Close()
+
+	b0, _, _ := genesis.NewDevnet().Build(state.NewCreator(kv))
+	c, _ := chain.New(kv, b0)
+
+	a0 := genesis.DevAccounts()[0]
+	p := packer.New(c, state.NewCreator(kv), a0.Address, &a0.Address)
+	filter, _ := txfilter.New(&txfilter.Rules{Addresses: []thor.Address{builtin.Energy.Address}})
+	p.SetFilter(filter)
+
+	iter := &txIterator{chainTag: b0.Header().ID()[31]}
+	flow, _ := p.Mock(b0.Header(), b0.Header().Timestamp()+thor.BlockInterval, b0.Header().GasLimit())
+	assert.True(t, txfilter.IsDenied(flow.Adopt(iter.Next())))
+	assert.Equal(t, 0, len(flow.Txs()))
+}
+
+func TestMasterRotation(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	defer kv.Close()
+
+	var (
+		master     = genesis.DevAccounts()[0]
+		nextKey, _ = crypto.GenerateKey()
+		nextMaster = thor.Address(crypto.PubkeyToAddress(nextKey.PublicKey))
+		activation = uint32(2)
+	)
+	stateCreator := state.NewCreator(kv)
+	b0, _, err := new(genesis.Builder).
+		GasLimit(thor.InitialGasLimit).
+		Timestamp(uint64(time.Now().Unix()) - 100).
+		State(func(state *state.State) error {
+			state.SetCode(builtin.Authority.Address, builtin.Authority.RuntimeBytecodes())
+			aut := builtin.Authority.Native(state)
+			aut.Add(master.Address, master.Address, thor.Bytes32{})
+			aut.ScheduleRotation(master.Address, nextMaster, activation)
+			return nil
+		}).
+		Build(stateCreator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, _ := chain.New(kv, b0)
+	cons := consensus.New(c, stateCreator)
+
+	p := packer.New(c, stateCreator, master.Address, &master.Address)
+	p.SetNextMaster(nextMaster)
+
+	// packs and processes the block upon the parent, with the key
+	pack := func(parent *block.Header, key *ecdsa.PrivateKey) (*block.Block, error) {
+		flow, err := p.Schedule(parent, parent.Timestamp()+thor.BlockInterval)
+		if err != nil {
+			return nil, err
+		}
+		blk, _, _, err := flow.Pack(key)
+		if err != nil {
+			return nil, err
+		}
+		stage, receipts, err := cons.Process(blk, flow.When())
+		if err != nil {
+			return nil, err
+		}
+		if _, err := stage.Commit(); err != nil {
+			return nil, err
+		}
+		if _, err := c.AddBlock(blk, receipts); err != nil {
+			return nil, err
+		}
+		return blk, nil
+	}
+	// re-signs the block by the key, and checks whether it's accepted by consensus
+	resign := func(blk *block.Block, key *ecdsa.PrivateKey) error {
+		sig, _ := crypto.Sign(blk.Header().SigningHash().Bytes(), key)
+		_, _, err := cons.Process(blk.WithSignature(sig), blk.Header().Timestamp())
+		return err
+	}
+
+	// before activated
+	signer, err := p.NodeMaster(b0.Header())
+	assert.Nil(t, err)
+	assert.Equal(t, master.Address, signer)
+	_, err = pack(b0.Header(), nextKey)
+	assert.NotNil(t, err, "signed by the next key before activated")
+	b1, err := pack(b0.Header(), master.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotNil(t, resign(b1, nextKey), "next master not listed before activated")
+
+	// at the activation block
+	signer, err = p.NodeMaster(b1.Header())
+	assert.Nil(t, err)
+	assert.Equal(t, nextMaster, signer)
+	_, err = pack(b1.Header(), master.PrivateKey)
+	assert.NotNil(t, err, "signed by the rotated key after activated")
+	b2, err := pack(b1.Header(), nextKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, activation, b2.Header().Number())
+	assert.NotNil(t, resign(b2, master.PrivateKey), "rotated master unlisted after activated")
+
+	// takes over the candidate in place
+	st, _ := stateCreator.NewState(b2.Header().StateRoot())
+	listed, endorsor, _, _ := builtin.Authority.Native(st).Get(nextMaster)
+	assert.True(t, listed)
+	assert.Equal(t, master.Address, endorsor)
+	listed, _, _, _ = builtin.Authority.Native(st).Get(master.Address)
+	assert.False(t, listed)
+}
+
+// newEndorsableChain creates a chain with all dev accounts listed as authority nodes.
+func newEndorsableChain(t *testing.T) (*chain.Chain, *state.Creator) {
+	kv, _ := lvldb.NewMem()
+	stateCreator := state.NewCreator(kv)
+	b0, _, err := new(genesis.Builder).
+		GasLimit(thor.InitialGasLimit).
+		Timestamp(uint64(time.Now().Unix()) - 1000).
+		State(func(state *state.State) error {
+			state.SetCode(builtin.Authority.Address, builtin.Authority.RuntimeBytecodes())
+			for _, acc := range genesis.DevAccounts() {
+				builtin.Authority.Native(state).Add(acc.Address, acc.Address, thor.Bytes32{})
+			}
+			return nil
+		}).
+		Build(stateCreator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, _ := chain.New(kv, b0)
+	return c, stateCreator
+}
+
+func TestEndorsedPack(t *testing.T) {
+	c, stateCreator := newEndorsableChain(t)
+	b0 := c.GenesisBlock()
+
+	proposer, endorser := genesis.DevAccounts()[0], genesis.DevAccounts()[1]
+	flow, err := packer.New(c, stateCreator, proposer.Address, &proposer.Address).Schedule(b0.Header(), b0.Header().Timestamp())
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposal, err := flow.Propose(proposer.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := packer.New(c, stateCreator, endorser.Address, &endorser.Address).Endorse(proposal, endorser.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, flow.AddEndorsement(e))
+
+	blk, _, _, err := flow.Pack(proposer.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, block.Extension{
+		Alpha:        b0.Header().ID().Bytes(),
+		COM:          true,
+		Endorsements: block.Endorsements{e}.RootHash(),
+	}, blk.Header().Extension())
+
+	_, _, err = consensus.New(c, stateCreator).Process(blk, flow.When())
+	assert.Nil(t, err)
+}
+
+func TestEndorse(t *testing.T) {
+	c, stateCreator := newEndorsableChain(t)
+	b0 := c.GenesisBlock()
+
+	proposer, endorser := genesis.DevAccounts()[0], genesis.DevAccounts()[1]
+	flow, err := packer.New(c, stateCreator, proposer.Address, &proposer.Address).Schedule(b0.Header(), b0.Header().Timestamp())
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposal, err := flow.Propose(proposer.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// rebuilds the proposal with the timestamp and beneficiary, signed by the key
+	rebuild := func(timestamp uint64, beneficiary thor.Address, key *ecdsa.PrivateKey) *block.Header {
+		blk := new(block.Builder).
+			ParentID(proposal.ParentID()).
+			Timestamp(timestamp).
+			TotalScore(proposal.TotalScore()).
+			GasLimit(proposal.GasLimit()).
+			Beneficiary(beneficiary).
+			StateRoot(proposal.StateRoot()).
+			ReceiptsRoot(proposal.ReceiptsRoot()).
+			Build()
+		sig, _ := crypto.Sign(blk.Header().SigningHash().Bytes(), key)
+		return blk.WithSignature(sig).Header()
+	}
+	p := packer.New(c, stateCreator, endorser.Address, &endorser.Address)
+
+	_, err = p.Endorse(rebuild(proposal.Timestamp(), proposal.Beneficiary(), genesis.DevAccounts()[2].PrivateKey), endorser.PrivateKey)
+	assert.NotNil(t, err, "signer unscheduled")
+	_, err = p.Endorse(rebuild(proposal.Timestamp()+1, proposal.Beneficiary(), proposer.PrivateKey), endorser.PrivateKey)
+	assert.NotNil(t, err, "timestamp not rounded")
+	_, err = p.Endorse(rebuild(uint64(time.Now().Unix())+thor.BlockInterval*100, proposal.Beneficiary(), proposer.PrivateKey), endorser.PrivateKey)
+	assert.Equal(t, "proposal in the future", err.Error())
+
+	_, err = p.Endorse(proposal, endorser.PrivateKey)
+	assert.Nil(t, err)
+	_, err = p.Endorse(proposal, endorser.PrivateKey)
+	assert.Nil(t, err, "same proposal endorsed again")
+
+	conflicting := rebuild(proposal.Timestamp(), thor.BytesToAddress([]byte("other")), proposer.PrivateKey)
+	_, err = p.Endorse(conflicting, endorser.PrivateKey)
+	assert.Equal(t, "another proposal upon the parent already endorsed", err.Error())
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package poa
+
+import (
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/builtin/authority"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+)
+
+// LoadCandidates loads candidates of block proposer from state.
+// Only those whose endorsor holds enough endorsement are picked, up to thor.MaxBlockProposers.
+func LoadCandidates(st *state.State) []*authority.Candidate {
+	endorsement := builtin.Params.Native(st).Get(thor.KeyProposerEndorsement)
+	return builtin.Authority.Native(st).Candidates(endorsement, thor.MaxBlockProposers)
+}
+
+// ToProposers converts candidates to proposers.
+func ToProposers(candidates []*authority.Candidate) []Proposer {
+	proposers := make([]Proposer, 0, len(candidates))
+	for _, c := range candidates {
+		proposers = append(proposers, Proposer{
+			Address: c.NodeMaster,
+			Active:  c.Active,
+		})
+	}
+	return proposers
+}
+
+// ApplyUpdates writes status changes of proposers into state.
+func ApplyUpdates(st *state.State, updates []Proposer) {
+	authority := builtin.Authority.Native(st)
+	for _, u := range updates {
+		authority.Update(u.Address, u.Active)
+	}
+}
+
+// ActivateRotations rotates node masters whose rotations are activated at the block with given number.
+// It should be applied to the state of the new block, before candidates loaded.
+func ActivateRotations(st *state.State, blockNumber uint32) []*authority.Rotation {
+	return builtin.Authority.Native(st).ActivateRotations(blockNumber)
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package poa
+
+import (
+	"crypto/ecdsa"
+	"encoding/binary"
+	"errors"
+	"math"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/vechain/thor/builtin/authority"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/vrf"
+)
+
+// IsCommitteeMember returns whether the VRF output selects its owner as committee member,
+// among n candidates. About thor.CommitteeSize members are expected to be selected.
+func IsCommitteeMember(beta []byte, n int) bool {
+	if uint64(n) <= thor.CommitteeSize {
+		return true
+	}
+	if len(beta) < 8 {
+		return false
+	}
+	threshold := math.MaxUint64 / uint64(n) * thor.CommitteeSize
+	return binary.BigEndian.Uint64(beta) < threshold
+}
+
+// VerifyEndorser verifies the endorser is a candidate selected as committee member, by the VRF proof
+// with parent block ID as input.
+func VerifyEndorser(pub *ecdsa.PublicKey, proof []byte, parentID thor.Bytes32, candidates []*authority.Candidate) error {
+	endorser := thor.Address(crypto.PubkeyToAddress(*pub))
+	listed := false
+	for _, c := range candidates {
+		if c.NodeMaster == endorser {
+			listed = true
+			break
+		}
+	}
+	if !listed {
+		return errors.New("unauthorized")
+	}
+
+	beta, err := vrf.Verify(pub, parentID.Bytes(), proof)
+	if err != nil {
+		return err
+	}
+	if !IsCommitteeMember(beta, len(candidates)) {
+		return errors.New("not in committee")
+	}
+	return nil
+}
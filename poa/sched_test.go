@@ -80,3 +80,26 @@ func TestUpdates(t *testing.T) {
 		assert.Equal(t, tt.want, score)
 	}
 }
+
+func TestWhoseTurn(t *testing.T) {
+	sched, _ := poa.NewScheduler(p1, proposers, 1, parentTime)
+
+	nbt := sched.Schedule(parentTime)
+	assert.Equal(t, p1, sched.WhoseTurn(nbt).Address)
+
+	for i := uint64(1); i <= 10; i++ {
+		p := sched.WhoseTurn(parentTime + i*thor.BlockInterval)
+		assert.True(t, p.Address == p1 || p.Address == p2, "inactive proposer should never be scheduled")
+	}
+}
+
+func TestIsCommitteeMember(t *testing.T) {
+	assert.True(t, poa.IsCommitteeMember(nil, int(thor.CommitteeSize)))
+	assert.True(t, poa.IsCommitteeMember(make([]byte, 32), 1000))
+
+	max := make([]byte, 32)
+	for i := range max {
+		max[i] = 0xff
+	}
+	assert.False(t, poa.IsCommitteeMember(max, 1000))
+}
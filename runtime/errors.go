@@ -0,0 +1,40 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package runtime
+
+import "github.com/pkg/errors"
+
+// Errors of tx resolving and gas buying, which can be checked by callers using errors.Cause.
+var (
+	// permanent, the tx can never be executed
+	ErrInvalidSignature     = errors.New("invalid signature")
+	ErrIntrinsicGasExceeded = errors.New("intrinsic gas exceeds provided gas")
+	ErrNegativeValue        = errors.New("clause with negative value")
+	ErrValueTooLarge        = errors.New("tx value too large")
+
+	// temporary, the tx may become executable as the state changes
+	ErrInsufficientEnergy  = errors.New("insufficient energy")
+	ErrInsufficientBalance = errors.New("insufficient balance for clause values")
+)
+
+// IsPermanent returns whether the error indicates the tx can never be executed, regardless of the state.
+func IsPermanent(err error) bool {
+	switch errors.Cause(err) {
+	case ErrInvalidSignature, ErrIntrinsicGasExceeded, ErrNegativeValue, ErrValueTooLarge:
+		return true
+	}
+	return false
+}
+
+// IsTemporary returns whether the error indicates the tx can't be executed on the current state,
+// but may become executable later, e.g. when energy grows.
+func IsTemporary(err error) bool {
+	switch errors.Cause(err) {
+	case ErrInsufficientEnergy, ErrInsufficientBalance:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package runtime
+
+import (
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/vm"
+)
+
+// gasOverrides gas overrides of the private network, nil for the default.
+var gasOverrides *vm.GasOverrides
+
+// SetGasOverrides sets gas overrides of the private network, which must have been validated.
+// They apply to txs resolved afterwards, and runtimes without overrides in VM config, so that
+// tx pool, packer and consensus agree on gas.
+// It should be called at startup, before any tx resolved.
+func SetGasOverrides(overrides *vm.GasOverrides) {
+	gasOverrides = overrides
+}
+
+// intrinsicGasSchedule returns the intrinsic gas schedule of overrides, nil for the default.
+func intrinsicGasSchedule(overrides *vm.GasOverrides) *tx.IntrinsicGasSchedule {
+	if overrides == nil {
+		return nil
+	}
+	return overrides.Intrinsic
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package runtime
+
+import (
+	"context"
+
+	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+// Hook receives execution results of blocks, for in-process plugins such as indexers.
+// For each committed block, OnTxExecuted is called for txs of the block in order, followed by
+// OnBlockCommitted. Calls are serialized, and should return quickly since they block the committing.
+//
+// Blocks of branches are committed too. The fork tells how the trunk changed by committing the block:
+// the block is on trunk if fork.Trunk is not empty, and blocks of fork.Branch are no longer on trunk.
+type Hook interface {
+	OnTxExecuted(executed *ExecutedTx)
+	OnBlockCommitted(blk *block.Block, fork *chain.Fork)
+}
+
+// Hooks combines hooks, which are called in order.
+type Hooks []Hook
+
+// OnTxExecuted implements Hook.
+func (hs Hooks) OnTxExecuted(executed *ExecutedTx) {
+	for _, h := range hs {
+		h.OnTxExecuted(executed)
+	}
+}
+
+// OnBlockCommitted implements Hook.
+func (hs Hooks) OnBlockCommitted(blk *block.Block, fork *chain.Fork) {
+	for _, h := range hs {
+		h.OnBlockCommitted(blk, fork)
+	}
+}
+
+// ExecutedTx execution result of a tx in detail.
+type ExecutedTx struct {
+	Tx      *tx.Transaction
+	Receipt *tx.Receipt
+	Outputs []*Output // outputs of executed clauses, may be shorter than clauses if reverted
+	GasUsed []uint64  // gas used by executed clauses, before refunded
+	Diff    state.Diff
+}
+
+// ExecuteTransactionWithDetail is like ExecuteTransaction, but also collects clause outputs and state changes.
+func (rt *Runtime) ExecuteTransactionWithDetail(tx *tx.Transaction) (*ExecutedTx, error) {
+	mark := rt.state.Mark()
+	executor, err := rt.PrepareTransaction(tx)
+	if err != nil {
+		return nil, err
+	}
+	return executeWithDetail(rt, tx, executor, mark)
+}
+
+// ExecuteTransactionAs is like ExecuteTransactionWithDetail, but the tx is executed as sent by the origin,
+// regardless of its signature, and aborted once ctx is done. It's for simulation only.
+func (rt *Runtime) ExecuteTransactionAs(ctx context.Context, tx *tx.Transaction, origin thor.Address) (*ExecutedTx, error) {
+	mark := rt.state.Mark()
+	executor, err := rt.PrepareTransactionAs(ctx, tx, origin)
+	if err != nil {
+		return nil, err
+	}
+	return executeWithDetail(rt, tx, executor, mark)
+}
+
+func executeWithDetail(rt *Runtime, tx *tx.Transaction, executor *TransactionExecutor, mark int) (*ExecutedTx, error) {
+	var (
+		outputs []*Output
+		gasUsed []uint64
+	)
+	for executor.HasNextClause() {
+		used, output, err := executor.NextClause()
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, output)
+		gasUsed = append(gasUsed, used)
+	}
+	receipt, err := executor.Finalize()
+	if err != nil {
+		return nil, err
+	}
+	return &ExecutedTx{tx, receipt, outputs, gasUsed, rt.state.DiffSince(mark)}, nil
+}
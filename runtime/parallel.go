@@ -0,0 +1,139 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package runtime
+
+import (
+	"time"
+
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+// ParallelExecutor executes txs speculatively in parallel, each on a copy of the state,
+// and commits results in order. A tx is re-executed serially, if it read anything written by
+// preceding txs, or its speculative execution failed.
+// The result is identical to serial execution. It's experimental.
+type ParallelExecutor struct {
+	rt      *Runtime
+	txs     tx.Transactions
+	specs   []*speculation
+	next    int
+	written state.KeySet // keys written by committed txs
+}
+
+// speculation result of speculative execution of a tx.
+type speculation struct {
+	state   *state.State
+	mark    int
+	seeker  *chain.Seeker
+	receipt *tx.Receipt
+	samples []profileSample // recorded once committed
+	err     error
+	done    chan struct{}
+}
+
+// NewParallelExecutor creates a ParallelExecutor for txs, and starts speculative execution
+// with the given count of workers. Txs should be committed in order by calling Next.
+func (rt *Runtime) NewParallelExecutor(txs tx.Transactions, workers int) *ParallelExecutor {
+	if workers < 1 {
+		workers = 1
+	}
+	p := &ParallelExecutor{
+		rt:      rt,
+		txs:     txs,
+		specs:   make([]*speculation, len(txs)),
+		written: make(state.KeySet),
+	}
+
+	jobs := make(chan int, len(txs))
+	for i := range txs {
+		// copies should be made in this goroutine
+		spec := &speculation{
+			state:  rt.state.Copy(),
+			seeker: rt.seeker.Copy(),
+			done:   make(chan struct{}),
+		}
+		spec.mark = spec.state.Mark()
+		spec.state.TrackReads()
+		p.specs[i] = spec
+		jobs <- i
+	}
+	close(jobs)
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			for j := range jobs {
+				p.speculate(j)
+			}
+		}()
+	}
+	return p
+}
+
+func (p *ParallelExecutor) speculate(i int) {
+	spec := p.specs[i]
+	defer close(spec.done)
+
+	rt := &Runtime{
+		vmConfig:    p.rt.vmConfig,
+		seeker:      spec.seeker,
+		state:       spec.state,
+		ctx:         p.rt.ctx,
+		forkConfig:  p.rt.forkConfig,
+		deferReward: true,
+	}
+	if p.rt.profile != nil {
+		rt.profile = func(addr thor.Address, gasUsed uint64, elapsed time.Duration) {
+			spec.samples = append(spec.samples, profileSample{addr, gasUsed, elapsed})
+		}
+	}
+	spec.receipt, spec.err = rt.ExecuteTransaction(p.txs[i])
+}
+
+// HasNext returns whether there are txs not committed.
+func (p *ParallelExecutor) HasNext() bool {
+	return p.next < len(p.txs)
+}
+
+// Next commits the next tx into the state of the runtime, and returns its receipt.
+func (p *ParallelExecutor) Next() (*tx.Receipt, error) {
+	i := p.next
+	p.next++
+	spec := p.specs[i]
+	<-spec.done
+	// release the copy
+	p.specs[i] = nil
+
+	st := p.rt.state
+	if spec.err == nil &&
+		spec.state.Err() == nil &&
+		spec.seeker.Err() == nil &&
+		!spec.state.ReadKeys().Intersects(p.written) {
+
+		st.Merge(spec.state, spec.mark)
+		p.written.Add(spec.state.WrittenKeys(spec.mark))
+		for _, s := range spec.samples {
+			p.rt.profile(s.addr, s.gasUsed, s.elapsed)
+		}
+
+		mark := st.Mark()
+		builtin.Energy.Native(st, p.rt.ctx.Time).Add(p.rt.ctx.Beneficiary, spec.receipt.Reward)
+		p.written.Add(st.WrittenKeys(mark))
+		return spec.receipt, nil
+	}
+
+	// conflicted or failed, re-execute serially
+	mark := st.Mark()
+	receipt, err := p.rt.ExecuteTransaction(p.txs[i])
+	if err != nil {
+		return nil, err
+	}
+	p.written.Add(st.WrittenKeys(mark))
+	return receipt, nil
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package runtime_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/runtime"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/xenv"
+)
+
+func TestParallelExecutor(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	b0, _, _ := genesis.NewDevnet().Build(state.NewCreator(kv))
+	ch, _ := chain.New(kv, b0)
+	accs := genesis.DevAccounts()
+
+	newTx := func(acc genesis.DevAccount, nonce uint64, clauses ...*tx.Clause) *tx.Transaction {
+		builder := new(tx.Builder).ChainTag(ch.Tag()).Gas(100000).Nonce(nonce)
+		for _, c := range clauses {
+			builder.Clause(c)
+		}
+		trx := builder.Build()
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), acc.PrivateKey)
+		return trx.WithSignature(sig)
+	}
+	fresh := thor.BytesToAddress([]byte("fresh"))
+	to := func(i int) *thor.Address {
+		addr := thor.BytesToAddress([]byte{byte(i)})
+		return &addr
+	}
+	txs := tx.Transactions{
+		// independent
+		newTx(accs[0], 1, tx.NewClause(to(1)).WithValue(big.NewInt(1))),
+		newTx(accs[1], 1, tx.NewClause(to(2)).WithValue(big.NewInt(2))),
+		newTx(accs[2], 1, tx.NewClause(&fresh).WithValue(big.NewInt(1e18))),
+		// depends on preceding ones
+		newTx(accs[0], 2, tx.NewClause(to(1)).WithValue(big.NewInt(3))),
+		newTx(accs[3], 1, tx.NewClause(&accs[4].Address).WithValue(big.NewInt(4))),
+		// reverted
+		newTx(accs[4], 1, tx.NewClause(to(5)).WithValue(new(big.Int).Lsh(big.NewInt(1), 200))),
+	}
+
+	ctx := &xenv.BlockContext{
+		Beneficiary: accs[0].Address,
+		Number:      1,
+		Time:        b0.Header().Timestamp() + thor.BlockInterval,
+		GasLimit:    b0.Header().GasLimit(),
+	}
+
+	st1, _ := state.New(b0.Header().StateRoot(), kv)
+	rt1 := runtime.New(ch.NewSeeker(b0.Header().ID()), st1, ctx)
+	var receipts1 tx.Receipts
+	for _, trx := range txs {
+		r, err := rt1.ExecuteTransaction(trx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		receipts1 = append(receipts1, r)
+	}
+
+	for _, workers := range []int{1, 2, 4} {
+		st2, _ := state.New(b0.Header().StateRoot(), kv)
+		rt2 := runtime.New(ch.NewSeeker(b0.Header().ID()), st2, ctx)
+		pe := rt2.NewParallelExecutor(txs, workers)
+		var receipts2 tx.Receipts
+		for pe.HasNext() {
+			r, err := pe.Next()
+			if err != nil {
+				t.Fatal(err)
+			}
+			receipts2 = append(receipts2, r)
+		}
+		assert.Nil(t, st2.Err())
+		assert.Equal(t, receipts1.RootHash(), receipts2.RootHash())
+
+		root1, _ := st1.Stage().Hash()
+		root2, _ := st2.Stage().Hash()
+		assert.Equal(t, root1, root2, "workers %v", workers)
+	}
+	assert.True(t, receipts1[5].Reverted)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package runtime
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/vechain/thor/thor"
+)
+
+var (
+	// upper bounds of histogram buckets, values beyond the last one fall into an extra bucket
+	gasBuckets  = []uint64{10000, 50000, 100000, 500000, 1000000, 5000000}
+	timeBuckets = []uint64{100, 1000, 10000, 100000, 1000000} // in microseconds
+
+	// maxContractProfiles limits count of contracts profiled. Once reached, the contract of the least gas used
+	// is dropped for a new one.
+	maxContractProfiles = 1000
+
+	profiling struct {
+		enabled   uint32
+		lock      sync.Mutex
+		contracts map[thor.Address]*ContractProfile
+	}
+)
+
+// Histogram counts values into buckets.
+type Histogram struct {
+	Bounds []uint64 `json:"bounds"`
+	Counts []uint64 `json:"counts"`
+	Sum    uint64   `json:"sum"`
+}
+
+func newHistogram(bounds []uint64) Histogram {
+	return Histogram{
+		Bounds: bounds,
+		Counts: make([]uint64, len(bounds)+1),
+	}
+}
+
+func (h *Histogram) add(v uint64) {
+	i := sort.Search(len(h.Bounds), func(i int) bool { return v <= h.Bounds[i] })
+	h.Counts[i]++
+	h.Sum += v
+}
+
+// ContractProfile execution statistics of clauses calling a contract.
+type ContractProfile struct {
+	Address thor.Address `json:"address"`
+	Count   uint64       `json:"count"`
+	Gas     Histogram    `json:"gas"`
+	Time    Histogram    `json:"time"` // in microseconds
+}
+
+// EnableProfiling turns on/off collecting of contract execution profiles, of clauses executed by
+// runtimes set to be profiled. Collected profiles are kept until ResetProfiles called.
+func EnableProfiling(enabled bool) {
+	if enabled {
+		atomic.StoreUint32(&profiling.enabled, 1)
+	} else {
+		atomic.StoreUint32(&profiling.enabled, 0)
+	}
+}
+
+func profilingEnabled() bool {
+	return atomic.LoadUint32(&profiling.enabled) != 0
+}
+
+// Profiles returns copies of collected contract profiles, in descending order of total gas used.
+func Profiles() []*ContractProfile {
+	profiling.lock.Lock()
+	defer profiling.lock.Unlock()
+
+	profiles := make([]*ContractProfile, 0, len(profiling.contracts))
+	for _, p := range profiling.contracts {
+		cpy := *p
+		cpy.Gas.Counts = append([]uint64(nil), p.Gas.Counts...)
+		cpy.Time.Counts = append([]uint64(nil), p.Time.Counts...)
+		profiles = append(profiles, &cpy)
+	}
+	sort.Slice(profiles, func(i, j int) bool {
+		return profiles[i].Gas.Sum > profiles[j].Gas.Sum
+	})
+	return profiles
+}
+
+// ResetProfiles clears collected profiles.
+func ResetProfiles() {
+	profiling.lock.Lock()
+	defer profiling.lock.Unlock()
+	profiling.contracts = nil
+}
+
+func recordProfile(addr thor.Address, gasUsed uint64, elapsed time.Duration) {
+	profiling.lock.Lock()
+	defer profiling.lock.Unlock()
+
+	if profiling.contracts == nil {
+		profiling.contracts = make(map[thor.Address]*ContractProfile)
+	}
+	p, ok := profiling.contracts[addr]
+	if !ok {
+		if len(profiling.contracts) >= maxContractProfiles {
+			var least *ContractProfile
+			for _, c := range profiling.contracts {
+				if least == nil || c.Gas.Sum < least.Gas.Sum {
+					least = c
+				}
+			}
+			delete(profiling.contracts, least.Address)
+		}
+		p = &ContractProfile{
+			Address: addr,
+			Gas:     newHistogram(gasBuckets),
+			Time:    newHistogram(timeBuckets),
+		}
+		profiling.contracts[addr] = p
+	}
+	p.Count++
+	p.Gas.add(gasUsed)
+	p.Time.add(uint64(elapsed / time.Microsecond))
+}
+
+// profileSample a profiled clause execution.
+type profileSample struct {
+	addr    thor.Address
+	gasUsed uint64
+	elapsed time.Duration
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/thor"
+)
+
+func TestProfilesLimit(t *testing.T) {
+	defer func(n int) { maxContractProfiles = n }(maxContractProfiles)
+	maxContractProfiles = 3
+	ResetProfiles()
+	defer ResetProfiles()
+
+	for i := 1; i <= 3; i++ {
+		recordProfile(thor.BytesToAddress([]byte{byte(i)}), uint64(i*100), 0)
+	}
+	// the least one dropped
+	recordProfile(thor.BytesToAddress([]byte{4}), 50, 0)
+	profiles := Profiles()
+	assert.Equal(t, 3, len(profiles))
+	assert.Equal(t, thor.BytesToAddress([]byte{3}), profiles[0].Address)
+	assert.Equal(t, thor.BytesToAddress([]byte{2}), profiles[1].Address)
+	assert.Equal(t, thor.BytesToAddress([]byte{4}), profiles[2].Address)
+
+	// known ones are still recorded
+	recordProfile(thor.BytesToAddress([]byte{4}), 500, 0)
+	profiles = Profiles()
+	assert.Equal(t, 3, len(profiles))
+	assert.Equal(t, thor.BytesToAddress([]byte{4}), profiles[0].Address)
+}
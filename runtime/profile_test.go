@@ -0,0 +1,70 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package runtime_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/runtime"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/xenv"
+)
+
+func TestProfiles(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	b0, _, _ := genesis.NewDevnet().Build(state.NewCreator(kv))
+	ch, _ := chain.New(kv, b0)
+	st, _ := state.New(b0.Header().StateRoot(), kv)
+
+	rt := runtime.New(ch.NewSeeker(b0.Header().ID()), st, &xenv.BlockContext{}).SetProfiled(true)
+
+	method, _ := builtin.Params.ABI.MethodByName("executor")
+	data, _ := method.EncodeInput()
+	to := thor.BytesToAddress([]byte("to"))
+	call := func() {
+		rt.ExecuteClause(tx.NewClause(&builtin.Params.Address).WithData(data), 0, math.MaxUint64, &xenv.TransactionContext{})
+		rt.ExecuteClause(tx.NewClause(&to), 0, math.MaxUint64, &xenv.TransactionContext{})
+	}
+
+	runtime.ResetProfiles()
+	call()
+	assert.Equal(t, 0, len(runtime.Profiles()), "disabled by default")
+
+	runtime.EnableProfiling(true)
+	defer runtime.EnableProfiling(false)
+	call()
+	call()
+
+	profiles := runtime.Profiles()
+	assert.Equal(t, 1, len(profiles), "plain transfer not profiled")
+	p := profiles[0]
+	assert.Equal(t, builtin.Params.Address, p.Address)
+	assert.Equal(t, uint64(2), p.Count)
+	assert.NotZero(t, p.Gas.Sum)
+	assert.Equal(t, len(p.Gas.Bounds)+1, len(p.Gas.Counts))
+
+	var n uint64
+	for _, c := range p.Time.Counts {
+		n += c
+	}
+	assert.Equal(t, p.Count, n)
+
+	runtime.ResetProfiles()
+	assert.Equal(t, 0, len(runtime.Profiles()))
+
+	// not profiled, e.g. simulations
+	rt.SetProfiled(false)
+	call()
+	assert.Equal(t, 0, len(runtime.Profiles()))
+}
@@ -6,6 +6,7 @@
 package runtime
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common/math"
@@ -26,17 +27,38 @@ type ResolvedTransaction struct {
 }
 
 // ResolveTransaction resolves the transaction and performs basic validation.
+// Intrinsic gas is calculated by the schedule of the network.
 func ResolveTransaction(tx *tx.Transaction) (*ResolvedTransaction, error) {
+	return resolveTransaction(tx, intrinsicGasSchedule(gasOverrides))
+}
+
+// resolveTransaction resolves the transaction, with intrinsic gas calculated by the schedule.
+// Nil schedule means the default.
+func resolveTransaction(tx *tx.Transaction, schedule *tx.IntrinsicGasSchedule) (*ResolvedTransaction, error) {
 	origin, err := tx.Signer()
 	if err != nil {
-		return nil, err
+		return nil, errors.WithMessage(ErrInvalidSignature, err.Error())
+	}
+	return resolveTransactionAs(tx, origin, schedule)
+}
+
+// resolveTransactionAs is like resolveTransaction, but the origin is given rather than recovered from signature.
+func resolveTransactionAs(tx *tx.Transaction, origin thor.Address, schedule *tx.IntrinsicGasSchedule) (*ResolvedTransaction, error) {
+	var (
+		intrinsicGas uint64
+		err          error
+	)
+	if schedule == nil {
+		intrinsicGas, err = tx.IntrinsicGas()
+	} else {
+		intrinsicGas, err = schedule.IntrinsicGas(tx.Clauses()...)
 	}
-	intrinsicGas, err := tx.IntrinsicGas()
 	if err != nil {
-		return nil, err
+		// overflowed
+		return nil, errors.WithMessage(ErrIntrinsicGasExceeded, err.Error())
 	}
 	if tx.Gas() < intrinsicGas {
-		return nil, errors.New("intrinsic gas exceeds provided gas")
+		return nil, ErrIntrinsicGasExceeded
 	}
 
 	clauses := tx.Clauses()
@@ -44,12 +66,12 @@ func ResolveTransaction(tx *tx.Transaction) (*ResolvedTransaction, error) {
 	for _, clause := range clauses {
 		value := clause.Value()
 		if value.Sign() < 0 {
-			return nil, errors.New("clause with negative value")
+			return nil, ErrNegativeValue
 		}
 
 		sumValue.Add(sumValue, value)
 		if sumValue.Cmp(math.MaxBig256) > 0 {
-			return nil, errors.New("tx value too large")
+			return nil, ErrValueTooLarge
 		}
 	}
 
@@ -85,7 +107,38 @@ func (r *ResolvedTransaction) CommonTo() *thor.Address {
 	return firstTo
 }
 
+// CheckBalance checks whether the balance of origin covers values of clauses, to fail fast
+// rather than reverting after gas burnt. Clauses are replayed in order, value sent to the origin
+// itself stays in the balance, and checking stops after a clause to a contract or creating one,
+// since contracts may send VET back to the origin.
+// It's a pre-check of tx pool and packer, rather than a consensus rule.
+func (r *ResolvedTransaction) CheckBalance(state *state.State) error {
+	balance := new(big.Int).Set(state.GetBalance(r.Origin))
+	for i, clause := range r.Clauses {
+		value := clause.Value()
+		if value.Cmp(balance) > 0 {
+			return errors.WithMessage(ErrInsufficientBalance, fmt.Sprintf("clause #%v", i))
+		}
+		to := clause.To()
+		if to == nil || len(state.GetCode(*to)) > 0 {
+			break
+		}
+		if *to != r.Origin {
+			balance.Sub(balance, value)
+		}
+	}
+	return nil
+}
+
 // BuyGas consumes energy to buy gas, to prepare for execution.
+//
+// The payer, which is recorded as GasPayer of the receipt, is determined in order of precedence:
+//  1. the current sponsor of the contract, if it's still sponsoring and affords the prepaid energy;
+//  2. the contract itself, if it affords the prepaid energy;
+//  3. the origin.
+//
+// The first two apply only if all clauses are sent to the same contract, and the origin's user
+// credit of the contract covers the prepaid energy. The credit is then reduced by energy used.
 func (r *ResolvedTransaction) BuyGas(state *state.State, blockTime uint64) (
 	baseGasPrice *big.Int,
 	gasPrice *big.Int,
@@ -103,8 +156,7 @@ func (r *ResolvedTransaction) BuyGas(state *state.State, blockTime uint64) (
 	}
 
 	prepaid := new(big.Int).Mul(new(big.Int).SetUint64(r.tx.Gas()), gasPrice)
-	commonTo := r.CommonTo()
-	if commonTo != nil {
+	if commonTo := r.CommonTo(); commonTo != nil {
 		binding := builtin.Prototype.Native(state).Bind(*commonTo)
 		credit := binding.UserCredit(r.Origin, blockTime)
 		if credit.Cmp(prepaid) >= 0 {
@@ -113,16 +165,16 @@ func (r *ResolvedTransaction) BuyGas(state *state.State, blockTime uint64) (
 				usedEnergy := new(big.Int).Sub(prepaid, returnedEnergy)
 				binding.SetUserCredit(r.Origin, new(big.Int).Sub(credit, usedEnergy), blockTime)
 			}
-			// has enough credit
+
+			candidates := make([]thor.Address, 0, 2)
 			if sponsor := binding.CurrentSponsor(); binding.IsSponsor(sponsor) {
-				// deduct from sponsor, if any
-				if energy.Sub(sponsor, prepaid) {
-					return baseGasPrice, gasPrice, sponsor, doReturnGasAndSetCredit, nil
-				}
+				candidates = append(candidates, sponsor)
 			}
-			// deduct from To
-			if energy.Sub(*commonTo, prepaid) {
-				return baseGasPrice, gasPrice, *commonTo, doReturnGasAndSetCredit, nil
+			candidates = append(candidates, *commonTo)
+			for _, candidate := range candidates {
+				if energy.Sub(candidate, prepaid) {
+					return baseGasPrice, gasPrice, candidate, doReturnGasAndSetCredit, nil
+				}
 			}
 		}
 	}
@@ -131,7 +183,7 @@ func (r *ResolvedTransaction) BuyGas(state *state.State, blockTime uint64) (
 	if energy.Sub(r.Origin, prepaid) {
 		return baseGasPrice, gasPrice, r.Origin, func(rgas uint64) { doReturnGas(rgas) }, nil
 	}
-	return nil, nil, thor.Address{}, nil, errors.New("insufficient energy")
+	return nil, nil, thor.Address{}, nil, ErrInsufficientEnergy
 }
 
 // ToContext create a tx context object.
@@ -8,7 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/crypto/secp256k1"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/vechain/thor/builtin"
 	"github.com/vechain/thor/chain"
@@ -80,20 +80,25 @@ func (tr *testResolvedTransaction) TestResolveTransaction() {
 	}
 
 	_, err := runtime.ResolveTransaction(txBuild().Build())
-	tr.assert.Equal(secp256k1.ErrInvalidSignatureLen, err)
+	tr.assert.Equal(runtime.ErrInvalidSignature, errors.Cause(err))
+	tr.assert.True(runtime.IsPermanent(err))
 
 	_, err = runtime.ResolveTransaction(txSign(txBuild().Gas(21000 - 1)))
-	tr.assert.NotNil(err)
+	tr.assert.Equal(runtime.ErrIntrinsicGasExceeded, errors.Cause(err))
+	tr.assert.True(runtime.IsPermanent(err))
 
 	address := thor.BytesToAddress([]byte("addr"))
 	_, err = runtime.ResolveTransaction(txSign(txBuild().Clause(tx.NewClause(&address).WithValue(big.NewInt(-10)).WithData(nil))))
-	tr.assert.NotNil(err)
+	tr.assert.Equal(runtime.ErrNegativeValue, errors.Cause(err))
+	tr.assert.True(runtime.IsPermanent(err))
 
 	_, err = runtime.ResolveTransaction(txSign(txBuild().
 		Clause(tx.NewClause(&address).WithValue(math.MaxBig256).WithData(nil)).
 		Clause(tx.NewClause(&address).WithValue(math.MaxBig256).WithData(nil)),
 	))
-	tr.assert.NotNil(err)
+	tr.assert.Equal(runtime.ErrValueTooLarge, errors.Cause(err))
+	tr.assert.True(runtime.IsPermanent(err))
+	tr.assert.False(runtime.IsTemporary(err))
 
 	_, err = runtime.ResolveTransaction(txSign(txBuild()))
 	tr.assert.Nil(err)
@@ -173,6 +178,111 @@ func (tr *testResolvedTransaction) TestBuyGas() {
 	)
 }
 
+func (tr *testResolvedTransaction) TestGasPayerPrecedence() {
+	var (
+		origin    = genesis.DevAccounts()[0].Address
+		rich      = genesis.DevAccounts()[1].Address
+		sponsor   = genesis.DevAccounts()[2].Address
+		poor      = thor.BytesToAddress([]byte("poor"))
+		other     = genesis.DevAccounts()[3].Address
+		blockTime = tr.chain.BestBlock().Header().Timestamp() + thor.BlockInterval
+	)
+
+	cases := []struct {
+		name     string
+		to       []thor.Address
+		credit   *big.Int // credit plan of the contract, nil if origin is not a user
+		sponsor  *thor.Address
+		sponsors bool
+		payer    thor.Address
+	}{
+		{"no common to", []thor.Address{rich, other}, math.MaxBig256, nil, false, origin},
+		{"not a user", []thor.Address{rich}, nil, nil, false, origin},
+		{"insufficient credit", []thor.Address{rich}, big.NewInt(1), nil, false, origin},
+		{"contract", []thor.Address{rich, rich}, math.MaxBig256, nil, false, rich},
+		{"contract unaffordable", []thor.Address{poor}, math.MaxBig256, nil, false, origin},
+		{"sponsor", []thor.Address{rich}, math.MaxBig256, &sponsor, true, sponsor},
+		{"sponsor unaffordable", []thor.Address{rich}, math.MaxBig256, &poor, true, rich},
+		{"sponsor quit", []thor.Address{rich}, math.MaxBig256, &sponsor, false, rich},
+		{"sponsor and contract unaffordable", []thor.Address{poor}, math.MaxBig256, &poor, true, origin},
+	}
+
+	for _, c := range cases {
+		state, err := tr.currentState()
+		if err != nil {
+			tr.t.Fatal(err)
+		}
+		for _, to := range c.to {
+			bind := builtin.Prototype.Native(state).Bind(to)
+			if c.credit != nil {
+				bind.SetCreditPlan(c.credit, big.NewInt(0))
+				bind.AddUser(origin, blockTime)
+			}
+			if c.sponsor != nil {
+				bind.Sponsor(*c.sponsor, true)
+				bind.SelectSponsor(*c.sponsor)
+				bind.Sponsor(*c.sponsor, c.sponsors)
+			}
+		}
+
+		builder := txBuilder(tr.chain.Tag())
+		for _, to := range c.to {
+			builder.Clause(tx.NewClause(&to))
+		}
+		resolve, err := runtime.ResolveTransaction(txSign(builder))
+		if err != nil {
+			tr.t.Fatal(err)
+		}
+		_, _, payer, returnGas, err := resolve.BuyGas(state, blockTime)
+		tr.assert.Nil(err, c.name)
+		tr.assert.Equal(c.payer, payer, c.name)
+
+		returnGas(0)
+		if payer != origin {
+			// credit reduced by used energy
+			bind := builtin.Prototype.Native(state).Bind(c.to[0])
+			tr.assert.True(bind.UserCredit(origin, blockTime).Cmp(c.credit) < 0, c.name)
+		}
+	}
+}
+
+func (tr *testResolvedTransaction) TestCheckBalance() {
+	state, err := tr.currentState()
+	if err != nil {
+		tr.t.Fatal(err)
+	}
+	origin := genesis.DevAccounts()[0].Address
+	to := thor.BytesToAddress([]byte("to"))
+	state.SetBalance(origin, big.NewInt(100))
+
+	check := func(values ...int64) error {
+		return tr.checkBalance(state, to, values...)
+	}
+	tr.assert.Nil(check(60, 40))
+	tr.assert.Equal(runtime.ErrInsufficientBalance, errors.Cause(check(60, 41)))
+	tr.assert.Equal(runtime.ErrInsufficientBalance, errors.Cause(check(101)))
+	tr.assert.True(runtime.IsTemporary(check(101)))
+	tr.assert.False(runtime.IsPermanent(check(101)))
+
+	// to origin itself
+	tr.assert.Nil(tr.checkBalance(state, origin, 100, 100))
+
+	// contracts may send VET back
+	tr.assert.Nil(tr.checkBalance(state, builtin.Energy.Address, 100, 100))
+}
+
+func (tr *testResolvedTransaction) checkBalance(state *state.State, to thor.Address, values ...int64) error {
+	builder := txBuilder(tr.chain.Tag())
+	for _, v := range values {
+		builder.Clause(tx.NewClause(&to).WithValue(big.NewInt(v)))
+	}
+	resolve, err := runtime.ResolveTransaction(txSign(builder))
+	if err != nil {
+		tr.t.Fatal(err)
+	}
+	return resolve.CheckBalance(state)
+}
+
 func clause() *tx.Clause {
 	address := genesis.DevAccounts()[1].Address
 	return tx.NewClause(&address).WithData(nil)
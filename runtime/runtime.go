@@ -6,15 +6,19 @@
 package runtime
 
 import (
+	"context"
 	"math/big"
 	"sync/atomic"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/inconshreveable/log15"
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/abi"
 	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/builtin/authority"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/runtime/statedb"
 	"github.com/vechain/thor/state"
@@ -26,9 +30,14 @@ import (
 )
 
 var (
+	log                     = log15.New("pkg", "runtime")
 	energyTransferEvent     *abi.Event
 	prototypeSetMasterEvent *abi.Event
 	nativeCallReturnGas     uint64 = 1562 // see test case for calculation
+	// with warm/cold access, call and extcodesize of the builtin itself cost warm access gas
+	nativeCallReturnGasWarmCold = nativeCallReturnGas -
+		(params.GasTableEIP150.Calls - vm.WarmStorageReadCost) -
+		(params.GasTableEIP150.ExtcodeSize - vm.WarmStorageReadCost)
 )
 
 func init() {
@@ -80,6 +89,11 @@ type Runtime struct {
 	state      *state.State
 	ctx        *xenv.BlockContext
 	forkConfig thor.ForkConfig
+
+	deferReward bool // reward of tx not added to the beneficiary, but left to the caller
+
+	// records profile of clause execution, nil if not profiled
+	profile func(addr thor.Address, gasUsed uint64, elapsed time.Duration)
 }
 
 // New create a Runtime object.
@@ -89,9 +103,10 @@ func New(
 	ctx *xenv.BlockContext,
 ) *Runtime {
 	rt := Runtime{
-		seeker: seeker,
-		state:  state,
-		ctx:    ctx,
+		vmConfig: vm.Config{GasOverrides: gasOverrides},
+		seeker:   seeker,
+		state:    state,
+		ctx:      ctx,
 	}
 	if seeker != nil {
 		rt.forkConfig = thor.GetForkConfig(seeker.GenesisID())
@@ -106,13 +121,47 @@ func (rt *Runtime) Seeker() *chain.Seeker       { return rt.seeker }
 func (rt *Runtime) State() *state.State         { return rt.state }
 func (rt *Runtime) Context() *xenv.BlockContext { return rt.ctx }
 
-// SetVMConfig config VM.
+// SetVMConfig config VM. Gas overrides of the network are kept if the config has none.
 // Returns this runtime.
 func (rt *Runtime) SetVMConfig(config vm.Config) *Runtime {
+	if config.GasOverrides == nil {
+		config.GasOverrides = gasOverrides
+	}
 	rt.vmConfig = config
 	return rt
 }
 
+// SetProfiled sets whether clauses executed by the runtime are profiled, when profiling enabled.
+// It's for runtimes executing blocks, rather than simulations.
+// Returns this runtime.
+func (rt *Runtime) SetProfiled(profiled bool) *Runtime {
+	if profiled {
+		rt.profile = recordProfile
+	} else {
+		rt.profile = nil
+	}
+	return rt
+}
+
+// NewAccessList creates the access list for a tx with given origin and clauses, with the origin,
+// recipients and precompiled contracts warm. Nil returned if warm/cold access pricing not enabled.
+func (rt *Runtime) NewAccessList(origin thor.Address, clauses []*tx.Clause) *vm.AccessList {
+	if rt.ctx.Number < rt.forkConfig.WarmColdAccess {
+		return nil
+	}
+	al := vm.NewAccessList()
+	al.AddAddress(common.Address(origin))
+	for _, clause := range clauses {
+		if to := clause.To(); to != nil {
+			al.AddAddress(common.Address(*to))
+		}
+	}
+	for addr := range vm.PrecompiledContractsByzantium {
+		al.AddAddress(addr)
+	}
+	return al
+}
+
 func (rt *Runtime) newEVM(stateDB *statedb.StateDB, clauseIndex uint32, txCtx *xenv.TransactionContext) *vm.EVM {
 	var lastNonNativeCallGas uint64
 	return vm.NewEVM(vm.Context{
@@ -182,7 +231,11 @@ func (rt *Runtime) newEVM(stateDB *statedb.StateDB, clauseIndex uint32, txCtx *x
 
 			// here we return call gas and extcodeSize gas for native calls, to make
 			// builtin contract cheap.
-			contract.Gas += nativeCallReturnGas
+			if evm.WarmColdAccess {
+				contract.Gas += nativeCallReturnGasWarmCold
+			} else {
+				contract.Gas += nativeCallReturnGas
+			}
 			if contract.Gas > lastNonNativeCallGas {
 				panic("serious bug: native call returned gas over consumed")
 			}
@@ -206,8 +259,27 @@ func (rt *Runtime) newEVM(stateDB *statedb.StateDB, clauseIndex uint32, txCtx *x
 			})
 		},
 		OnSuicideContract: func(_ *vm.EVM, contractAddr, tokenReceiver common.Address) {
+			var (
+				fixed = rt.ctx.Number >= rt.forkConfig.FixSuicide
+				// selfdestruct to itself leaves no heir, then VET and energy are destroyed along with
+				// the contract, and recorded as burned.
+				noHeir = fixed && contractAddr == tokenReceiver
+			)
+			if noHeir {
+				builtin.Energy.Native(rt.state, rt.ctx.Time).BurnToken(rt.state.GetBalance(thor.Address(contractAddr)))
+			}
+			if fixed && !noHeir {
+				// settle grown energy of the heir, before its balance changed
+				rt.state.SetEnergy(thor.Address(tokenReceiver),
+					rt.state.GetEnergy(thor.Address(tokenReceiver), rt.ctx.Time), rt.ctx.Time)
+			}
+
 			// it's IMPORTANT to process energy before token
 			if amount := rt.state.GetEnergy(thor.Address(contractAddr), rt.ctx.Time); amount.Sign() != 0 {
+				if noHeir {
+					builtin.Energy.Native(rt.state, rt.ctx.Time).Sub(thor.Address(contractAddr), amount)
+					return
+				}
 				// add remained energy of suiciding contract to receiver.
 				// no need to clear contract's energy, vm will delete the whole contract later.
 				rt.state.SetEnergy(
@@ -234,6 +306,9 @@ func (rt *Runtime) newEVM(stateDB *statedb.StateDB, clauseIndex uint32, txCtx *x
 				})
 			}
 
+			if noHeir {
+				return
+			}
 			if amount := stateDB.GetBalance(contractAddr); amount.Sign() != 0 {
 				stateDB.AddBalance(tokenReceiver, amount)
 
@@ -251,6 +326,8 @@ func (rt *Runtime) newEVM(stateDB *statedb.StateDB, clauseIndex uint32, txCtx *x
 		BlockNumber: new(big.Int).SetUint64(uint64(rt.ctx.Number)),
 		Time:        new(big.Int).SetUint64(rt.ctx.Time),
 		Difficulty:  &big.Int{},
+
+		WarmColdAccess: txCtx.AccessList != nil,
 	}, stateDB, &chainConfig, rt.vmConfig)
 }
 
@@ -266,6 +343,37 @@ func (rt *Runtime) ExecuteClause(
 	return output
 }
 
+// ExecuteClauseContext is like ExecuteClause, but aborts execution once ctx is done.
+// The error of ctx is returned if aborted, and state changes should be discarded.
+func (rt *Runtime) ExecuteClauseContext(
+	ctx context.Context,
+	clause *tx.Clause,
+	clauseIndex uint32,
+	gas uint64,
+	txCtx *xenv.TransactionContext,
+) (*Output, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	exec, interrupt := rt.PrepareClause(clause, clauseIndex, gas, txCtx)
+	if ctx.Done() != nil {
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			select {
+			case <-ctx.Done():
+				interrupt()
+			case <-done:
+			}
+		}()
+	}
+	output, interrupted := exec()
+	if interrupted {
+		return nil, ctx.Err()
+	}
+	return output, nil
+}
+
 // PrepareClause prepare to execute clause.
 // It allows to interrupt execution.
 func (rt *Runtime) PrepareClause(
@@ -283,8 +391,13 @@ func (rt *Runtime) PrepareClause(
 		contractAddr  *thor.Address
 		interruptFlag uint32
 	)
+	stateDB.SetAccessList(txCtx.AccessList)
 
 	exec = func() (*Output, bool) {
+		// plain transfers are not profiled
+		profile := rt.profile != nil && profilingEnabled() && (clause.To() == nil || len(rt.state.GetCode(*clause.To())) > 0)
+		start := time.Now()
+
 		if clause.To() == nil {
 			var caddr common.Address
 			data, caddr, leftOverGas, vmErr = evm.Create(vm.AccountRef(txCtx.Origin), clause.Data(), gas, clause.Value())
@@ -293,7 +406,16 @@ func (rt *Runtime) PrepareClause(
 			data, leftOverGas, vmErr = evm.Call(vm.AccountRef(txCtx.Origin), common.Address(*clause.To()), clause.Data(), gas, clause.Value())
 		}
 
+		if profile {
+			target := clause.To()
+			if target == nil {
+				target = contractAddr
+			}
+			rt.profile(*target, gas-leftOverGas, time.Since(start))
+		}
+
 		interrupted := atomic.LoadUint32(&interruptFlag) != 0
+		stateDB.CommitAccessList()
 		output := &Output{
 			Data:            data,
 			LeftOverGas:     leftOverGas,
@@ -315,7 +437,18 @@ func (rt *Runtime) PrepareClause(
 // ExecuteTransaction executes a transaction.
 // If some clause failed, receipt.Outputs will be nil and vmOutputs may shorter than clause count.
 func (rt *Runtime) ExecuteTransaction(tx *tx.Transaction) (receipt *tx.Receipt, err error) {
-	executor, err := rt.PrepareTransaction(tx)
+	return rt.ExecuteTransactionContext(context.Background(), tx)
+}
+
+// ExecuteTransactionContext is like ExecuteTransaction, but aborts execution once ctx is done.
+// The error of ctx is returned if aborted, and state changes should be discarded.
+func (rt *Runtime) ExecuteTransactionContext(ctx context.Context, tx *tx.Transaction) (receipt *tx.Receipt, err error) {
+	defer func() {
+		if err != nil {
+			log.Debug("failed to execute tx", "number", rt.ctx.Number, "txid", tx.ID(), "err", err)
+		}
+	}()
+	executor, err := rt.PrepareTransactionContext(ctx, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -329,11 +462,30 @@ func (rt *Runtime) ExecuteTransaction(tx *tx.Transaction) (receipt *tx.Receipt,
 
 // PrepareTransaction prepare to execute tx.
 func (rt *Runtime) PrepareTransaction(tx *tx.Transaction) (*TransactionExecutor, error) {
-	resolvedTx, err := ResolveTransaction(tx)
+	return rt.PrepareTransactionContext(context.Background(), tx)
+}
+
+// PrepareTransactionContext is like PrepareTransaction, but clauses are aborted once ctx is done.
+func (rt *Runtime) PrepareTransactionContext(ctx context.Context, tx *tx.Transaction) (*TransactionExecutor, error) {
+	resolvedTx, err := resolveTransaction(tx, intrinsicGasSchedule(rt.vmConfig.GasOverrides))
 	if err != nil {
 		return nil, err
 	}
+	return rt.prepareResolvedTransaction(ctx, resolvedTx)
+}
+
+// PrepareTransactionAs is like PrepareTransactionContext, but the tx is executed as sent by the origin,
+// regardless of its signature. It's for simulation only, e.g. of unsigned txs.
+func (rt *Runtime) PrepareTransactionAs(ctx context.Context, tx *tx.Transaction, origin thor.Address) (*TransactionExecutor, error) {
+	resolvedTx, err := resolveTransactionAs(tx, origin, intrinsicGasSchedule(rt.vmConfig.GasOverrides))
+	if err != nil {
+		return nil, err
+	}
+	return rt.prepareResolvedTransaction(ctx, resolvedTx)
+}
 
+func (rt *Runtime) prepareResolvedTransaction(ctx context.Context, resolvedTx *ResolvedTransaction) (*TransactionExecutor, error) {
+	tx := resolvedTx.tx
 	baseGasPrice, gasPrice, payer, returnGas, err := resolvedTx.BuyGas(rt.state, rt.ctx.Time)
 	if err != nil {
 		return nil, err
@@ -345,11 +497,15 @@ func (rt *Runtime) PrepareTransaction(tx *tx.Transaction) (*TransactionExecutor,
 	checkpoint := rt.state.NewCheckpoint()
 
 	txCtx := resolvedTx.ToContext(gasPrice, rt.ctx.Number, rt.seeker.GetID)
+	txCtx.AccessList = rt.NewAccessList(resolvedTx.Origin, resolvedTx.Clauses)
 
 	txOutputs := make([]*Tx.Output, 0, len(resolvedTx.Clauses))
 	reverted := false
 	finalized := false
 
+	txRefundCap := rt.ctx.Number >= rt.forkConfig.TxRefundCap
+	totalRefund := uint64(0)
+
 	hasNext := func() bool {
 		return !reverted && len(txOutputs) < len(resolvedTx.Clauses)
 	}
@@ -361,18 +517,26 @@ func (rt *Runtime) PrepareTransaction(tx *tx.Transaction) (*TransactionExecutor,
 				return 0, nil, errors.New("no more clause")
 			}
 			nextClauseIndex := uint32(len(txOutputs))
-			output = rt.ExecuteClause(resolvedTx.Clauses[nextClauseIndex], nextClauseIndex, leftOverGas, txCtx)
+			output, err = rt.ExecuteClauseContext(ctx, resolvedTx.Clauses[nextClauseIndex], nextClauseIndex, leftOverGas, txCtx)
+			if err != nil {
+				return 0, nil, err
+			}
 			gasUsed = leftOverGas - output.LeftOverGas
 			leftOverGas = output.LeftOverGas
 
-			// Apply refund counter, capped to half of the used gas.
-			refund := gasUsed / 2
-			if refund > output.RefundGas {
-				refund = output.RefundGas
-			}
+			if txRefundCap {
+				// refund counters are accumulated, and applied when finalizing
+				totalRefund += output.RefundGas
+			} else {
+				// Apply refund counter, capped to half of the used gas.
+				refund := gasUsed / 2
+				if refund > output.RefundGas {
+					refund = output.RefundGas
+				}
 
-			// won't overflow
-			leftOverGas += refund
+				// won't overflow
+				leftOverGas += refund
+			}
 
 			if output.VMErr != nil {
 				// vm exception here
@@ -380,6 +544,8 @@ func (rt *Runtime) PrepareTransaction(tx *tx.Transaction) (*TransactionExecutor,
 				rt.state.RevertTo(checkpoint)
 				reverted = true
 				txOutputs = nil
+				// refunds go along with the reverted state changes
+				totalRefund = 0
 				return
 			}
 			txOutputs = append(txOutputs, &Tx.Output{Events: output.Events, Transfers: output.Transfers})
@@ -394,6 +560,19 @@ func (rt *Runtime) PrepareTransaction(tx *tx.Transaction) (*TransactionExecutor,
 			}
 			finalized = true
 
+			if !reverted && rt.ctx.Number >= rt.forkConfig.MasterRotation {
+				rt.scheduleMasterRotation(resolvedTx)
+			}
+
+			if txRefundCap {
+				// Apply refund counter of the whole tx, capped to a fraction of gas used by the tx.
+				refund := (tx.Gas() - leftOverGas) / thor.MaxRefundQuotient
+				if refund > totalRefund {
+					refund = totalRefund
+				}
+				leftOverGas += refund
+			}
+
 			receipt := &Tx.Receipt{
 				Reverted: reverted,
 				Outputs:  txOutputs,
@@ -413,10 +592,35 @@ func (rt *Runtime) PrepareTransaction(tx *tx.Transaction) (*TransactionExecutor,
 			reward.Mul(reward, overallGasPrice)
 			reward.Mul(reward, rewardRatio)
 			reward.Div(reward, big.NewInt(1e18))
-			builtin.Energy.Native(rt.state, rt.ctx.Time).Add(rt.ctx.Beneficiary, reward)
+			if !rt.deferReward {
+				builtin.Energy.Native(rt.state, rt.ctx.Time).Add(rt.ctx.Beneficiary, reward)
+			}
 
 			receipt.Reward = reward
 			return receipt, nil
 		},
 	}, nil
 }
+
+// scheduleMasterRotation schedules rotation of the node master, if the tx is a rotation intent, i.e. sent by
+// the endorsor of a listed node master, with the only clause to the node master carrying the intent signed
+// by the new key.
+func (rt *Runtime) scheduleMasterRotation(resolvedTx *ResolvedTransaction) {
+	if len(resolvedTx.Clauses) != 1 {
+		return
+	}
+	clause := resolvedTx.Clauses[0]
+	if clause.To() == nil || clause.Value().Sign() != 0 {
+		return
+	}
+	nodeMaster := *clause.To()
+	newMaster, ok := authority.DecodeRotationIntent(rt.seeker.GenesisID(), nodeMaster, clause.Data())
+	if !ok {
+		return
+	}
+	aut := builtin.Authority.Native(rt.state)
+	if listed, endorsor, _, _ := aut.Get(nodeMaster); !listed || endorsor != resolvedTx.Origin {
+		return
+	}
+	aut.ScheduleRotation(nodeMaster, newMaster, rt.ctx.Number+thor.MasterRotationDelay)
+}
@@ -6,15 +6,19 @@
 package runtime_test
 
 import (
+	"context"
 	"encoding/hex"
 	"math"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/vechain/thor/abi"
 	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/builtin/authority"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/genesis"
 	"github.com/vechain/thor/lvldb"
@@ -22,6 +26,7 @@ import (
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/vm"
 	"github.com/vechain/thor/xenv"
 )
 
@@ -102,6 +107,46 @@ func TestContractSuicide(t *testing.T) {
 	assert.Equal(new(big.Int).Add(bal, big.NewInt(100)), state.GetEnergy(origin, time))
 }
 
+func TestSuicideEnergy(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	b0, _, _ := genesis.NewDevnet().Build(state.NewCreator(kv))
+	ch, _ := chain.New(kv, b0)
+
+	var (
+		origin = genesis.DevAccounts()[0].Address
+		time   = b0.Header().Timestamp() + 1000
+		heirs  = thor.BytesToAddress([]byte("heirs"))
+		noHeir = thor.BytesToAddress([]byte("noheir"))
+	)
+	st, _ := state.New(b0.Header().StateRoot(), kv)
+	st.SetCode(heirs, []byte{0x33, 0xff})  // CALLER SELFDESTRUCT
+	st.SetCode(noHeir, []byte{0x30, 0xff}) // ADDRESS SELFDESTRUCT
+	for _, addr := range []thor.Address{heirs, noHeir} {
+		st.SetEnergy(addr, big.NewInt(100), time)
+		st.SetBalance(addr, big.NewInt(200))
+	}
+
+	rt := runtime.New(ch.NewSeeker(b0.Header().ID()), st, &xenv.BlockContext{Time: time})
+
+	// the heir's energy grown upon its balance before inheriting
+	energy := new(big.Int).Add(st.GetEnergy(origin, time), big.NewInt(100))
+	balance := new(big.Int).Add(st.GetBalance(origin), big.NewInt(200))
+	out := rt.ExecuteClause(tx.NewClause(&heirs), 0, math.MaxUint64, &xenv.TransactionContext{Origin: origin})
+	assert.Nil(t, out.VMErr)
+	assert.Equal(t, energy, st.GetEnergy(origin, time))
+	assert.Equal(t, balance, st.GetBalance(origin))
+
+	burned := builtin.Energy.Native(st, time).TotalBurned()
+	supply := builtin.Energy.Native(st, time).TokenTotalSupply()
+	out = rt.ExecuteClause(tx.NewClause(&noHeir), 0, math.MaxUint64, &xenv.TransactionContext{Origin: origin})
+	assert.Nil(t, out.VMErr)
+	assert.Equal(t, 0, len(out.Transfers))
+	assert.Equal(t, 0, len(out.Events))
+	assert.False(t, st.Exists(noHeir))
+	assert.Equal(t, new(big.Int).Add(burned, big.NewInt(100)), builtin.Energy.Native(st, time).TotalBurned())
+	assert.Equal(t, new(big.Int).Sub(supply, big.NewInt(200)), builtin.Energy.Native(st, time).TokenTotalSupply())
+}
+
 func TestCall(t *testing.T) {
 	kv, _ := lvldb.NewMem()
 
@@ -157,40 +202,336 @@ func TestCall(t *testing.T) {
 }
 
 func TestExecuteTransaction(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	b0, _, _ := genesis.NewDevnet().Build(state.NewCreator(kv))
+	ch, _ := chain.New(kv, b0)
+
+	acc := genesis.DevAccounts()[0]
+	to := thor.BytesToAddress([]byte("to"))
+	reverter := thor.BytesToAddress([]byte("reverter"))
+
+	// index of the failed clause, or -1 if none
+	for _, failAt := range []int{-1, 0, 1, 2} {
+		st, _ := state.New(b0.Header().StateRoot(), kv)
+		st.SetCode(reverter, []byte{0x60, 0x00, 0x60, 0x00, 0xfd}) // PUSH1 0 PUSH1 0 REVERT
+
+		builder := new(tx.Builder).ChainTag(ch.Tag()).Gas(1000000)
+		for i := 0; i < 3; i++ {
+			if i == failAt {
+				builder.Clause(tx.NewClause(&reverter))
+			} else {
+				builder.Clause(tx.NewClause(&to).WithValue(big.NewInt(10)))
+			}
+		}
+		trx := builder.Build()
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), acc.PrivateKey)
+		trx = trx.WithSignature(sig)
+
+		energy := st.GetEnergy(acc.Address, b0.Header().Timestamp()+10)
+		rt := runtime.New(ch.NewSeeker(b0.Header().ID()), st, &xenv.BlockContext{Number: 1, Time: b0.Header().Timestamp() + 10})
+		executed, err := rt.ExecuteTransactionWithDetail(trx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		receipt := executed.Receipt
+
+		if failAt < 0 {
+			assert.False(t, receipt.Reverted)
+			assert.Equal(t, 3, len(receipt.Outputs))
+			assert.Equal(t, 3, len(executed.Outputs))
+			assert.Equal(t, big.NewInt(30), st.GetBalance(to))
+		} else {
+			assert.True(t, receipt.Reverted, "failed at %v", failAt)
+			assert.Nil(t, receipt.Outputs, "failed at %v", failAt)
+			// outputs kept up to the failed clause
+			assert.Equal(t, failAt+1, len(executed.Outputs), "failed at %v", failAt)
+			assert.NotNil(t, executed.Outputs[failAt].VMErr, "failed at %v", failAt)
+			assert.Equal(t, big.NewInt(0), st.GetBalance(to), "failed at %v", failAt)
+		}
+		// gas charged anyway
+		assert.Equal(t, acc.Address, receipt.GasPayer)
+		assert.True(t, receipt.GasUsed > 0)
+		assert.Equal(t, new(big.Int).Sub(energy, receipt.Paid), st.GetEnergy(acc.Address, b0.Header().Timestamp()+10))
+	}
+}
+
+func TestExecuteTransactionWithDetail(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	b0, _, _ := genesis.NewDevnet().Build(state.NewCreator(kv))
+	ch, _ := chain.New(kv, b0)
+	st, _ := state.New(b0.Header().StateRoot(), kv)
+
+	acc := genesis.DevAccounts()[0]
+	to := thor.BytesToAddress([]byte("to"))
+	trx := new(tx.Builder).
+		ChainTag(ch.Tag()).
+		Gas(21000).
+		Clause(tx.NewClause(&to).WithValue(big.NewInt(10))).
+		Build()
+	sig, _ := crypto.Sign(trx.SigningHash().Bytes(), acc.PrivateKey)
+	trx = trx.WithSignature(sig)
+
+	rt := runtime.New(ch.NewSeeker(b0.Header().ID()), st, &xenv.BlockContext{Number: 1, Time: b0.Header().Timestamp() + 10})
+	executed, err := rt.ExecuteTransactionWithDetail(trx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, executed.Receipt.Reverted)
+	assert.Equal(t, 1, len(executed.Outputs))
+	assert.Equal(t, 1, len(executed.Outputs[0].Transfers))
+	// plain transfer uses no gas besides intrinsic gas
+	assert.Equal(t, []uint64{0}, executed.GasUsed)
+	assert.Equal(t, big.NewInt(10), executed.Diff[to].Account.Balance)
+	// gas paid by the sender
+	assert.NotNil(t, executed.Diff[acc.Address].Account)
+}
 
-	// kv, _ := lvldb.NewMem()
+func TestTxRefundCap(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	b0, _, _ := genesis.NewDevnet().Build(state.NewCreator(kv))
+	ch, _ := chain.New(kv, b0)
 
-	// key, _ := crypto.GenerateKey()
-	// addr1 := thor.Address(crypto.PubkeyToAddress(key.PublicKey))
-	// addr2 := thor.BytesToAddress([]byte("acc2"))
-	// balance1 := big.NewInt(1000 * 1000 * 1000)
+	acc := genesis.DevAccounts()[0]
+	to := thor.BytesToAddress([]byte("to"))
+	clearer := thor.BytesToAddress([]byte("clearer"))
+	reverter := thor.BytesToAddress([]byte("reverter"))
+
+	tests := []struct {
+		name       string
+		clauses    []*tx.Clause
+		wantRefund bool
+	}{
+		{"single clause", []*tx.Clause{tx.NewClause(&clearer)}, true},
+		// refund of the first clause is capped by gas used of the whole tx
+		{"multi clauses", []*tx.Clause{tx.NewClause(&clearer), tx.NewClause(&to), tx.NewClause(&to)}, true},
+		{"reverted", []*tx.Clause{tx.NewClause(&clearer), tx.NewClause(&reverter)}, false},
+	}
 
-	// b0, err := new(genesis.Builder).
-	// 	Alloc(contracts.Energy.Address, &big.Int{}, contracts.Energy.RuntimeBytecodes()).
-	// 	Alloc(addr1, balance1, nil).
-	// 	Call(contracts.Energy.PackCharge(addr1, big.NewInt(1000000))).
-	// 	Build(state.NewCreator(kv))
+	for _, tt := range tests {
+		st, _ := state.New(b0.Header().StateRoot(), kv)
+		st.SetCode(clearer, []byte{0x60, 0x00, 0x60, 0x00, 0x55})  // PUSH1 0 PUSH1 0 SSTORE
+		st.SetCode(reverter, []byte{0x60, 0x00, 0x60, 0x00, 0xfd}) // PUSH1 0 PUSH1 0 REVERT
+		st.SetStorage(clearer, thor.Bytes32{}, thor.BytesToBytes32([]byte{1}))
 
-	// if err != nil {
-	// 	t.Fatal(err)
-	// }
+		builder := new(tx.Builder).ChainTag(ch.Tag()).Gas(1000000)
+		for _, c := range tt.clauses {
+			builder.Clause(c)
+		}
+		trx := builder.Build()
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), acc.PrivateKey)
+		trx = trx.WithSignature(sig)
+
+		// fork config of devnet has all forks enabled
+		rt := runtime.New(ch.NewSeeker(b0.Header().ID()), st, &xenv.BlockContext{Number: 1, Time: b0.Header().Timestamp() + 10})
+		executed, err := rt.ExecuteTransactionWithDetail(trx)
+		if err != nil {
+			t.Fatal(err)
+		}
 
-	// tx := new(tx.Builder).
-	// 	GasPrice(big.NewInt(1)).
-	// 	Gas(1000000).
-	// 	Clause(tx.NewClause(&addr2).WithValue(big.NewInt(10))).
-	// 	Build()
-
-	// sig, _ := crypto.Sign(tx.SigningHash().Bytes(), key)
-	// tx = tx.WithSignature(sig)
-
-	// state, _ := state.New(b0.Header().StateRoot(), kv)
-	// rt := runtime.New(state,
-	// 	thor.Address{}, 0, 0, 0, func(uint32) thor.Bytes32 { return thor.Bytes32{} })
-	// receipt, _, err := rt.ExecuteTransaction(tx)
-	// if err != nil {
-	// 	t.Fatal(err)
-	// }
-	// _ = receipt
-	// assert.Equal(t, state.GetBalance(addr1), new(big.Int).Sub(balance1, big.NewInt(10)))
+		// refunds not applied to gas of subsequent clauses
+		last := executed.Outputs[len(executed.Outputs)-1]
+		gasUsed := trx.Gas() - last.LeftOverGas
+		assert.Equal(t, uint64(15000), executed.Outputs[0].RefundGas, tt.name)
+
+		if tt.wantRefund {
+			refund := gasUsed / thor.MaxRefundQuotient
+			assert.True(t, refund < executed.Outputs[0].RefundGas, tt.name)
+			assert.Equal(t, gasUsed-refund, executed.Receipt.GasUsed, tt.name)
+		} else {
+			assert.Equal(t, gasUsed, executed.Receipt.GasUsed, tt.name)
+		}
+	}
+}
+
+func TestExecuteContext(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	b0, _, _ := genesis.NewDevnet().Build(state.NewCreator(kv))
+	ch, _ := chain.New(kv, b0)
+	st, _ := state.New(b0.Header().StateRoot(), kv)
+
+	rt := runtime.New(ch.NewSeeker(b0.Header().ID()), st, &xenv.BlockContext{Number: 1, Time: b0.Header().Timestamp() + 10})
+
+	// JUMPDEST PUSH1 0 JUMP
+	loop := thor.BytesToAddress([]byte("loop"))
+	st.SetCode(loop, []byte{0x5b, 0x60, 0x00, 0x56})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	out, err := rt.ExecuteClauseContext(ctx, tx.NewClause(&loop), 0, math.MaxUint64, &xenv.TransactionContext{})
+	assert.Nil(t, out)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	to := thor.BytesToAddress([]byte("to"))
+	out, err = rt.ExecuteClauseContext(context.Background(), tx.NewClause(&to), 0, math.MaxUint64, &xenv.TransactionContext{})
+	assert.Nil(t, err)
+	assert.Nil(t, out.VMErr)
+
+	trx := new(tx.Builder).ChainTag(ch.Tag()).Gas(1000000).Clause(tx.NewClause(&loop)).Build()
+	sig, _ := crypto.Sign(trx.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+	trx = trx.WithSignature(sig)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = rt.ExecuteTransactionContext(ctx, trx)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestMaxLogMemory(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	b0, _, _ := genesis.NewDevnet().Build(state.NewCreator(kv))
+	ch, _ := chain.New(kv, b0)
+	st, _ := state.New(b0.Header().StateRoot(), kv)
+
+	// emits 10 empty logs
+	var code []byte
+	for i := 0; i < 10; i++ {
+		code = append(code, 0x60, 0x00, 0x60, 0x00, 0xa0) // PUSH1 0 PUSH1 0 LOG0
+	}
+	emitter := thor.BytesToAddress([]byte("emitter"))
+	st.SetCode(emitter, code)
+
+	rt := runtime.New(ch.NewSeeker(b0.Header().ID()), st, &xenv.BlockContext{})
+	out := rt.ExecuteClause(tx.NewClause(&emitter), 0, 1000000, &xenv.TransactionContext{})
+	assert.Nil(t, out.VMErr)
+	assert.Equal(t, 10, len(out.Events))
+
+	rt.SetVMConfig(vm.Config{MaxLogMemory: 1000})
+	out = rt.ExecuteClause(tx.NewClause(&emitter), 0, 1000000, &xenv.TransactionContext{})
+	assert.Equal(t, vm.ErrLogMemoryLimitReached, out.VMErr)
+	assert.Equal(t, 0, len(out.Events))
+	assert.Equal(t, uint64(0), out.LeftOverGas, "all gas consumed")
+}
+
+func TestWarmColdAccess(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	b0, _, _ := genesis.NewDevnet().Build(state.NewCreator(kv))
+	ch, _ := chain.New(kv, b0)
+	st, _ := state.New(b0.Header().StateRoot(), kv)
+
+	reader := thor.BytesToAddress([]byte("reader"))
+	st.SetCode(reader, []byte{
+		0x60, 0x00, 0x54, 0x50, // PUSH1 0 SLOAD POP
+		0x60, 0x00, 0x54, 0x50, // PUSH1 0 SLOAD POP
+		0x60, 0xff, 0x31, 0x50, // PUSH1 0xff BALANCE POP
+		0x60, 0xff, 0x31, 0x50, // PUSH1 0xff BALANCE POP
+	})
+	clause := tx.NewClause(&reader)
+	origin := genesis.DevAccounts()[0].Address
+
+	// fork config of devnet has all forks enabled
+	rt := runtime.New(ch.NewSeeker(b0.Header().ID()), st, &xenv.BlockContext{})
+	gasUsed := func(txCtx *xenv.TransactionContext) uint64 {
+		out := rt.ExecuteClause(clause, 0, 1000000, txCtx)
+		assert.Nil(t, out.VMErr)
+		return 1000000 - out.LeftOverGas
+	}
+
+	// legacy pricing without access list
+	assert.Equal(t, uint64(1220), gasUsed(&xenv.TransactionContext{Origin: origin}))
+
+	al := rt.NewAccessList(origin, []*tx.Clause{clause})
+	assert.NotNil(t, al)
+	txCtx := &xenv.TransactionContext{Origin: origin, AccessList: al}
+	// the first access is cold
+	assert.Equal(t, uint64(4920), gasUsed(txCtx))
+	// all warm in the subsequent clause of the same tx
+	assert.Equal(t, uint64(420), gasUsed(txCtx))
+}
+
+func TestGasOverrides(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	b0, _, _ := genesis.NewDevnet().Build(state.NewCreator(kv))
+	ch, _ := chain.New(kv, b0)
+	st, _ := state.New(b0.Header().StateRoot(), kv)
+
+	adder := thor.BytesToAddress([]byte("adder"))
+	st.SetCode(adder, []byte{0x60, 0x01, 0x60, 0x02, 0x01, 0x50}) // PUSH1 1 PUSH1 2 ADD POP
+	trx := new(tx.Builder).ChainTag(ch.Tag()).Clause(tx.NewClause(&adder)).Gas(2000).Build()
+	sig, _ := crypto.Sign(trx.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+	trx = trx.WithSignature(sig)
+
+	gasUsed := func() uint64 {
+		rt := runtime.New(ch.NewSeeker(b0.Header().ID()), st, &xenv.BlockContext{})
+		rt.SetVMConfig(vm.Config{MaxLogMemory: 1000})
+		out := rt.ExecuteClause(tx.NewClause(&adder), 0, 1000, &xenv.TransactionContext{})
+		return 1000 - out.LeftOverGas
+	}
+
+	_, err := runtime.ResolveTransaction(trx)
+	assert.Equal(t, runtime.ErrIntrinsicGasExceeded, err)
+	assert.Equal(t, uint64(3+3+3+2), gasUsed())
+
+	runtime.SetGasOverrides(&vm.GasOverrides{
+		Ops:       map[vm.OpCode]uint64{vm.ADD: 1},
+		Intrinsic: &tx.IntrinsicGasSchedule{TxGas: 1000, ClauseGas: 1, ClauseGasContractCreation: 1},
+	})
+	defer runtime.SetGasOverrides(nil)
+
+	resolved, err := runtime.ResolveTransaction(trx)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1001), resolved.IntrinsicGas)
+	assert.Equal(t, uint64(3+3+1+2), gasUsed())
+}
+
+func TestMasterRotation(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	b0, _, _ := genesis.NewDevnet().Build(state.NewCreator(kv))
+	ch, _ := chain.New(kv, b0)
+
+	var (
+		master     = genesis.DevAccounts()[2]
+		endorsor   = genesis.DevAccounts()[1]
+		unlisted   = genesis.DevAccounts()[3]
+		nextKey, _ = crypto.GenerateKey()
+		nextMaster = thor.Address(crypto.PubkeyToAddress(nextKey.PublicKey))
+	)
+	intent, _ := authority.EncodeRotationIntent(b0.Header().ID(), master.Address, nextKey)
+	unlistedIntent, _ := authority.EncodeRotationIntent(b0.Header().ID(), unlisted.Address, nextKey)
+	otherChainIntent, _ := authority.EncodeRotationIntent(thor.BytesToBytes32([]byte("other")), master.Address, nextKey)
+
+	tests := []struct {
+		name      string
+		signer    genesis.DevAccount
+		clauses   []*tx.Clause
+		scheduled bool
+	}{
+		{"sent by endorsor", endorsor, []*tx.Clause{tx.NewClause(&master.Address).WithData(intent)}, true},
+		{"sent by master", master, []*tx.Clause{tx.NewClause(&master.Address).WithData(intent)}, false},
+		{"with value", endorsor, []*tx.Clause{tx.NewClause(&master.Address).WithValue(big.NewInt(1)).WithData(intent)}, false},
+		{"unlisted master", endorsor, []*tx.Clause{tx.NewClause(&unlisted.Address).WithData(unlistedIntent)}, false},
+		{"other chain", endorsor, []*tx.Clause{tx.NewClause(&master.Address).WithData(otherChainIntent)}, false},
+		{"multi clauses", endorsor, []*tx.Clause{tx.NewClause(&master.Address).WithData(intent), tx.NewClause(&master.Address)}, false},
+	}
+
+	for _, tt := range tests {
+		st, _ := state.New(b0.Header().StateRoot(), kv)
+		builtin.Authority.Native(st).Add(master.Address, endorsor.Address, thor.Bytes32{})
+
+		builder := new(tx.Builder).ChainTag(ch.Tag()).Gas(1000000)
+		for _, c := range tt.clauses {
+			builder.Clause(c)
+		}
+		trx := builder.Build()
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), tt.signer.PrivateKey)
+		trx = trx.WithSignature(sig)
+
+		// fork config of devnet has all forks enabled
+		rt := runtime.New(ch.NewSeeker(b0.Header().ID()), st, &xenv.BlockContext{Number: 1, Time: b0.Header().Timestamp() + 10})
+		receipt, err := rt.ExecuteTransaction(trx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.False(t, receipt.Reverted, tt.name)
+
+		rotation := builtin.Authority.Native(st).PendingRotation(master.Address)
+		if tt.scheduled {
+			assert.Equal(t, &authority.Rotation{
+				NodeMaster: master.Address,
+				NewMaster:  nextMaster,
+				Activation: 1 + thor.MasterRotationDelay,
+			}, rotation, tt.name)
+		} else {
+			assert.Nil(t, rotation, tt.name)
+		}
+	}
 }
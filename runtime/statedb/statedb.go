@@ -16,14 +16,16 @@ import (
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/vm"
 )
 
 var codeSizeCache, _ = lru.New(32 * 1024)
 
 // StateDB implements evm.StateDB, only adapt to evm.
 type StateDB struct {
-	state *state.State
-	repo  *stackedmap.StackedMap
+	state      *state.State
+	repo       *stackedmap.StackedMap
+	accessList *vm.AccessList
 }
 
 type (
@@ -33,25 +35,54 @@ type (
 	eventKey       struct{}
 	transferKey    struct{}
 	stateRevKey    struct{}
+	accessAddrKey  common.Address
+	accessSlotKey  struct {
+		addr common.Address
+		slot common.Hash
+	}
 )
 
 // New create a statedb object.
 func New(state *state.State) *StateDB {
+	s := &StateDB{state: state}
 	getter := func(k interface{}) (interface{}, bool) {
-		switch k.(type) {
+		switch key := k.(type) {
 		case suicideFlagKey:
 			return false, true
 		case refundKey:
 			return uint64(0), true
+		case accessAddrKey:
+			return s.accessList != nil && s.accessList.ContainsAddress(common.Address(key)), true
+		case accessSlotKey:
+			return s.accessList != nil && s.accessList.ContainsSlot(key.addr, key.slot), true
 		}
 		panic(fmt.Sprintf("unknown type of key %+v", k))
 	}
 
-	repo := stackedmap.New(getter)
-	return &StateDB{
-		state,
-		repo,
+	s.repo = stackedmap.New(getter)
+	return s
+}
+
+// SetAccessList sets the access list of the tx, which addresses and storage slots are warm from.
+func (s *StateDB) SetAccessList(al *vm.AccessList) {
+	s.accessList = al
+}
+
+// CommitAccessList adds addresses and storage slots accessed, and not reverted, into the
+// access list of the tx.
+func (s *StateDB) CommitAccessList() {
+	if s.accessList == nil {
+		return
 	}
+	s.repo.Journal(func(k, v interface{}) bool {
+		switch key := k.(type) {
+		case accessAddrKey:
+			s.accessList.AddAddress(common.Address(key))
+		case accessSlotKey:
+			s.accessList.AddSlot(key.addr, key.slot)
+		}
+		return true
+	})
 }
 
 // GetRefund returns total refund during VM life-cycle.
@@ -60,12 +91,30 @@ func (s *StateDB) GetRefund() uint64 {
 	return v.(uint64)
 }
 
-// GetLogs returns collected event and transfer logs.
+// GetLogs returns collected event and transfer logs, in order of emitting.
 func (s *StateDB) GetLogs() (tx.Events, tx.Transfers) {
 	var (
 		events    tx.Events
 		transfers tx.Transfers
+		nEvents   int
+		nTransfer int
 	)
+	// count first to allocate exactly
+	s.repo.Journal(func(k, v interface{}) bool {
+		switch k.(type) {
+		case eventKey:
+			nEvents++
+		case transferKey:
+			nTransfer++
+		}
+		return true
+	})
+	if nEvents > 0 {
+		events = make(tx.Events, 0, nEvents)
+	}
+	if nTransfer > 0 {
+		transfers = make(tx.Transfers, 0, nTransfer)
+	}
 	s.repo.Journal(func(k, v interface{}) bool {
 		switch k.(type) {
 		case eventKey:
@@ -134,6 +183,10 @@ func (s *StateDB) GetCodeSize(addr common.Address) int {
 	if hash.IsZero() {
 		return 0
 	}
+	if s.state.RecordsWitness() {
+		// code should be read to be witnessed
+		return len(s.state.GetCode(thor.Address(addr)))
+	}
 	if v, ok := codeSizeCache.Get(hash); ok {
 		return v.(int)
 	}
@@ -208,6 +261,28 @@ func (s *StateDB) AddTransfer(transfer *tx.Transfer) {
 	s.repo.Put(transferKey{}, transfer)
 }
 
+// AddressInAccessList implements vm.StateDB.
+func (s *StateDB) AddressInAccessList(addr common.Address) bool {
+	v, _ := s.repo.Get(accessAddrKey(addr))
+	return v.(bool)
+}
+
+// SlotInAccessList implements vm.StateDB.
+func (s *StateDB) SlotInAccessList(addr common.Address, slot common.Hash) bool {
+	v, _ := s.repo.Get(accessSlotKey{addr, slot})
+	return v.(bool)
+}
+
+// AddAddressToAccessList implements vm.StateDB.
+func (s *StateDB) AddAddressToAccessList(addr common.Address) {
+	s.repo.Put(accessAddrKey(addr), true)
+}
+
+// AddSlotToAccessList implements vm.StateDB.
+func (s *StateDB) AddSlotToAccessList(addr common.Address, slot common.Hash) {
+	s.repo.Put(accessSlotKey{addr, slot}, true)
+}
+
 // Snapshot stub.
 func (s *StateDB) Snapshot() int {
 	srev := s.state.NewCheckpoint()
@@ -0,0 +1,64 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package runtime
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+// TxLimits limits of clause count and encoded size of txs, set by governance params.
+// Zero means no limit other than the hard ones checked when decoding.
+type TxLimits struct {
+	MaxClauses uint64
+	MaxSize    uint64
+}
+
+// LoadTxLimits loads tx limits from params in the state.
+func LoadTxLimits(state *state.State) TxLimits {
+	params := builtin.Params.Native(state)
+	return TxLimits{
+		MaxClauses: limitValue(params.Get(thor.KeyMaxTxClauses)),
+		MaxSize:    limitValue(params.Get(thor.KeyMaxTxSize)),
+	}
+}
+
+func limitValue(v *big.Int) uint64 {
+	if !v.IsUint64() {
+		return 0
+	}
+	return v.Uint64()
+}
+
+type txLimitError struct {
+	msg string
+}
+
+func (e txLimitError) Error() string {
+	return e.msg
+}
+
+// IsTxLimitExceeded returns whether the error is caused by tx exceeding limits.
+func IsTxLimitExceeded(err error) bool {
+	_, ok := err.(txLimitError)
+	return ok
+}
+
+// Check returns an error if the tx exceeds limits.
+func (l TxLimits) Check(tx *tx.Transaction) error {
+	if l.MaxClauses > 0 && uint64(len(tx.Clauses())) > l.MaxClauses {
+		return txLimitError{fmt.Sprintf("tx clause count exceeds limit: limit %v, have %v", l.MaxClauses, len(tx.Clauses()))}
+	}
+	if l.MaxSize > 0 && uint64(tx.Size()) > l.MaxSize {
+		return txLimitError{fmt.Sprintf("tx size exceeds limit: limit %v, have %v", l.MaxSize, uint64(tx.Size()))}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package runtime_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/builtin"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/runtime"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestTxLimits(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	st, _ := state.New(thor.Bytes32{}, kv)
+
+	to := thor.BytesToAddress([]byte("to"))
+	trx := new(tx.Builder).
+		Clause(tx.NewClause(&to)).
+		Clause(tx.NewClause(&to)).
+		Build()
+
+	limits := runtime.LoadTxLimits(st)
+	assert.Equal(t, runtime.TxLimits{}, limits)
+	assert.Nil(t, limits.Check(trx), "unlimited")
+
+	builtin.Params.Native(st).Set(thor.KeyMaxTxClauses, big.NewInt(1))
+	limits = runtime.LoadTxLimits(st)
+	assert.Equal(t, uint64(1), limits.MaxClauses)
+	assert.True(t, runtime.IsTxLimitExceeded(limits.Check(trx)))
+
+	limits = runtime.TxLimits{MaxSize: uint64(trx.Size()) - 1}
+	assert.True(t, runtime.IsTxLimitExceeded(limits.Check(trx)))
+	limits.MaxSize++
+	assert.Nil(t, limits.Check(trx))
+}
@@ -118,6 +118,32 @@ func (sm *StackedMap) Journal(cb func(key, value interface{}) bool) {
 	}
 }
 
+// JournalLen returns count of journal entries.
+func (sm *StackedMap) JournalLen() int {
+	n := 0
+	for _, lvl := range sm.mapStack {
+		n += len(lvl.(*level).journal)
+	}
+	return n
+}
+
+// JournalFrom is like Journal, but skips the first n entries.
+func (sm *StackedMap) JournalFrom(n int, cb func(key, value interface{}) bool) {
+	for _, lvl := range sm.mapStack {
+		journal := lvl.(*level).journal
+		if n >= len(journal) {
+			n -= len(journal)
+			continue
+		}
+		for _, entry := range journal[n:] {
+			if !cb(entry.key, entry.value) {
+				return
+			}
+		}
+		n = 0
+	}
+}
+
 // stack ops
 type stack []interface{}
 
@@ -94,4 +94,13 @@ func TestStackedMapPuts(t *testing.T) {
 	})
 
 	assert.Equal(1, i, "Journal traverse should abort")
+
+	assert.Equal(len(kvs), sm.JournalLen())
+	i = 3
+	sm.JournalFrom(3, func(k, v interface{}) bool {
+		assert.Equal(k, kvs[i].k)
+		i++
+		return true
+	})
+	assert.Equal(len(kvs), i)
 }
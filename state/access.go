@@ -0,0 +1,58 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+// KeySet set of keys of accounts, code and storage slots accessed, to detect conflicts
+// between txs executed speculatively.
+type KeySet map[interface{}]struct{}
+
+// Intersects returns whether any key is contained in both sets.
+func (ks KeySet) Intersects(other KeySet) bool {
+	if len(ks) > len(other) {
+		ks, other = other, ks
+	}
+	for k := range ks {
+		if _, ok := other[k]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Add adds keys of other set.
+func (ks KeySet) Add(other KeySet) {
+	for k := range other {
+		ks[k] = struct{}{}
+	}
+}
+
+// TrackReads starts recording keys read, which can be retrieved by ReadKeys.
+func (s *State) TrackReads() {
+	s.reads = make(KeySet)
+}
+
+// ReadKeys returns keys read since TrackReads called.
+func (s *State) ReadKeys() KeySet {
+	return s.reads
+}
+
+// WrittenKeys returns keys written since the mark.
+func (s *State) WrittenKeys(mark int) KeySet {
+	keys := make(KeySet)
+	s.sm.JournalFrom(mark, func(k, v interface{}) bool {
+		keys[k] = struct{}{}
+		return true
+	})
+	return keys
+}
+
+// get gets value from stackedmap, and records the key if tracking reads.
+func (s *State) get(key interface{}) (interface{}, bool) {
+	if s.reads != nil {
+		s.reads[key] = struct{}{}
+	}
+	return s.sm.Get(key)
+}
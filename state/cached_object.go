@@ -7,10 +7,14 @@ package state
 
 import (
 	"github.com/ethereum/go-ethereum/rlp"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/vechain/thor/kv"
 	"github.com/vechain/thor/thor"
 )
 
+// codeCache caches contract codes keyed by code hash, which are immutable.
+var codeCache, _ = lru.New(512)
+
 // cachedObject to cache code and storage of an account.
 type cachedObject struct {
 	kv   kv.GetPutter
@@ -80,10 +84,20 @@ func (co *cachedObject) GetCode() ([]byte, error) {
 
 	if len(co.data.CodeHash) > 0 {
 		// do have code
+		key := thor.BytesToBytes32(co.data.CodeHash)
+		if cached, ok := codeCache.Get(key); ok {
+			if r, ok := co.kv.(*WitnessRecorder); ok {
+				// codes hit in cache are read as well
+				r.record(co.data.CodeHash, cached.([]byte))
+			}
+			cache.code = cached.([]byte)
+			return cache.code, nil
+		}
 		code, err := co.kv.Get(co.data.CodeHash)
 		if err != nil {
 			return nil, err
 		}
+		codeCache.Add(key, code)
 		cache.code = code
 		return code, nil
 	}
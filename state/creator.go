@@ -24,3 +24,19 @@ func NewCreator(kv kv.GetPutter) *Creator {
 func (c *Creator) NewState(root thor.Bytes32) (*State, error) {
 	return New(root, c.kv)
 }
+
+// NewWitnessedState create a new state object, with values read from kv recorded as witness.
+// Tries of the state bypass the shared trie cache, so that all nodes read are recorded.
+func (c *Creator) NewWitnessedState(root thor.Bytes32) (*State, *WitnessRecorder, error) {
+	recorder := NewWitnessRecorder(c.kv)
+	state, err := New(root, recorder)
+	if err != nil {
+		return nil, nil, err
+	}
+	return state, recorder, nil
+}
+
+// NewSnapshot create a new state snapshot.
+func (c *Creator) NewSnapshot(root thor.Bytes32) (*Snapshot, error) {
+	return NewSnapshot(root, c.kv)
+}
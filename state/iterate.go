@@ -0,0 +1,76 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/trie"
+)
+
+// ForEachAccount iterates accounts in the trie of the root that the state was created with,
+// in order of hashed address. Uncommitted changes are not covered.
+// The iteration stops if cb returns false.
+func (s *State) ForEachAccount(cb func(addr thor.Address, a *Account) bool) error {
+	tr, err := trCache.Get(s.root, s.kv, true)
+	if err != nil {
+		return err
+	}
+	it := trie.NewIterator(tr.NodeIterator(nil))
+	for it.Next() {
+		preimage := tr.GetKey(it.Key)
+		if len(preimage) != len(thor.Address{}) {
+			return fmt.Errorf("missing preimage of account key %x", it.Key)
+		}
+		var a Account
+		if err := rlp.DecodeBytes(it.Value, &a); err != nil {
+			return err
+		}
+		if !cb(thor.BytesToAddress(preimage), &a) {
+			return nil
+		}
+	}
+	return it.Err
+}
+
+// ForEachStorage iterates raw storage values of the account at addr, in order of hashed key.
+// Uncommitted changes are not covered.
+// The iteration stops if cb returns false.
+func (s *State) ForEachStorage(addr thor.Address, cb func(key thor.Bytes32, value rlp.RawValue) bool) error {
+	return s.ForEachStorageFrom(addr, thor.Bytes32{}, func(_, key thor.Bytes32, value rlp.RawValue) bool {
+		return cb(key, value)
+	})
+}
+
+// ForEachStorageFrom is like ForEachStorage, but starts at the hashed key start, inclusively.
+// The hashed key of each entry is also passed to cb, so that the iteration can be resumed from it.
+func (s *State) ForEachStorageFrom(addr thor.Address, start thor.Bytes32, cb func(hashedKey, key thor.Bytes32, value rlp.RawValue) bool) error {
+	tr, err := trCache.Get(s.root, s.kv, false)
+	if err != nil {
+		return err
+	}
+	account, err := loadAccount(tr, addr)
+	if err != nil {
+		return err
+	}
+	storageTrie, err := trCache.Get(thor.BytesToBytes32(account.StorageRoot), s.kv, true)
+	if err != nil {
+		return err
+	}
+	it := trie.NewIterator(storageTrie.NodeIterator(start[:]))
+	for it.Next() {
+		preimage := storageTrie.GetKey(it.Key)
+		if len(preimage) != len(thor.Bytes32{}) {
+			return fmt.Errorf("missing preimage of storage key %x", it.Key)
+		}
+		if !cb(thor.BytesToBytes32(it.Key), thor.BytesToBytes32(preimage), it.Value) {
+			return nil
+		}
+	}
+	return it.Err
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/trie"
+)
+
+// ProveAccount returns merkle proof of the account at addr, against the root that
+// the state was created with. Uncommitted changes are not covered.
+func (s *State) ProveAccount(addr thor.Address) ([][]byte, error) {
+	tr, err := trCache.Get(s.root, s.kv, false)
+	if err != nil {
+		return nil, err
+	}
+	var proof trie.ProofList
+	if err := tr.Prove(addr[:], 0, &proof); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// ProveStorage returns merkle proof of the storage value for given key, against the
+// storage root of the account at addr.
+func (s *State) ProveStorage(addr thor.Address, key thor.Bytes32) ([][]byte, error) {
+	tr, err := trCache.Get(s.root, s.kv, false)
+	if err != nil {
+		return nil, err
+	}
+	account, err := loadAccount(tr, addr)
+	if err != nil {
+		return nil, err
+	}
+	storageTrie, err := trCache.Get(thor.BytesToBytes32(account.StorageRoot), s.kv, false)
+	if err != nil {
+		return nil, err
+	}
+	var proof trie.ProofList
+	if err := storageTrie.Prove(key[:], 0, &proof); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
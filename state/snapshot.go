@@ -0,0 +1,82 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"sync"
+
+	"github.com/vechain/thor/kv"
+	"github.com/vechain/thor/stackedmap"
+	"github.com/vechain/thor/thor"
+)
+
+// Snapshot is an immutable view of accounts state at a given root.
+// It's safe for concurrent use, and states created from it share its read cache.
+// It's intended for read-only executions like contract calls via API.
+type Snapshot struct {
+	lock  sync.Mutex
+	state *State // used as read cache only
+}
+
+// NewSnapshot create a snapshot of state at the given root.
+func NewSnapshot(root thor.Bytes32, kv kv.GetPutter) (*Snapshot, error) {
+	state, err := New(root, kv)
+	if err != nil {
+		return nil, err
+	}
+	return &Snapshot{state: state}, nil
+}
+
+// Root returns the state root of the snapshot.
+func (s *Snapshot) Root() thor.Bytes32 {
+	return s.state.root
+}
+
+// Err returns the first error occurred when reading the snapshot.
+func (s *Snapshot) Err() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.state.err
+}
+
+func (s *Snapshot) get(key interface{}) (value interface{}, exist bool, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	value, exist = s.state.cacheGetter(key)
+	return value, exist, s.state.err
+}
+
+func (s *Snapshot) getCachedObject(addr thor.Address) (*cachedObject, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	co := s.state.getCachedObject(addr)
+	return co, s.state.err
+}
+
+// NewState create a state upon the snapshot. It's cheap since no trie is loaded.
+// Changes made to the returned state are invisible to the snapshot and other states.
+func (s *Snapshot) NewState() *State {
+	state := State{
+		root:     s.state.root,
+		kv:       s.state.kv,
+		snapshot: s,
+	}
+	state.setError = func(err error) {
+		if state.err == nil {
+			state.err = err
+		}
+	}
+	state.sm = stackedmap.New(func(key interface{}) (interface{}, bool) {
+		value, exist, err := s.get(key)
+		if err != nil {
+			state.setError(err)
+		}
+		return value, exist
+	})
+	return &state
+}
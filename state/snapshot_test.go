@@ -0,0 +1,66 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/thor"
+)
+
+func TestSnapshot(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	st, _ := New(thor.Bytes32{}, kv)
+
+	addr := thor.BytesToAddress([]byte("account1"))
+	key := thor.BytesToBytes32([]byte("key"))
+	value := thor.BytesToBytes32([]byte("value"))
+
+	st.SetBalance(addr, big.NewInt(10))
+	st.SetCode(addr, []byte("code"))
+	st.SetStorage(addr, key, value)
+	root, _ := st.Stage().Commit()
+
+	snapshot, err := NewSnapshot(root, kv)
+	assert.Nil(t, err)
+	assert.Equal(t, root, snapshot.Root())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			st := snapshot.NewState()
+			assert.Equal(t, big.NewInt(10), st.GetBalance(addr))
+			assert.Equal(t, []byte("code"), st.GetCode(addr))
+			assert.Equal(t, value, st.GetStorage(addr, key))
+
+			// changes are invisible to others
+			st.SetBalance(addr, big.NewInt(int64(i)))
+			assert.Equal(t, big.NewInt(int64(i)), st.GetBalance(addr))
+			assert.Nil(t, st.Err())
+		}(i)
+	}
+	wg.Wait()
+
+	st = snapshot.NewState()
+	assert.Equal(t, big.NewInt(10), st.GetBalance(addr))
+
+	// staging from snapshot state
+	st.SetBalance(addr, big.NewInt(20))
+	newRoot, err := st.Stage().Hash()
+	assert.Nil(t, err)
+
+	st, _ = New(root, kv)
+	st.SetBalance(addr, big.NewInt(20))
+	expectedRoot, _ := st.Stage().Hash()
+	assert.Equal(t, expectedRoot, newRoot)
+	assert.Nil(t, snapshot.Err())
+}
@@ -27,6 +27,9 @@ type State struct {
 	sm       *stackedmap.StackedMap         // keeps revisions of accounts state
 	err      error
 	setError func(err error)
+	snapshot *Snapshot // non-nil if created from snapshot
+	copyBase *Snapshot // shared by copies, lazily created
+	reads    KeySet    // keys read, if tracking enabled
 }
 
 // to constrain ability of trie
@@ -42,7 +45,12 @@ type trieWriter interface {
 
 // New create an state object.
 func New(root thor.Bytes32, kv kv.GetPutter) (*State, error) {
-	trie, err := trCache.Get(root, kv, false)
+	return newState(root, kv, false)
+}
+
+// newState creates a state object. If copyTrie is true, the accounts trie is not shared with other states.
+func newState(root thor.Bytes32, kv kv.GetPutter, copyTrie bool) (*State, error) {
+	trie, err := trCache.Get(root, kv, copyTrie)
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +84,46 @@ func (s *State) Spawn(root thor.Bytes32) *State {
 	return newState
 }
 
+// Copy returns a copy of the state, with changes made so far. It's cheap, since only changes are
+// copied, while unchanged accounts are read through a snapshot of the initial root, which is shared
+// by all copies. Changes made to the copy are invisible to the origin state and vice versa.
+// Copies can be used concurrently in different goroutines, e.g. to speculatively execute txs.
+// Checkpoints are not copied.
+func (s *State) Copy() *State {
+	base := s.snapshot
+	if base == nil {
+		if s.copyBase == nil {
+			// copies may be used in other goroutines, so don't share the trie with s
+			st, err := newState(s.root, s.kv, true)
+			if err != nil {
+				s.setError(err)
+				st, _ = newState(thor.Bytes32{}, s.kv, true)
+			}
+			s.copyBase = &Snapshot{state: st}
+		}
+		base = s.copyBase
+	}
+	c := base.NewState()
+	c.err = s.err
+	s.sm.Journal(func(k, v interface{}) bool {
+		c.sm.Put(k, v)
+		return true
+	})
+	return c
+}
+
+// Merge applies changes made to c since the mark, where c is usually a copy of s.
+// Errors of c are also reported to s.
+func (s *State) Merge(c *State, mark int) {
+	if c.err != nil {
+		s.setError(c.err)
+	}
+	c.sm.JournalFrom(mark, func(k, v interface{}) bool {
+		s.sm.Put(k, v)
+		return true
+	})
+}
+
 // implements stackedmap.MapGetter
 func (s *State) cacheGetter(key interface{}) (value interface{}, exist bool) {
 	switch k := key.(type) {
@@ -135,6 +183,13 @@ func (s *State) changes() map[thor.Address]*changedObject {
 }
 
 func (s *State) getCachedObject(addr thor.Address) *cachedObject {
+	if s.snapshot != nil {
+		co, err := s.snapshot.getCachedObject(addr)
+		if err != nil {
+			s.setError(err)
+		}
+		return co
+	}
 	if co, ok := s.cache[addr]; ok {
 		return co
 	}
@@ -150,7 +205,7 @@ func (s *State) getCachedObject(addr thor.Address) *cachedObject {
 
 // the returned account should not be modified
 func (s *State) getAccount(addr thor.Address) *Account {
-	v, _ := s.sm.Get(addr)
+	v, _ := s.get(addr)
 	return v.(*Account)
 }
 
@@ -214,6 +269,13 @@ func (s *State) Err() error {
 	return s.err
 }
 
+// RecordsWitness returns whether values read are recorded as witness.
+// Callers caching values derived from the state should bypass caches if it's true.
+func (s *State) RecordsWitness() bool {
+	_, ok := s.kv.(*WitnessRecorder)
+	return ok
+}
+
 // GetBalance returns balance for the given address.
 func (s *State) GetBalance(addr thor.Address) *big.Int {
 	return s.getAccount(addr).Balance
@@ -286,7 +348,7 @@ func (s *State) SetStorage(addr thor.Address, key, value thor.Bytes32) {
 
 // GetRawStorage returns storage value in rlp raw for given address and key.
 func (s *State) GetRawStorage(addr thor.Address, key thor.Bytes32) rlp.RawValue {
-	data, _ := s.sm.Get(storageKey{addr, key})
+	data, _ := s.get(storageKey{addr, key})
 	return data.(rlp.RawValue)
 }
 
@@ -317,7 +379,7 @@ func (s *State) DecodeStorage(addr thor.Address, key thor.Bytes32, dec func([]by
 
 // GetCode returns code for the given address.
 func (s *State) GetCode(addr thor.Address) []byte {
-	v, _ := s.sm.Get(codeKey(addr))
+	v, _ := s.get(codeKey(addr))
 	return v.([]byte)
 }
 
@@ -392,6 +454,55 @@ func (s *State) BuildStorageTrie(addr thor.Address) (*trie.SecureTrie, error) {
 	return trie, nil
 }
 
+// Diff changes of accounts, with the latest values.
+type Diff map[thor.Address]*AccountDiff
+
+// AccountDiff changes of an account. Fields are nil if not changed.
+type AccountDiff struct {
+	Account *Account
+	Code    []byte
+	Storage map[thor.Bytes32]rlp.RawValue // raw value, empty for deleted
+}
+
+// Mark returns the position of changes made so far, to be passed to DiffSince.
+func (s *State) Mark() int {
+	return s.sm.JournalLen()
+}
+
+// DiffSince returns changes made since the mark.
+// The mark is invalid if reverted to a checkpoint created before it.
+func (s *State) DiffSince(mark int) Diff {
+	diff := make(Diff)
+	get := func(addr thor.Address) *AccountDiff {
+		if d, ok := diff[addr]; ok {
+			return d
+		}
+		d := &AccountDiff{}
+		diff[addr] = d
+		return d
+	}
+	s.sm.JournalFrom(mark, func(k, v interface{}) bool {
+		switch key := k.(type) {
+		case thor.Address:
+			get(key).Account = v.(*Account)
+		case codeKey:
+			code := v.([]byte)
+			if code == nil {
+				code = []byte{}
+			}
+			get(thor.Address(key)).Code = code
+		case storageKey:
+			d := get(key.addr)
+			if d.Storage == nil {
+				d.Storage = make(map[thor.Bytes32]rlp.RawValue)
+			}
+			d.Storage[key.key] = v.(rlp.RawValue)
+		}
+		return true
+	})
+	return diff
+}
+
 // Stage makes a stage object to compute hash of trie or commit all changes.
 func (s *State) Stage() *Stage {
 	if s.err != nil {
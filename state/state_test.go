@@ -149,3 +149,137 @@ func TestStorage(t *testing.T) {
 
 	assert.Equal(t, thor.Blake2b(data), st.GetStorage(addr, key))
 }
+
+func TestForEach(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	st, _ := New(thor.Bytes32{}, kv)
+
+	addr := thor.BytesToAddress([]byte("account1"))
+	st.SetBalance(addr, big.NewInt(1))
+	for i := 0; i < 10; i++ {
+		st.SetBalance(thor.BytesToAddress([]byte{byte(i)}), big.NewInt(int64(i)+1))
+		st.SetStorage(addr, thor.BytesToBytes32([]byte{byte(i)}), thor.BytesToBytes32([]byte{byte(i + 1)}))
+	}
+	root, _ := st.Stage().Commit()
+	st, _ = New(root, kv)
+
+	accounts := make(map[thor.Address]*big.Int)
+	assert.Nil(t, st.ForEachAccount(func(addr thor.Address, a *Account) bool {
+		accounts[addr] = a.Balance
+		return true
+	}))
+	assert.Equal(t, 11, len(accounts))
+	assert.Equal(t, big.NewInt(3), accounts[thor.BytesToAddress([]byte{2})])
+
+	n := 0
+	assert.Nil(t, st.ForEachStorage(addr, func(key thor.Bytes32, value rlp.RawValue) bool {
+		assert.Equal(t, st.GetRawStorage(addr, key), value)
+		n++
+		return n < 5
+	}))
+	assert.Equal(t, 5, n)
+
+	// resume from the 4th entry
+	var hashedKeys []thor.Bytes32
+	assert.Nil(t, st.ForEachStorageFrom(addr, thor.Bytes32{}, func(hashedKey, key thor.Bytes32, value rlp.RawValue) bool {
+		hashedKeys = append(hashedKeys, hashedKey)
+		return true
+	}))
+	assert.Equal(t, 10, len(hashedKeys))
+	n = 0
+	assert.Nil(t, st.ForEachStorageFrom(addr, hashedKeys[3], func(hashedKey, key thor.Bytes32, value rlp.RawValue) bool {
+		assert.Equal(t, hashedKeys[3+n], hashedKey)
+		n++
+		return true
+	}))
+	assert.Equal(t, 7, n)
+}
+
+func TestDiffSince(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	st, _ := New(thor.Bytes32{}, kv)
+
+	addr1 := thor.BytesToAddress([]byte("addr1"))
+	addr2 := thor.BytesToAddress([]byte("addr2"))
+	key := thor.BytesToBytes32([]byte("key"))
+
+	st.SetBalance(addr1, big.NewInt(1))
+	mark := st.Mark()
+	st.SetBalance(addr2, big.NewInt(2))
+	st.SetStorage(addr2, key, thor.BytesToBytes32([]byte("value")))
+	st.SetBalance(addr2, big.NewInt(3))
+
+	diff := st.DiffSince(mark)
+	assert.Equal(t, 1, len(diff))
+	assert.Equal(t, big.NewInt(3), diff[addr2].Account.Balance)
+	assert.Nil(t, diff[addr2].Code)
+	assert.Equal(t, st.GetRawStorage(addr2, key), diff[addr2].Storage[key])
+}
+
+func TestCopy(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	addr1 := thor.BytesToAddress([]byte("addr1"))
+	addr2 := thor.BytesToAddress([]byte("addr2"))
+	key := thor.BytesToBytes32([]byte("key"))
+
+	st, _ := New(thor.Bytes32{}, kv)
+	st.SetBalance(addr1, big.NewInt(1))
+	root, _ := st.Stage().Commit()
+
+	st, _ = New(root, kv)
+	st.SetStorage(addr1, key, thor.BytesToBytes32([]byte("v1")))
+
+	c1 := st.Copy()
+	c2 := st.Copy()
+	assert.Equal(t, big.NewInt(1), c1.GetBalance(addr1))
+	assert.Equal(t, thor.BytesToBytes32([]byte("v1")), c1.GetStorage(addr1, key))
+
+	c1.SetBalance(addr2, big.NewInt(2))
+	c2.SetStorage(addr1, key, thor.BytesToBytes32([]byte("v2")))
+	st.SetBalance(addr1, big.NewInt(3))
+
+	// isolated from each other
+	assert.Equal(t, big.NewInt(2), c1.GetBalance(addr2))
+	assert.Equal(t, &big.Int{}, c2.GetBalance(addr2))
+	assert.Equal(t, &big.Int{}, st.GetBalance(addr2))
+	assert.Equal(t, thor.BytesToBytes32([]byte("v1")), c1.GetStorage(addr1, key))
+	assert.Equal(t, thor.BytesToBytes32([]byte("v2")), c2.GetStorage(addr1, key))
+	assert.Equal(t, big.NewInt(1), c1.GetBalance(addr1))
+
+	// copy of copy
+	c3 := c2.Copy()
+	assert.Equal(t, thor.BytesToBytes32([]byte("v2")), c3.GetStorage(addr1, key))
+
+	// same root as the origin with same changes
+	c4 := st.Copy()
+	root1, _ := st.Stage().Hash()
+	root2, _ := c4.Stage().Hash()
+	assert.Equal(t, root1, root2)
+
+	for _, s := range []*State{st, c1, c2, c3, c4} {
+		assert.Nil(t, s.Err())
+	}
+}
+
+func TestTrackReadsAndMerge(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	addr1 := thor.BytesToAddress([]byte("addr1"))
+	addr2 := thor.BytesToAddress([]byte("addr2"))
+	key := thor.BytesToBytes32([]byte("key"))
+
+	st, _ := New(thor.Bytes32{}, kv)
+	c := st.Copy()
+	mark := c.Mark()
+	c.TrackReads()
+
+	c.GetBalance(addr1)
+	c.SetStorage(addr2, key, thor.BytesToBytes32([]byte("v")))
+
+	assert.Equal(t, KeySet{addr1: {}}, c.ReadKeys())
+	assert.Equal(t, KeySet{storageKey{addr2, key}: {}}, c.WrittenKeys(mark))
+	assert.True(t, c.ReadKeys().Intersects(KeySet{addr1: {}, addr2: {}}))
+	assert.False(t, c.ReadKeys().Intersects(c.WrittenKeys(mark)))
+
+	st.Merge(c, mark)
+	assert.Equal(t, thor.BytesToBytes32([]byte("v")), st.GetStorage(addr2, key))
+}
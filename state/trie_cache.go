@@ -28,8 +28,19 @@ func newTrieCache() *trieCache {
 	return &trieCache{cache: cache}
 }
 
+// bypassed returns whether tries on the kv store should bypass the cache.
+// Witness recorders are per execution, and tries loaded through them must read nodes from them,
+// rather than sharing with other states.
+func bypassed(kv kv.GetPutter) bool {
+	_, ok := kv.(*WitnessRecorder)
+	return ok
+}
+
 // to get a trie for writing, copy should be set to true
 func (tc *trieCache) Get(root thor.Bytes32, kv kv.GetPutter, copy bool) (*trie.SecureTrie, error) {
+	if bypassed(kv) {
+		return trie.NewSecure(root, kv, 16)
+	}
 
 	if v, ok := tc.cache.Get(root); ok {
 		entry := v.(*trieCacheEntry)
@@ -52,5 +63,8 @@ func (tc *trieCache) Get(root thor.Bytes32, kv kv.GetPutter, copy bool) (*trie.S
 }
 
 func (tc *trieCache) Add(root thor.Bytes32, trie *trie.SecureTrie, kv kv.GetPutter) {
+	if bypassed(kv) {
+		return
+	}
 	tc.cache.Add(root, &trieCacheEntry{trie.Copy(), kv})
 }
@@ -0,0 +1,99 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/vechain/thor/kv"
+	"github.com/vechain/thor/thor"
+)
+
+// Witness trie nodes and contract codes read during execution, which are sufficient to
+// re-execute it statelessly. Keys are omitted since they are hashes of values.
+type Witness struct {
+	Nodes [][]byte // trie nodes, keyed by blake2b hash
+	Codes [][]byte // contract codes, keyed by keccak256 hash
+}
+
+// Fill puts entries of the witness into the putter, to build states upon.
+func (w *Witness) Fill(putter kv.Putter) error {
+	for _, node := range w.Nodes {
+		if err := putter.Put(thor.Blake2b(node).Bytes(), node); err != nil {
+			return err
+		}
+	}
+	for _, code := range w.Codes {
+		if err := putter.Put(crypto.Keccak256(code), code); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WitnessRecorder wraps a kv store, and records values read from it.
+type WitnessRecorder struct {
+	kv.GetPutter
+	lock    sync.Mutex
+	entries map[string][]byte
+	stopped bool
+}
+
+// NewWitnessRecorder create a recorder wrapping the kv store.
+func NewWitnessRecorder(kv kv.GetPutter) *WitnessRecorder {
+	return &WitnessRecorder{
+		GetPutter: kv,
+		entries:   make(map[string][]byte),
+	}
+}
+
+// Get implements kv.Getter.
+func (r *WitnessRecorder) Get(key []byte) ([]byte, error) {
+	value, err := r.GetPutter.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	r.record(key, value)
+	return value, nil
+}
+
+func (r *WitnessRecorder) record(key, value []byte) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if !r.stopped {
+		r.entries[string(key)] = value
+	}
+}
+
+// Witness returns the witness of values read so far, and stops recording.
+// Entries are sorted by key, to make the witness deterministic.
+func (r *WitnessRecorder) Witness() *Witness {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	keys := make([]string, 0, len(r.entries))
+	for k := range r.entries {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var w Witness
+	for _, k := range keys {
+		v := r.entries[k]
+		if bytes.Equal(crypto.Keccak256(v), []byte(k)) {
+			w.Codes = append(w.Codes, v)
+		} else {
+			w.Nodes = append(w.Nodes, v)
+		}
+	}
+	// the recorder may be retained by the stage, so release entries
+	r.entries = nil
+	r.stopped = true
+	return &w
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/thor"
+)
+
+func TestWitness(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	st, _ := New(thor.Bytes32{}, kv)
+
+	addr := thor.BytesToAddress([]byte("addr"))
+	key := thor.BytesToBytes32([]byte("key"))
+	for i := 0; i < 100; i++ {
+		st.SetBalance(thor.BytesToAddress([]byte{byte(i)}), big.NewInt(int64(i)))
+	}
+	st.SetBalance(addr, big.NewInt(1))
+	st.SetCode(addr, []byte("code"))
+	st.SetStorage(addr, key, thor.BytesToBytes32([]byte("value")))
+	root, _ := st.Stage().Commit()
+
+	// reads and writes on the state
+	execute := func(st *State) thor.Bytes32 {
+		assert.Equal(t, big.NewInt(1), st.GetBalance(addr))
+		assert.Equal(t, []byte("code"), st.GetCode(addr))
+		assert.Equal(t, thor.BytesToBytes32([]byte("value")), st.GetStorage(addr, key))
+		st.SetBalance(thor.BytesToAddress([]byte("new")), big.NewInt(1))
+		st.SetStorage(addr, key, thor.Bytes32{})
+		assert.Nil(t, st.Err())
+		h, err := st.Stage().Hash()
+		assert.Nil(t, err)
+		return h
+	}
+
+	st, recorder, err := NewCreator(kv).NewWitnessedState(root)
+	assert.Nil(t, err)
+	assert.True(t, st.RecordsWitness())
+	want := execute(st)
+
+	witness := recorder.Witness()
+	assert.Equal(t, [][]byte{[]byte("code")}, witness.Codes)
+	assert.NotEmpty(t, witness.Nodes)
+
+	// the shared trie cache is bypassed
+	v, ok := trCache.cache.Get(root)
+	assert.True(t, ok)
+	assert.Equal(t, kv, v.(*trieCacheEntry).kv)
+
+	// re-execute with the witness only
+	wkv, _ := lvldb.NewMem()
+	assert.Nil(t, witness.Fill(wkv))
+	st, _ = New(root, wkv)
+	assert.False(t, st.RecordsWitness())
+	assert.Equal(t, want, execute(st))
+}
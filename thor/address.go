@@ -6,6 +6,7 @@
 package thor
 
 import (
+	"encoding"
 	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
@@ -25,15 +26,38 @@ const (
 type Address common.Address
 
 var (
-	_ json.Marshaler   = (*Address)(nil)
-	_ json.Unmarshaler = (*Address)(nil)
+	_ json.Marshaler           = (*Address)(nil)
+	_ json.Unmarshaler         = (*Address)(nil)
+	_ encoding.TextMarshaler   = Address{}
+	_ encoding.TextUnmarshaler = (*Address)(nil)
 )
 
-// String implements the stringer interface
+// String implements the stringer interface.
+// It returns lower-case hex, see ChecksumString for the mixed-case form.
 func (a Address) String() string {
 	return "0x" + hex.EncodeToString(a[:])
 }
 
+// ChecksumString returns hex with checksum encoded in letter case, as defined by EIP-55.
+func (a Address) ChecksumString() string {
+	lower := hex.EncodeToString(a[:])
+	hash := crypto.Keccak256([]byte(lower))
+	result := []byte(lower)
+	for i, c := range result {
+		if c < 'a' {
+			continue
+		}
+		nibble := hash[i/2]
+		if i%2 == 0 {
+			nibble >>= 4
+		}
+		if nibble&0xf >= 8 {
+			result[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(result)
+}
+
 // Bytes returns byte slice form of address.
 func (a Address) Bytes() []byte {
 	return a[:]
@@ -49,7 +73,7 @@ func (a *Address) MarshalJSON() ([]byte, error) {
 	if a == nil {
 		return json.Marshal(nil)
 	}
-	return json.Marshal(a.String())
+	return json.Marshal(a.ChecksumString())
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
@@ -58,7 +82,18 @@ func (a *Address) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &hex); err != nil {
 		return err
 	}
-	parsed, err := ParseAddress(hex)
+	return a.UnmarshalText([]byte(hex))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// So that addresses are checksummed also as map keys or non-addressable values.
+func (a Address) MarshalText() ([]byte, error) {
+	return []byte(a.ChecksumString()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (a *Address) UnmarshalText(text []byte) error {
+	parsed, err := ParseAddress(string(text))
 	if err != nil {
 		return err
 	}
@@ -67,6 +102,7 @@ func (a *Address) UnmarshalJSON(data []byte) error {
 }
 
 // ParseAddress convert string presented address into Address type.
+// Mixed-case hex is validated against the checksum, while all lower or upper case is not.
 func ParseAddress(s string) (Address, error) {
 	if len(s) == AddressLength*2 {
 	} else if len(s) == AddressLength*2+2 {
@@ -83,6 +119,11 @@ func ParseAddress(s string) (Address, error) {
 	if err != nil {
 		return Address{}, err
 	}
+	if s != strings.ToLower(s) && s != strings.ToUpper(s) {
+		if addr.ChecksumString()[2:] != s {
+			return Address{}, errors.New("invalid checksum")
+		}
+	}
 	return addr, nil
 }
 
@@ -104,6 +145,8 @@ func BytesToAddress(b []byte) Address {
 
 // CreateContractAddress to generate contract address according to tx id, clause index and
 // contract creation count.
+// The creation count starts from 0 in each clause, so contracts deployed by different clauses
+// of a tx get distinct addresses, which are predictable once the tx is signed.
 func CreateContractAddress(txID Bytes32, clauseIndex uint32, creationCount uint32) Address {
 	var b4_1, b4_2 [4]byte
 	binary.BigEndian.PutUint32(b4_1[:], clauseIndex)
@@ -6,6 +6,7 @@
 package thor
 
 import (
+	"encoding"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -19,8 +20,10 @@ import (
 type Bytes32 [32]byte
 
 var (
-	_ json.Marshaler   = (*Bytes32)(nil)
-	_ json.Unmarshaler = (*Bytes32)(nil)
+	_ json.Marshaler           = (*Bytes32)(nil)
+	_ json.Unmarshaler         = (*Bytes32)(nil)
+	_ encoding.TextMarshaler   = Bytes32{}
+	_ encoding.TextUnmarshaler = (*Bytes32)(nil)
 )
 
 // String implements stringer
@@ -57,7 +60,17 @@ func (b *Bytes32) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &hex); err != nil {
 		return err
 	}
-	parsed, err := ParseBytes32(hex)
+	return b.UnmarshalText([]byte(hex))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (b Bytes32) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (b *Bytes32) UnmarshalText(text []byte) error {
+	parsed, err := ParseBytes32(string(text))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,97 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package thor
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Constants protocol constants which may be overridden by private networks.
+type Constants struct {
+	BlockInterval        uint64 `json:"blockInterval"`        // time interval between two consecutive blocks.
+	MaxBlockProposers    uint64 `json:"maxBlockProposers"`    // max count of proposers can be scheduled.
+	MinGasLimit          uint64 `json:"minGasLimit"`          // lower bound of block gas limit.
+	GasLimitBoundDivisor uint64 `json:"gasLimitBoundDivisor"` // bound divisor of gas limit adjustment between blocks.
+	EpochLength          uint32 `json:"epochLength"`          // (unit: block) count of blocks in an epoch.
+}
+
+// DefaultConstants constants used by well-known networks.
+var DefaultConstants = Constants{
+	BlockInterval:        10,
+	MaxBlockProposers:    101,
+	MinGasLimit:          1000 * 1000,
+	GasLimitBoundDivisor: 1024, // from ethereum
+	EpochLength:          8640, // one day
+}
+
+// Effective values of constants. Use SetConstants to override them.
+var (
+	BlockInterval        = DefaultConstants.BlockInterval
+	MaxBlockProposers    = DefaultConstants.MaxBlockProposers
+	MinGasLimit          = DefaultConstants.MinGasLimit
+	GasLimitBoundDivisor = DefaultConstants.GasLimitBoundDivisor
+	EpochLength          = DefaultConstants.EpochLength
+)
+
+// WithDefaults returns a copy of c, with zero fields replaced by default values.
+func (c Constants) WithDefaults() Constants {
+	if c.BlockInterval == 0 {
+		c.BlockInterval = DefaultConstants.BlockInterval
+	}
+	if c.MaxBlockProposers == 0 {
+		c.MaxBlockProposers = DefaultConstants.MaxBlockProposers
+	}
+	if c.MinGasLimit == 0 {
+		c.MinGasLimit = DefaultConstants.MinGasLimit
+	}
+	if c.GasLimitBoundDivisor == 0 {
+		c.GasLimitBoundDivisor = DefaultConstants.GasLimitBoundDivisor
+	}
+	if c.EpochLength == 0 {
+		c.EpochLength = DefaultConstants.EpochLength
+	}
+	return c
+}
+
+// Validate checks whether constants are reasonable.
+func (c Constants) Validate() error {
+	c = c.WithDefaults()
+	if c.BlockInterval < 2 {
+		return errors.New("blockInterval must be at least 2")
+	}
+	if c.GasLimitBoundDivisor < 2 {
+		return errors.New("gasLimitBoundDivisor must be at least 2")
+	}
+	return nil
+}
+
+func (c Constants) String() string {
+	return fmt.Sprintf("interval: %vs, proposers: %v, min gas limit: %v, gas limit divisor: %v, epoch: %v",
+		c.BlockInterval, c.MaxBlockProposers, c.MinGasLimit, c.GasLimitBoundDivisor, c.EpochLength)
+}
+
+// CurrentConstants returns constants currently in effect.
+func CurrentConstants() Constants {
+	return Constants{
+		BlockInterval:        BlockInterval,
+		MaxBlockProposers:    MaxBlockProposers,
+		MinGasLimit:          MinGasLimit,
+		GasLimitBoundDivisor: GasLimitBoundDivisor,
+		EpochLength:          EpochLength,
+	}
+}
+
+// SetConstants overrides constants in effect. Zero fields fall back to default values.
+// It's not thread safe, and should be called only once at startup, before any block processed.
+func SetConstants(c Constants) {
+	c = c.WithDefaults()
+	BlockInterval = c.BlockInterval
+	MaxBlockProposers = c.MaxBlockProposers
+	MinGasLimit = c.MinGasLimit
+	GasLimitBoundDivisor = c.GasLimitBoundDivisor
+	EpochLength = c.EpochLength
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package thor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/thor"
+)
+
+func TestConstants(t *testing.T) {
+	defer thor.SetConstants(thor.DefaultConstants)
+
+	assert.Equal(t, thor.DefaultConstants, thor.CurrentConstants())
+
+	thor.SetConstants(thor.Constants{BlockInterval: 3, EpochLength: 100})
+	assert.Equal(t, uint64(3), thor.BlockInterval)
+	assert.Equal(t, uint32(100), thor.EpochLength)
+	assert.Equal(t, thor.DefaultConstants.MaxBlockProposers, thor.MaxBlockProposers)
+	assert.Equal(t, thor.DefaultConstants.MinGasLimit, thor.MinGasLimit)
+
+	assert.Nil(t, thor.Constants{}.Validate())
+	assert.NotNil(t, thor.Constants{BlockInterval: 1}.Validate())
+	assert.NotNil(t, thor.Constants{GasLimitBoundDivisor: 1}.Validate())
+}
@@ -8,15 +8,28 @@ import (
 // ForkConfig config for a fork.
 type ForkConfig struct {
 	FixTransferLog uint32
+	FixSuicide     uint32 // settle energy of the heir, and burn what's left if no heir
+	TxLimits       uint32 // limit clause count and size of txs in blocks, by params
+	TxRefundCap    uint32 // cap refund by gas used of the whole tx, rather than half of gas used of each clause
+	WarmColdAccess uint32 // price account and storage access by whether accessed before in the tx
+	MasterRotation uint32 // authority node master key rotation by intent tx
+	VRF            uint32 // header extension, and endorsements of committee members selected by VRF
 }
 
 func (fc ForkConfig) String() string {
-	return fmt.Sprintf("FTRL: #%v", fc.FixTransferLog)
+	return fmt.Sprintf("FTRL: #%v, FSCD: #%v, TXLM: #%v, TXRC: #%v, WCAC: #%v, MKRT: #%v, VRF: #%v",
+		fc.FixTransferLog, fc.FixSuicide, fc.TxLimits, fc.TxRefundCap, fc.WarmColdAccess, fc.MasterRotation, fc.VRF)
 }
 
 // NoFork a special config without any forks.
 var NoFork = ForkConfig{
 	FixTransferLog: math.MaxUint32,
+	FixSuicide:     math.MaxUint32,
+	TxLimits:       math.MaxUint32,
+	TxRefundCap:    math.MaxUint32,
+	WarmColdAccess: math.MaxUint32,
+	MasterRotation: math.MaxUint32,
+	VRF:            math.MaxUint32,
 }
 
 // for well-known networks
@@ -24,10 +37,22 @@ var forkConfigs = map[Bytes32]ForkConfig{
 	// mainnet
 	MustParseBytes32("0x00000000851caf3cfdb6e899cf5958bfb1ac3413d346d43539627e6be7ec1b4a"): {
 		FixTransferLog: 1072000,
+		FixSuicide:     math.MaxUint32, // not scheduled yet
+		TxLimits:       math.MaxUint32, // not scheduled yet
+		TxRefundCap:    math.MaxUint32, // not scheduled yet
+		WarmColdAccess: math.MaxUint32, // not scheduled yet
+		MasterRotation: math.MaxUint32, // not scheduled yet
+		VRF:            math.MaxUint32, // not scheduled yet
 	},
 	// testnet
 	MustParseBytes32("0x000000000b2bce3c70bc649a02749e8687721b09ed2e15997f466536b20bb127"): {
 		FixTransferLog: 1080000,
+		FixSuicide:     math.MaxUint32, // not scheduled yet
+		TxLimits:       math.MaxUint32, // not scheduled yet
+		TxRefundCap:    math.MaxUint32, // not scheduled yet
+		WarmColdAccess: math.MaxUint32, // not scheduled yet
+		MasterRotation: math.MaxUint32, // not scheduled yet
+		VRF:            math.MaxUint32, // not scheduled yet
 	},
 }
 
@@ -13,28 +13,32 @@ import (
 )
 
 // Constants of block chain.
+// Constants overridable by private networks are defined in constants.go.
 const (
-	BlockInterval uint64 = 10 // time interval between two consecutive blocks.
-
 	TxGas                     uint64 = 5000
 	ClauseGas                 uint64 = params.TxGas - TxGas
 	ClauseGasContractCreation uint64 = params.TxGasContractCreation - TxGas
 
-	MinGasLimit          uint64 = 450 * 1000 * 1000
-	InitialGasLimit      uint64 = 10 * 1000 * 1000 // InitialGasLimit gas limit value int genesis block.
-	GasLimitBoundDivisor uint64 = 1024             // from ethereum
-	GetBalanceGas        uint64 = 400              //EIP158 gas table
-	SloadGas             uint64 = 200              // EIP158 gas table
-	SstoreSetGas         uint64 = params.SstoreSetGas
-	SstoreResetGas       uint64 = params.SstoreResetGas
+	InitialGasLimit uint64 = 10 * 1000 * 1000 // InitialGasLimit gas limit value int genesis block.
+	GetBalanceGas   uint64 = 400              //EIP158 gas table
+	SloadGas        uint64 = 200              // EIP158 gas table
+	SstoreSetGas    uint64 = params.SstoreSetGas
+	SstoreResetGas  uint64 = params.SstoreResetGas
 
-	MaxTxWorkDelay uint32 = 30 // (unit: block) if tx delay exceeds this value, no energy can be exchanged.
+	MaxRefundQuotient uint64 = 5 // refund capped to gas used of tx divided by this value, since fork TxRefundCap
+
+	MasterRotationDelay uint32 = 360 // (unit: block) delay of master key rotation to be activated, since the intent tx packed
 
-	MaxBlockProposers uint64 = 101
+	MaxTxWorkDelay uint32 = 30 // (unit: block) if tx delay exceeds this value, no energy can be exchanged.
 
 	TolerableBlockPackingTime = 2 * time.Second // the indicator to adjust target block gas limit
 
 	MaxBackTrackingBlockNumber = 65535
+
+	CommitteeSize uint64 = 21 // expected count of committee members selected to endorse a block.
+
+	MaxTxClauses uint64 = 4096            // hard limit of clause count of a tx, checked when decoding.
+	MaxTxSize    uint64 = 4 * 1024 * 1024 // hard limit of encoded size of a tx in bytes, checked when decoding.
 )
 
 // Keys of governance params.
@@ -43,6 +47,10 @@ var (
 	KeyRewardRatio         = BytesToBytes32([]byte("reward-ratio"))
 	KeyBaseGasPrice        = BytesToBytes32([]byte("base-gas-price"))
 	KeyProposerEndorsement = BytesToBytes32([]byte("proposer-endorsement"))
+	KeyMaxTxClauses        = BytesToBytes32([]byte("max-tx-clauses"))     // lower limit than MaxTxClauses if set
+	KeyMaxTxSize           = BytesToBytes32([]byte("max-tx-size"))        // lower limit than MaxTxSize if set
+	KeyConstantsHash       = BytesToBytes32([]byte("constants-hash"))     // hash of constants overridden by custom genesis
+	KeyGasOverridesHash    = BytesToBytes32([]byte("gas-overrides-hash")) // hash of gas costs overridden by custom genesis
 
 	InitialRewardRatio         = big.NewInt(3e17) // 30%
 	InitialBaseGasPrice        = big.NewInt(1e15)
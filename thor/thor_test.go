@@ -7,6 +7,8 @@ package thor
 
 import (
 	"encoding/json"
+	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -25,9 +27,53 @@ func TestBytes32(t *testing.T) {
 func TestAddress(t *testing.T) {
 	addr := BytesToAddress([]byte("addr"))
 	data, _ := json.Marshal(&addr)
-	assert.Equal(t, "\""+addr.String()+"\"", string(data))
+	assert.Equal(t, "\""+addr.ChecksumString()+"\"", string(data))
 
 	var dec Address
 	assert.Nil(t, json.Unmarshal(data, &dec))
 	assert.Equal(t, addr, dec)
 }
+
+func TestChecksumAddress(t *testing.T) {
+	// test vectors of EIP-55
+	for _, s := range []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+	} {
+		addr, err := ParseAddress(s)
+		assert.Nil(t, err)
+		assert.Equal(t, s, addr.ChecksumString())
+
+		_, err = ParseAddress(strings.ToLower(s))
+		assert.Nil(t, err)
+		_, err = ParseAddress("0x" + strings.ToUpper(s[2:]))
+		assert.Nil(t, err)
+	}
+	_, err := ParseAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD")
+	assert.NotNil(t, err, "invalid checksum")
+}
+
+func TestTextMarshaling(t *testing.T) {
+	addr := BytesToAddress([]byte("addr"))
+	b32 := BytesToBytes32([]byte("b32"))
+
+	// as map keys and values not addressable
+	data, err := json.Marshal(map[Address]Bytes32{addr: b32})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"`+addr.ChecksumString()+`":"`+b32.String()+`"}`, string(data))
+
+	var dec map[Address]Bytes32
+	assert.Nil(t, json.Unmarshal(data, &dec))
+	assert.Equal(t, map[Address]Bytes32{addr: b32}, dec)
+}
+
+func TestIsUint256(t *testing.T) {
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	assert.True(t, IsUint256(new(big.Int)))
+	assert.True(t, IsUint256(max))
+	assert.False(t, IsUint256(new(big.Int).Add(max, big.NewInt(1))))
+	assert.False(t, IsUint256(big.NewInt(-1)))
+	assert.False(t, IsUint256(nil))
+}
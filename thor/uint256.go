@@ -0,0 +1,14 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package thor
+
+import "math/big"
+
+// IsUint256 returns whether v is non-negative and fits in 256 bits, as words of VM.
+// Amounts like values, balances and gas prices must satisfy it.
+func IsUint256(v *big.Int) bool {
+	return v != nil && v.Sign() >= 0 && v.BitLen() <= 256
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package thorclient is a Go client of thor's REST and websocket APIs.
+package thorclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/accounts"
+	"github.com/vechain/thor/api/attestations"
+	"github.com/vechain/thor/api/blocks"
+	"github.com/vechain/thor/api/node"
+	"github.com/vechain/thor/api/transactions"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+// Revision to specify which block the request is against.
+type Revision string
+
+// predefined revisions.
+const (
+	RevisionBest      Revision = "best"
+	RevisionFinalized Revision = "finalized"
+)
+
+// RevisionNumber revision of block number.
+func RevisionNumber(num uint32) Revision {
+	return Revision(fmt.Sprintf("%d", num))
+}
+
+// RevisionID revision of block ID.
+func RevisionID(id thor.Bytes32) Revision {
+	return Revision(id.String())
+}
+
+// HTTPError returned when API responds non-200 status.
+type HTTPError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("http %d: %s", e.StatusCode, e.Message)
+}
+
+// TxRejectedError returned when tx is rejected by tx pool of the node.
+type TxRejectedError struct {
+	Reason  string
+	Message string
+}
+
+func (e *TxRejectedError) Error() string {
+	return fmt.Sprintf("tx rejected (%s): %s", e.Reason, e.Message)
+}
+
+// Client client of thor API.
+type Client struct {
+	url     string
+	http    *http.Client
+	retries int
+	backoff time.Duration
+}
+
+// New create a client with the base URL of API, e.g. http://localhost:8669.
+func New(url string) *Client {
+	return &Client{
+		url:     strings.TrimRight(url, "/"),
+		http:    &http.Client{Timeout: 20 * time.Second},
+		retries: 3,
+		backoff: 500 * time.Millisecond,
+	}
+}
+
+// SetRetry sets max retries and the initial backoff, which doubles after each retry.
+// Only read requests are retried, on network errors or 5xx responses.
+func (c *Client) SetRetry(retries int, backoff time.Duration) {
+	c.retries = retries
+	c.backoff = backoff
+}
+
+// GetBlock returns block at given revision, or nil if not found.
+func (c *Client) GetBlock(rev Revision) (*blocks.Block, error) {
+	var blk *blocks.Block
+	if err := c.get("/blocks/"+string(rev), &blk); err != nil {
+		return nil, err
+	}
+	return blk, nil
+}
+
+// GetAccount returns account at given revision.
+func (c *Client) GetAccount(addr thor.Address, rev Revision) (*accounts.Account, error) {
+	var acc *accounts.Account
+	if err := c.get("/accounts/"+addr.String()+"?revision="+string(rev), &acc); err != nil {
+		return nil, err
+	}
+	return acc, nil
+}
+
+// GetTransaction returns tx by ID, or nil if not found.
+func (c *Client) GetTransaction(id thor.Bytes32) (*transactions.Transaction, error) {
+	var trx *transactions.Transaction
+	if err := c.get("/transactions/"+id.String(), &trx); err != nil {
+		return nil, err
+	}
+	return trx, nil
+}
+
+// GetReceipt returns receipt of tx, or nil if the tx not packed yet.
+func (c *Client) GetReceipt(id thor.Bytes32) (*transactions.Receipt, error) {
+	var receipt *transactions.Receipt
+	if err := c.get("/transactions/"+id.String()+"/receipt", &receipt); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// GetSlot returns the block production schedule as seen by the node.
+func (c *Client) GetSlot() (*node.Slot, error) {
+	var slot *node.Slot
+	if err := c.get("/node/packer/slot", &slot); err != nil {
+		return nil, err
+	}
+	return slot, nil
+}
+
+// GetAttestation returns attestation of contract event by hash, or nil if not found.
+func (c *Client) GetAttestation(hash thor.Bytes32) (*attestations.Attestation, error) {
+	var at *attestations.Attestation
+	if err := c.get("/attestations/"+hash.String(), &at); err != nil {
+		return nil, err
+	}
+	return at, nil
+}
+
+// Call executes a call on contract, and no tx will be sent.
+// If contract is nil, the data is treated as contract creation code.
+func (c *Client) Call(contract *thor.Address, data *accounts.CallData, rev Revision) (*accounts.CallResult, error) {
+	path := "/accounts"
+	if contract != nil {
+		path += "/" + contract.String()
+	}
+	var result *accounts.CallResult
+	if err := c.post(path+"?revision="+string(rev), data, &result, true); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SendTransaction sends a signed tx to the node, and returns tx ID.
+// *TxRejectedError is returned if the tx is rejected.
+func (c *Client) SendTransaction(trx *tx.Transaction) (thor.Bytes32, error) {
+	raw, err := rlp.EncodeToBytes(trx)
+	if err != nil {
+		return thor.Bytes32{}, err
+	}
+	var result struct {
+		ID thor.Bytes32 `json:"id"`
+	}
+	if err := c.post("/transactions", &transactions.RawTx{Raw: hexutil.Encode(raw)}, &result, false); err != nil {
+		if httpErr, ok := err.(*HTTPError); ok {
+			var rejected transactions.RejectedTx
+			if json.Unmarshal([]byte(httpErr.Message), &rejected) == nil && rejected.Reason != "" {
+				return thor.Bytes32{}, &TxRejectedError{rejected.Reason, rejected.Error}
+			}
+		}
+		return thor.Bytes32{}, err
+	}
+	return result.ID, nil
+}
+
+func (c *Client) get(path string, result interface{}) error {
+	return c.do(http.MethodGet, path, nil, result, true)
+}
+
+func (c *Client) post(path string, body interface{}, result interface{}, retry bool) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return c.do(http.MethodPost, path, data, result, retry)
+}
+
+func (c *Client) do(method, path string, body []byte, result interface{}, retry bool) error {
+	backoff := c.backoff
+	for i := 0; ; i++ {
+		err := c.doOnce(method, path, body, result)
+		if err == nil || !retry || i >= c.retries || !isRetriable(err) {
+			return err
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+func (c *Client) doOnce(method, path string, body []byte, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(method, c.url+path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return &HTTPError{resp.StatusCode, strings.TrimSpace(string(data))}
+	}
+	if err := json.Unmarshal(data, result); err != nil {
+		return errors.WithMessage(err, "decode response")
+	}
+	return nil
+}
+
+func isRetriable(err error) bool {
+	switch e := err.(type) {
+	case *HTTPError:
+		return e.StatusCode >= 500
+	case net.Error:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package thorclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/api/blocks"
+	"github.com/vechain/thor/api/subscriptions"
+	"github.com/vechain/thor/api/transactions"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func TestGetBlock(t *testing.T) {
+	id := thor.BytesToBytes32([]byte("block"))
+	failures := 2
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if failures > 0 {
+			failures--
+			writeJSON(w, http.StatusInternalServerError, nil)
+			return
+		}
+		switch req.URL.Path {
+		case "/blocks/best":
+			writeJSON(w, http.StatusOK, &blocks.Block{ID: id, Number: 1})
+		default:
+			writeJSON(w, http.StatusOK, nil)
+		}
+	}))
+	defer ts.Close()
+
+	c := New(ts.URL)
+	c.SetRetry(2, time.Millisecond)
+
+	blk, err := c.GetBlock(RevisionBest)
+	assert.Nil(t, err)
+	assert.Equal(t, id, blk.ID)
+
+	blk, err = c.GetBlock(RevisionNumber(100))
+	assert.Nil(t, err)
+	assert.Nil(t, blk)
+
+	failures = 3
+	_, err = c.GetBlock(RevisionBest)
+	assert.Equal(t, http.StatusInternalServerError, err.(*HTTPError).StatusCode)
+}
+
+func TestSendTransaction(t *testing.T) {
+	trx := new(tx.Builder).Build()
+	rejected := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if rejected {
+			writeJSON(w, http.StatusForbidden, &transactions.RejectedTx{Reason: "expired", Error: "tx rejected: expired"})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]string{"id": trx.ID().String()})
+	}))
+	defer ts.Close()
+
+	c := New(ts.URL)
+	id, err := c.SendTransaction(trx)
+	assert.Nil(t, err)
+	assert.Equal(t, trx.ID(), id)
+
+	rejected = true
+	_, err = c.SendTransaction(trx)
+	assert.Equal(t, "expired", err.(*TxRejectedError).Reason)
+}
+
+func TestSubscribeBlocks(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		conn, err := upgrader.Upgrade(w, req, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for i := uint32(0); i < 3; i++ {
+			conn.WriteJSON(&subscriptions.BlockMessage{Number: i})
+		}
+		// wait for client to close
+		conn.ReadMessage()
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan *subscriptions.BlockMessage)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- New(ts.URL).SubscribeBlocks(ctx, nil, ch)
+	}()
+
+	for i := uint32(0); i < 3; i++ {
+		assert.Equal(t, i, (<-ch).Number)
+	}
+	cancel()
+	assert.Equal(t, context.Canceled, <-errCh)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package thorclient
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/api/subscriptions"
+	"github.com/vechain/thor/thor"
+)
+
+// SubscribeBlocks subscribes new blocks, and sends them to ch.
+// Blocks after pos are sent first if pos is given, otherwise it starts from the best block.
+// It blocks until ctx is done or the connection is broken.
+func (c *Client) SubscribeBlocks(ctx context.Context, pos *thor.Bytes32, ch chan<- *subscriptions.BlockMessage) error {
+	url := "/subscriptions/block"
+	if pos != nil {
+		url += "?pos=" + pos.String()
+	}
+	conn, err := c.dial(url)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// unblock reading when ctx done
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	for {
+		var msg subscriptions.BlockMessage
+		if err := conn.ReadJSON(&msg); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		select {
+		case ch <- &msg:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (c *Client) dial(path string) (*websocket.Conn, error) {
+	url := c.url + path
+	switch {
+	case strings.HasPrefix(url, "https://"):
+		url = "wss://" + strings.TrimPrefix(url, "https://")
+	case strings.HasPrefix(url, "http://"):
+		url = "ws://" + strings.TrimPrefix(url, "http://")
+	}
+	conn, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		if resp != nil {
+			return nil, &HTTPError{resp.StatusCode, resp.Status}
+		}
+		return nil, errors.WithMessage(err, "dial")
+	}
+	return conn, nil
+}
@@ -0,0 +1,412 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package tracers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/vechain/thor/vm"
+)
+
+// ResultTracer is a tracer that reports the result in JSON.
+// Both JavaScript tracers and native Go tracers implement it.
+type ResultTracer interface {
+	vm.Tracer
+	GetResult() (json.RawMessage, error)
+}
+
+// natives contains native Go tracers by name, which take precedence over JavaScript ones.
+var natives = make(map[string]func() ResultTracer)
+
+// RegisterNative registers a native Go tracer. It's not thread-safe, and should be called in init.
+func RegisterNative(name string, ctor func() ResultTracer) {
+	if _, ok := natives[name]; ok {
+		panic(fmt.Sprintf("tracer %v already registered", name))
+	}
+	natives[name] = ctor
+}
+
+// NativeByName returns the constructor of native tracer by name.
+func NativeByName(name string) (func() ResultTracer, bool) {
+	ctor, ok := natives[name]
+	return ctor, ok
+}
+
+func init() {
+	RegisterNative("4byteTracer", func() ResultTracer { return &fourByteTracer{ids: make(map[string]int)} })
+	RegisterNative("callTracer", func() ResultTracer { return &callTracer{callstack: []*callFrame{{}}} })
+	RegisterNative("prestateTracer", func() ResultTracer {
+		return &prestateTracer{
+			prestate: make(map[common.Address]*prestateAccount),
+			created:  make(map[common.Address]bool),
+		}
+	})
+}
+
+// peek returns the nth-from-the-top element of the stack, or zero if out of bound.
+// Stacks are pooled, so the element should not be retained.
+func peek(stack *vm.Stack, n int) *big.Int {
+	if len(stack.Data()) <= n {
+		return new(big.Int)
+	}
+	return stack.Back(n)
+}
+
+// slice returns a copy of the memory in range [offset, offset+size), or nil if out of bound.
+func slice(memory *vm.Memory, offset, size *big.Int) []byte {
+	if !offset.IsInt64() || !size.IsInt64() || offset.Int64()+size.Int64() > int64(memory.Len()) {
+		return nil
+	}
+	return memory.Get(offset.Int64(), size.Int64())
+}
+
+// fourByteTracer is the native version of 4byte_tracer.js.
+// It collects selectors and sizes of call data of all calls, as map "selector-size" -> count.
+type fourByteTracer struct {
+	ids   map[string]int
+	input []byte
+}
+
+func (t *fourByteTracer) store(id []byte, size int) {
+	t.ids[fmt.Sprintf("%v-%v", hexutil.Encode(id), size)]++
+}
+
+func (t *fourByteTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	t.input = input
+	return nil
+}
+
+func (t *fourByteTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	// stack position of input offset
+	var pos int
+	switch op {
+	case vm.CALL, vm.CALLCODE:
+		pos = 3
+	case vm.DELEGATECALL, vm.STATICCALL:
+		pos = 2
+	default:
+		return nil
+	}
+	if len(stack.Data()) < pos+2 {
+		return nil
+	}
+	if _, ok := vm.PrecompiledContractsByzantium[common.BigToAddress(stack.Back(1))]; ok {
+		return nil
+	}
+	inOff, inSize := stack.Back(pos), stack.Back(pos+1)
+	if !inSize.IsInt64() || inSize.Int64() < 4 || !inOff.IsInt64() || inOff.Int64() > int64(memory.Len())-4 {
+		return nil
+	}
+	t.store(memory.Get(inOff.Int64(), 4), int(inSize.Int64()-4))
+	return nil
+}
+
+func (t *fourByteTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	return nil
+}
+
+func (t *fourByteTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	return nil
+}
+
+func (t *fourByteTracer) GetResult() (json.RawMessage, error) {
+	if len(t.input) >= 4 {
+		t.store(t.input[:4], len(t.input)-4)
+	}
+	return json.Marshal(t.ids)
+}
+
+// callFrame is a call in the result of callTracer, laid out the same as call_tracer.js does.
+type callFrame struct {
+	Type    string       `json:"type,omitempty"`
+	From    string       `json:"from,omitempty"`
+	To      string       `json:"to,omitempty"`
+	Value   string       `json:"value,omitempty"`
+	Gas     string       `json:"gas,omitempty"`
+	GasUsed string       `json:"gasUsed,omitempty"`
+	Input   string       `json:"input,omitempty"`
+	Output  string       `json:"output,omitempty"`
+	Error   string       `json:"error,omitempty"`
+	Time    string       `json:"time,omitempty"`
+	Calls   []*callFrame `json:"calls,omitempty"`
+
+	gasIn   uint64
+	gasCost uint64
+	gas     *uint64 // gas available in the callee, nil until entered
+	outOff  *big.Int
+	outLen  *big.Int
+}
+
+// callTracer is the native version of call_tracer.js.
+// It reports the tree of internal calls, with their inputs, outputs and gas.
+type callTracer struct {
+	callstack []*callFrame
+	descended bool
+	root      callFrame
+	err       error
+}
+
+func (t *callTracer) top() *callFrame {
+	return t.callstack[len(t.callstack)-1]
+}
+
+func (t *callTracer) pop() *callFrame {
+	call := t.callstack[len(t.callstack)-1]
+	t.callstack = t.callstack[:len(t.callstack)-1]
+	return call
+}
+
+func (t *callTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	t.root = callFrame{
+		Type:  "CALL",
+		From:  hexutil.Encode(from[:]),
+		To:    hexutil.Encode(to[:]),
+		Value: hexutil.EncodeBig(value),
+		Gas:   hexutil.EncodeUint64(gas),
+		Input: hexutil.Encode(input),
+	}
+	if create {
+		t.root.Type = "CREATE"
+	}
+	return nil
+}
+
+func (t *callTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	if err != nil {
+		return t.CaptureFault(env, pc, op, gas, cost, memory, stack, contract, depth, err)
+	}
+	switch op {
+	case vm.CREATE:
+		from := contract.Address()
+		t.callstack = append(t.callstack, &callFrame{
+			Type:    op.String(),
+			From:    hexutil.Encode(from[:]),
+			Input:   hexutil.Encode(slice(memory, peek(stack, 1), peek(stack, 2))),
+			Value:   hexutil.EncodeBig(peek(stack, 0)),
+			gasIn:   gas,
+			gasCost: cost,
+		})
+		t.descended = true
+		return nil
+	case vm.SELFDESTRUCT:
+		t.top().Calls = append(t.top().Calls, &callFrame{Type: op.String()})
+		return nil
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		to := common.BigToAddress(peek(stack, 1))
+		if _, ok := vm.PrecompiledContractsByzantium[to]; ok {
+			return nil
+		}
+		off := 1
+		if op == vm.DELEGATECALL || op == vm.STATICCALL {
+			off = 0
+		}
+		from := contract.Address()
+		call := &callFrame{
+			Type:    op.String(),
+			From:    hexutil.Encode(from[:]),
+			To:      hexutil.Encode(to[:]),
+			Input:   hexutil.Encode(slice(memory, peek(stack, 2+off), peek(stack, 3+off))),
+			gasIn:   gas,
+			gasCost: cost,
+			outOff:  new(big.Int).Set(peek(stack, 4+off)),
+			outLen:  new(big.Int).Set(peek(stack, 5+off)),
+		}
+		if off == 1 {
+			call.Value = hexutil.EncodeBig(peek(stack, 2))
+		}
+		t.callstack = append(t.callstack, call)
+		t.descended = true
+		return nil
+	}
+	if t.descended {
+		if depth >= len(t.callstack) {
+			g := gas
+			t.top().gas = &g
+		}
+		t.descended = false
+	}
+	if op == vm.REVERT {
+		t.top().Error = "execution reverted"
+		return nil
+	}
+	if depth == len(t.callstack)-1 {
+		call := t.pop()
+		ret := peek(stack, 0)
+		if call.Type == vm.CREATE.String() {
+			call.GasUsed = hexutil.EncodeUint64(call.gasIn - call.gasCost - gas)
+			if ret.Sign() != 0 {
+				addr := common.BigToAddress(ret)
+				call.To = hexutil.Encode(addr[:])
+				call.Output = hexutil.Encode(env.StateDB.GetCode(addr))
+			} else if call.Error == "" {
+				call.Error = "internal failure"
+			}
+		} else if call.gas != nil {
+			call.GasUsed = hexutil.EncodeUint64(call.gasIn - call.gasCost + *call.gas - gas)
+			if ret.Sign() != 0 {
+				call.Output = hexutil.Encode(slice(memory, call.outOff, call.outLen))
+			} else if call.Error == "" {
+				call.Error = "internal failure"
+			}
+		}
+		if call.gas != nil {
+			call.Gas = hexutil.EncodeUint64(*call.gas)
+		}
+		t.top().Calls = append(t.top().Calls, call)
+	}
+	return nil
+}
+
+func (t *callTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	if t.top().Error != "" {
+		return nil
+	}
+	call := t.pop()
+	call.Error = err.Error()
+	if call.gas != nil {
+		call.Gas = hexutil.EncodeUint64(*call.gas)
+		call.GasUsed = call.Gas
+	}
+	if len(t.callstack) > 0 {
+		t.top().Calls = append(t.top().Calls, call)
+	} else {
+		t.callstack = append(t.callstack, call)
+	}
+	return nil
+}
+
+func (t *callTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	t.root.Output = hexutil.Encode(output)
+	t.root.GasUsed = hexutil.EncodeUint64(gasUsed)
+	t.root.Time = d.String()
+	t.err = err
+	return nil
+}
+
+func (t *callTracer) GetResult() (json.RawMessage, error) {
+	result := t.root
+	result.Calls = t.callstack[0].Calls
+	if t.callstack[0].Error != "" {
+		result.Error = t.callstack[0].Error
+	} else if t.err != nil {
+		result.Error = t.err.Error()
+	}
+	if result.Error != "" {
+		result.Output = ""
+	}
+	return json.Marshal(&result)
+}
+
+// prestateAccount is an account in the result of prestateTracer.
+// Nonce is always 0, since accounts have no nonce in Thor.
+type prestateAccount struct {
+	Balance string            `json:"balance"`
+	Nonce   uint64            `json:"nonce"`
+	Code    string            `json:"code"`
+	Storage map[string]string `json:"storage"`
+
+	balance *big.Int
+}
+
+// prestateTracer is the native version of prestate_tracer.js.
+// It reports accounts and storage touched by the clause, in state before executed.
+type prestateTracer struct {
+	prestate map[common.Address]*prestateAccount
+	created  map[common.Address]bool
+	creates  []int // depths of pending CREATE
+	db       vm.StateDB
+	from     common.Address
+	to       common.Address
+	create   bool
+	value    *big.Int
+}
+
+func (t *prestateTracer) lookupAccount(addr common.Address) {
+	if _, ok := t.prestate[addr]; ok {
+		return
+	}
+	t.prestate[addr] = &prestateAccount{
+		balance: new(big.Int).Set(t.db.GetBalance(addr)),
+		Nonce:   t.db.GetNonce(addr),
+		Code:    hexutil.Encode(t.db.GetCode(addr)),
+		Storage: make(map[string]string),
+	}
+}
+
+func (t *prestateTracer) lookupStorage(addr common.Address, key common.Hash) {
+	acc := t.prestate[addr]
+	k := hexutil.Encode(key[:])
+	if _, ok := acc.Storage[k]; ok {
+		return
+	}
+	if val := t.db.GetState(addr, key); val != (common.Hash{}) {
+		acc.Storage[k] = hexutil.Encode(val[:])
+	}
+}
+
+func (t *prestateTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	t.from, t.to, t.create = from, to, create
+	t.value = new(big.Int).Set(value)
+	return nil
+}
+
+func (t *prestateTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	if t.db == nil {
+		// value is already transferred when the first op executed
+		t.db = env.StateDB
+		t.lookupAccount(t.from)
+		t.lookupAccount(t.to)
+		t.prestate[t.from].balance.Add(t.prestate[t.from].balance, t.value)
+		t.prestate[t.to].balance.Sub(t.prestate[t.to].balance, t.value)
+		if t.create {
+			t.created[t.to] = true
+		}
+	}
+	// back from the init code, the address of the created contract is on top of the stack
+	for len(t.creates) > 0 && depth <= t.creates[len(t.creates)-1] {
+		t.creates = t.creates[:len(t.creates)-1]
+		if addr := peek(stack, 0); addr.Sign() != 0 {
+			t.created[common.BigToAddress(addr)] = true
+		}
+	}
+	switch op {
+	case vm.EXTCODECOPY, vm.EXTCODESIZE, vm.BALANCE:
+		t.lookupAccount(common.BigToAddress(peek(stack, 0)))
+	case vm.CREATE:
+		t.creates = append(t.creates, depth)
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		t.lookupAccount(common.BigToAddress(peek(stack, 1)))
+	case vm.SSTORE, vm.SLOAD:
+		t.lookupAccount(contract.Address())
+		t.lookupStorage(contract.Address(), common.BigToHash(peek(stack, 0)))
+	}
+	return nil
+}
+
+func (t *prestateTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	return nil
+}
+
+func (t *prestateTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	return nil
+}
+
+func (t *prestateTracer) GetResult() (json.RawMessage, error) {
+	result := make(map[string]*prestateAccount, len(t.prestate))
+	for addr, acc := range t.prestate {
+		if t.created[addr] {
+			continue
+		}
+		acc.Balance = hexutil.EncodeBig(acc.balance)
+		result[hexutil.Encode(addr[:])] = acc
+	}
+	return json.Marshal(result)
+}
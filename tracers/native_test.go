@@ -0,0 +1,174 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package tracers_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/chain"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/runtime"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tracers"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/vm"
+	"github.com/vechain/thor/xenv"
+)
+
+var (
+	caller = thor.BytesToAddress([]byte("caller"))
+	callee = thor.BytesToAddress([]byte("callee"))
+	origin = genesis.DevAccounts()[0].Address
+)
+
+// newRuntime deploys the caller, which calls callee with selector 0x12345678,
+// and the callee, which reads and writes slot 0 and returns 0x2a.
+func newRuntime(t *testing.T) *runtime.Runtime {
+	kv, _ := lvldb.NewMem()
+	b0, _, err := genesis.NewDevnet().Build(state.NewCreator(kv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch, _ := chain.New(kv, b0)
+	st, _ := state.New(b0.Header().StateRoot(), kv)
+
+	code := []byte{
+		0x63, 0x12, 0x34, 0x56, 0x78, // PUSH4 0x12345678
+		0x60, 0x00, 0x52, // PUSH1 0 MSTORE
+		0x60, 0x20, // PUSH1 32, out size
+		0x60, 0x00, // PUSH1 0, out offset
+		0x60, 0x04, // PUSH1 4, in size
+		0x60, 0x1c, // PUSH1 28, in offset
+		0x60, 0x00, // PUSH1 0, value
+		0x73, // PUSH20 callee
+	}
+	code = append(code, callee.Bytes()...)
+	code = append(code,
+		0x5a, 0xf1, 0x50, // GAS CALL POP
+		0x00, // STOP
+	)
+	st.SetCode(caller, code)
+	st.SetCode(callee, []byte{
+		0x60, 0x00, 0x54, 0x50, // PUSH1 0 SLOAD POP
+		0x60, 0x2a, 0x60, 0x00, 0x55, // PUSH1 0x2a PUSH1 0 SSTORE
+		0x60, 0x2a, 0x60, 0x00, 0x52, // PUSH1 0x2a PUSH1 0 MSTORE
+		0x60, 0x20, 0x60, 0x00, 0xf3, // PUSH1 32 PUSH1 0 RETURN
+	})
+	st.SetStorage(callee, thor.Bytes32{}, thor.BytesToBytes32([]byte{1}))
+	st.SetBalance(callee, big.NewInt(100))
+
+	return runtime.New(ch.NewSeeker(b0.Header().ID()), st, &xenv.BlockContext{})
+}
+
+// trace executes the clause under the named native tracer, and decodes the result.
+func trace(t *testing.T, rt *runtime.Runtime, name string, clause *tx.Clause, result interface{}) {
+	ctor, ok := tracers.NativeByName(name)
+	if !ok {
+		t.Fatal("tracer not found", name)
+	}
+	tracer := ctor()
+	rt.SetVMConfig(vm.Config{Debug: true, Tracer: tracer})
+	out := rt.ExecuteClause(clause, 0, 1000000, &xenv.TransactionContext{Origin: origin})
+	assert.Nil(t, out.VMErr)
+
+	data, err := tracer.GetResult()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, result); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFourByteTracer(t *testing.T) {
+	rt := newRuntime(t)
+	var result map[string]int
+	trace(t, rt, "4byteTracer", tx.NewClause(&caller).WithData(hexutil.MustDecode("0xaabbccdd00")), &result)
+	assert.Equal(t, map[string]int{"0x12345678-0": 1, "0xaabbccdd-1": 1}, result)
+}
+
+func TestCallTracer(t *testing.T) {
+	rt := newRuntime(t)
+
+	type frame struct {
+		Type    string
+		From    string
+		To      string
+		Value   string
+		Gas     string
+		GasUsed string
+		Input   string
+		Output  string
+		Error   string
+		Calls   []frame
+	}
+	var result frame
+	trace(t, rt, "callTracer", tx.NewClause(&caller).WithValue(big.NewInt(1)), &result)
+
+	assert.Equal(t, "CALL", result.Type)
+	assert.Equal(t, hexutil.Encode(origin.Bytes()), result.From)
+	assert.Equal(t, hexutil.Encode(caller.Bytes()), result.To)
+	assert.Equal(t, "0x1", result.Value)
+	assert.Equal(t, "0xf4240", result.Gas)
+	assert.Equal(t, "0x", result.Output)
+	assert.Equal(t, "", result.Error)
+	if assert.Equal(t, 1, len(result.Calls)) {
+		call := result.Calls[0]
+		assert.Equal(t, "CALL", call.Type)
+		assert.Equal(t, hexutil.Encode(caller.Bytes()), call.From)
+		assert.Equal(t, hexutil.Encode(callee.Bytes()), call.To)
+		assert.Equal(t, "0x0", call.Value)
+		assert.Equal(t, "0x12345678", call.Input)
+		assert.Equal(t, hexutil.Encode(thor.BytesToBytes32([]byte{0x2a}).Bytes()), call.Output)
+		assert.NotEmpty(t, call.Gas)
+		assert.NotEmpty(t, call.GasUsed)
+		assert.Empty(t, call.Calls)
+	}
+
+	// contract creation, with init code returning empty code
+	var created frame
+	trace(t, rt, "callTracer", tx.NewClause(nil).WithData([]byte{0x60, 0x00, 0x60, 0x00, 0xf3}), &created)
+	assert.Equal(t, "CREATE", created.Type)
+	assert.Empty(t, created.Calls)
+}
+
+func TestPrestateTracer(t *testing.T) {
+	rt := newRuntime(t)
+
+	type account struct {
+		Balance string
+		Nonce   uint64
+		Code    string
+		Storage map[string]string
+	}
+	var result map[string]account
+	trace(t, rt, "prestateTracer", tx.NewClause(&caller).WithValue(big.NewInt(1)), &result)
+
+	assert.Equal(t, 3, len(result))
+	// value already transferred is restored
+	assert.Equal(t, "0x0", result[hexutil.Encode(caller.Bytes())].Balance)
+	assert.Equal(t, hexutil.Encode(rt.State().GetCode(caller)), result[hexutil.Encode(caller.Bytes())].Code)
+	assert.Equal(t, hexutil.EncodeBig(new(big.Int).Add(rt.State().GetBalance(origin), big.NewInt(1))), result[hexutil.Encode(origin.Bytes())].Balance)
+
+	// storage before written
+	acc := result[hexutil.Encode(callee.Bytes())]
+	assert.Equal(t, "0x64", acc.Balance)
+	assert.Equal(t, map[string]string{
+		hexutil.Encode(thor.Bytes32{}.Bytes()): hexutil.Encode(thor.BytesToBytes32([]byte{1}).Bytes()),
+	}, acc.Storage)
+
+	// the created contract is excluded
+	var created map[string]account
+	trace(t, rt, "prestateTracer", tx.NewClause(nil).WithData([]byte{0x60, 0x00, 0x60, 0x00, 0xf3}), &created)
+	assert.Equal(t, 1, len(created))
+	assert.Contains(t, created, hexutil.Encode(origin.Bytes()))
+}
@@ -15,6 +15,10 @@ type DerivableList interface {
 }
 
 func DeriveRoot(list DerivableList) thor.Bytes32 {
+	return deriveTrie(list).Hash()
+}
+
+func deriveTrie(list DerivableList) *Trie {
 	keybuf := new(bytes.Buffer)
 	trie := new(Trie)
 	for i := 0; i < list.Len(); i++ {
@@ -22,5 +26,23 @@ func DeriveRoot(list DerivableList) thor.Bytes32 {
 		rlp.Encode(keybuf, uint(i))
 		trie.Update(keybuf.Bytes(), list.GetRlp(i))
 	}
-	return trie.Hash()
+	return trie
+}
+
+// DeriveProof constructs merkle proof of the i-th item of the list, against the root
+// computed by DeriveRoot.
+func DeriveProof(list DerivableList, i int) ([][]byte, error) {
+	key, _ := rlp.EncodeToBytes(uint(i))
+	var proof ProofList
+	if err := deriveTrie(list).Prove(key, 0, &proof); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// VerifyDerivedProof verifies proof of the i-th item of a list against the root, and
+// returns the rlp encoded item.
+func VerifyDerivedProof(root thor.Bytes32, i int, proof [][]byte) ([]byte, error) {
+	key, _ := rlp.EncodeToBytes(uint(i))
+	return VerifyProofList(root, key, proof)
 }
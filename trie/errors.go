@@ -19,6 +19,7 @@ package trie
 import (
 	"fmt"
 
+	"github.com/pkg/errors"
 	"github.com/vechain/thor/thor"
 )
 
@@ -33,3 +34,10 @@ type MissingNodeError struct {
 func (err *MissingNodeError) Error() string {
 	return fmt.Sprintf("missing trie node %x (path %x)", err.NodeHash, err.Path)
 }
+
+// IsMissingNode returns whether the error is caused by a trie node not present in the
+// local database, e.g. pruned.
+func IsMissingNode(err error) bool {
+	_, ok := errors.Cause(err).(*MissingNodeError)
+	return ok
+}
@@ -141,3 +141,35 @@ func get(tn node, key []byte) ([]byte, node) {
 		}
 	}
 }
+
+// ProofList collects encoded nodes of a merkle proof in order.
+// It implements DatabaseWriter, to be passed to Prove.
+type ProofList [][]byte
+
+// Put appends the node.
+func (l *ProofList) Put(key []byte, value []byte) error {
+	*l = append(*l, append([]byte(nil), value...))
+	return nil
+}
+
+// proofDB serves proof nodes keyed by their hash.
+type proofDB map[thor.Bytes32][]byte
+
+func (db proofDB) Get(key []byte) ([]byte, error) {
+	return db[thor.BytesToBytes32(key)], nil
+}
+
+func (db proofDB) Has(key []byte) (bool, error) {
+	_, ok := db[thor.BytesToBytes32(key)]
+	return ok, nil
+}
+
+// VerifyProofList is like VerifyProof, but takes proof in form of a node list.
+func VerifyProofList(rootHash thor.Bytes32, key []byte, proof [][]byte) ([]byte, error) {
+	db := make(proofDB, len(proof))
+	for _, node := range proof {
+		db[thor.Blake2b(node)] = node
+	}
+	value, err, _ := VerifyProof(rootHash, key, db)
+	return value, err
+}
@@ -78,6 +78,9 @@ func TestMissingRoot(t *testing.T) {
 	if _, ok := err.(*MissingNodeError); !ok {
 		t.Errorf("New returned wrong error: %v", err)
 	}
+	if !IsMissingNode(err) {
+		t.Errorf("IsMissingNode returned false for %v", err)
+	}
 }
 
 func TestMissingNode(t *testing.T) {
@@ -6,6 +6,7 @@
 package tx
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/rlp"
@@ -23,12 +24,68 @@ type Receipt struct {
 	Paid *big.Int
 	// energy reward given to block proposer
 	Reward *big.Int
-	// if the tx reverted
+	// if the tx reverted, i.e. any clause failed, so that all state changes made by clauses are discarded
 	Reverted bool
-	// outputs of clauses in tx
+	// outputs of clauses in tx, empty if reverted
 	Outputs []*Output
 }
 
+// ReceiptVersion the highest supported version of receipt encoding.
+// Version 0 is the legacy encoding without version tag. A later version appends the version
+// number followed by fields introduced, to the legacy RLP list, like block headers do.
+const ReceiptVersion = 0
+
+const numLegacyReceiptFields = 6
+
+// legacyReceipt to decode legacy fields by default rules.
+type legacyReceipt Receipt
+
+// DecodeRLP implements rlp.Decoder.
+func (r *Receipt) DecodeRLP(s *rlp.Stream) error {
+	raw, err := s.Raw()
+	if err != nil {
+		return err
+	}
+	content, _, err := rlp.SplitList(raw)
+	if err != nil {
+		return err
+	}
+	n, err := rlp.CountValues(content)
+	if err != nil {
+		return err
+	}
+	if n > numLegacyReceiptFields {
+		var fields []rlp.RawValue
+		if err := rlp.DecodeBytes(raw, &fields); err != nil {
+			return err
+		}
+		var version uint64
+		if err := rlp.DecodeBytes(fields[numLegacyReceiptFields], &version); err != nil {
+			return err
+		}
+		if version == 0 || version > ReceiptVersion {
+			return unsupportedVersionError(version)
+		}
+		// fields of later versions to be decoded here
+		if raw, err = rlp.EncodeToBytes(fields[:numLegacyReceiptFields]); err != nil {
+			return err
+		}
+	}
+	return rlp.DecodeBytes(raw, (*legacyReceipt)(r))
+}
+
+type unsupportedVersionError uint64
+
+func (e unsupportedVersionError) Error() string {
+	return fmt.Sprintf("unsupported receipt encoding version %v, node upgrade may be required", uint64(e))
+}
+
+// IsUnsupportedVersion returns whether the error is caused by unknown encoding version.
+func IsUnsupportedVersion(err error) bool {
+	_, ok := err.(unsupportedVersionError)
+	return ok
+}
+
 // Output output of clause execution.
 type Output struct {
 	// events produced by the clause
@@ -49,6 +106,11 @@ func (rs Receipts) RootHash() thor.Bytes32 {
 	return trie.DeriveRoot(derivableReceipts(rs))
 }
 
+// Proof returns merkle proof of the i-th receipt against the root hash.
+func (rs Receipts) Proof(i int) ([][]byte, error) {
+	return trie.DeriveProof(derivableReceipts(rs), i)
+}
+
 // implements DerivableList
 type derivableReceipts Receipts
 
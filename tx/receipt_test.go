@@ -7,8 +7,12 @@ package tx_test
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/trie"
 	. "github.com/vechain/thor/tx"
 )
 
@@ -19,3 +23,38 @@ func TestReceipt(t *testing.T) {
 	var txs Transactions
 	fmt.Println(txs.RootHash())
 }
+
+func TestReceiptVersion(t *testing.T) {
+	r := &Receipt{Paid: big.NewInt(1), Reward: big.NewInt(2), Outputs: []*Output{{}}}
+	data, _ := rlp.EncodeToBytes(r)
+
+	var decoded Receipt
+	assert.Nil(t, rlp.DecodeBytes(data, &decoded))
+	reencoded, _ := rlp.EncodeToBytes(&decoded)
+	assert.Equal(t, data, reencoded)
+
+	var fields []rlp.RawValue
+	rlp.DecodeBytes(data, &fields)
+	data, _ = rlp.EncodeToBytes(append(fields, rlp.RawValue{0x01}))
+	assert.True(t, IsUnsupportedVersion(rlp.DecodeBytes(data, &decoded)))
+}
+
+func TestProof(t *testing.T) {
+	var txs Transactions
+	for i := 0; i < 20; i++ {
+		txs = append(txs, new(Builder).Nonce(uint64(i)).Build())
+	}
+	root := txs.RootHash()
+	for i, tx := range txs {
+		proof, err := txs.Proof(i)
+		assert.Nil(t, err)
+		data, err := trie.VerifyDerivedProof(root, i, proof)
+		assert.Nil(t, err)
+		enc, _ := rlp.EncodeToBytes(tx)
+		assert.Equal(t, enc, data)
+	}
+
+	proof, _ := txs.Proof(0)
+	_, err := trie.VerifyDerivedProof(Receipts{}.RootHash(), 0, proof)
+	assert.NotNil(t, err)
+}
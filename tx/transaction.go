@@ -185,6 +185,26 @@ func (t *Transaction) Clauses() []*Clause {
 	return append([]*Clause(nil), t.body.Clauses...)
 }
 
+// ContractAddresses precomputes addresses of contracts deployed by clauses, which have nil To.
+// The result is indexed by clause index, with nil for other clauses.
+// Since addresses are derived from tx ID and clause index, rather than account nonce,
+// the tx must be signed.
+func (t *Transaction) ContractAddresses() ([]*thor.Address, error) {
+	id := t.ID()
+	if id.IsZero() {
+		return nil, errors.New("tx not signed")
+	}
+	addrs := make([]*thor.Address, len(t.body.Clauses))
+	for i, c := range t.body.Clauses {
+		if c.To() == nil {
+			// the deployment itself is the first creation in the clause
+			addr := thor.CreateContractAddress(id, uint32(i), 0)
+			addrs[i] = &addr
+		}
+	}
+	return addrs, nil
+}
+
 // DependsOn returns depended tx hash.
 func (t *Transaction) DependsOn() *thor.Bytes32 {
 	if t.body.DependsOn == nil {
@@ -242,10 +262,16 @@ func (t *Transaction) EncodeRLP(w io.Writer) error {
 // DecodeRLP implements rlp.Decoder
 func (t *Transaction) DecodeRLP(s *rlp.Stream) error {
 	_, size, _ := s.Kind()
+	if rlp.ListSize(size) > thor.MaxTxSize {
+		return fmt.Errorf("tx size exceeds limit %v", thor.MaxTxSize)
+	}
 	var body body
 	if err := s.Decode(&body); err != nil {
 		return err
 	}
+	if uint64(len(body.Clauses)) > thor.MaxTxClauses {
+		return fmt.Errorf("tx clause count exceeds limit %v", thor.MaxTxClauses)
+	}
 	*t = Transaction{body: body}
 
 	t.cache.size.Store(metric.StorageSize(rlp.ListSize(size)))
@@ -368,16 +394,61 @@ func (t *Transaction) String() string {
 		t.body.ChainTag, br.Number(), br[4:], t.body.Expiration, dependsOn, t.body.Nonce, t.UnprovedWork(), t.body.Signature)
 }
 
-// IntrinsicGas calculate intrinsic gas cost for tx with such clauses.
-func IntrinsicGas(clauses ...*Clause) (uint64, error) {
+// IntrinsicGasSchedule gas costs to calculate intrinsic gas of txs.
+type IntrinsicGasSchedule struct {
+	TxGas                     uint64 `json:"txGas"`                     // base gas of a tx
+	ClauseGas                 uint64 `json:"clauseGas"`                 // gas of a clause
+	ClauseGasContractCreation uint64 `json:"clauseGasContractCreation"` // gas of a clause creating contract
+	DataZeroGas               uint64 `json:"dataZeroGas"`               // gas of a zero byte of clause data
+	DataNonZeroGas            uint64 `json:"dataNonZeroGas"`            // gas of a non-zero byte of clause data
+}
+
+// DefaultIntrinsicGasSchedule the intrinsic gas schedule of public networks.
+var DefaultIntrinsicGasSchedule = IntrinsicGasSchedule{
+	TxGas:                     thor.TxGas,
+	ClauseGas:                 thor.ClauseGas,
+	ClauseGasContractCreation: thor.ClauseGasContractCreation,
+	DataZeroGas:               params.TxDataZeroGas,
+	DataNonZeroGas:            params.TxDataNonZeroGas,
+}
+
+// maxIntrinsicGasMultiple max multiple of default cost allowed in a schedule.
+const maxIntrinsicGasMultiple = 100
+
+// Validate checks the sanity of the schedule.
+// Base gas of a tx must be positive to keep txs metered, and no cost should exceed the default
+// too much, to avoid overflows.
+func (s *IntrinsicGasSchedule) Validate() error {
+	if s.TxGas == 0 {
+		return errors.New("intrinsic gas schedule: zero tx gas")
+	}
+	for _, pair := range [][2]uint64{
+		{s.TxGas, thor.TxGas},
+		{s.ClauseGas, thor.ClauseGas},
+		{s.ClauseGasContractCreation, thor.ClauseGasContractCreation},
+		{s.DataZeroGas, params.TxDataZeroGas},
+		{s.DataNonZeroGas, params.TxDataNonZeroGas},
+	} {
+		if pair[0] > pair[1]*maxIntrinsicGasMultiple {
+			return fmt.Errorf("intrinsic gas schedule: %v exceeds %v times of default", pair[0], maxIntrinsicGasMultiple)
+		}
+	}
+	if s.ClauseGasContractCreation < s.ClauseGas {
+		return errors.New("intrinsic gas schedule: contract creation cheaper than clause")
+	}
+	return nil
+}
+
+// IntrinsicGas calculate intrinsic gas cost for tx with such clauses, using the schedule.
+func (s *IntrinsicGasSchedule) IntrinsicGas(clauses ...*Clause) (uint64, error) {
 	if len(clauses) == 0 {
-		return thor.TxGas + thor.ClauseGas, nil
+		return s.TxGas + s.ClauseGas, nil
 	}
 
-	var total = thor.TxGas
+	var total = s.TxGas
 	var overflow bool
 	for _, c := range clauses {
-		gas, err := dataGas(c.body.Data)
+		gas, err := s.dataGas(c.body.Data)
 		if err != nil {
 			return 0, err
 		}
@@ -389,9 +460,9 @@ func IntrinsicGas(clauses ...*Clause) (uint64, error) {
 		var cgas uint64
 		if c.IsCreatingContract() {
 			// contract creation
-			cgas = thor.ClauseGasContractCreation
+			cgas = s.ClauseGasContractCreation
 		} else {
-			cgas = thor.ClauseGas
+			cgas = s.ClauseGas
 		}
 
 		total, overflow = math.SafeAdd(total, cgas)
@@ -402,8 +473,13 @@ func IntrinsicGas(clauses ...*Clause) (uint64, error) {
 	return total, nil
 }
 
+// IntrinsicGas calculate intrinsic gas cost for tx with such clauses.
+func IntrinsicGas(clauses ...*Clause) (uint64, error) {
+	return DefaultIntrinsicGasSchedule.IntrinsicGas(clauses...)
+}
+
 // see core.IntrinsicGas
-func dataGas(data []byte) (uint64, error) {
+func (s *IntrinsicGasSchedule) dataGas(data []byte) (uint64, error) {
 	if len(data) == 0 {
 		return 0, nil
 	}
@@ -415,11 +491,11 @@ func dataGas(data []byte) (uint64, error) {
 			nz++
 		}
 	}
-	zgas, overflow := math.SafeMul(params.TxDataZeroGas, z)
+	zgas, overflow := math.SafeMul(s.DataZeroGas, z)
 	if overflow {
 		return 0, errIntrinsicGasOverflow
 	}
-	nzgas, overflow := math.SafeMul(params.TxDataNonZeroGas, nz)
+	nzgas, overflow := math.SafeMul(s.DataNonZeroGas, nz)
 	if overflow {
 		return 0, errIntrinsicGasOverflow
 	}
@@ -69,6 +69,24 @@ func TestIntrinsicGas(t *testing.T) {
 	assert.Equal(t, thor.TxGas+thor.ClauseGas*2, gas)
 }
 
+func TestIntrinsicGasSchedule(t *testing.T) {
+	s := tx.IntrinsicGasSchedule{TxGas: 1, ClauseGas: 1, ClauseGasContractCreation: 2, DataZeroGas: 0, DataNonZeroGas: 1}
+	assert.Nil(t, s.Validate())
+
+	gas, err := s.IntrinsicGas(tx.NewClause(&thor.Address{}).WithData([]byte{0, 1, 2}), tx.NewClause(nil))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1+1+2+2), gas)
+
+	assert.Nil(t, tx.DefaultIntrinsicGasSchedule.Validate())
+	for _, bad := range []tx.IntrinsicGasSchedule{
+		{TxGas: 0, ClauseGas: 1, ClauseGasContractCreation: 1},
+		{TxGas: 1, ClauseGas: 2, ClauseGasContractCreation: 1},
+		{TxGas: thor.TxGas * 1000, ClauseGas: 1, ClauseGasContractCreation: 1},
+	} {
+		assert.NotNil(t, bad.Validate(), "%+v", bad)
+	}
+}
+
 func BenchmarkTxMining(b *testing.B) {
 	tx := new(tx.Builder).Build()
 	signer := thor.BytesToAddress([]byte("acc1"))
@@ -81,3 +99,27 @@ func BenchmarkTxMining(b *testing.B) {
 		}
 	}
 }
+
+func TestContractAddresses(t *testing.T) {
+	to := thor.BytesToAddress([]byte("to"))
+	trx := new(tx.Builder).
+		Clause(tx.NewClause(nil)).
+		Clause(tx.NewClause(&to)).
+		Clause(tx.NewClause(nil)).
+		Build()
+
+	_, err := trx.ContractAddresses()
+	assert.NotNil(t, err, "unsigned tx")
+
+	priv, _ := crypto.GenerateKey()
+	sig, _ := crypto.Sign(trx.SigningHash().Bytes(), priv)
+	trx = trx.WithSignature(sig)
+
+	addrs, err := trx.ContractAddresses()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(addrs))
+	assert.Equal(t, thor.CreateContractAddress(trx.ID(), 0, 0), *addrs[0])
+	assert.Nil(t, addrs[1])
+	assert.Equal(t, thor.CreateContractAddress(trx.ID(), 2, 0), *addrs[2])
+	assert.NotEqual(t, *addrs[0], *addrs[2])
+}
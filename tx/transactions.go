@@ -27,6 +27,11 @@ func (txs Transactions) RootHash() thor.Bytes32 {
 	return trie.DeriveRoot(derivableTxs(txs))
 }
 
+// Proof returns merkle proof of the i-th tx against the root hash.
+func (txs Transactions) Proof(i int) ([][]byte, error) {
+	return trie.DeriveProof(derivableTxs(txs), i)
+}
+
 // implements types.DerivableList
 type derivableTxs Transactions
 
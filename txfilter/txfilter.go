@@ -0,0 +1,171 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package txfilter provides admission filters of txs, for operators with compliance obligations.
+// Filters are local policies of tx pool and packer, and never apply to validating blocks.
+package txfilter
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+// Filter decides whether a tx is admitted.
+type Filter interface {
+	// Check returns an error if the tx is denied.
+	Check(tx *tx.Transaction) error
+}
+
+// Rules denylist of addresses and clause data patterns.
+type Rules struct {
+	Addresses    []thor.Address `json:"addresses"`    // denied as origin or clause recipient
+	DataPatterns []string       `json:"dataPatterns"` // regexps matched against 0x-prefixed lower-case hex of clause data
+}
+
+type deniedError struct {
+	msg string
+}
+
+func (e deniedError) Error() string {
+	return "denied by filter: " + e.msg
+}
+
+// IsDenied returns whether the error indicates the tx is denied by a filter.
+func IsDenied(err error) bool {
+	_, ok := errors.Cause(err).(deniedError)
+	return ok
+}
+
+type compiledRules struct {
+	addresses map[thor.Address]bool
+	patterns  []*regexp.Regexp
+}
+
+func compile(rules *Rules) (*compiledRules, error) {
+	c := &compiledRules{addresses: make(map[thor.Address]bool)}
+	for _, addr := range rules.Addresses {
+		c.addresses[addr] = true
+	}
+	for _, p := range rules.DataPatterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, errors.WithMessage(err, "data pattern")
+		}
+		c.patterns = append(c.patterns, re)
+	}
+	return c, nil
+}
+
+func (c *compiledRules) check(trx *tx.Transaction) error {
+	if len(c.addresses) > 0 {
+		origin, err := trx.Signer()
+		if err != nil {
+			return err
+		}
+		if c.addresses[origin] {
+			return deniedError{"origin " + origin.String()}
+		}
+	}
+	for _, clause := range trx.Clauses() {
+		if to := clause.To(); to != nil && c.addresses[*to] {
+			return deniedError{"recipient " + to.String()}
+		}
+		if len(c.patterns) == 0 {
+			continue
+		}
+		data := hexutil.Encode(clause.Data())
+		for _, re := range c.patterns {
+			if re.MatchString(data) {
+				return deniedError{"data matches " + re.String()}
+			}
+		}
+	}
+	return nil
+}
+
+// New create a filter with fixed rules.
+func New(rules *Rules) (Filter, error) {
+	c, err := compile(rules)
+	if err != nil {
+		return nil, err
+	}
+	return FilterFunc(c.check), nil
+}
+
+// FilterFunc adapts a func to Filter.
+type FilterFunc func(tx *tx.Transaction) error
+
+// Check implements Filter.
+func (f FilterFunc) Check(tx *tx.Transaction) error {
+	return f(tx)
+}
+
+// FileFilter filter with rules loaded from a JSON file, which can be reloaded at runtime.
+type FileFilter struct {
+	path    string
+	lock    sync.RWMutex
+	rules   *compiledRules
+	modTime time.Time
+}
+
+// NewFileFilter create a FileFilter and loads rules from the file.
+func NewFileFilter(path string) (*FileFilter, error) {
+	f := &FileFilter{path: path}
+	if _, err := f.Reload(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Reload loads rules again if the file modified since last loaded.
+// It returns whether reloaded. Rules in effect are kept if failed to reload.
+func (f *FileFilter) Reload() (bool, error) {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return false, err
+	}
+	f.lock.RLock()
+	loaded := f.rules != nil && info.ModTime().Equal(f.modTime)
+	f.lock.RUnlock()
+	if loaded {
+		return false, nil
+	}
+
+	data, err := ioutil.ReadFile(f.path)
+	if err != nil {
+		return false, err
+	}
+	var rules Rules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return false, err
+	}
+	c, err := compile(&rules)
+	if err != nil {
+		return false, err
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.rules = c
+	f.modTime = info.ModTime()
+	return true, nil
+}
+
+// Check implements Filter.
+func (f *FileFilter) Check(tx *tx.Transaction) error {
+	f.lock.RLock()
+	rules := f.rules
+	f.lock.RUnlock()
+	return rules.check(tx)
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txfilter_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/genesis"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/txfilter"
+)
+
+func newTx(acc genesis.DevAccount, to thor.Address, data []byte) *tx.Transaction {
+	trx := new(tx.Builder).Clause(tx.NewClause(&to).WithData(data)).Gas(50000).Build()
+	sig, _ := crypto.Sign(trx.SigningHash().Bytes(), acc.PrivateKey)
+	return trx.WithSignature(sig)
+}
+
+func TestFilter(t *testing.T) {
+	a0, a1 := genesis.DevAccounts()[0], genesis.DevAccounts()[1]
+	denied := thor.BytesToAddress([]byte("denied"))
+	other := thor.BytesToAddress([]byte("other"))
+
+	f, err := txfilter.New(&txfilter.Rules{
+		Addresses:    []thor.Address{a1.Address, denied},
+		DataPatterns: []string{"^0xa9059cbb"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Nil(t, f.Check(newTx(a0, other, nil)))
+	assert.Nil(t, f.Check(newTx(a0, other, []byte{0x01, 0xa9, 0x05, 0x9c, 0xbb})))
+	assert.True(t, txfilter.IsDenied(f.Check(newTx(a1, other, nil))), "denied origin")
+	assert.True(t, txfilter.IsDenied(f.Check(newTx(a0, denied, nil))), "denied recipient")
+	assert.True(t, txfilter.IsDenied(f.Check(newTx(a0, other, []byte{0xa9, 0x05, 0x9c, 0xbb}))), "denied data")
+
+	_, err = txfilter.New(&txfilter.Rules{DataPatterns: []string{"("}})
+	assert.NotNil(t, err)
+}
+
+func TestFileFilter(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "txfilter")
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "rules.json")
+
+	a0 := genesis.DevAccounts()[0]
+	to := thor.BytesToAddress([]byte("to"))
+	trx := newTx(a0, to, nil)
+
+	ioutil.WriteFile(path, []byte(`{"addresses": []}`), 0600)
+	f, err := txfilter.NewFileFilter(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, f.Check(trx))
+
+	reloaded, err := f.Reload()
+	assert.Nil(t, err)
+	assert.False(t, reloaded, "not modified")
+
+	ioutil.WriteFile(path, []byte(`{"addresses": ["`+to.String()+`"]}`), 0600)
+	os.Chtimes(path, time.Now(), time.Now().Add(time.Second))
+	reloaded, err = f.Reload()
+	assert.Nil(t, err)
+	assert.True(t, reloaded)
+	assert.True(t, txfilter.IsDenied(f.Check(trx)))
+
+	// keeps rules in effect on bad file
+	ioutil.WriteFile(path, []byte(`{`), 0600)
+	os.Chtimes(path, time.Now(), time.Now().Add(2*time.Second))
+	_, err = f.Reload()
+	assert.NotNil(t, err)
+	assert.True(t, txfilter.IsDenied(f.Check(trx)))
+}
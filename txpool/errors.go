@@ -5,9 +5,45 @@
 
 package txpool
 
+import (
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/runtime"
+)
+
+// Machine-readable reasons of tx rejection.
+const (
+	ReasonBadTx                  = "bad_tx"
+	ReasonChainTagMismatch       = "chain_tag_mismatch"
+	ReasonReservedFields         = "reserved_fields_not_empty"
+	ReasonSizeTooLarge           = "size_too_large"
+	ReasonIntrinsicGasTooLow     = "intrinsic_gas_too_low"
+	ReasonRejected               = "rejected"
+	ReasonExpired                = "expired"
+	ReasonKnownTx                = "known_tx"
+	ReasonGasTooLarge            = "gas_too_large"
+	ReasonInsufficientEnergy     = "insufficient_energy"
+	ReasonNotExecutable          = "not_executable"
+	ReasonPoolFull               = "pool_full"
+	ReasonAccountQuotaExceeded   = "account_quota_exceeded"
+	ReasonReplacementUnderpriced = "replacement_underpriced"
+	ReasonDenied                 = "denied"
+	ReasonTxLimitExceeded        = "tx_limit_exceeded"
+	ReasonInsufficientBalance    = "insufficient_balance"
+)
+
+var (
+	errExpired                = errors.New("expired")
+	errKnownTx                = errors.New("known tx")
+	errGasTooLarge            = errors.New("gas too large")
+	errAccountQuotaExceeded   = errors.New("account quota exceeded")
+	errReplacementUnderpriced = errors.New("replacement tx underpriced")
+	errBlockRefOutOfSchedule  = errors.New("block ref out of schedule")
+	errDepReverted            = errors.New("dep reverted")
+)
+
 type (
-	badTxError      struct{ msg string }
-	txRejectedError struct{ msg string }
+	badTxError      struct{ msg, reason string }
+	txRejectedError struct{ msg, reason string }
 )
 
 func (e badTxError) Error() string {
@@ -18,6 +54,32 @@ func (e txRejectedError) Error() string {
 	return "tx rejected: " + e.msg
 }
 
+// newTxRejectedError creates txRejectedError with reason derived from the cause.
+func newTxRejectedError(cause error) txRejectedError {
+	reason := ReasonRejected
+	switch errors.Cause(cause) {
+	case errExpired:
+		reason = ReasonExpired
+	case errKnownTx:
+		reason = ReasonKnownTx
+	case errGasTooLarge:
+		reason = ReasonGasTooLarge
+	case errAccountQuotaExceeded:
+		reason = ReasonAccountQuotaExceeded
+	case errReplacementUnderpriced:
+		reason = ReasonReplacementUnderpriced
+	case runtime.ErrInsufficientEnergy:
+		reason = ReasonInsufficientEnergy
+	case runtime.ErrInsufficientBalance:
+		reason = ReasonInsufficientBalance
+	default:
+		if runtime.IsTxLimitExceeded(cause) {
+			reason = ReasonTxLimitExceeded
+		}
+	}
+	return txRejectedError{cause.Error(), reason}
+}
+
 // IsBadTx returns whether the given error indicates that tx is bad.
 func IsBadTx(err error) bool {
 	_, ok := err.(badTxError)
@@ -29,3 +91,15 @@ func IsTxRejected(err error) bool {
 	_, ok := err.(txRejectedError)
 	return ok
 }
+
+// Reason returns the machine-readable reason of the error returned by TxPool.Add.
+// Empty string returned if the error is not a bad tx or rejection error.
+func Reason(err error) string {
+	switch e := err.(type) {
+	case badTxError:
+		return e.reason
+	case txRejectedError:
+		return e.reason
+	}
+	return ""
+}
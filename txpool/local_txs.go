@@ -0,0 +1,101 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"sync"
+	"time"
+
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+// status of locally submitted txs.
+const (
+	LocalTxExecutable = "executable"
+	LocalTxPending    = "pending"
+	LocalTxMined      = "mined"
+	LocalTxExpired    = "expired"
+	LocalTxDropped    = "dropped"
+)
+
+// LocalTx tx submitted via local API, and its current status.
+type LocalTx struct {
+	Tx     *tx.Transaction
+	Status string
+}
+
+type localTx struct {
+	tx        *tx.Transaction
+	timeAdded int64
+}
+
+// localTxs to track txs submitted locally, at most limit txs.
+type localTxs struct {
+	lock  sync.RWMutex
+	m     map[thor.Bytes32]*localTx
+	limit int
+}
+
+func newLocalTxs(limit int) *localTxs {
+	return &localTxs{
+		m:     make(map[thor.Bytes32]*localTx),
+		limit: limit,
+	}
+}
+
+// Add tracks the tx. It returns false if the limit reached.
+func (l *localTxs) Add(tx *tx.Transaction) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if _, found := l.m[tx.ID()]; found {
+		return true
+	}
+	if len(l.m) >= l.limit {
+		return false
+	}
+	l.m[tx.ID()] = &localTx{tx, time.Now().UnixNano()}
+	return true
+}
+
+func (l *localTxs) Remove(txID thor.Bytes32) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	delete(l.m, txID)
+}
+
+func (l *localTxs) Contains(txID thor.Bytes32) bool {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	_, found := l.m[txID]
+	return found
+}
+
+func (l *localTxs) ToTxs() tx.Transactions {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	txs := make(tx.Transactions, 0, len(l.m))
+	for _, ltx := range l.m {
+		txs = append(txs, ltx.tx)
+	}
+	return txs
+}
+
+// Prune forgets txs that satisfy the given condition.
+func (l *localTxs) Prune(cond func(tx *tx.Transaction, timeAdded int64) bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	for id, ltx := range l.m {
+		if cond(ltx.tx, ltx.timeAdded) {
+			delete(l.m, id)
+		}
+	}
+}
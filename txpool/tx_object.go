@@ -10,6 +10,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/pkg/errors"
 	"github.com/vechain/thor/block"
 	"github.com/vechain/thor/chain"
@@ -45,14 +46,33 @@ func (o *txObject) Origin() thor.Address {
 	return o.resolved.Origin
 }
 
+// ReplacementKey returns the hash of origin and all signing fields except gas price coef.
+// Txs with the same replacement key differ only in gas price, so at most one of them
+// is kept in the pool.
+func (o *txObject) ReplacementKey() (key thor.Bytes32) {
+	hw := thor.NewBlake2b()
+	rlp.Encode(hw, []interface{}{
+		o.Origin(),
+		o.ChainTag(),
+		o.BlockRef(),
+		o.Expiration(),
+		o.Clauses(),
+		o.Gas(),
+		o.DependsOn(),
+		o.Nonce(),
+	})
+	hw.Sum(key[:0])
+	return
+}
+
 func (o *txObject) Executable(chain *chain.Chain, state *state.State, headBlock *block.Header) (bool, error) {
 	switch {
 	case o.Gas() > headBlock.GasLimit():
-		return false, errors.New("gas too large")
+		return false, errGasTooLarge
 	case o.IsExpired(headBlock.Number()):
-		return false, errors.New("expired")
+		return false, errExpired
 	case o.BlockRef().Number() > headBlock.Number()+uint32(3600*24/thor.BlockInterval):
-		return false, errors.New("block ref out of schedule")
+		return false, errBlockRefOutOfSchedule
 	}
 
 	if _, err := chain.GetTransactionMeta(o.ID(), headBlock.ID()); err != nil {
@@ -60,7 +80,7 @@ func (o *txObject) Executable(chain *chain.Chain, state *state.State, headBlock
 			return false, err
 		}
 	} else {
-		return false, errors.New("known tx")
+		return false, errKnownTx
 	}
 
 	if dep := o.DependsOn(); dep != nil {
@@ -72,7 +92,7 @@ func (o *txObject) Executable(chain *chain.Chain, state *state.State, headBlock
 			return false, err
 		}
 		if txMeta.Reverted {
-			return false, errors.New("dep reverted")
+			return false, errDepReverted
 		}
 	}
 
@@ -80,13 +100,26 @@ func (o *txObject) Executable(chain *chain.Chain, state *state.State, headBlock
 		return false, nil
 	}
 
+	if err := o.afford(state, headBlock); err != nil {
+		if errors.Cause(err) == runtime.ErrInsufficientBalance {
+			// may be affordable after VET received
+			return false, nil
+		}
+		// gas payer can no longer pay
+		return false, err
+	}
+	return true, nil
+}
+
+// afford checks whether gas and clause values of the tx can be paid on the state of the head block.
+func (o *txObject) afford(state *state.State, headBlock *block.Header) error {
 	checkpoint := state.NewCheckpoint()
 	defer state.RevertTo(checkpoint)
 
 	if _, _, _, _, err := o.resolved.BuyGas(state, headBlock.Timestamp()+thor.BlockInterval); err != nil {
-		return false, err
+		return err
 	}
-	return true, nil
+	return o.resolved.CheckBalance(state)
 }
 
 func sortTxObjsByOverallGasPriceDesc(txObjs []*txObject) {
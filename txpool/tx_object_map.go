@@ -6,24 +6,28 @@
 package txpool
 
 import (
-	"errors"
 	"sync"
 
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
 )
 
-// txObjectMap to maintain mapping of ID to tx object, and account quota.
+// the minimum percentage of gas price coef increase to replace a tx
+const priceBumpPercent = 10
+
+// txObjectMap to maintain mapping of ID to tx object, account quota and replacement keys.
 type txObjectMap struct {
 	lock     sync.RWMutex
 	txObjMap map[thor.Bytes32]*txObject
 	quota    map[thor.Address]int
+	replaced map[thor.Bytes32]thor.Bytes32 // replacement key => tx ID
 }
 
 func newTxObjectMap() *txObjectMap {
 	return &txObjectMap{
 		txObjMap: make(map[thor.Bytes32]*txObject),
 		quota:    make(map[thor.Address]int),
+		replaced: make(map[thor.Bytes32]thor.Bytes32),
 	}
 }
 
@@ -34,6 +38,12 @@ func (m *txObjectMap) Contains(txID thor.Bytes32) bool {
 	return found
 }
 
+func (m *txObjectMap) Get(txID thor.Bytes32) *txObject {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.txObjMap[txID]
+}
+
 func (m *txObjectMap) Add(txObj *txObject, limitPerAccount int) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
@@ -42,31 +52,59 @@ func (m *txObjectMap) Add(txObj *txObject, limitPerAccount int) error {
 		return nil
 	}
 
-	if m.quota[txObj.Origin()] >= limitPerAccount {
-		return errors.New("account quota exceeded")
+	key := txObj.ReplacementKey()
+	if id, found := m.replaced[key]; found {
+		existing := m.txObjMap[id]
+		if !canReplace(existing, txObj) {
+			return errReplacementUnderpriced
+		}
+		// replacing takes the slot of the existing one, so quota is not checked
+		m.removeLocked(id)
+	} else if m.quota[txObj.Origin()] >= limitPerAccount {
+		return errAccountQuotaExceeded
 	}
 
-	m.quota[txObj.Origin()]++
-	m.txObjMap[txObj.ID()] = txObj
+	m.addLocked(key, txObj)
 	return nil
 }
 
-func (m *txObjectMap) Remove(txID thor.Bytes32) bool {
-	m.lock.Lock()
-	defer m.lock.Unlock()
+func (m *txObjectMap) addLocked(key thor.Bytes32, txObj *txObject) {
+	m.quota[txObj.Origin()]++
+	m.txObjMap[txObj.ID()] = txObj
+	m.replaced[key] = txObj.ID()
+}
 
+func (m *txObjectMap) removeLocked(txID thor.Bytes32) bool {
 	if txObj, ok := m.txObjMap[txID]; ok {
 		if m.quota[txObj.Origin()] > 1 {
 			m.quota[txObj.Origin()]--
 		} else {
 			delete(m.quota, txObj.Origin())
 		}
+		delete(m.replaced, txObj.ReplacementKey())
 		delete(m.txObjMap, txID)
 		return true
 	}
 	return false
 }
 
+// canReplace returns whether the new tx offers a sufficiently higher gas price coef than the existing one.
+func canReplace(existing, newTxObj *txObject) bool {
+	existingCoef := uint64(existing.GasPriceCoef())
+	bump := existingCoef * priceBumpPercent / 100
+	if bump == 0 {
+		bump = 1
+	}
+	return uint64(newTxObj.GasPriceCoef()) >= existingCoef+bump
+}
+
+func (m *txObjectMap) Remove(txID thor.Bytes32) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.removeLocked(txID)
+}
+
 func (m *txObjectMap) ToTxObjects() []*txObject {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
@@ -78,6 +116,28 @@ func (m *txObjectMap) ToTxObjects() []*txObject {
 	return txObjs
 }
 
+// ToTxObjectsMayShareGasPayer returns tx objects that may share the gas payer with the given one.
+// They are of the same origin, or sent to the same contract, whose sponsor or itself pays for users.
+func (m *txObjectMap) ToTxObjectsMayShareGasPayer(txObj *txObject) []*txObject {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	commonTo := txObj.resolved.CommonTo()
+	var txObjs []*txObject
+	for _, pending := range m.txObjMap {
+		if pending.Origin() == txObj.Origin() {
+			txObjs = append(txObjs, pending)
+			continue
+		}
+		if commonTo != nil {
+			if to := pending.resolved.CommonTo(); to != nil && *to == *commonTo {
+				txObjs = append(txObjs, pending)
+			}
+		}
+	}
+	return txObjs
+}
+
 func (m *txObjectMap) ToTxs() tx.Transactions {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
@@ -96,10 +156,12 @@ func (m *txObjectMap) Fill(txObjs []*txObject) {
 		if _, found := m.txObjMap[txObj.ID()]; found {
 			continue
 		}
+		key := txObj.ReplacementKey()
+		if _, found := m.replaced[key]; found {
+			continue
+		}
 		// skip account limit check
-
-		m.quota[txObj.Origin()]++
-		m.txObjMap[txObj.ID()] = txObj
+		m.addLocked(key, txObj)
 	}
 }
 
@@ -109,3 +171,11 @@ func (m *txObjectMap) Len() int {
 
 	return len(m.txObjMap)
 }
+
+// Stats returns count of txs and count of distinct origins.
+func (m *txObjectMap) Stats() (txs int, accounts int) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return len(m.txObjMap), len(m.quota)
+}
@@ -6,7 +6,6 @@
 package txpool
 
 import (
-	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -35,7 +34,7 @@ func TestTxObjMap(t *testing.T) {
 	assert.Nil(t, m.Add(txObj1, 1), "should no error if exists")
 	assert.Equal(t, 1, m.Len())
 
-	assert.Equal(t, errors.New("account quota exceeded"), m.Add(txObj2, 1))
+	assert.Equal(t, errAccountQuotaExceeded, m.Add(txObj2, 1))
 	assert.Equal(t, 1, m.Len())
 
 	assert.Nil(t, m.Add(txObj3, 1))
@@ -44,6 +43,8 @@ func TestTxObjMap(t *testing.T) {
 	assert.True(t, m.Contains(tx1.ID()))
 	assert.False(t, m.Contains(tx2.ID()))
 	assert.True(t, m.Contains(tx3.ID()))
+	assert.Equal(t, txObj3, m.Get(tx3.ID()))
+	assert.Nil(t, m.Get(tx2.ID()))
 
 	assert.True(t, m.Remove(tx1.ID()))
 	assert.False(t, m.Contains(tx1.ID()))
@@ -53,3 +54,41 @@ func TestTxObjMap(t *testing.T) {
 	assert.Equal(t, tx.Transactions{tx3}, m.ToTxs())
 
 }
+
+func TestTxObjMapReplace(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	chain := newChain(kv)
+
+	acc := genesis.DevAccounts()[0]
+	newTxWithCoef := func(coef uint8) *txObject {
+		tx := new(tx.Builder).
+			ChainTag(chain.Tag()).
+			Expiration(100).
+			Gas(21000).
+			Nonce(1).
+			GasPriceCoef(coef).
+			Build()
+		txObj, _ := resolveTx(signTx(tx, acc))
+		return txObj
+	}
+
+	txObj1 := newTxWithCoef(100)
+	txObj2 := newTxWithCoef(105)
+	txObj3 := newTxWithCoef(110)
+
+	assert.Equal(t, txObj1.ReplacementKey(), txObj2.ReplacementKey())
+
+	m := newTxObjectMap()
+	assert.Nil(t, m.Add(txObj1, 1))
+
+	assert.Equal(t, errReplacementUnderpriced, m.Add(txObj2, 1))
+	assert.True(t, m.Contains(txObj1.ID()))
+
+	assert.Nil(t, m.Add(txObj3, 1), "should replace regardless of account quota")
+	assert.Equal(t, 1, m.Len())
+	assert.False(t, m.Contains(txObj1.ID()))
+	assert.True(t, m.Contains(txObj3.ID()))
+
+	assert.True(t, m.Remove(txObj3.ID()))
+	assert.Nil(t, m.Add(txObj1, 1), "replacement key should be released on removal")
+}
@@ -6,6 +6,7 @@
 package txpool
 
 import (
+	"sort"
 	"sync/atomic"
 	"time"
 
@@ -18,14 +19,22 @@ import (
 	"github.com/vechain/thor/builtin"
 	"github.com/vechain/thor/chain"
 	"github.com/vechain/thor/co"
+	"github.com/vechain/thor/runtime"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
+	"github.com/vechain/thor/txfilter"
 )
 
 const (
 	// max size of tx allowed
 	maxTxSize = 256 * 1024
+
+	// interval to rebroadcast executable local txs
+	rebroadcastInterval = time.Minute
+
+	// local txs tracked are limited to a fraction of the pool limit
+	localsLimitDivisor = 4
 )
 
 var (
@@ -39,6 +48,21 @@ type Options struct {
 	MaxLifetime     time.Duration
 }
 
+// Stats statistics of tx pool.
+type Stats struct {
+	Total      int
+	Executable int
+	Accounts   int
+	Limit      int
+}
+
+// PendingTx tx in the pool along with its origin.
+type PendingTx struct {
+	Tx         *tx.Transaction
+	Origin     thor.Address
+	Executable bool
+}
+
 // TxEvent will be posted when tx is added or status changed.
 type TxEvent struct {
 	Tx         *tx.Transaction
@@ -50,9 +74,11 @@ type TxPool struct {
 	options      Options
 	chain        *chain.Chain
 	stateCreator *state.Creator
+	filter       txfilter.Filter
 
 	executables    atomic.Value
 	all            *txObjectMap
+	locals         *localTxs
 	addedAfterWash uint32
 
 	done   chan struct{}
@@ -69,12 +95,20 @@ func New(chain *chain.Chain, stateCreator *state.Creator, options Options) *TxPo
 		chain:        chain,
 		stateCreator: stateCreator,
 		all:          newTxObjectMap(),
+		locals:       newLocalTxs(localsLimit(options.Limit)),
 		done:         make(chan struct{}),
 	}
 	pool.goes.Go(pool.housekeeping)
 	return pool
 }
 
+func localsLimit(poolLimit int) int {
+	if limit := poolLimit / localsLimitDivisor; limit > 0 {
+		return limit
+	}
+	return 1
+}
+
 func (p *TxPool) housekeeping() {
 	log.Debug("enter housekeeping")
 	defer log.Debug("leave housekeeping")
@@ -82,12 +116,17 @@ func (p *TxPool) housekeeping() {
 	ticker := time.NewTicker(time.Second * 2)
 	defer ticker.Stop()
 
+	rebroadcastTicker := time.NewTicker(rebroadcastInterval)
+	defer rebroadcastTicker.Stop()
+
 	headBlock := p.chain.BestBlock().Header()
 
 	for {
 		select {
 		case <-p.done:
 			return
+		case <-rebroadcastTicker.C:
+			p.rebroadcastLocals()
 		case <-ticker.C:
 			var headBlockChanged bool
 			if newHeadBlock := p.chain.BestBlock().Header(); newHeadBlock.ID() != headBlock.ID() {
@@ -143,6 +182,11 @@ func (p *TxPool) SubscribeTxEvent(ch chan *TxEvent) event.Subscription {
 	return p.scope.Track(p.txFeed.Subscribe(ch))
 }
 
+// SetFilter set the admission filter of txs. It should be called before adding txs.
+func (p *TxPool) SetFilter(filter txfilter.Filter) {
+	p.filter = filter
+}
+
 func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool) error {
 	if p.all.Contains(newTx.ID()) {
 		// tx already in the pool
@@ -152,16 +196,27 @@ func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool) error {
 	// validation
 	switch {
 	case newTx.ChainTag() != p.chain.Tag():
-		return badTxError{"chain tag mismatch"}
+		return badTxError{"chain tag mismatch", ReasonChainTagMismatch}
 	case newTx.HasReservedFields():
-		return badTxError{"reserved fields not empty"}
+		return badTxError{"reserved fields not empty", ReasonReservedFields}
 	case newTx.Size() > maxTxSize:
-		return txRejectedError{"size too large"}
+		return txRejectedError{"size too large", ReasonSizeTooLarge}
+	case !hasUint256Values(newTx):
+		return badTxError{"clause value exceeds 256 bits", ReasonBadTx}
 	}
 
 	txObj, err := resolveTx(newTx)
 	if err != nil {
-		return badTxError{err.Error()}
+		if errors.Cause(err) == runtime.ErrIntrinsicGasExceeded {
+			return badTxError{err.Error(), ReasonIntrinsicGasTooLow}
+		}
+		return badTxError{err.Error(), ReasonBadTx}
+	}
+
+	if p.filter != nil {
+		if err := p.filter.Check(newTx); err != nil {
+			return txRejectedError{err.Error(), ReasonDenied}
+		}
 	}
 
 	headBlock := p.chain.BestBlock().Header()
@@ -171,17 +226,30 @@ func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool) error {
 			return err
 		}
 
+		if err := runtime.LoadTxLimits(state).Check(newTx); err != nil {
+			return newTxRejectedError(err)
+		}
+
 		executable, err := txObj.Executable(p.chain, state, headBlock)
 		if err != nil {
-			return txRejectedError{err.Error()}
+			return newTxRejectedError(err)
 		}
 
 		if rejectNonexecutable && !executable {
-			return txRejectedError{"tx is not executable"}
+			if err := txObj.afford(state, headBlock); err != nil {
+				return newTxRejectedError(err)
+			}
+			return txRejectedError{"tx is not executable", ReasonNotExecutable}
+		}
+
+		if executable {
+			if err := p.checkEnergy(txObj, state, headBlock); err != nil {
+				return newTxRejectedError(err)
+			}
 		}
 
 		if err := p.all.Add(txObj, p.options.LimitPerAccount); err != nil {
-			return txRejectedError{err.Error()}
+			return newTxRejectedError(err)
 		}
 
 		txObj.executable = executable
@@ -193,11 +261,11 @@ func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool) error {
 		// we skip steps that rely on head block when chain is not synced,
 		// but check the pool's limit
 		if p.all.Len() >= p.options.Limit {
-			return txRejectedError{"pool is full"}
+			return txRejectedError{"pool is full", ReasonPoolFull}
 		}
 
 		if err := p.all.Add(txObj, p.options.LimitPerAccount); err != nil {
-			return txRejectedError{err.Error()}
+			return newTxRejectedError(err)
 		}
 		log.Debug("tx added", "id", newTx.ID())
 		p.txFeed.Send(&TxEvent{newTx, nil})
@@ -206,17 +274,84 @@ func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool) error {
 	return nil
 }
 
+// checkEnergy checks whether the payer can afford the new tx, along with other pending txs that may
+// share the payer.
+func (p *TxPool) checkEnergy(txObj *txObject, state *state.State, headBlock *block.Header) error {
+	checkpoint := state.NewCheckpoint()
+	defer state.RevertTo(checkpoint)
+
+	blockTime := headBlock.Timestamp() + thor.BlockInterval
+	for _, pending := range p.all.ToTxObjectsMayShareGasPayer(txObj) {
+		// pending txs can't afford are ignored, they'll be washed out
+		pending.resolved.BuyGas(state, blockTime)
+	}
+	if _, _, _, _, err := txObj.resolved.BuyGas(state, blockTime); err != nil {
+		return err
+	}
+	return state.Err()
+}
+
 // Add add new tx into pool.
 // It's not assumed as an error if the tx to be added is already in the pool,
 func (p *TxPool) Add(newTx *tx.Transaction) error {
 	return p.add(newTx, false)
 }
 
+// AddLocal add new tx submitted locally into pool.
+// Local txs are exempted from eviction due to lifetime or pool limit, and rebroadcast
+// periodically until mined or expired. They are limited to a fraction of the pool limit.
+func (p *TxPool) AddLocal(newTx *tx.Transaction) error {
+	tracked := p.locals.Contains(newTx.ID())
+	if !p.locals.Add(newTx) {
+		return txRejectedError{"too many local txs", ReasonPoolFull}
+	}
+	if err := p.add(newTx, false); err != nil {
+		if !tracked {
+			p.locals.Remove(newTx.ID())
+		}
+		return err
+	}
+	return nil
+}
+
 // StrictlyAdd add new tx into pool. A rejection error will be returned, if tx is not executable at this time.
 func (p *TxPool) StrictlyAdd(newTx *tx.Transaction) error {
 	return p.add(newTx, true)
 }
 
+// Reinject adds txs of blocks abandoned by chain reorg back into the pool, so they won't get lost.
+// Txs expired or included in the new trunk are skipped. It returns count of txs re-injected.
+func (p *TxPool) Reinject(txs tx.Transactions) int {
+	headBlock := p.chain.BestBlock().Header()
+	n := 0
+	for _, tx := range txs {
+		if tx.IsExpired(headBlock.Number() + 1) {
+			continue
+		}
+		if _, err := p.chain.GetTransactionMeta(tx.ID(), headBlock.ID()); err == nil {
+			// re-included
+			continue
+		} else if !p.chain.IsNotFound(err) {
+			log.Warn("failed to get tx meta", "err", err)
+			continue
+		}
+		if err := p.add(tx, false); err != nil {
+			log.Debug("failed to re-inject tx", "err", err, "id", tx.ID())
+			continue
+		}
+		n++
+	}
+	return n
+}
+
+// Get returns tx in pool by its ID, or nil if not found.
+func (p *TxPool) Get(txID thor.Bytes32) *tx.Transaction {
+	if txObj := p.all.Get(txID); txObj != nil {
+		return txObj.Transaction
+	}
+	return nil
+}
+
 // Remove removes tx from pool by its ID.
 func (p *TxPool) Remove(txID thor.Bytes32) bool {
 	if p.all.Remove(txID) {
@@ -226,6 +361,59 @@ func (p *TxPool) Remove(txID thor.Bytes32) bool {
 	return false
 }
 
+// Locals returns txs submitted locally with their current status.
+func (p *TxPool) Locals() ([]*LocalTx, error) {
+	headBlock := p.chain.BestBlock().Header()
+
+	executables := make(map[thor.Bytes32]bool)
+	for _, tx := range p.Executables() {
+		executables[tx.ID()] = true
+	}
+
+	txs := p.locals.ToTxs()
+	locals := make([]*LocalTx, 0, len(txs))
+	for _, tx := range txs {
+		status := LocalTxDropped
+		if _, err := p.chain.GetTransactionMeta(tx.ID(), headBlock.ID()); err != nil {
+			if !p.chain.IsNotFound(err) {
+				return nil, err
+			}
+			switch {
+			case executables[tx.ID()]:
+				status = LocalTxExecutable
+			case p.all.Contains(tx.ID()):
+				status = LocalTxPending
+			case tx.IsExpired(headBlock.Number()):
+				status = LocalTxExpired
+			}
+		} else {
+			status = LocalTxMined
+		}
+		locals = append(locals, &LocalTx{tx, status})
+	}
+	return locals, nil
+}
+
+func (p *TxPool) rebroadcastLocals() {
+	var toBroadcast tx.Transactions
+	for _, tx := range p.Executables() {
+		if p.locals.Contains(tx.ID()) {
+			toBroadcast = append(toBroadcast, tx)
+		}
+	}
+	if len(toBroadcast) == 0 {
+		return
+	}
+
+	p.goes.Go(func() {
+		for _, tx := range toBroadcast {
+			executable := true
+			p.txFeed.Send(&TxEvent{tx, &executable})
+		}
+	})
+	log.Debug("local txs rebroadcast", "count", len(toBroadcast))
+}
+
 // Executables returns executable txs.
 func (p *TxPool) Executables() tx.Transactions {
 	if sorted := p.executables.Load(); sorted != nil {
@@ -234,6 +422,40 @@ func (p *TxPool) Executables() tx.Transactions {
 	return nil
 }
 
+// Stats returns current statistics of the pool.
+func (p *TxPool) Stats() Stats {
+	total, accounts := p.all.Stats()
+	return Stats{
+		Total:      total,
+		Executable: len(p.Executables()),
+		Accounts:   accounts,
+		Limit:      p.options.Limit,
+	}
+}
+
+// Content returns all txs in the pool. Executable ones come first, in the order the packer adopts.
+func (p *TxPool) Content() []*PendingTx {
+	executables := p.Executables()
+	order := make(map[thor.Bytes32]int, len(executables))
+	for i, tx := range executables {
+		order[tx.ID()] = i
+	}
+
+	txObjs := p.all.ToTxObjects()
+	content := make([]*PendingTx, 0, len(txObjs))
+	for _, txObj := range txObjs {
+		_, executable := order[txObj.ID()]
+		content = append(content, &PendingTx{txObj.Transaction, txObj.Origin(), executable})
+	}
+	sort.SliceStable(content, func(i, j int) bool {
+		if content[i].Executable != content[j].Executable {
+			return content[i].Executable
+		}
+		return content[i].Executable && order[content[i].Tx.ID()] < order[content[j].Tx.ID()]
+	})
+	return content
+}
+
 // Fill fills txs into pool.
 func (p *TxPool) Fill(txs tx.Transactions) {
 	txObjs := make([]*txObject, 0, len(txs))
@@ -244,6 +466,8 @@ func (p *TxPool) Fill(txs tx.Transactions) {
 		}
 	}
 	p.all.Fill(txObjs)
+	// to trigger washing, which revalidates filled txs
+	atomic.AddUint32(&p.addedAfterWash, uint32(len(txObjs)))
 }
 
 // Dump dumps all txs in the pool.
@@ -281,17 +505,24 @@ func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, rem
 	var (
 		seeker            = p.chain.NewSeeker(headBlock.ID())
 		baseGasPrice      = builtin.Params.Native(state).Get(thor.KeyBaseGasPrice)
+		txLimits          = runtime.LoadTxLimits(state)
 		executableObjs    = make([]*txObject, 0, len(all))
 		nonExecutableObjs = make([]*txObject, 0, len(all))
 		now               = time.Now().UnixNano()
 	)
 	for _, txObj := range all {
-		// out of lifetime
-		if now > txObj.timeAdded+int64(p.options.MaxLifetime) {
+		// out of lifetime, local txs are exempted
+		if now > txObj.timeAdded+int64(p.options.MaxLifetime) && !p.locals.Contains(txObj.ID()) {
 			toRemove = append(toRemove, txObj.ID())
 			log.Debug("tx washed out", "id", txObj.ID(), "err", "out of lifetime")
 			continue
 		}
+		// limits lowered
+		if err := txLimits.Check(txObj.Transaction); err != nil {
+			toRemove = append(toRemove, txObj.ID())
+			log.Debug("tx washed out", "id", txObj.ID(), "err", err)
+			continue
+		}
 		// settled, out of energy or dep broken
 		executable, err := txObj.Executable(p.chain, state, headBlock)
 		if err != nil {
@@ -311,10 +542,6 @@ func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, rem
 		}
 	}
 
-	if err := state.Err(); err != nil {
-		return nil, 0, errors.WithMessage(err, "state")
-	}
-
 	if err := seeker.Err(); err != nil {
 		return nil, 0, errors.WithMessage(err, "seeker")
 	}
@@ -322,27 +549,64 @@ func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, rem
 	// sort objs by price from high to low
 	sortTxObjsByOverallGasPriceDesc(executableObjs)
 
+	// buy gas cumulatively from high priced to low, to evict txs whose payers
+	// can't afford them along with other pending txs
+	checkpoint := state.NewCheckpoint()
+	affordableObjs := make([]*txObject, 0, len(executableObjs))
+	for _, txObj := range executableObjs {
+		if _, _, _, _, err := txObj.resolved.BuyGas(state, headBlock.Timestamp()+thor.BlockInterval); err != nil {
+			toRemove = append(toRemove, txObj.ID())
+			log.Debug("tx washed out", "id", txObj.ID(), "err", err)
+			continue
+		}
+		affordableObjs = append(affordableObjs, txObj)
+	}
+	state.RevertTo(checkpoint)
+	executableObjs = affordableObjs
+
+	if err := state.Err(); err != nil {
+		return nil, 0, errors.WithMessage(err, "state")
+	}
+
 	limit := p.options.Limit
 
-	// remove over limit txs, from non-executables to low priced
+	// remove over limit txs, from non-executables to low priced, local txs are exempted
 	if len(executableObjs) > limit {
 		for _, txObj := range nonExecutableObjs {
+			if p.locals.Contains(txObj.ID()) {
+				continue
+			}
 			toRemove = append(toRemove, txObj.ID())
 			log.Debug("non-executable tx washed out due to pool limit", "id", txObj.ID())
 		}
+		kept := make([]*txObject, limit, len(executableObjs))
+		copy(kept, executableObjs)
 		for _, txObj := range executableObjs[limit:] {
+			if p.locals.Contains(txObj.ID()) {
+				kept = append(kept, txObj)
+				continue
+			}
 			toRemove = append(toRemove, txObj.ID())
 			log.Debug("executable tx washed out due to pool limit", "id", txObj.ID())
 		}
-		executableObjs = executableObjs[:limit]
+		executableObjs = kept
 	} else if len(executableObjs)+len(nonExecutableObjs) > limit {
 		// executableObjs + nonExecutableObjs over pool limit
 		for _, txObj := range nonExecutableObjs[limit-len(executableObjs):] {
+			if p.locals.Contains(txObj.ID()) {
+				continue
+			}
 			toRemove = append(toRemove, txObj.ID())
 			log.Debug("non-executable tx washed out due to pool limit", "id", txObj.ID())
 		}
 	}
 
+	// forget local txs which are expired, or already out of pool for long
+	p.locals.Prune(func(tx *tx.Transaction, timeAdded int64) bool {
+		return tx.IsExpired(headBlock.Number()) ||
+			(now > timeAdded+int64(p.options.MaxLifetime) && !p.all.Contains(tx.ID()))
+	})
+
 	executables = make(tx.Transactions, 0, len(executableObjs))
 	var toBroadcast tx.Transactions
 
@@ -363,6 +627,17 @@ func (p *TxPool) wash(headBlock *block.Header) (executables tx.Transactions, rem
 	return executables, 0, nil
 }
 
+// hasUint256Values returns whether values of all clauses fit in 256 bits.
+// It's not a consensus rule, but txs out of it are certainly failed.
+func hasUint256Values(trx *tx.Transaction) bool {
+	for _, c := range trx.Clauses() {
+		if !thor.IsUint256(c.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
 func isChainSynced(nowTimestamp, blockTimestamp uint64) bool {
 	timeDiff := nowTimestamp - blockTimestamp
 	if blockTimestamp > nowTimestamp {
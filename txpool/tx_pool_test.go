@@ -6,18 +6,22 @@
 package txpool
 
 import (
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/inconshreveable/log15"
 	"github.com/stretchr/testify/assert"
 	"github.com/vechain/thor/block"
+	"github.com/vechain/thor/builtin"
 	"github.com/vechain/thor/genesis"
 	"github.com/vechain/thor/lvldb"
 	"github.com/vechain/thor/state"
 	"github.com/vechain/thor/thor"
 	"github.com/vechain/thor/tx"
 	Tx "github.com/vechain/thor/tx"
+	"github.com/vechain/thor/txfilter"
 )
 
 func init() {
@@ -105,12 +109,14 @@ func TestAdd(t *testing.T) {
 	acc := genesis.DevAccounts()[0]
 
 	dupTx := newTx(pool.chain.Tag(), nil, 21000, tx.BlockRef{}, 100, nil, acc)
+	hugeValue := tx.NewClause(&acc.Address).WithValue(new(big.Int).Lsh(big.NewInt(1), 256))
 
 	tests := []struct {
 		tx     *tx.Transaction
 		errStr string
 	}{
 		{newTx(pool.chain.Tag()+1, nil, 21000, tx.BlockRef{}, 100, nil, acc), "bad tx: chain tag mismatch"},
+		{newTx(pool.chain.Tag(), []*tx.Clause{hugeValue}, 21000, tx.BlockRef{}, 100, nil, acc), "bad tx: clause value exceeds 256 bits"},
 		{dupTx, ""},
 		{dupTx, ""},
 	}
@@ -141,3 +147,283 @@ func TestAdd(t *testing.T) {
 		}
 	}
 }
+
+func TestAddLocal(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	chain := newChain(kv)
+	pool := New(chain, state.NewCreator(kv), Options{
+		Limit:           10,
+		LimitPerAccount: 2,
+		MaxLifetime:     0,
+	})
+	defer pool.Close()
+
+	b1 := new(block.Builder).
+		ParentID(pool.chain.GenesisBlock().Header().ID()).
+		Timestamp(uint64(time.Now().Unix())).
+		TotalScore(100).
+		GasLimit(10000000).
+		StateRoot(pool.chain.GenesisBlock().Header().StateRoot()).
+		Build()
+	pool.chain.AddBlock(b1, nil)
+
+	localTx := newTx(pool.chain.Tag(), nil, 21000, tx.BlockRef{}, 100, nil, genesis.DevAccounts()[0])
+	remoteTx := newTx(pool.chain.Tag(), nil, 21000, tx.BlockRef{}, 100, nil, genesis.DevAccounts()[1])
+	assert.Nil(t, pool.AddLocal(localTx))
+	assert.Nil(t, pool.Add(remoteTx))
+
+	// out of lifetime
+	executables, _, err := pool.wash(pool.chain.BestBlock().Header())
+	assert.Nil(t, err)
+	assert.Equal(t, Tx.Transactions{localTx}, executables)
+	assert.Equal(t, Tx.Transactions{localTx}, pool.Dump(), "local tx should not be washed out")
+
+	locals, err := pool.Locals()
+	assert.Nil(t, err)
+	assert.Equal(t, []*LocalTx{{localTx, LocalTxPending}}, locals)
+
+	pool.executables.Store(executables)
+	locals, err = pool.Locals()
+	assert.Nil(t, err)
+	assert.Equal(t, []*LocalTx{{localTx, LocalTxExecutable}}, locals)
+}
+
+func TestAddLocalLimit(t *testing.T) {
+	pool := newPool()
+	defer pool.Close()
+
+	b1 := new(block.Builder).
+		ParentID(pool.chain.GenesisBlock().Header().ID()).
+		Timestamp(uint64(time.Now().Unix())).
+		TotalScore(100).
+		GasLimit(10000000).
+		StateRoot(pool.chain.GenesisBlock().Header().StateRoot()).
+		Build()
+	pool.chain.AddBlock(b1, nil)
+
+	// a quarter of pool limit
+	for i := 0; i < 2; i++ {
+		assert.Nil(t, pool.AddLocal(newTx(pool.chain.Tag(), nil, 21000, tx.BlockRef{}, 100, nil, genesis.DevAccounts()[i])))
+	}
+	trx := newTx(pool.chain.Tag(), nil, 21000, tx.BlockRef{}, 100, nil, genesis.DevAccounts()[2])
+	assert.Equal(t, ReasonPoolFull, Reason(pool.AddLocal(trx)))
+	assert.Equal(t, 2, pool.all.Len())
+
+	// not tracked if rejected
+	pool.locals.Prune(func(*tx.Transaction, int64) bool { return true })
+	bad := newTx(pool.chain.Tag()+1, nil, 21000, tx.BlockRef{}, 100, nil, genesis.DevAccounts()[2])
+	assert.NotNil(t, pool.AddLocal(bad))
+	assert.False(t, pool.locals.Contains(bad.ID()))
+	assert.Nil(t, pool.AddLocal(trx))
+}
+
+func TestWashInsufficientEnergy(t *testing.T) {
+	pool := newPool()
+	defer pool.Close()
+
+	now := uint64(time.Now().Unix())
+	key, _ := crypto.GenerateKey()
+	acc := genesis.DevAccount{Address: thor.Address(crypto.PubkeyToAddress(key.PublicKey)), PrivateKey: key}
+
+	// energy only enough for one tx
+	st, _ := pool.stateCreator.NewState(pool.chain.GenesisBlock().Header().StateRoot())
+	builtin.Energy.Native(st, now).Add(acc.Address, new(big.Int).Mul(big.NewInt(21000*3/2), thor.InitialBaseGasPrice))
+	root, _ := st.Stage().Commit()
+
+	b1 := new(block.Builder).
+		ParentID(pool.chain.GenesisBlock().Header().ID()).
+		Timestamp(now).
+		TotalScore(100).
+		GasLimit(10000000).
+		StateRoot(root).
+		Build()
+	pool.chain.AddBlock(b1, nil)
+
+	tx1 := newTx(pool.chain.Tag(), nil, 21000, tx.BlockRef{}, 100, nil, acc)
+	tx2 := newTx(pool.chain.Tag(), nil, 21000, tx.BlockRef{}, 100, nil, acc)
+	assert.Nil(t, pool.Add(tx1))
+	assert.Equal(t, "tx rejected: insufficient energy", pool.Add(tx2).Error())
+	assert.Equal(t, ReasonInsufficientEnergy, Reason(pool.Add(tx2)))
+
+	// bypass admission check
+	txObj2, _ := resolveTx(tx2)
+	pool.all.Fill([]*txObject{txObj2})
+
+	executables, _, err := pool.wash(pool.chain.BestBlock().Header())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(executables))
+	assert.Equal(t, 1, pool.all.Len())
+}
+
+func TestWashUnaffordable(t *testing.T) {
+	pool := newPool()
+	defer pool.Close()
+
+	now := uint64(time.Now().Unix())
+	key, _ := crypto.GenerateKey()
+	acc := genesis.DevAccount{Address: thor.Address(crypto.PubkeyToAddress(key.PublicKey)), PrivateKey: key}
+
+	st, _ := pool.stateCreator.NewState(pool.chain.GenesisBlock().Header().StateRoot())
+	builtin.Energy.Native(st, now).Add(acc.Address, new(big.Int).Mul(big.NewInt(21000*3/2), thor.InitialBaseGasPrice))
+	root, _ := st.Stage().Commit()
+	b1 := new(block.Builder).
+		ParentID(pool.chain.GenesisBlock().Header().ID()).
+		Timestamp(now).
+		TotalScore(100).
+		GasLimit(10000000).
+		StateRoot(root).
+		Build()
+	pool.chain.AddBlock(b1, nil)
+
+	tx1 := newTx(pool.chain.Tag(), nil, 21000, tx.BlockRef{}, 100, nil, acc)
+	assert.Nil(t, pool.AddLocal(tx1))
+
+	// energy spent by others
+	st, _ = pool.stateCreator.NewState(root)
+	st.SetEnergy(acc.Address, &big.Int{}, now)
+	root, _ = st.Stage().Commit()
+	b2 := new(block.Builder).
+		ParentID(b1.Header().ID()).
+		Timestamp(now + thor.BlockInterval).
+		TotalScore(200).
+		GasLimit(10000000).
+		StateRoot(root).
+		Build()
+	pool.chain.AddBlock(b2, nil)
+
+	// evicted even if local
+	executables, _, err := pool.wash(b2.Header())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(executables))
+	assert.Equal(t, 0, pool.all.Len())
+}
+
+func TestContent(t *testing.T) {
+	pool := newPool()
+	defer pool.Close()
+
+	acc0, acc1 := genesis.DevAccounts()[0], genesis.DevAccounts()[1]
+	tx0 := newTx(pool.chain.Tag(), nil, 21000, tx.BlockRef{}, 100, nil, acc0)
+	tx1 := newTx(pool.chain.Tag(), nil, 21000, tx.BlockRef{}, 100, nil, acc1)
+	assert.Nil(t, pool.Add(tx0))
+	assert.Nil(t, pool.Add(tx1))
+
+	content := pool.Content()
+	assert.Equal(t, 2, len(content))
+	for _, p := range content {
+		assert.False(t, p.Executable)
+	}
+
+	pool.executables.Store(Tx.Transactions{tx1})
+	assert.Equal(t, []*PendingTx{
+		{tx1, acc1.Address, true},
+		{tx0, acc0.Address, false},
+	}, pool.Content())
+}
+
+func TestFilter(t *testing.T) {
+	pool := newPool()
+	defer pool.Close()
+
+	acc0, acc1 := genesis.DevAccounts()[0], genesis.DevAccounts()[1]
+	filter, _ := txfilter.New(&txfilter.Rules{Addresses: []thor.Address{acc1.Address}})
+	pool.SetFilter(filter)
+
+	assert.Nil(t, pool.Add(newTx(pool.chain.Tag(), nil, 21000, tx.BlockRef{}, 100, nil, acc0)))
+	err := pool.Add(newTx(pool.chain.Tag(), nil, 21000, tx.BlockRef{}, 100, nil, acc1))
+	assert.True(t, IsTxRejected(err))
+	assert.Equal(t, ReasonDenied, Reason(err))
+}
+
+func TestReinject(t *testing.T) {
+	pool := newPool()
+	defer pool.Close()
+
+	acc0, acc1 := genesis.DevAccounts()[0], genesis.DevAccounts()[1]
+	included := newTx(pool.chain.Tag(), nil, 21000, tx.BlockRef{}, 100, nil, acc0)
+	expired := newTx(pool.chain.Tag(), nil, 21000, tx.BlockRef{}, 0, nil, acc0)
+	valid := newTx(pool.chain.Tag(), nil, 21000, tx.BlockRef{}, 100, nil, acc1)
+
+	b1 := new(block.Builder).
+		ParentID(pool.chain.GenesisBlock().Header().ID()).
+		Timestamp(uint64(time.Now().Unix())).
+		TotalScore(100).
+		GasLimit(10000000).
+		StateRoot(pool.chain.GenesisBlock().Header().StateRoot()).
+		Transaction(included).
+		Build()
+	pool.chain.AddBlock(b1, tx.Receipts{{}})
+
+	assert.Equal(t, 1, pool.Reinject(Tx.Transactions{included, expired, valid}))
+	assert.Equal(t, Tx.Transactions{valid}, pool.Dump())
+}
+
+func TestAddInsufficientSharedPayerEnergy(t *testing.T) {
+	pool := newPool()
+	defer pool.Close()
+
+	now := uint64(time.Now().Unix())
+	newAccount := func() genesis.DevAccount {
+		key, _ := crypto.GenerateKey()
+		return genesis.DevAccount{Address: thor.Address(crypto.PubkeyToAddress(key.PublicKey)), PrivateKey: key}
+	}
+	acc1, acc2 := newAccount(), newAccount()
+	contract := thor.BytesToAddress([]byte("contract"))
+
+	// users of the contract have no energy, and the contract affords only one tx
+	st, _ := pool.stateCreator.NewState(pool.chain.GenesisBlock().Header().StateRoot())
+	builtin.Energy.Native(st, now).Add(contract, new(big.Int).Mul(big.NewInt(21000*3/2), thor.InitialBaseGasPrice))
+	binding := builtin.Prototype.Native(st).Bind(contract)
+	binding.SetCreditPlan(new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e18)), big.NewInt(0))
+	binding.AddUser(acc1.Address, now)
+	binding.AddUser(acc2.Address, now)
+	root, _ := st.Stage().Commit()
+
+	b1 := new(block.Builder).
+		ParentID(pool.chain.GenesisBlock().Header().ID()).
+		Timestamp(now).
+		TotalScore(100).
+		GasLimit(10000000).
+		StateRoot(root).
+		Build()
+	pool.chain.AddBlock(b1, nil)
+
+	clauses := []*tx.Clause{tx.NewClause(&contract)}
+	tx1 := newTx(pool.chain.Tag(), clauses, 21000, tx.BlockRef{}, 100, nil, acc1)
+	tx2 := newTx(pool.chain.Tag(), clauses, 21000, tx.BlockRef{}, 100, nil, acc2)
+	assert.Nil(t, pool.Add(tx1))
+	assert.Equal(t, ReasonInsufficientEnergy, Reason(pool.Add(tx2)))
+}
+
+func TestAddUnaffordable(t *testing.T) {
+	pool := newPool()
+	defer pool.Close()
+
+	b1 := new(block.Builder).
+		ParentID(pool.chain.GenesisBlock().Header().ID()).
+		Timestamp(uint64(time.Now().Unix())).
+		TotalScore(100).
+		GasLimit(10000000).
+		StateRoot(pool.chain.GenesisBlock().Header().StateRoot()).
+		Build()
+	pool.chain.AddBlock(b1, nil)
+
+	key, _ := crypto.GenerateKey()
+	acc := genesis.DevAccount{Address: thor.Address(crypto.PubkeyToAddress(key.PublicKey)), PrivateKey: key}
+
+	// no energy to pay gas
+	tx1 := newTx(pool.chain.Tag(), nil, 21000, tx.BlockRef{}, 100, nil, acc)
+	assert.Equal(t, ReasonInsufficientEnergy, Reason(pool.StrictlyAdd(tx1)))
+	assert.Equal(t, ReasonInsufficientEnergy, Reason(pool.Add(tx1)))
+
+	// short of VET, but may be received later
+	to := thor.BytesToAddress([]byte("to"))
+	dev := genesis.DevAccounts()[0]
+	st, _ := pool.stateCreator.NewState(b1.Header().StateRoot())
+	value := new(big.Int).Add(st.GetBalance(dev.Address), big.NewInt(1))
+	tx2 := newTx(pool.chain.Tag(), []*tx.Clause{tx.NewClause(&to).WithValue(value)}, 21000, tx.BlockRef{}, 100, nil, dev)
+	assert.Equal(t, ReasonInsufficientBalance, Reason(pool.StrictlyAdd(tx2)))
+	assert.Nil(t, pool.Add(tx2))
+	assert.Equal(t, 1, pool.all.Len())
+	assert.Equal(t, 0, len(pool.Executables()))
+}
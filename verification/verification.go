@@ -0,0 +1,124 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package verification verifies contracts against their on-chain code, and stores the sources and ABIs.
+// Sources are not compiled by the node. Bytecode is compiled by the submitter, e.g. from solc's
+// standard JSON input, and the node only checks that it matches the deployed code.
+package verification
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/abi"
+	"github.com/vechain/thor/kv"
+	"github.com/vechain/thor/thor"
+)
+
+var verifiedPrefix = []byte("verified") // (prefix, address) -> contract
+
+type rejectedError struct {
+	msg string
+}
+
+func (e rejectedError) Error() string {
+	return e.msg
+}
+
+// IsRejected returns whether the error is caused by the submitted contract, rather than the store.
+func IsRejected(err error) bool {
+	_, ok := err.(rejectedError)
+	return ok
+}
+
+// Contract a verified contract.
+type Contract struct {
+	CodeHash thor.Bytes32 // hash of the on-chain code verified against
+	ABI      string
+	Source   string
+	Compiler string
+	Settings string // compiler settings, in JSON
+}
+
+// Store persists verified contracts.
+type Store struct {
+	kv kv.GetPutter
+}
+
+// New create a store.
+func New(kv kv.GetPutter) *Store {
+	return &Store{kv}
+}
+
+// Verify checks the compiled runtime bytecode against the on-chain code, and stores the contract if matched.
+// The metadata appended by solc is ignored, since it covers the source file paths and comments.
+// A verified contract is never overwritten, unless it's outdated.
+func (s *Store) Verify(addr thor.Address, code []byte, bytecode []byte, contract *Contract) error {
+	if len(code) == 0 {
+		return rejectedError{"no code deployed"}
+	}
+	codeHash := thor.Bytes32(crypto.Keccak256Hash(code))
+	existing, err := s.Get(addr)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.CodeHash == codeHash {
+		return rejectedError{"already verified"}
+	}
+	if !bytes.Equal(stripMetadata(code), stripMetadata(bytecode)) {
+		return rejectedError{"bytecode mismatch"}
+	}
+	if _, err := abi.New([]byte(contract.ABI)); err != nil {
+		return rejectedError{"abi: " + err.Error()}
+	}
+	contract.CodeHash = codeHash
+
+	data, err := rlp.EncodeToBytes(contract)
+	if err != nil {
+		return err
+	}
+	return s.kv.Put(append(append([]byte{}, verifiedPrefix...), addr.Bytes()...), data)
+}
+
+// Get returns the verified contract at addr, or nil if not verified.
+// The contract is outdated if the code hash differs from the current one, e.g. destroyed and re-deployed.
+func (s *Store) Get(addr thor.Address) (*Contract, error) {
+	data, err := s.kv.Get(append(append([]byte{}, verifiedPrefix...), addr.Bytes()...))
+	if err != nil {
+		if s.kv.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var contract Contract
+	if err := rlp.DecodeBytes(data, &contract); err != nil {
+		return nil, err
+	}
+	return &contract, nil
+}
+
+// ABI returns the ABI of the verified contract at addr, or nil if not verified.
+func (s *Store) ABI(addr thor.Address) (*abi.ABI, error) {
+	contract, err := s.Get(addr)
+	if err != nil || contract == nil {
+		return nil, err
+	}
+	return abi.New([]byte(contract.ABI))
+}
+
+// stripMetadata removes the CBOR encoded metadata appended by solc, which ends with its 2 bytes length.
+func stripMetadata(code []byte) []byte {
+	if len(code) < 2 {
+		return code
+	}
+	n := int(code[len(code)-2])<<8 | int(code[len(code)-1])
+	start := len(code) - 2 - n
+	// the metadata is a CBOR map
+	if n == 0 || start < 0 || code[start]&0xf0 != 0xa0 {
+		return code
+	}
+	return code[:start]
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package verification
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/thor"
+)
+
+func TestVerify(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	store := New(kv)
+	addr := thor.BytesToAddress([]byte("addr"))
+
+	// STOP, followed by metadata {0x65: 0x01} of 3 bytes
+	code := hexutil.MustDecode("0x00a165010003")
+	bytecode := hexutil.MustDecode("0x00a165020003")
+	abiJSON := `[{"constant":true,"inputs":[],"name":"get","outputs":[],"payable":false,"stateMutability":"view","type":"function"}]`
+
+	c, err := store.Get(addr)
+	assert.Nil(t, err)
+	assert.Nil(t, c)
+
+	err = store.Verify(addr, nil, bytecode, &Contract{ABI: abiJSON})
+	assert.True(t, IsRejected(err))
+	err = store.Verify(addr, code, hexutil.MustDecode("0x01"), &Contract{ABI: abiJSON})
+	assert.True(t, IsRejected(err))
+	err = store.Verify(addr, code, bytecode, &Contract{ABI: "{"})
+	assert.True(t, IsRejected(err))
+
+	assert.Nil(t, store.Verify(addr, code, bytecode, &Contract{ABI: abiJSON, Compiler: "0.4.24"}))
+	c, err = store.Get(addr)
+	assert.Nil(t, err)
+	assert.Equal(t, "0.4.24", c.Compiler)
+
+	// never overwritten
+	err = store.Verify(addr, code, bytecode, &Contract{ABI: abiJSON, Compiler: "0.5.0"})
+	assert.True(t, IsRejected(err))
+	c, _ = store.Get(addr)
+	assert.Equal(t, "0.4.24", c.Compiler)
+
+	// unless outdated
+	code = hexutil.MustDecode("0x01a165010003")
+	assert.Nil(t, store.Verify(addr, code, code, &Contract{ABI: abiJSON, Compiler: "0.5.0"}))
+	c, _ = store.Get(addr)
+	assert.Equal(t, "0.5.0", c.Compiler)
+
+	abi, err := store.ABI(addr)
+	assert.Nil(t, err)
+	_, ok := abi.MethodByName("get")
+	assert.True(t, ok)
+}
+
+func TestStripMetadata(t *testing.T) {
+	assert.Equal(t, []byte{0x00}, stripMetadata(hexutil.MustDecode("0x00a165010003")))
+	// not a map
+	assert.Equal(t, hexutil.MustDecode("0x00016501000003"), stripMetadata(hexutil.MustDecode("0x00016501000003")))
+	// length overflow
+	assert.Equal(t, hexutil.MustDecode("0x00ff"), stripMetadata(hexutil.MustDecode("0x00ff")))
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package vm
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Gas costs of account and storage access, when warm/cold access pricing enabled.
+// An account or storage slot is cold until first accessed in the tx, and warm after that.
+const (
+	ColdAccountAccessCost uint64 = 2600
+	ColdSloadCost         uint64 = 2100
+	WarmStorageReadCost   uint64 = 100
+)
+
+type accessSlot struct {
+	addr common.Address
+	slot common.Hash
+}
+
+// AccessList addresses and storage slots accessed in a tx.
+type AccessList struct {
+	addresses map[common.Address]struct{}
+	slots     map[accessSlot]struct{}
+}
+
+// NewAccessList creates an empty access list.
+func NewAccessList() *AccessList {
+	return &AccessList{
+		addresses: make(map[common.Address]struct{}),
+		slots:     make(map[accessSlot]struct{}),
+	}
+}
+
+// ContainsAddress returns whether the address is in the list.
+func (al *AccessList) ContainsAddress(addr common.Address) bool {
+	_, ok := al.addresses[addr]
+	return ok
+}
+
+// ContainsSlot returns whether the storage slot is in the list.
+func (al *AccessList) ContainsSlot(addr common.Address, slot common.Hash) bool {
+	_, ok := al.slots[accessSlot{addr, slot}]
+	return ok
+}
+
+// AddAddress adds the address into the list.
+func (al *AccessList) AddAddress(addr common.Address) {
+	al.addresses[addr] = struct{}{}
+}
+
+// AddSlot adds the storage slot into the list.
+func (al *AccessList) AddSlot(addr common.Address, slot common.Hash) {
+	al.slots[accessSlot{addr, slot}] = struct{}{}
+}
+
+// withWarmColdAccess returns a copy of the jump table, with account and storage access
+// priced by warm/cold.
+func withWarmColdAccess(jt [256]operation) [256]operation {
+	jt[SLOAD].gasCost = gasSLoadWarmCold
+	jt[SSTORE].gasCost = gasSStoreWarmCold
+	jt[SELFDESTRUCT].gasCost = gasSuicideWarmCold
+
+	jt[BALANCE].gasCost = makeAccountAccessGas(jt[BALANCE].gasCost, 0, func(gt *params.GasTable, gas uint64) { gt.Balance = gas })
+	jt[EXTCODESIZE].gasCost = makeAccountAccessGas(jt[EXTCODESIZE].gasCost, 0, func(gt *params.GasTable, gas uint64) { gt.ExtcodeSize = gas })
+	jt[EXTCODECOPY].gasCost = makeAccountAccessGas(jt[EXTCODECOPY].gasCost, 0, func(gt *params.GasTable, gas uint64) { gt.ExtcodeCopy = gas })
+	for _, op := range []OpCode{CALL, CALLCODE, DELEGATECALL, STATICCALL} {
+		if jt[op].valid {
+			// the address is the 2nd item of stack
+			jt[op].gasCost = makeAccountAccessGas(jt[op].gasCost, 1, func(gt *params.GasTable, gas uint64) { gt.Calls = gas })
+		}
+	}
+	return jt
+}
+
+// accountAccessGas returns the gas of accessing the account, and marks it warm.
+func accountAccessGas(evm *EVM, addr common.Address) uint64 {
+	if evm.StateDB.AddressInAccessList(addr) {
+		return WarmStorageReadCost
+	}
+	evm.StateDB.AddAddressToAccessList(addr)
+	return ColdAccountAccessCost
+}
+
+// makeAccountAccessGas wraps the gas func, to replace the static access cost in the gas table with
+// the warm/cold one of the address at the given position of stack.
+func makeAccountAccessGas(fn gasFunc, addrPos int, setCost func(gt *params.GasTable, gas uint64)) gasFunc {
+	return func(gt params.GasTable, evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
+		setCost(&gt, accountAccessGas(evm, common.BigToAddress(stack.Back(addrPos))))
+		return fn(gt, evm, contract, stack, mem, memorySize)
+	}
+}
+
+func gasSLoadWarmCold(gt params.GasTable, evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
+	slot := common.BigToHash(stack.peek())
+	if evm.StateDB.SlotInAccessList(contract.Address(), slot) {
+		return WarmStorageReadCost, nil
+	}
+	evm.StateDB.AddSlotToAccessList(contract.Address(), slot)
+	return ColdSloadCost, nil
+}
+
+func gasSStoreWarmCold(gt params.GasTable, evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
+	var (
+		slot = common.BigToHash(stack.Back(0))
+		cost uint64
+	)
+	if !evm.StateDB.SlotInAccessList(contract.Address(), slot) {
+		evm.StateDB.AddSlotToAccessList(contract.Address(), slot)
+		cost = ColdSloadCost
+	}
+	gas, err := gasSStore(gt, evm, contract, stack, mem, memorySize)
+	if err != nil {
+		return 0, err
+	}
+	if gas != params.SstoreSetGas {
+		// the cold read cost is included in the reset and clear cost
+		gas -= ColdSloadCost
+	}
+	return gas + cost, nil
+}
+
+func gasSuicideWarmCold(gt params.GasTable, evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
+	var (
+		addr = common.BigToAddress(stack.Back(0))
+		cost uint64
+	)
+	if !evm.StateDB.AddressInAccessList(addr) {
+		evm.StateDB.AddAddressToAccessList(addr)
+		cost = ColdAccountAccessCost
+	}
+	gas, err := gasSuicide(gt, evm, contract, stack, mem, memorySize)
+	if err != nil {
+		return 0, err
+	}
+	var overflow bool
+	if gas, overflow = math.SafeAdd(gas, cost); overflow {
+		return 0, errGasUintOverflow
+	}
+	return gas, nil
+}
@@ -20,8 +20,13 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	lru "github.com/hashicorp/golang-lru"
 )
 
+// analysisCache caches results of JUMPDEST analysis across executions (keyed by hash of code),
+// so that hot contracts are not re-analysed on every call.
+var analysisCache, _ = lru.New(4096)
+
 // destinations stores one map per contract (keyed by hash of code).
 // The maps contain an entry for each location of a JUMPDEST
 // instruction.
@@ -38,12 +43,27 @@ func (d destinations) has(codehash common.Hash, code []byte, dest *big.Int) bool
 
 	m, analysed := d[codehash]
 	if !analysed {
-		m = codeBitmap(code)
+		m = analyse(codehash, code)
 		d[codehash] = m
 	}
 	return OpCode(code[udest]) == JUMPDEST && m.codeSegment(udest)
 }
 
+// analyse returns the code bitmap, from cache if possible.
+// The returned bitvec is shared and must not be modified.
+func analyse(codehash common.Hash, code []byte) bitvec {
+	// zero hash is not trustworthy as a cache key
+	if codehash == (common.Hash{}) {
+		return codeBitmap(code)
+	}
+	if cached, ok := analysisCache.Get(codehash); ok {
+		return cached.(bitvec)
+	}
+	m := codeBitmap(code)
+	analysisCache.Add(codehash, m)
+	return m
+}
+
 // bitvec is a bit vector which maps bytes in a program.
 // An unset bit means the byte is an opcode, a set bit means
 // it's data (i.e. argument of PUSHxx).
@@ -16,7 +16,13 @@
 
 package vm
 
-import "testing"
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
 
 func TestJumpDestAnalysis(t *testing.T) {
 	tests := []struct {
@@ -51,3 +57,28 @@ func TestJumpDestAnalysis(t *testing.T) {
 	}
 
 }
+
+func TestAnalyseCache(t *testing.T) {
+	code := []byte{byte(PUSH1), byte(JUMPDEST), byte(JUMPDEST)}
+	codehash := crypto.Keccak256Hash(code)
+
+	m := analyse(codehash, code)
+	if _, ok := analysisCache.Get(codehash); !ok {
+		t.Fatal("analysis result should be cached")
+	}
+	if cached := analyse(codehash, code); &cached[0] != &m[0] {
+		t.Fatal("cached analysis result should be reused")
+	}
+
+	d := make(destinations)
+	if d.has(codehash, code, big.NewInt(1)) {
+		t.Fatal("JUMPDEST in PUSH data should not be valid")
+	}
+	if !d.has(codehash, code, big.NewInt(2)) {
+		t.Fatal("JUMPDEST should be valid")
+	}
+
+	if analyse(common.Hash{}, code); analysisCache.Contains(common.Hash{}) {
+		t.Fatal("zero hash should not be cached")
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package difftest executes plain (non-native) contracts on both thor's VM and go-ethereum's EVM,
+// and compares outputs, gas and logs, to catch consensus-relevant divergences of the forked VM.
+package difftest
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethstate "github.com/ethereum/go-ethereum/core/state"
+	ethvm "github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/runtime/statedb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/vm"
+)
+
+// storage slots [0, MaxStorageKey) are compared after execution.
+const MaxStorageKey = 256
+
+var (
+	// same rules as thor runtime
+	chainConfig = params.ChainConfig{
+		ChainID:        big.NewInt(0),
+		HomesteadBlock: big.NewInt(0),
+		DAOForkBlock:   big.NewInt(0),
+		EIP150Block:    big.NewInt(0),
+		EIP155Block:    big.NewInt(0),
+		EIP158Block:    big.NewInt(0),
+		ByzantiumBlock: big.NewInt(0),
+	}
+
+	contractAddr = common.BytesToAddress([]byte("contract"))
+)
+
+// Input input of an execution.
+type Input struct {
+	Code     []byte
+	Data     []byte
+	Gas      uint64
+	Caller   common.Address
+	GasPrice *big.Int
+	Number   uint64
+	Time     uint64
+	Coinbase common.Address
+	GasLimit uint64
+}
+
+// Log simplified log for comparison.
+type Log struct {
+	Address common.Address
+	Topics  []common.Hash
+	Data    []byte
+}
+
+// Result outcome of an execution.
+type Result struct {
+	Output  []byte
+	GasLeft uint64
+	Err     error
+	Refund  uint64
+	Logs    []*Log
+	Storage map[common.Hash]common.Hash
+}
+
+// RunThor executes the input on thor's VM.
+func RunThor(input *Input) (*Result, error) {
+	kv, err := lvldb.NewMem()
+	if err != nil {
+		return nil, err
+	}
+	defer kv.Close()
+
+	st, err := state.New(thor.Bytes32{}, kv)
+	if err != nil {
+		return nil, err
+	}
+	st.SetCode(thor.Address(contractAddr), input.Code)
+	stateDB := statedb.New(st)
+
+	evm := vm.NewEVM(vm.Context{
+		CanTransfer: func(db vm.StateDB, addr common.Address, amount *big.Int) bool {
+			return db.GetBalance(addr).Cmp(amount) >= 0
+		},
+		Transfer: func(db vm.StateDB, sender, recipient common.Address, amount *big.Int) {
+			db.SubBalance(sender, amount)
+			db.AddBalance(recipient, amount)
+		},
+		GetHash: getHash,
+		NewContractAddress: func(_ *vm.EVM, counter uint32) common.Address {
+			return common.Address(thor.CreateContractAddress(thor.Bytes32{}, 0, counter))
+		},
+		Origin:      input.Caller,
+		GasPrice:    input.GasPrice,
+		Coinbase:    input.Coinbase,
+		GasLimit:    input.GasLimit,
+		BlockNumber: new(big.Int).SetUint64(input.Number),
+		Time:        new(big.Int).SetUint64(input.Time),
+		Difficulty:  &big.Int{},
+	}, stateDB, &chainConfig, vm.Config{})
+
+	output, gasLeft, vmErr := evm.Call(vm.AccountRef(input.Caller), contractAddr, input.Data, input.Gas, &big.Int{})
+
+	events, _ := stateDB.GetLogs()
+	logs := make([]*Log, 0, len(events))
+	for _, ev := range events {
+		topics := make([]common.Hash, 0, len(ev.Topics))
+		for _, t := range ev.Topics {
+			topics = append(topics, common.Hash(t))
+		}
+		logs = append(logs, &Log{common.Address(ev.Address), topics, ev.Data})
+	}
+
+	storage := make(map[common.Hash]common.Hash)
+	for i := 0; i < MaxStorageKey; i++ {
+		key := common.BigToHash(big.NewInt(int64(i)))
+		if v := stateDB.GetState(contractAddr, key); v != (common.Hash{}) {
+			storage[key] = v
+		}
+	}
+	if err := st.Err(); err != nil {
+		return nil, err
+	}
+	return &Result{output, gasLeft, vmErr, stateDB.GetRefund(), logs, storage}, nil
+}
+
+// RunEthereum executes the input on go-ethereum's EVM.
+func RunEthereum(input *Input) (*Result, error) {
+	st, err := ethstate.New(common.Hash{}, ethstate.NewDatabase(ethdb.NewMemDatabase()))
+	if err != nil {
+		return nil, err
+	}
+	st.SetCode(contractAddr, input.Code)
+
+	evm := ethvm.NewEVM(ethvm.Context{
+		CanTransfer: func(db ethvm.StateDB, addr common.Address, amount *big.Int) bool {
+			return db.GetBalance(addr).Cmp(amount) >= 0
+		},
+		Transfer: func(db ethvm.StateDB, sender, recipient common.Address, amount *big.Int) {
+			db.SubBalance(sender, amount)
+			db.AddBalance(recipient, amount)
+		},
+		GetHash:     getHash,
+		Origin:      input.Caller,
+		GasPrice:    input.GasPrice,
+		Coinbase:    input.Coinbase,
+		GasLimit:    input.GasLimit,
+		BlockNumber: new(big.Int).SetUint64(input.Number),
+		Time:        new(big.Int).SetUint64(input.Time),
+		Difficulty:  &big.Int{},
+	}, st, &chainConfig, ethvm.Config{})
+
+	output, gasLeft, vmErr := evm.Call(ethvm.AccountRef(input.Caller), contractAddr, input.Data, input.Gas, &big.Int{})
+
+	logs := make([]*Log, 0)
+	for _, l := range st.Logs() {
+		logs = append(logs, &Log{l.Address, l.Topics, l.Data})
+	}
+
+	storage := make(map[common.Hash]common.Hash)
+	for i := 0; i < MaxStorageKey; i++ {
+		key := common.BigToHash(big.NewInt(int64(i)))
+		if v := st.GetState(contractAddr, key); v != (common.Hash{}) {
+			storage[key] = v
+		}
+	}
+	return &Result{output, gasLeft, vmErr, st.GetRefund(), logs, storage}, nil
+}
+
+// Compare compares two results, and returns the first divergence found.
+func Compare(thorResult, ethResult *Result) error {
+	errString := func(err error) string {
+		if err == nil {
+			return ""
+		}
+		return err.Error()
+	}
+
+	switch {
+	case errString(thorResult.Err) != errString(ethResult.Err):
+		return fmt.Errorf("error: thor %v, ethereum %v", thorResult.Err, ethResult.Err)
+	case !bytes.Equal(thorResult.Output, ethResult.Output):
+		return fmt.Errorf("output: thor %x, ethereum %x", thorResult.Output, ethResult.Output)
+	case thorResult.GasLeft != ethResult.GasLeft:
+		return fmt.Errorf("gas left: thor %v, ethereum %v", thorResult.GasLeft, ethResult.GasLeft)
+	case thorResult.Refund != ethResult.Refund:
+		return fmt.Errorf("refund: thor %v, ethereum %v", thorResult.Refund, ethResult.Refund)
+	case len(thorResult.Logs) != len(ethResult.Logs):
+		return fmt.Errorf("logs count: thor %v, ethereum %v", len(thorResult.Logs), len(ethResult.Logs))
+	}
+
+	for i, tl := range thorResult.Logs {
+		el := ethResult.Logs[i]
+		if tl.Address != el.Address || !bytes.Equal(tl.Data, el.Data) || len(tl.Topics) != len(el.Topics) {
+			return fmt.Errorf("log #%v: thor %+v, ethereum %+v", i, tl, el)
+		}
+		for j := range tl.Topics {
+			if tl.Topics[j] != el.Topics[j] {
+				return fmt.Errorf("log #%v topic #%v: thor %v, ethereum %v", i, j, tl.Topics[j], el.Topics[j])
+			}
+		}
+	}
+
+	if len(thorResult.Storage) != len(ethResult.Storage) {
+		return fmt.Errorf("storage count: thor %v, ethereum %v", len(thorResult.Storage), len(ethResult.Storage))
+	}
+	for k, v := range thorResult.Storage {
+		if ethResult.Storage[k] != v {
+			return fmt.Errorf("storage %v: thor %v, ethereum %v", k, v, ethResult.Storage[k])
+		}
+	}
+	return nil
+}
+
+// Run executes the input on both VMs, and compares results.
+func Run(input *Input) error {
+	thorResult, err := RunThor(input)
+	if err != nil {
+		return err
+	}
+	ethResult, err := RunEthereum(input)
+	if err != nil {
+		return err
+	}
+	return Compare(thorResult, ethResult)
+}
+
+func getHash(num uint64) common.Hash {
+	return crypto.Keccak256Hash(new(big.Int).SetUint64(num).Bytes())
+}
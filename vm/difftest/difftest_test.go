@@ -0,0 +1,70 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package difftest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func newInput(code string) *Input {
+	return &Input{
+		Code:     hexutil.MustDecode(code),
+		Gas:      100000,
+		Caller:   common.BytesToAddress([]byte("caller")),
+		GasPrice: common.Big1,
+		Coinbase: common.BytesToAddress([]byte("coinbase")),
+		GasLimit: 10 * 1000 * 1000,
+	}
+}
+
+func TestRun(t *testing.T) {
+	tests := []string{
+		// PUSH1 1 PUSH1 0 SSTORE, then reset to 0 for refund
+		"0x6001600055600060005500",
+		// MSTORE then LOG2
+		"0x602a60005260aa60bb60206000a2",
+		// MSTORE then REVERT
+		"0x602a60005260206000fd",
+		// MSTORE then RETURN
+		"0x602a60005260206000f3",
+		// invalid jump
+		"0x600356",
+	}
+	for _, code := range tests {
+		assert.Nil(t, Run(newInput(code)), code)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	input := newInput("0x6001600055")
+	thorResult, err := RunThor(input)
+	assert.Nil(t, err)
+	ethResult, err := RunEthereum(input)
+	assert.Nil(t, err)
+	assert.Nil(t, Compare(thorResult, ethResult))
+
+	ethResult.GasLeft++
+	assert.NotNil(t, Compare(thorResult, ethResult))
+}
+
+func TestFuzz(t *testing.T) {
+	n := 2000
+	if testing.Short() {
+		n = 200
+	}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < n; i++ {
+		input := RandomInput(r)
+		if err := Run(input); err != nil {
+			t.Fatalf("divergence on code %x data %x gas %v: %v", input.Code, input.Data, input.Gas, err)
+		}
+	}
+}
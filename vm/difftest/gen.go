@@ -0,0 +1,75 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package difftest
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/vechain/thor/vm"
+)
+
+// opcodes behave identically on both VMs when executed against a single plain contract.
+// Ops touching other accounts, creating contracts or depending on chain data are excluded,
+// since thor deliberately diverges on them (native contracts, address derivation, energy).
+var opcodes = func() []vm.OpCode {
+	ops := []vm.OpCode{
+		vm.STOP, vm.ADD, vm.MUL, vm.SUB, vm.DIV, vm.SDIV, vm.MOD, vm.SMOD, vm.ADDMOD, vm.MULMOD, vm.EXP, vm.SIGNEXTEND,
+		vm.LT, vm.GT, vm.SLT, vm.SGT, vm.EQ, vm.ISZERO, vm.AND, vm.OR, vm.XOR, vm.NOT, vm.BYTE,
+		vm.SHA3,
+		vm.ADDRESS, vm.ORIGIN, vm.CALLER, vm.CALLVALUE, vm.CALLDATALOAD, vm.CALLDATASIZE, vm.CALLDATACOPY,
+		vm.CODESIZE, vm.CODECOPY, vm.GASPRICE, vm.RETURNDATASIZE,
+		vm.COINBASE, vm.TIMESTAMP, vm.NUMBER, vm.GASLIMIT,
+		vm.POP, vm.MLOAD, vm.MSTORE, vm.MSTORE8, vm.SLOAD, vm.SSTORE, vm.JUMP, vm.JUMPI, vm.PC, vm.MSIZE, vm.GAS, vm.JUMPDEST,
+		vm.LOG0, vm.LOG1, vm.LOG2, vm.LOG3, vm.LOG4,
+		vm.RETURN, vm.REVERT,
+	}
+	for i := 0; i < 16; i++ {
+		ops = append(ops, vm.DUP1+vm.OpCode(i), vm.SWAP1+vm.OpCode(i))
+	}
+	return ops
+}()
+
+// RandomCode generates n random instructions.
+// Operands are mostly small pushes, so that storage keys, memory offsets and jump destinations
+// hit meaningful values rather than failing at once.
+func RandomCode(r *rand.Rand, n int) []byte {
+	var code []byte
+	for i := 0; i < n; i++ {
+		switch p := r.Intn(10); {
+		case p < 4:
+			// small value, e.g. storage key, offset, size, or jump dest
+			code = append(code, byte(vm.PUSH1), byte(r.Intn(MaxStorageKey)))
+		case p < 5:
+			size := 1 + r.Intn(32)
+			value := make([]byte, size)
+			r.Read(value)
+			code = append(code, byte(vm.PUSH1)+byte(size-1))
+			code = append(code, value...)
+		default:
+			code = append(code, byte(opcodes[r.Intn(len(opcodes))]))
+		}
+	}
+	return code
+}
+
+// RandomInput generates a random input with random code, call data and gas.
+func RandomInput(r *rand.Rand) *Input {
+	data := make([]byte, r.Intn(100))
+	r.Read(data)
+
+	return &Input{
+		Code:     RandomCode(r, 1+r.Intn(200)),
+		Data:     data,
+		Gas:      uint64(r.Intn(1000 * 1000)),
+		Caller:   common.BytesToAddress([]byte("caller")),
+		GasPrice: common.Big1,
+		Number:   uint64(r.Intn(1000)),
+		Time:     uint64(r.Intn(1000 * 1000)),
+		Coinbase: common.BytesToAddress([]byte("coinbase")),
+		GasLimit: 10 * 1000 * 1000,
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package disasm disassembles contract code and detects selectors of functions it dispatches.
+package disasm
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/vechain/thor/vm"
+)
+
+// Instruction a single instruction in code.
+type Instruction struct {
+	PC  uint64
+	Op  vm.OpCode
+	Arg []byte // immediate data of PUSHn, may be truncated at the end of code
+}
+
+func (i *Instruction) String() string {
+	if len(i.Arg) > 0 {
+		return fmt.Sprintf("%05x: %v %v", i.PC, i.Op, hexutil.Encode(i.Arg))
+	}
+	return fmt.Sprintf("%05x: %v", i.PC, i.Op)
+}
+
+// Disassemble splits code into instructions.
+// Data appended to code (e.g. metadata by compiler) is also decoded as instructions,
+// since it can't be told apart without control flow analysis.
+func Disassemble(code []byte) []Instruction {
+	var instrs []Instruction
+	for pc := uint64(0); pc < uint64(len(code)); pc++ {
+		instr := Instruction{PC: pc, Op: vm.OpCode(code[pc])}
+		if instr.Op.IsPush() {
+			end := pc + 1 + uint64(instr.Op-vm.PUSH1+1)
+			if end > uint64(len(code)) {
+				end = uint64(len(code))
+			}
+			instr.Arg = code[pc+1 : end]
+			pc = end - 1
+		}
+		instrs = append(instrs, instr)
+	}
+	return instrs
+}
+
+// Selectors detects function selectors, by matching the dispatcher pattern emitted by solidity:
+//
+//	PUSH4 <selector> [DUPn] EQ
+//
+// Selectors are returned in order of first appearance, without duplicates.
+func Selectors(instrs []Instruction) [][4]byte {
+	var (
+		selectors [][4]byte
+		seen      = make(map[[4]byte]bool)
+	)
+	for i, instr := range instrs {
+		if instr.Op != vm.PUSH4 || len(instr.Arg) != 4 {
+			continue
+		}
+		next := i + 1
+		if next < len(instrs) && instrs[next].Op >= vm.DUP1 && instrs[next].Op <= vm.DUP16 {
+			next++
+		}
+		if next >= len(instrs) || instrs[next].Op != vm.EQ {
+			continue
+		}
+		var sel [4]byte
+		copy(sel[:], instr.Arg)
+		// 0xffffffff is the mask of selector in calldata
+		if sel == [4]byte{0xff, 0xff, 0xff, 0xff} || seen[sel] {
+			continue
+		}
+		seen[sel] = true
+		selectors = append(selectors, sel)
+	}
+	return selectors
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package disasm
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/vm"
+)
+
+func TestDisassemble(t *testing.T) {
+	// PUSH1 0x80 PUSH1 0x40 MSTORE PUSH2 (truncated)
+	instrs := Disassemble(hexutil.MustDecode("0x608060405261ff"))
+	assert.Equal(t, []Instruction{
+		{0, vm.PUSH1, []byte{0x80}},
+		{2, vm.PUSH1, []byte{0x40}},
+		{4, vm.MSTORE, nil},
+		{5, vm.PUSH2, []byte{0xff}},
+	}, instrs)
+	assert.Equal(t, "00000: PUSH1 0x80", instrs[0].String())
+	assert.Equal(t, "00004: MSTORE", instrs[2].String())
+}
+
+func TestSelectors(t *testing.T) {
+	// dispatcher of solidity 0.4:
+	// PUSH4 0xffffffff AND DUP1 PUSH4 0x6d4ce63c EQ PUSH2 0x0046 JUMPI DUP1 PUSH4 0x60fe47b1 EQ PUSH2 0x0071 JUMPI
+	code := hexutil.MustDecode("0x63ffffffff1680636d4ce63c1461004657806360fe47b11461007157")
+	assert.Equal(t, [][4]byte{
+		{0x6d, 0x4c, 0xe6, 0x3c},
+		{0x60, 0xfe, 0x47, 0xb1},
+	}, Selectors(Disassemble(code)))
+}
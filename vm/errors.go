@@ -25,4 +25,5 @@ var (
 	ErrTraceLimitReached        = errors.New("the number of logs reached the specified limit")
 	ErrInsufficientBalance      = errors.New("insufficient balance for transfer")
 	ErrContractAddressCollision = errors.New("contract address collision")
+	ErrLogMemoryLimitReached    = errors.New("memory held by logs reached the specified limit")
 )
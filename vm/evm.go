@@ -89,6 +89,9 @@ type Context struct {
 	BlockNumber *big.Int       // Provides information for NUMBER
 	Time        *big.Int       // Provides information for TIME
 	Difficulty  *big.Int       // Provides information for DIFFICULTY
+
+	// WarmColdAccess prices account and storage access by whether accessed before in the tx
+	WarmColdAccess bool
 }
 
 // EVM is the Ethereum Virtual Machine base object and provides
@@ -129,6 +132,9 @@ type EVM struct {
 	// contract created during execution.
 	// this value is important for generating contract address.
 	contractCreationCount uint32
+
+	// estimated memory held by logs emitted, including reverted ones.
+	logMemory uint64
 }
 
 // NewEVM returns a new EVM. The returned EVM is not thread safe and should
@@ -362,6 +368,10 @@ func (evm *EVM) Create(caller ContractRef, code []byte, gas uint64, value *big.I
 	// let runtime make new contract address
 	contractAddr = evm.NewContractAddress(evm, evm.contractCreationCount)
 	evm.contractCreationCount++
+	if evm.WarmColdAccess {
+		// added before taking the snapshot, so that it stays warm even if creation failed
+		evm.StateDB.AddAddressToAccessList(contractAddr)
+	}
 
 	//
 	contractHash := evm.StateDB.GetCodeHash(contractAddr)
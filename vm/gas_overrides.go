@@ -0,0 +1,66 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package vm
+
+import (
+	"fmt"
+
+	"github.com/vechain/thor/tx"
+)
+
+// maxOverriddenOpGas the upper bound of overridden gas of an opcode.
+const maxOverriddenOpGas uint64 = 1000000
+
+// GasOverrides customized gas costs, for private networks, e.g. to make execution nearly free
+// while keeping it metered. All nodes of a network must apply identical overrides, or they will
+// fail to reach consensus.
+type GasOverrides struct {
+	Ops       map[OpCode]uint64        // constant gas of opcodes
+	Intrinsic *tx.IntrinsicGasSchedule // intrinsic gas of txs, nil to keep the default
+}
+
+// Validate checks the sanity of overrides. It should be done once the overrides are loaded,
+// since the VM takes them as valid.
+// Only opcodes of constant gas can be overridden, and the gas must be positive, so that
+// loops are always bounded by gas.
+func (o *GasOverrides) Validate() error {
+	for op, gas := range o.Ops {
+		if !isOverridableOp(op) {
+			return fmt.Errorf("gas overrides: gas of %v not overridable", op)
+		}
+		if gas == 0 || gas > maxOverriddenOpGas {
+			return fmt.Errorf("gas overrides: gas %v of %v out of range [1, %v]", gas, op, maxOverriddenOpGas)
+		}
+	}
+	if o.Intrinsic != nil {
+		return o.Intrinsic.Validate()
+	}
+	return nil
+}
+
+// isOverridableOp returns whether gas of the opcode is constant, that's independent of memory,
+// operands or state.
+// Account and storage reads are excluded too, as they are priced by warm/cold access since
+// the fork, and overriding them would drop the access list.
+func isOverridableOp(op OpCode) bool {
+	operation := byzantiumInstructionSet[op]
+	if !operation.valid || operation.memorySize != nil {
+		return false
+	}
+	switch op {
+	case EXP, SSTORE, SELFDESTRUCT, SLOAD, BALANCE, EXTCODESIZE:
+		return false
+	}
+	return true
+}
+
+// withGasOverrides returns a copy of the jump table, with gas of opcodes overridden.
+func withGasOverrides(jt [256]operation, ops map[OpCode]uint64) [256]operation {
+	for op, gas := range ops {
+		jt[op].gasCost = constGasFunc(gas)
+	}
+	return jt
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package vm
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/vechain/thor/tx"
+)
+
+func TestGasOverridesValidate(t *testing.T) {
+	valid := &GasOverrides{
+		Ops:       map[OpCode]uint64{ADD: 1, JUMP: 1},
+		Intrinsic: &tx.IntrinsicGasSchedule{TxGas: 1, ClauseGas: 1, ClauseGasContractCreation: 1},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("expected valid, got %v", err)
+	}
+
+	for _, bad := range []*GasOverrides{
+		{Ops: map[OpCode]uint64{ADD: 0}},
+		{Ops: map[OpCode]uint64{ADD: maxOverriddenOpGas + 1}},
+		{Ops: map[OpCode]uint64{MSTORE: 1}},
+		{Ops: map[OpCode]uint64{SSTORE: 1}},
+		{Ops: map[OpCode]uint64{SLOAD: 1}},
+		{Ops: map[OpCode]uint64{BALANCE: 1}},
+		{Ops: map[OpCode]uint64{CALL: 1}},
+		{Ops: map[OpCode]uint64{OpCode(0xef): 1}},
+		{Intrinsic: &tx.IntrinsicGasSchedule{}},
+	} {
+		if err := bad.Validate(); err == nil {
+			t.Errorf("expected invalid: %+v", bad)
+		}
+	}
+
+	jt := withGasOverrides(byzantiumInstructionSet, valid.Ops)
+	if gas, _ := jt[ADD].gasCost(params.GasTableEIP158, nil, nil, nil, nil, 0); gas != 1 {
+		t.Errorf("expected overridden gas 1, got %v", gas)
+	}
+}
@@ -815,6 +815,14 @@ func makeLog(size int) executionFunc {
 			topics[i] = common.BigToHash(stack.pop())
 		}
 
+		if limit := evm.vmConfig.MaxLogMemory; limit > 0 {
+			evm.logMemory += logMemorySize(size, mSize.Uint64())
+			if evm.logMemory > limit {
+				evm.interpreter.intPool.put(mStart, mSize)
+				return nil, ErrLogMemoryLimitReached
+			}
+		}
+
 		d := memory.Get(mStart.Int64(), mSize.Int64())
 		evm.StateDB.AddLog(&types.Log{
 			Address: contract.Address(),
@@ -830,6 +838,14 @@ func makeLog(size int) executionFunc {
 	}
 }
 
+// logOverheadSize estimated size of a log object, excluding topics and data.
+const logOverheadSize = 256
+
+// logMemorySize estimates memory held by a log.
+func logMemorySize(nTopics int, dataSize uint64) uint64 {
+	return logOverheadSize + uint64(nTopics)*common.HashLength + dataSize
+}
+
 // make push instruction function
 func makePush(size uint64, pushByteSize int) executionFunc {
 	return func(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
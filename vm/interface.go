@@ -61,6 +61,12 @@ type StateDB interface {
 	AddLog(*types.Log)
 	AddPreimage(common.Hash, []byte)
 
+	// access list of the tx, for warm/cold access pricing
+	AddressInAccessList(common.Address) bool
+	SlotInAccessList(common.Address, common.Hash) bool
+	AddAddressToAccessList(common.Address)
+	AddSlotToAccessList(common.Address, common.Hash)
+
 	// ForEachStorage(common.Address, func(common.Hash, common.Hash) bool)
 }
 
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"sync/atomic"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/params"
 )
@@ -35,6 +36,14 @@ type Config struct {
 	NoRecursion bool
 	// Enable recording of SHA3/keccak preimages
 	EnablePreimageRecording bool
+	// MaxLogMemory limits memory held by logs, to protect the node from
+	// contracts emitting massive logs. Exceeding it fails the execution and
+	// consumes all gas. Zero means no limit. It's not a consensus rule, and
+	// should be set only for call simulation.
+	MaxLogMemory uint64
+	// GasOverrides customizes gas costs for private networks. Nil to use the
+	// default. It must have been validated.
+	GasOverrides *GasOverrides
 	// JumpTable contains the EVM instruction table. This
 	// may be left uninitialised and will be set to the default
 	// table.
@@ -72,12 +81,17 @@ func NewInterpreter(evm *EVM, cfg Config) *Interpreter {
 			cfg.JumpTable = frontierInstructionSet
 		}
 	}
+	if evm.WarmColdAccess {
+		cfg.JumpTable = withWarmColdAccess(cfg.JumpTable)
+	}
+	if cfg.GasOverrides != nil {
+		cfg.JumpTable = withGasOverrides(cfg.JumpTable, cfg.GasOverrides.Ops)
+	}
 
 	return &Interpreter{
 		evm:      evm,
 		cfg:      cfg,
 		gasTable: evm.ChainConfig().GasTable(evm.BlockNumber),
-		intPool:  newIntPool(),
 	}
 }
 
@@ -104,6 +118,14 @@ func (in *Interpreter) enforceRestrictions(op OpCode, operation operation, stack
 // considered a revert-and-consume-all-gas operation except for
 // errExecutionReverted which means revert-and-keep-gas-left.
 func (in *Interpreter) Run(contract *Contract, input []byte) (ret []byte, err error) {
+	if in.intPool == nil {
+		in.intPool = poolOfIntPools.get()
+		defer func() {
+			poolOfIntPools.put(in.intPool)
+			in.intPool = nil
+		}()
+	}
+
 	// Increment the call depth which is restricted to 1024
 	in.evm.depth++
 	defer func() { in.evm.depth-- }()
@@ -130,8 +152,8 @@ func (in *Interpreter) Run(contract *Contract, input []byte) (ret []byte, err er
 
 	var (
 		op    OpCode        // current opcode
-		mem   = NewMemory() // bound memory
-		stack = newstack()  // local stack
+		mem   = getMemory() // bound memory
+		stack = getStack()  // local stack
 		// For optimisation reason we're using uint64 as the program counter.
 		// It's theoretically possible to go above 2^64. The YP defines the PC
 		// to be uint256. Practically much less so feasible.
@@ -143,6 +165,15 @@ func (in *Interpreter) Run(contract *Contract, input []byte) (ret []byte, err er
 		logged  bool   // deferred Tracer should ignore already logged steps
 	)
 	contract.Input = input
+	// stack and memory are reused by later executions, so they should be returned after tracer's deferred capture
+	defer returnStack(stack)
+	defer func() {
+		// ret may refer to memory, so it's copied before the memory reused
+		if ret != nil {
+			ret = common.CopyBytes(ret)
+		}
+		returnMemory(mem)
+	}()
 
 	if in.cfg.Debug {
 		defer func() {
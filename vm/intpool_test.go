@@ -0,0 +1,69 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package vm
+
+import (
+	"testing"
+)
+
+func TestIntPoolPoolGet(t *testing.T) {
+	poolOfIntPools.pools = make([]*intPool, 0, poolDefaultCap)
+
+	nip := poolOfIntPools.get()
+	if nip == nil {
+		t.Fatalf("Invalid pool allocation")
+	}
+}
+
+func TestIntPoolPoolPut(t *testing.T) {
+	poolOfIntPools.pools = make([]*intPool, 0, poolDefaultCap)
+
+	nip := poolOfIntPools.get()
+	if len(poolOfIntPools.pools) != 0 {
+		t.Fatalf("Pool got added to list when none should have been")
+	}
+
+	poolOfIntPools.put(nip)
+	if len(poolOfIntPools.pools) == 0 {
+		t.Fatalf("Pool did not get added to list when one should have been")
+	}
+}
+
+func TestIntPoolPoolReUse(t *testing.T) {
+	poolOfIntPools.pools = make([]*intPool, 0, poolDefaultCap)
+	nip := poolOfIntPools.get()
+	poolOfIntPools.put(nip)
+	poolOfIntPools.get()
+
+	if len(poolOfIntPools.pools) != 0 {
+		t.Fatalf("Invalid number of pools. Got %d, expected %d", len(poolOfIntPools.pools), 0)
+	}
+}
+
+func TestMemoryReUse(t *testing.T) {
+	mem := getMemory()
+	mem.Resize(64)
+	mem.Set(0, 3, []byte("abc"))
+	returnMemory(mem)
+	if mem.Len() != 0 || mem.lastGasCost != 0 {
+		t.Fatalf("Memory should be reset when returned")
+	}
+
+	// reused memory is zeroed when resized
+	mem.Resize(32)
+	if mem.Data()[0] != 0 {
+		t.Fatalf("Memory should be zeroed when resized")
+	}
+}
+
+func TestStackReUse(t *testing.T) {
+	st := getStack()
+	st.push(checkVal)
+	returnStack(st)
+	if st.len() != 0 {
+		t.Fatalf("Stack should be reset when returned")
+	}
+}
@@ -16,7 +16,10 @@
 
 package vm
 
-import "fmt"
+import (
+	"fmt"
+	"sync"
+)
 
 // Memory implements a simple memory model for the ethereum virtual machine.
 type Memory struct {
@@ -28,6 +31,32 @@ func NewMemory() *Memory {
 	return &Memory{}
 }
 
+// maxPooledMemorySize memory larger than it is not kept by memoryPool, to bound the pool's footprint.
+const maxPooledMemorySize = 64 * 1024
+
+// memoryPool keeps memory to be reused between executions.
+var memoryPool = sync.Pool{
+	New: func() interface{} {
+		return NewMemory()
+	},
+}
+
+// getMemory returns a memory from memoryPool.
+func getMemory() *Memory {
+	return memoryPool.Get().(*Memory)
+}
+
+// returnMemory resets the memory and puts it back to memoryPool.
+// Slices obtained by GetPtr must not be referenced afterwards.
+func returnMemory(m *Memory) {
+	if cap(m.store) > maxPooledMemorySize {
+		return
+	}
+	m.store = m.store[:0]
+	m.lastGasCost = 0
+	memoryPool.Put(m)
+}
+
 // Set sets offset + size to value
 func (m *Memory) Set(offset, size uint64, value []byte) {
 	// length of store may never be less than offset + size.
@@ -45,8 +74,16 @@ func (m *Memory) Set(offset, size uint64, value []byte) {
 
 // Resize resizes the memory to size
 func (m *Memory) Resize(size uint64) {
-	if uint64(m.Len()) < size {
-		m.store = append(m.store, make([]byte, size-uint64(m.Len()))...)
+	if n := uint64(m.Len()); n < size {
+		if size <= uint64(cap(m.store)) {
+			// reused capacity may be dirty
+			m.store = m.store[:size]
+			for i := n; i < size; i++ {
+				m.store[i] = 0
+			}
+		} else {
+			m.store = append(m.store, make([]byte, size-n)...)
+		}
 	}
 }
 
@@ -68,3 +68,7 @@ func (NoopStateDB) Snapshot() int
 func (NoopStateDB) AddLog(*types.Log)                                                  {}
 func (NoopStateDB) AddPreimage(common.Hash, []byte)                                    {}
 func (NoopStateDB) ForEachStorage(common.Address, func(common.Hash, common.Hash) bool) {}
+func (NoopStateDB) AddressInAccessList(common.Address) bool                            { return false }
+func (NoopStateDB) SlotInAccessList(common.Address, common.Hash) bool                  { return false }
+func (NoopStateDB) AddAddressToAccessList(common.Address)                              {}
+func (NoopStateDB) AddSlotToAccessList(common.Address, common.Hash)                    {}
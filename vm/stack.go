@@ -19,6 +19,7 @@ package vm
 import (
 	"fmt"
 	"math/big"
+	"sync"
 )
 
 // stack is an object for basic stack operations. Items popped to the stack are
@@ -32,6 +33,24 @@ func newstack() *Stack {
 	return &Stack{data: make([]*big.Int, 0, 1024)}
 }
 
+// stackPool keeps stacks to be reused between executions.
+var stackPool = sync.Pool{
+	New: func() interface{} {
+		return newstack()
+	},
+}
+
+// getStack returns a stack from stackPool.
+func getStack() *Stack {
+	return stackPool.Get().(*Stack)
+}
+
+// returnStack resets the stack and puts it back to stackPool.
+func returnStack(st *Stack) {
+	st.data = st.data[:0]
+	stackPool.Put(st)
+}
+
 func (st *Stack) Data() []*big.Int {
 	return st.data
 }
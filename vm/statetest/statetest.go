@@ -0,0 +1,418 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package statetest runs Ethereum GeneralStateTests fixtures against thor's VM.
+//
+// Thor accounts are encoded differently, so thor state roots are never comparable.
+// Instead, the transaction is applied with Ethereum's message semantics on top of thor
+// state, then accounts of the post state are re-encoded into an Ethereum state trie.
+// Both the state root and the logs hash are checked against the fixture.
+package statetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	ethstate "github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
+	"github.com/vechain/thor/lvldb"
+	"github.com/vechain/thor/runtime/statedb"
+	"github.com/vechain/thor/state"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/vm"
+)
+
+// ErrSkipped returned when a subtest relies on semantics thor doesn't share with Ethereum.
+var ErrSkipped = errors.New("skipped")
+
+// forks supported by thor's VM, mapped to chain config.
+var forks = map[string]*params.ChainConfig{
+	"Byzantium": {
+		ChainID:        big.NewInt(1),
+		HomesteadBlock: big.NewInt(0),
+		DAOForkBlock:   big.NewInt(0),
+		EIP150Block:    big.NewInt(0),
+		EIP155Block:    big.NewInt(0),
+		EIP158Block:    big.NewInt(0),
+		ByzantiumBlock: big.NewInt(0),
+	},
+}
+
+// StateTest a single GeneralStateTests case.
+type StateTest struct {
+	Env  stEnv                    `json:"env"`
+	Pre  map[common.Address]stAcc `json:"pre"`
+	Tx   stTransaction            `json:"transaction"`
+	Post map[string][]stPost      `json:"post"`
+}
+
+type stEnv struct {
+	Coinbase   common.Address        `json:"currentCoinbase"`
+	Difficulty *math.HexOrDecimal256 `json:"currentDifficulty"`
+	GasLimit   math.HexOrDecimal64   `json:"currentGasLimit"`
+	Number     math.HexOrDecimal64   `json:"currentNumber"`
+	Timestamp  math.HexOrDecimal64   `json:"currentTimestamp"`
+}
+
+type stAcc struct {
+	Balance *math.HexOrDecimal256 `json:"balance"`
+	Code    hexutil.Bytes         `json:"code"`
+	Nonce   math.HexOrDecimal64   `json:"nonce"`
+	Storage map[string]string     `json:"storage"`
+}
+
+type stTransaction struct {
+	Data      []hexutil.Bytes         `json:"data"`
+	GasLimit  []math.HexOrDecimal64   `json:"gasLimit"`
+	GasPrice  *math.HexOrDecimal256   `json:"gasPrice"`
+	Nonce     math.HexOrDecimal64     `json:"nonce"`
+	SecretKey hexutil.Bytes           `json:"secretKey"`
+	To        string                  `json:"to"`
+	Value     []*math.HexOrDecimal256 `json:"value"`
+}
+
+type stPost struct {
+	Root    common.Hash `json:"hash"`
+	Logs    common.Hash `json:"logs"`
+	Indexes struct {
+		Data  int `json:"data"`
+		Gas   int `json:"gas"`
+		Value int `json:"value"`
+	} `json:"indexes"`
+}
+
+// Subtest identifies one post condition of a state test.
+type Subtest struct {
+	Fork  string
+	Index int
+}
+
+// Result result of a subtest.
+type Result struct {
+	Name string
+	Subtest
+	Err error
+}
+
+// Load loads state tests from a fixture file.
+func Load(path string) (map[string]*StateTest, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var tests map[string]*StateTest
+	if err := json.Unmarshal(data, &tests); err != nil {
+		return nil, errors.Wrap(err, "decode "+path)
+	}
+	return tests, nil
+}
+
+// RunFile runs all subtests in a fixture file.
+func RunFile(path string) ([]*Result, error) {
+	tests, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+	var results []*Result
+	for name, test := range tests {
+		for _, sub := range test.Subtests() {
+			results = append(results, &Result{name, sub, test.Run(sub)})
+		}
+	}
+	return results, nil
+}
+
+// Subtests returns all subtests.
+func (t *StateTest) Subtests() []Subtest {
+	var subs []Subtest
+	for fork, posts := range t.Post {
+		for i := range posts {
+			subs = append(subs, Subtest{fork, i})
+		}
+	}
+	return subs
+}
+
+// Run runs the subtest, and checks post state root and logs hash.
+func (t *StateTest) Run(sub Subtest) error {
+	config, ok := forks[sub.Fork]
+	if !ok {
+		return ErrSkipped
+	}
+	if t.Tx.To == "" || t.usesCreate() {
+		// contract address derivation differs
+		return ErrSkipped
+	}
+	post := t.Post[sub.Fork][sub.Index]
+
+	root, logs, err := t.apply(config, post.Indexes.Data, post.Indexes.Gas, post.Indexes.Value)
+	if err != nil {
+		return err
+	}
+	if root != post.Root {
+		return fmt.Errorf("post state root mismatch: want %v, got %v", post.Root.Hex(), root.Hex())
+	}
+	if hash := rlpHash(logs); hash != post.Logs {
+		return fmt.Errorf("logs hash mismatch: want %v, got %v", post.Logs.Hex(), hash.Hex())
+	}
+	return nil
+}
+
+// usesCreate returns whether any pre code may create contracts.
+func (t *StateTest) usesCreate() bool {
+	for _, acc := range t.Pre {
+		code := acc.Code
+		for pc := 0; pc < len(code); pc++ {
+			op := vm.OpCode(code[pc])
+			if op == vm.CREATE {
+				return true
+			}
+			if op.IsPush() {
+				pc += int(op - vm.PUSH1 + 1)
+			}
+		}
+	}
+	return false
+}
+
+func (t *StateTest) apply(config *params.ChainConfig, dataIndex, gasIndex, valueIndex int) (common.Hash, []*types.Log, error) {
+	kv, err := lvldb.NewMem()
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+	defer kv.Close()
+
+	st, err := state.New(thor.Bytes32{}, kv)
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+	nonces := make(map[common.Address]uint64)
+	for addr, acc := range t.Pre {
+		if err := setAccount(st, thor.Address(addr), &acc); err != nil {
+			return common.Hash{}, nil, err
+		}
+		nonces[addr] = uint64(acc.Nonce)
+	}
+
+	key, err := crypto.ToECDSA(t.Tx.SecretKey)
+	if err != nil {
+		return common.Hash{}, nil, errors.Wrap(err, "secret key")
+	}
+	var (
+		sender   = crypto.PubkeyToAddress(key.PublicKey)
+		to       = common.HexToAddress(t.Tx.To)
+		data     = t.Tx.Data[dataIndex]
+		gas      = uint64(t.Tx.GasLimit[gasIndex])
+		value    = (*big.Int)(t.Tx.Value[valueIndex])
+		gasPrice = (*big.Int)(t.Tx.GasPrice)
+	)
+
+	stateDB := newRecorder(statedb.New(st))
+
+	// invalid txs leave state untouched
+	intrinsicGas, err := core.IntrinsicGas(data, false, true)
+	if err != nil || gas < intrinsicGas || nonces[sender] != uint64(t.Tx.Nonce) {
+		root, err := t.postRoot(st, stateDB, nonces)
+		return root, nil, err
+	}
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(gas), gasPrice)
+	if stateDB.GetBalance(sender).Cmp(new(big.Int).Add(cost, value)) < 0 {
+		root, err := t.postRoot(st, stateDB, nonces)
+		return root, nil, err
+	}
+	stateDB.SubBalance(sender, cost)
+	nonces[sender]++
+
+	evm := vm.NewEVM(vm.Context{
+		CanTransfer: func(db vm.StateDB, addr common.Address, amount *big.Int) bool {
+			return db.GetBalance(addr).Cmp(amount) >= 0
+		},
+		Transfer: func(db vm.StateDB, sender, recipient common.Address, amount *big.Int) {
+			db.SubBalance(sender, amount)
+			db.AddBalance(recipient, amount)
+		},
+		GetHash:     getHash,
+		Origin:      sender,
+		GasPrice:    gasPrice,
+		Coinbase:    t.Env.Coinbase,
+		GasLimit:    uint64(t.Env.GasLimit),
+		BlockNumber: new(big.Int).SetUint64(uint64(t.Env.Number)),
+		Time:        new(big.Int).SetUint64(uint64(t.Env.Timestamp)),
+		Difficulty:  (*big.Int)(t.Env.Difficulty),
+	}, stateDB, config, vm.Config{})
+
+	_, leftOverGas, vmErr := evm.Call(vm.AccountRef(sender), to, data, gas-intrinsicGas, value)
+
+	// refund as Ethereum does, and reward coinbase for gas used
+	refund := (gas - leftOverGas) / 2
+	if r := stateDB.GetRefund(); r < refund {
+		refund = r
+	}
+	leftOverGas += refund
+	stateDB.AddBalance(sender, new(big.Int).Mul(new(big.Int).SetUint64(leftOverGas), gasPrice))
+	stateDB.AddBalance(t.Env.Coinbase, new(big.Int).Mul(new(big.Int).SetUint64(gas-leftOverGas), gasPrice))
+
+	root, err := t.postRoot(st, stateDB, nonces)
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+	if vmErr != nil {
+		// logs are discarded along with reverted state
+		return root, nil, nil
+	}
+
+	events, _ := stateDB.GetLogs()
+	logs := make([]*types.Log, 0, len(events))
+	for _, ev := range events {
+		topics := make([]common.Hash, 0, len(ev.Topics))
+		for _, topic := range ev.Topics {
+			topics = append(topics, common.Hash(topic))
+		}
+		logs = append(logs, &types.Log{
+			Address: common.Address(ev.Address),
+			Topics:  topics,
+			Data:    ev.Data,
+		})
+	}
+	return root, logs, nil
+}
+
+// postRoot re-encodes pre and touched accounts into an Ethereum state trie, and returns its root.
+// Touched accounts which are empty or suicided are deleted, as EIP158 does.
+func (t *StateTest) postRoot(st *state.State, rec *recorder, nonces map[common.Address]uint64) (common.Hash, error) {
+	ethState, err := ethstate.New(common.Hash{}, ethstate.NewDatabase(ethdb.NewMemDatabase()))
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	addrs := make(map[common.Address]bool)
+	for addr := range t.Pre {
+		addrs[addr] = true
+	}
+	for addr := range rec.touched {
+		addrs[addr] = true
+	}
+	for addr := range addrs {
+		var (
+			balance = st.GetBalance(thor.Address(addr))
+			code    = st.GetCode(thor.Address(addr))
+			nonce   = nonces[addr]
+		)
+		if rec.touched[addr] &&
+			(rec.HasSuicided(addr) || (balance.Sign() == 0 && nonce == 0 && len(code) == 0)) {
+			continue
+		}
+		ethState.CreateAccount(addr)
+		ethState.SetBalance(addr, balance)
+		ethState.SetNonce(addr, nonce)
+		ethState.SetCode(addr, code)
+
+		keys := make(map[common.Hash]bool)
+		for k := range t.Pre[addr].Storage {
+			if key, ok := math.ParseBig256(k); ok {
+				keys[common.BigToHash(key)] = true
+			}
+		}
+		for k := range rec.slots[addr] {
+			keys[k] = true
+		}
+		for k := range keys {
+			if v := st.GetStorage(thor.Address(addr), thor.Bytes32(k)); !v.IsZero() {
+				ethState.SetState(addr, k, common.Hash(v))
+			}
+		}
+	}
+	if err := st.Err(); err != nil {
+		return common.Hash{}, err
+	}
+	return ethState.IntermediateRoot(false), nil
+}
+
+// recorder records accounts and storage slots touched during execution, which thor state
+// is unable to enumerate.
+type recorder struct {
+	*statedb.StateDB
+	touched map[common.Address]bool
+	slots   map[common.Address]map[common.Hash]bool
+}
+
+func newRecorder(db *statedb.StateDB) *recorder {
+	return &recorder{
+		db,
+		make(map[common.Address]bool),
+		make(map[common.Address]map[common.Hash]bool),
+	}
+}
+
+func (r *recorder) SubBalance(addr common.Address, amount *big.Int) {
+	r.touched[addr] = true
+	r.StateDB.SubBalance(addr, amount)
+}
+
+func (r *recorder) AddBalance(addr common.Address, amount *big.Int) {
+	r.touched[addr] = true
+	r.StateDB.AddBalance(addr, amount)
+}
+
+func (r *recorder) SetCode(addr common.Address, code []byte) {
+	r.touched[addr] = true
+	r.StateDB.SetCode(addr, code)
+}
+
+func (r *recorder) Suicide(addr common.Address) bool {
+	r.touched[addr] = true
+	return r.StateDB.Suicide(addr)
+}
+
+func (r *recorder) SetState(addr common.Address, key, value common.Hash) {
+	slots := r.slots[addr]
+	if slots == nil {
+		slots = make(map[common.Hash]bool)
+		r.slots[addr] = slots
+	}
+	slots[key] = true
+	r.StateDB.SetState(addr, key, value)
+}
+
+func setAccount(st *state.State, addr thor.Address, acc *stAcc) error {
+	if acc.Balance != nil {
+		st.SetBalance(addr, (*big.Int)(acc.Balance))
+	}
+	if len(acc.Code) > 0 {
+		st.SetCode(addr, acc.Code)
+	}
+	for k, v := range acc.Storage {
+		key, ok := math.ParseBig256(k)
+		if !ok {
+			return fmt.Errorf("invalid storage key %v", k)
+		}
+		value, ok := math.ParseBig256(v)
+		if !ok {
+			return fmt.Errorf("invalid storage value %v", v)
+		}
+		st.SetStorage(addr, thor.BytesToBytes32(key.Bytes()), thor.BytesToBytes32(value.Bytes()))
+	}
+	return nil
+}
+
+func getHash(num uint64) common.Hash {
+	return crypto.Keccak256Hash([]byte(new(big.Int).SetUint64(num).String()))
+}
+
+func rlpHash(x interface{}) common.Hash {
+	data, _ := rlp.EncodeToBytes(x)
+	return crypto.Keccak256Hash(data)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package statetest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestStateTests runs fixtures under testdata, or under the directory given by
+// env STATE_TESTS_DIR, e.g. a checkout of ethereum/tests/GeneralStateTests.
+func TestStateTests(t *testing.T) {
+	dir := os.Getenv("STATE_TESTS_DIR")
+	if dir == "" {
+		dir = "testdata"
+	}
+
+	type counter struct{ pass, fail, skip int }
+	counters := make(map[string]*counter)
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.HasSuffix(path, ".json") {
+			return err
+		}
+		results, err := RunFile(path)
+		if err != nil {
+			return err
+		}
+		for _, r := range results {
+			c := counters[r.Fork]
+			if c == nil {
+				c = &counter{}
+				counters[r.Fork] = c
+			}
+			switch r.Err {
+			case nil:
+				c.pass++
+			case ErrSkipped:
+				c.skip++
+			default:
+				c.fail++
+				t.Errorf("%v %v/%v/%v: %v", path, r.Name, r.Fork, r.Index, r.Err)
+			}
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+
+	for fork, c := range counters {
+		t.Logf("%v: %v passed, %v failed, %v skipped", fork, c.pass, c.fail, c.skip)
+	}
+}
+
+func TestRunSubtest(t *testing.T) {
+	tests, err := Load("testdata/sstore.json")
+	assert.Nil(t, err)
+
+	test := tests["sstore"]
+	assert.Nil(t, test.Run(Subtest{"Byzantium", 0}))
+	assert.Equal(t, ErrSkipped, test.Run(Subtest{"Frontier", 0}))
+
+	test.Post["Byzantium"][0].Root[0] ^= 1
+	assert.NotNil(t, test.Run(Subtest{"Byzantium", 0}))
+	test.Post["Byzantium"][0].Root[0] ^= 1
+
+	test.Post["Byzantium"][0].Logs[0] ^= 1
+	assert.NotNil(t, test.Run(Subtest{"Byzantium", 0}))
+}
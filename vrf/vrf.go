@@ -0,0 +1,223 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package vrf implements verifiable random function over secp256k1,
+// in the way of ECVRF (try-and-increment hash to curve, sha256), so that
+// node master keys can be reused to produce publicly verifiable randomness.
+package vrf
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/secp256k1"
+)
+
+const (
+	suite = 0xfe // private suite id
+
+	// ProofLength length of proof in bytes.
+	ProofLength = 33 + 16 + 32
+)
+
+var (
+	curve   = crypto.S256()
+	params  = curve.Params()
+	fieldP  = params.P
+	curveB  = big.NewInt(7)
+	orderN  = params.N
+	sqrtExp = new(big.Int).Div(new(big.Int).Add(fieldP, big.NewInt(1)), big.NewInt(4)) // (p+1)/4
+
+	errInvalidProof = errors.New("invalid vrf proof")
+)
+
+type point struct {
+	x, y *big.Int
+}
+
+// Prove computes vrf output beta and its proof pi for message alpha.
+func Prove(sk *ecdsa.PrivateKey, alpha []byte) (beta, pi []byte, err error) {
+	h, err := hashToCurve(&point{sk.X, sk.Y}, alpha)
+	if err != nil {
+		return nil, nil, err
+	}
+	skBytes := math32(sk.D)
+
+	// secret scalars multiplied in constant time
+	gamma := secretMul(h, skBytes)
+	k := nonce(skBytes, h)
+	kBytes := math32(k)
+
+	c := hashPoints(h, gamma, secretMul(&point{params.Gx, params.Gy}, kBytes), secretMul(h, kBytes))
+	// s = k + c*sk mod N
+	s := new(big.Int).Mul(c, sk.D)
+	s.Add(s, k).Mod(s, orderN)
+
+	pi = make([]byte, 0, ProofLength)
+	pi = append(pi, compress(gamma)...)
+	pi = append(pi, math16(c)...)
+	pi = append(pi, math32(s)...)
+
+	return gammaToHash(gamma), pi, nil
+}
+
+// Verify checks proof pi against public key and message alpha, and returns vrf output beta.
+func Verify(pk *ecdsa.PublicKey, alpha, pi []byte) (beta []byte, err error) {
+	if len(pi) != ProofLength {
+		return nil, errInvalidProof
+	}
+	gamma, err := decompress(pi[:33])
+	if err != nil {
+		return nil, err
+	}
+	c := new(big.Int).SetBytes(pi[33:49])
+	s := new(big.Int).SetBytes(pi[49:])
+	if s.Cmp(orderN) >= 0 {
+		return nil, errInvalidProof
+	}
+
+	y := &point{pk.X, pk.Y}
+	h, err := hashToCurve(y, alpha)
+	if err != nil {
+		return nil, err
+	}
+
+	// U = s*G - c*Y
+	u, err := sub(baseMul(math32(s)), mul(y, math32(c)))
+	if err != nil {
+		return nil, err
+	}
+	// V = s*H - c*Gamma
+	v, err := sub(mul(h, math32(s)), mul(gamma, math32(c)))
+	if err != nil {
+		return nil, err
+	}
+	if hashPoints(h, gamma, u, v).Cmp(c) != 0 {
+		return nil, errInvalidProof
+	}
+	return gammaToHash(gamma), nil
+}
+
+func hashToCurve(y *point, alpha []byte) (*point, error) {
+	pk := compress(y)
+	for ctr := 0; ctr < 256; ctr++ {
+		hash := sha256.New()
+		hash.Write([]byte{suite, 0x01})
+		hash.Write(pk)
+		hash.Write(alpha)
+		hash.Write([]byte{byte(ctr), 0x00})
+
+		if p, err := decompress(append([]byte{0x02}, hash.Sum(nil)...)); err == nil {
+			return p, nil
+		}
+	}
+	return nil, errors.New("hash to curve failed")
+}
+
+func hashPoints(points ...*point) *big.Int {
+	hash := sha256.New()
+	hash.Write([]byte{suite, 0x02})
+	for _, p := range points {
+		hash.Write(compress(p))
+	}
+	hash.Write([]byte{0x00})
+	return new(big.Int).SetBytes(hash.Sum(nil)[:16])
+}
+
+func gammaToHash(gamma *point) []byte {
+	hash := sha256.New()
+	hash.Write([]byte{suite, 0x03})
+	hash.Write(compress(gamma))
+	hash.Write([]byte{0x00})
+	return hash.Sum(nil)
+}
+
+// nonce derives deterministic nonce from secret key and h.
+func nonce(sk []byte, h *point) *big.Int {
+	for i := byte(0); ; i++ {
+		hash := sha256.New()
+		hash.Write(sk)
+		hash.Write(compress(h))
+		hash.Write([]byte{i})
+		k := new(big.Int).SetBytes(hash.Sum(nil))
+		if k.Sign() > 0 && k.Cmp(orderN) < 0 {
+			return k
+		}
+	}
+}
+
+func baseMul(k []byte) *point {
+	x, y := curve.ScalarBaseMult(k)
+	return &point{x, y}
+}
+
+func mul(p *point, k []byte) *point {
+	x, y := curve.ScalarMult(p.x, p.y, k)
+	return &point{x, y}
+}
+
+// secretMul computes k*p by libsecp256k1, which multiplies in constant time (ecmult_const).
+// It's for secret scalars, regardless of the curve implementation crypto.S256 picks.
+func secretMul(p *point, k []byte) *point {
+	x, y := secp256k1.S256().ScalarMult(p.x, p.y, k)
+	return &point{x, y}
+}
+
+// sub computes a - b. Degenerated cases (result or operands at infinity, or a == b) are rejected.
+func sub(a, b *point) (*point, error) {
+	if a.x.Sign() == 0 || b.x.Sign() == 0 || a.x.Cmp(b.x) == 0 {
+		return nil, errInvalidProof
+	}
+	negY := new(big.Int).Sub(fieldP, b.y)
+	x, y := curve.Add(a.x, a.y, b.x, negY)
+	return &point{x, y}, nil
+}
+
+func compress(p *point) []byte {
+	b := make([]byte, 33)
+	b[0] = byte(0x02 + p.y.Bit(0))
+	copy(b[1:], math32(p.x))
+	return b
+}
+
+func decompress(b []byte) (*point, error) {
+	if len(b) != 33 || (b[0] != 0x02 && b[0] != 0x03) {
+		return nil, errInvalidProof
+	}
+	x := new(big.Int).SetBytes(b[1:])
+	if x.Cmp(fieldP) >= 0 {
+		return nil, errInvalidProof
+	}
+	// y^2 = x^3 + 7
+	rhs := new(big.Int).Exp(x, big.NewInt(3), fieldP)
+	rhs.Add(rhs, curveB).Mod(rhs, fieldP)
+
+	y := new(big.Int).Exp(rhs, sqrtExp, fieldP)
+	if new(big.Int).Exp(y, big.NewInt(2), fieldP).Cmp(rhs) != 0 {
+		return nil, errInvalidProof
+	}
+	if y.Bit(0) != uint(b[0]-0x02) {
+		y.Sub(fieldP, y)
+	}
+	return &point{x, y}, nil
+}
+
+func math32(i *big.Int) []byte {
+	return padded(i, 32)
+}
+
+func math16(i *big.Int) []byte {
+	return padded(i, 16)
+}
+
+func padded(i *big.Int, n int) []byte {
+	b := make([]byte, n)
+	ib := i.Bytes()
+	copy(b[n-len(ib):], ib)
+	return b
+}
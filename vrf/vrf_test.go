@@ -0,0 +1,46 @@
+// Copyright (c) 2018 The VeChainThor developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package vrf_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/vechain/thor/vrf"
+)
+
+func TestProveVerify(t *testing.T) {
+	sk, _ := crypto.GenerateKey()
+	alpha := []byte("alpha")
+
+	beta, pi, err := vrf.Prove(sk, alpha)
+	assert.Nil(t, err)
+	assert.Equal(t, vrf.ProofLength, len(pi))
+
+	// deterministic
+	beta2, pi2, _ := vrf.Prove(sk, alpha)
+	assert.Equal(t, beta, beta2)
+	assert.Equal(t, pi, pi2)
+
+	got, err := vrf.Verify(&sk.PublicKey, alpha, pi)
+	assert.Nil(t, err)
+	assert.Equal(t, beta, got)
+
+	_, err = vrf.Verify(&sk.PublicKey, []byte("beta"), pi)
+	assert.NotNil(t, err)
+
+	other, _ := crypto.GenerateKey()
+	_, err = vrf.Verify(&other.PublicKey, alpha, pi)
+	assert.NotNil(t, err)
+
+	pi[len(pi)-1] ^= 1
+	_, err = vrf.Verify(&sk.PublicKey, alpha, pi)
+	assert.NotNil(t, err)
+
+	_, err = vrf.Verify(&sk.PublicKey, alpha, pi[1:])
+	assert.NotNil(t, err)
+}
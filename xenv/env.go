@@ -38,6 +38,7 @@ type TransactionContext struct {
 	ProvedWork *big.Int
 	BlockRef   tx.BlockRef
 	Expiration uint32
+	AccessList *vm.AccessList // nil if warm/cold access pricing not enabled
 }
 
 // Environment an env to execute native method.